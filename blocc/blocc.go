@@ -15,7 +15,9 @@ const (
 	HeightUnknown        = -1
 	BlockIdMempool       = "mempool"
 	BlockIdMempoolUpdate = "mempool-update"
-	BlockIdTip           = "tip"
+	// Mempool transactions replaced by a conflicting transaction while they are evicted
+	BlockIdMempoolReplaced = "mempool-replaced"
+	BlockIdTip             = "tip"
 
 	SymbolBTC = "btc"
 
@@ -51,6 +53,7 @@ var (
 	ErrMissingBlock     = errors.New("Missing Block")
 	ErrMissingInput     = errors.New("Missing Input")
 	ErrBestChainUnknown = errors.New("Could not determine best block chain")
+	ErrNoTxRelay        = errors.New("No transaction relay")
 )
 
 // BlockChainStore is an interface that is used to get and store blocks and transactions
@@ -73,7 +76,7 @@ type BlockChainStore interface {
 	FlushBlocks(symbol string) error
 	FlushTransactions(symbol string) error
 
-	// This should delete all blocks and transactions above a block height
+	// This should delete all blocks, transactions, outputs and address transactions above a block height and unspend outputs spent above it
 	DeleteAboveBlockHeight(symbol string, above int64) error
 
 	// Return the highest block optionally with status (nil or empty status slice implies any status)
@@ -108,6 +111,30 @@ type BlockChainStore interface {
 	// Find transactions by txids and time period, order by time descending -
 	FindTxs(symbol string, txIds []string, blockId string, dataFields map[string]string, incomplete TxFilterIncomplete, start *time.Time, end *time.Time, include TxInclude, offset int, count int) ([]*Tx, error)
 
+	// Track the state of transaction outputs
+	// Outputs are keyed by TxId and Height and merged with any existing output. The fields describing the output
+	// are only set when BlockId is provided and the spending fields only when SpentTxId is provided. An output spent in
+	// a block will not be replaced by a spend from the mempool.
+	UpsertOutputs(symbol string, outputs []*Output) error
+	// Change the BlockId (and SpentBlockId) of outputs from one to another
+	UpdateOutputBlockIdByBlockId(symbol string, blockId string, newBlockId string) error
+	// This should delete outputs created in blockId and mark outputs spent in blockId as unspent
+	RollbackOutputsByBlockId(symbol string, blockId string) error
+	// Get an output by TxId and Height, the spending fields are the transaction spending it
+	GetOutputByOutPoint(symbol string, txId string, height int64) (*Output, error)
+	// Find unspent outputs (including those only spent in the mempool) by address, ordered by time descending
+	FindUnspentOutputsByAddresses(symbol string, addresses []string, offset int, count int) ([]*Output, error)
+
+	// Track the effect of transactions on addresses
+	// Address transactions are keyed by Address and TxId and replace any existing one, the Address summary is updated to match
+	UpsertAddressTxs(symbol string, atxs []*AddressTx) error
+	// Change the BlockId of address transactions from one to another
+	UpdateAddressTxBlockIdByBlockId(symbol string, blockId string, newBlockId string) error
+	// This should delete address transactions in blockId and remove them from the Address summaries
+	RollbackAddressTxsByBlockId(symbol string, blockId string) error
+	// Return the summary of an address
+	GetAddress(symbol string, address string) (*Address, error)
+
 	// This will calculate the average of a data field between block heights
 	AverageBlockDataFieldByHeight(symbol string, field string, omitZero bool, startHeight int64, endHeight int64) (float64, error)
 	// This will calculate the percentile value of a datafield between block heights
@@ -149,6 +176,76 @@ type TxChannel interface {
 	Close()
 }
 
+// EventBus is an interface to publish and subscribe to block and transaction events
+type EventBus interface {
+	PublishEvent(symbol string, event *Event) error
+	SubscribeEvents(symbol string) (EventChannel, error)
+}
+
+// EventChannel is a MsgBus channel for events
+type EventChannel interface {
+	Channel() <-chan *Event
+	Close()
+}
+
+// TxRelay sends transactions from the API server to be broadcast by the transaction extractor connected to the peer
+type TxRelay interface {
+	// Send a raw transaction and wait up to timeout for it to be relayed, ErrNoTxRelay if nothing is handling them
+	RelayTx(symbol string, raw []byte, timeout time.Duration) error
+	// Call relay for each transaction sent with RelayTx until the handler is closed
+	HandleRelayTxs(symbol string, relay func(raw []byte) error) (TxRelayHandler, error)
+}
+
+// TxRelayHandler is a running TxRelay handler
+type TxRelayHandler interface {
+	Close()
+}
+
+// WebhookStore stores webhooks and a persistent queue of their deliveries
+type WebhookStore interface {
+	InsertWebhook(symbol string, wh *Webhook) error
+	GetWebhook(symbol string, id string) (*Webhook, error)
+	FindWebhooks(symbol string) ([]*Webhook, error)
+	DeleteWebhook(symbol string, id string) error
+
+	// Queue a delivery to be taken at a time, it replaces any delivery with the same id
+	ScheduleWebhookDelivery(symbol string, d *WebhookDelivery, at time.Time) error
+	// Take up to count deliveries due by now, they are scheduled again after lease in case they are never completed
+	TakeWebhookDeliveries(symbol string, now time.Time, lease time.Duration, count int) ([]*WebhookDelivery, error)
+	// Remove a delivery from the queue once it's done
+	DeleteWebhookDelivery(symbol string, id string) error
+	// Remove a delivery from the queue and keep it in the webhook's dead letter list of up to max deliveries
+	DeadLetterWebhookDelivery(symbol string, d *WebhookDelivery, max int) error
+	// Get the dead letter list of a webhook, most recent first
+	GetWebhookDeadLetters(symbol string, webhookId string) ([]*WebhookDelivery, error)
+}
+
+// MemPoolSpends tracks the previous outputs spent by mempool transactions so conflicting transactions (replace-by-fee
+// or double spends) can be detected as they arrive
+type MemPoolSpends interface {
+	// AddTx records the previous outputs spent by a transaction and returns the ids of any other transactions spending them
+	AddTx(tx *Tx) []string
+	// RemoveTx forgets the previous outputs spent by a transaction
+	RemoveTx(txId string)
+	// GetSpender returns the id of the transaction spending an output or a blank string if it's not spent
+	GetSpender(txId string, height int64) string
+	// Clear forgets all transactions
+	Clear()
+}
+
+// MemPoolPackages tracks the parent/child links between mempool transactions to compute their ancestor and descendant
+// packages (child-pays-for-parent). Parents may arrive after their children.
+type MemPoolPackages interface {
+	// AddTx adds a transaction spending parentTxIds and returns the ids of the other transactions whose package changed
+	AddTx(txId string, fee int64, vSize int64, parentTxIds []string) []string
+	// RemoveTx removes a confirmed or evicted transaction and returns the ids of the other transactions whose package changed
+	RemoveTx(txId string) []string
+	// GetPackage returns the package of a transaction or nil if it's not tracked
+	GetPackage(txId string) *TxPackage
+	// Clear forgets all transactions
+	Clear()
+}
+
 // BlockHeaderTxMonitor is an in memory waiter interface that both caches and provides concurrency tools for parsing blocks
 // You can add a BlockHeader or a Tx to the monitor. You can then use the BlockHeaderTxMonitorWaiter to access the data
 type BlockHeaderTxMonitor interface {
@@ -170,6 +267,19 @@ type BlockHeaderTxMonitorWaiter interface {
 	LastBlockHeaderWithHeightTime() time.Time
 }
 
+// MemPoolBlockIds are the blockIds of transactions in the mempool (including those being updated or replaced)
+var MemPoolBlockIds = []string{BlockIdMempool, BlockIdMempoolUpdate, BlockIdMempoolReplaced}
+
+// IsMemPool returns true if the blockId is the mempool (or the mempool being updated or replaced)
+func IsMemPool(blockId string) bool {
+	for _, mempoolBlockId := range MemPoolBlockIds {
+		if blockId == mempoolBlockId {
+			return true
+		}
+	}
+	return false
+}
+
 // When there is something wrong with the block chain, it should return validation error in the error string and can be checked with this
 func IsValidationError(err error) bool {
 	if err == nil {
@@ -678,6 +678,411 @@ func (m *TxOut) GetMetric() map[string]float64 {
 	return nil
 }
 
+// Output - The state of a transaction output, created by one transaction and optionally spent by another
+type Output struct {
+	// Symbol
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Transaction Id
+	TxId string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// Output Height Within The Transaction
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height"`
+	// Block Id of the transaction
+	BlockId string `protobuf:"bytes,4,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	// Block Height of the transaction
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Transaction Time
+	Time int64 `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+	// The transaction output
+	Out *TxOut `protobuf:"bytes,7,opt,name=out,proto3" json:"out,omitempty"`
+	// Spending Transaction Id
+	SpentTxId string `protobuf:"bytes,8,opt,name=spent_tx_id,json=spentTxId,proto3" json:"spent_tx_id,omitempty"`
+	// Input Height Within The Spending Transaction
+	SpentHeight int64 `protobuf:"varint,9,opt,name=spent_height,json=spentHeight,proto3" json:"spent_height,omitempty"`
+	// Block Id of the spending transaction
+	SpentBlockId string `protobuf:"bytes,10,opt,name=spent_block_id,json=spentBlockId,proto3" json:"spent_block_id,omitempty"`
+	// Block Height of the spending transaction
+	SpentBlockHeight int64 `protobuf:"varint,11,opt,name=spent_block_height,json=spentBlockHeight,proto3" json:"spent_block_height,omitempty"`
+}
+
+func (m *Output) Reset()      { *m = Output{} }
+func (*Output) ProtoMessage() {}
+func (*Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_297e677bdf07cca5, []int{5}
+}
+func (m *Output) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Output.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Output.Merge(m, src)
+}
+func (m *Output) XXX_Size() int {
+	return m.Size()
+}
+func (m *Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_Output.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Output proto.InternalMessageInfo
+
+func (m *Output) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *Output) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *Output) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Output) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
+	}
+	return ""
+}
+
+func (m *Output) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *Output) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *Output) GetOut() *TxOut {
+	if m != nil {
+		return m.Out
+	}
+	return nil
+}
+
+func (m *Output) GetSpentTxId() string {
+	if m != nil {
+		return m.SpentTxId
+	}
+	return ""
+}
+
+func (m *Output) GetSpentHeight() int64 {
+	if m != nil {
+		return m.SpentHeight
+	}
+	return 0
+}
+
+func (m *Output) GetSpentBlockId() string {
+	if m != nil {
+		return m.SpentBlockId
+	}
+	return ""
+}
+
+func (m *Output) GetSpentBlockHeight() int64 {
+	if m != nil {
+		return m.SpentBlockHeight
+	}
+	return 0
+}
+
+// AddressTx is the effect of a transaction on an address
+type AddressTx struct {
+	// Symbol
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Transaction Id
+	TxId string `protobuf:"bytes,3,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// Block Id of the transaction
+	BlockId string `protobuf:"bytes,4,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	// Block Height of the transaction
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Transaction Time
+	Time int64 `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+	// The value of the transaction outputs to the address
+	Received int64 `protobuf:"varint,7,opt,name=received,proto3" json:"received,omitempty"`
+	// The value of the transaction inputs from the address
+	Sent int64 `protobuf:"varint,8,opt,name=sent,proto3" json:"sent,omitempty"`
+}
+
+func (m *AddressTx) Reset()      { *m = AddressTx{} }
+func (*AddressTx) ProtoMessage() {}
+func (*AddressTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_297e677bdf07cca5, []int{6}
+}
+func (m *AddressTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTx.Merge(m, src)
+}
+func (m *AddressTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTx proto.InternalMessageInfo
+
+func (m *AddressTx) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *AddressTx) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressTx) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *AddressTx) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
+	}
+	return ""
+}
+
+func (m *AddressTx) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *AddressTx) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *AddressTx) GetReceived() int64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+func (m *AddressTx) GetSent() int64 {
+	if m != nil {
+		return m.Sent
+	}
+	return 0
+}
+
+// Address is the summary of the transactions of an address
+type Address struct {
+	// Symbol
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The count of transactions in blocks
+	TxCount int64 `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count"`
+	// The value received in blocks
+	Received int64 `protobuf:"varint,4,opt,name=received,proto3" json:"received"`
+	// The value sent in blocks
+	Sent int64 `protobuf:"varint,5,opt,name=sent,proto3" json:"sent"`
+	// The confirmed balance
+	Balance int64 `protobuf:"varint,6,opt,name=balance,proto3" json:"balance"`
+	// The count of transactions in the mempool
+	MempoolTxCount int64 `protobuf:"varint,7,opt,name=mempool_tx_count,json=mempoolTxCount,proto3" json:"mempool_tx_count"`
+	// The value received in the mempool
+	MempoolReceived int64 `protobuf:"varint,8,opt,name=mempool_received,json=mempoolReceived,proto3" json:"mempool_received"`
+	// The value sent in the mempool
+	MempoolSent int64 `protobuf:"varint,9,opt,name=mempool_sent,json=mempoolSent,proto3" json:"mempool_sent"`
+	// The change in balance from the mempool
+	MempoolBalance int64 `protobuf:"varint,10,opt,name=mempool_balance,json=mempoolBalance,proto3" json:"mempool_balance"`
+	// The first block with a transaction
+	FirstSeenBlockId string `protobuf:"bytes,11,opt,name=first_seen_block_id,json=firstSeenBlockId,proto3" json:"first_seen_block_id,omitempty"`
+	// The height of the first block with a transaction
+	FirstSeenBlockHeight int64 `protobuf:"varint,12,opt,name=first_seen_block_height,json=firstSeenBlockHeight,proto3" json:"first_seen_block_height,omitempty"`
+	// The last block with a transaction
+	LastSeenBlockId string `protobuf:"bytes,13,opt,name=last_seen_block_id,json=lastSeenBlockId,proto3" json:"last_seen_block_id,omitempty"`
+	// The height of the last block with a transaction
+	LastSeenBlockHeight int64 `protobuf:"varint,14,opt,name=last_seen_block_height,json=lastSeenBlockHeight,proto3" json:"last_seen_block_height,omitempty"`
+}
+
+func (m *Address) Reset()      { *m = Address{} }
+func (*Address) ProtoMessage() {}
+func (*Address) Descriptor() ([]byte, []int) {
+	return fileDescriptor_297e677bdf07cca5, []int{7}
+}
+func (m *Address) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Address) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Address.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Address) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Address.Merge(m, src)
+}
+func (m *Address) XXX_Size() int {
+	return m.Size()
+}
+func (m *Address) XXX_DiscardUnknown() {
+	xxx_messageInfo_Address.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Address proto.InternalMessageInfo
+
+func (m *Address) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *Address) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Address) GetTxCount() int64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *Address) GetReceived() int64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+func (m *Address) GetSent() int64 {
+	if m != nil {
+		return m.Sent
+	}
+	return 0
+}
+
+func (m *Address) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *Address) GetMempoolTxCount() int64 {
+	if m != nil {
+		return m.MempoolTxCount
+	}
+	return 0
+}
+
+func (m *Address) GetMempoolReceived() int64 {
+	if m != nil {
+		return m.MempoolReceived
+	}
+	return 0
+}
+
+func (m *Address) GetMempoolSent() int64 {
+	if m != nil {
+		return m.MempoolSent
+	}
+	return 0
+}
+
+func (m *Address) GetMempoolBalance() int64 {
+	if m != nil {
+		return m.MempoolBalance
+	}
+	return 0
+}
+
+func (m *Address) GetFirstSeenBlockId() string {
+	if m != nil {
+		return m.FirstSeenBlockId
+	}
+	return ""
+}
+
+func (m *Address) GetFirstSeenBlockHeight() int64 {
+	if m != nil {
+		return m.FirstSeenBlockHeight
+	}
+	return 0
+}
+
+func (m *Address) GetLastSeenBlockId() string {
+	if m != nil {
+		return m.LastSeenBlockId
+	}
+	return ""
+}
+
+func (m *Address) GetLastSeenBlockHeight() int64 {
+	if m != nil {
+		return m.LastSeenBlockHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("blocc.BlockInclude", BlockInclude_name, BlockInclude_value)
 	proto.RegisterEnum("blocc.TxInclude", TxInclude_name, TxInclude_value)
@@ -694,69 +1099,94 @@ func init() {
 	proto.RegisterType((*TxOut)(nil), "blocc.TxOut")
 	proto.RegisterMapType((map[string]string)(nil), "blocc.TxOut.DataEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "blocc.TxOut.MetricEntry")
+	proto.RegisterType((*Output)(nil), "blocc.Output")
+	proto.RegisterType((*AddressTx)(nil), "blocc.AddressTx")
+	proto.RegisterType((*Address)(nil), "blocc.Address")
 }
 
 func init() { proto.RegisterFile("blocc/blocc.proto", fileDescriptor_297e677bdf07cca5) }
 
 var fileDescriptor_297e677bdf07cca5 = []byte{
-	// 904 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x8f, 0xdb, 0xc4,
-	0x17, 0xcf, 0x38, 0xce, 0x0f, 0x3f, 0x27, 0x5d, 0xef, 0x64, 0x77, 0xeb, 0xef, 0x7e, 0x85, 0x9d,
-	0x46, 0x42, 0xa4, 0x2b, 0x9a, 0xa0, 0x72, 0x00, 0x7a, 0x0c, 0x45, 0x62, 0x0f, 0xa8, 0x92, 0xc9,
-	0x09, 0x0e, 0xab, 0x89, 0x3d, 0xcd, 0x9a, 0x4d, 0xec, 0x28, 0x19, 0x77, 0x93, 0x1e, 0x10, 0xfc,
-	0x07, 0x1c, 0x39, 0x22, 0x4e, 0xfc, 0x17, 0x5c, 0x39, 0xee, 0xb1, 0x02, 0x29, 0x62, 0xbd, 0x12,
-	0x42, 0x39, 0xf5, 0xcc, 0x09, 0xf9, 0x8d, 0x6b, 0x3b, 0x5b, 0x21, 0x28, 0x20, 0xb1, 0x97, 0x64,
-	0xde, 0xe7, 0x7d, 0xe6, 0xbd, 0x37, 0xef, 0x7d, 0x3c, 0x36, 0xec, 0x8e, 0x26, 0xa1, 0xeb, 0xf6,
-	0xf1, 0xb7, 0x37, 0x9b, 0x87, 0x22, 0xa4, 0x15, 0x34, 0x0e, 0xef, 0x8d, 0x7d, 0x71, 0x1a, 0x8d,
-	0x7a, 0x6e, 0x38, 0xed, 0x8f, 0xc3, 0x71, 0xd8, 0x47, 0xef, 0x28, 0x7a, 0x8c, 0x16, 0x1a, 0xb8,
-	0x92, 0xbb, 0x3a, 0xdf, 0x12, 0xd0, 0x07, 0x93, 0xd0, 0x3d, 0xfb, 0x90, 0x33, 0x8f, 0xcf, 0xe9,
-	0x01, 0x54, 0x17, 0xab, 0xe9, 0x28, 0x9c, 0x98, 0xa4, 0x4d, 0xba, 0x9a, 0x93, 0x5a, 0xf4, 0x7f,
-	0x50, 0x4f, 0xe2, 0x9f, 0x9d, 0xf8, 0x9e, 0xa9, 0xa0, 0xa7, 0x86, 0xf6, 0xb1, 0x47, 0x3b, 0x50,
-	0x3d, 0xe5, 0xfe, 0xf8, 0x54, 0x98, 0xe5, 0x36, 0xe9, 0x96, 0x07, 0xb0, 0x59, 0xdb, 0x29, 0xe2,
-	0xa4, 0xff, 0xb4, 0x03, 0xcd, 0xd9, 0x9c, 0x3f, 0x39, 0xc9, 0x62, 0xa8, 0x18, 0x43, 0x4f, 0xc0,
-	0x41, 0x1a, 0x87, 0x82, 0x2a, 0xfc, 0x29, 0x37, 0x2b, 0x49, 0x14, 0x07, 0xd7, 0x0f, 0xd4, 0xaf,
-	0xbf, 0xb1, 0x4b, 0x9d, 0x5f, 0x54, 0xa8, 0x20, 0xeb, 0xbf, 0x2c, 0xaf, 0x03, 0xcd, 0x80, 0x2f,
-	0x45, 0xce, 0xa9, 0x48, 0x4e, 0x02, 0x5e, 0x3f, 0x42, 0x35, 0x3f, 0x42, 0x52, 0x9a, 0x58, 0x9e,
-	0xb8, 0x61, 0x14, 0x08, 0xb3, 0x86, 0x78, 0x4d, 0x2c, 0xdf, 0x4f, 0x4c, 0x7a, 0x07, 0xd4, 0x85,
-	0xff, 0x94, 0x9b, 0x75, 0x2c, 0xac, 0x19, 0xaf, 0x6d, 0x0d, 0x23, 0x7d, 0xec, 0x3f, 0xe5, 0x0e,
-	0xba, 0xf0, 0xc0, 0x82, 0x89, 0x68, 0x61, 0x6a, 0xe9, 0x81, 0xd1, 0xa2, 0x3d, 0x00, 0x3f, 0x70,
-	0xc3, 0xe9, 0x6c, 0xc2, 0x05, 0x37, 0xa1, 0x4d, 0xba, 0xf5, 0xc1, 0xad, 0xcd, 0xda, 0x2e, 0xa0,
-	0x4e, 0x61, 0x4d, 0xdb, 0x50, 0x15, 0xcb, 0x13, 0xdf, 0x5b, 0x98, 0x7a, 0xbb, 0xdc, 0xd5, 0x06,
-	0xda, 0x66, 0x6d, 0x57, 0x10, 0x71, 0x2a, 0x62, 0x79, 0xec, 0x2d, 0xe8, 0x11, 0x94, 0xe7, 0xec,
-	0xdc, 0x6c, 0xb6, 0x49, 0xb7, 0x31, 0x30, 0x37, 0x6b, 0xbb, 0x39, 0x67, 0xe7, 0x6f, 0x86, 0x53,
-	0x5f, 0xf0, 0xe9, 0x4c, 0xac, 0x7e, 0x5b, 0xdb, 0x65, 0x87, 0x9d, 0x3b, 0x09, 0x89, 0x1e, 0x81,
-	0xea, 0x31, 0xc1, 0xcc, 0x5b, 0xed, 0x72, 0x57, 0xbf, 0x7f, 0xd0, 0x93, 0x3a, 0xc4, 0xda, 0x7b,
-	0x0f, 0x99, 0x60, 0x1f, 0x04, 0x62, 0xbe, 0x72, 0x90, 0x43, 0xdf, 0x82, 0xea, 0x94, 0x8b, 0xb9,
-	0xef, 0x9a, 0x3b, 0xc8, 0x36, 0xb7, 0xd8, 0x1f, 0xa1, 0x4b, 0xf2, 0x53, 0xde, 0xe1, 0x3b, 0xa0,
-	0x65, 0x41, 0xa8, 0x01, 0xe5, 0x33, 0xbe, 0x4a, 0xc7, 0x9d, 0x2c, 0xe9, 0x1e, 0x54, 0x9e, 0xb0,
-	0x49, 0xc4, 0xd3, 0x41, 0x4b, 0xe3, 0x81, 0xf2, 0x2e, 0x39, 0x7c, 0x0f, 0xf4, 0x42, 0xbc, 0x3f,
-	0xdb, 0x4a, 0x0a, 0x5b, 0x53, 0xa1, 0x7d, 0xaf, 0x82, 0x32, 0x5c, 0xfe, 0x1d, 0x95, 0xdd, 0x81,
-	0x86, 0x74, 0x15, 0xb5, 0xe6, 0xe8, 0x23, 0xf9, 0x68, 0xa1, 0xc8, 0x5e, 0x03, 0x90, 0x14, 0x94,
-	0x88, 0x8a, 0x04, 0x0d, 0x91, 0x61, 0xa2, 0x93, 0x16, 0xc8, 0x79, 0xa4, 0xba, 0x52, 0x93, 0xa9,
-	0x24, 0x95, 0xa4, 0x01, 0xa5, 0xa4, 0x5e, 0x08, 0xf6, 0x85, 0xd0, 0x6a, 0x05, 0xa1, 0x59, 0xa9,
-	0x9a, 0x34, 0x29, 0xf3, 0x78, 0x6d, 0x57, 0x87, 0xcb, 0x82, 0x94, 0x5e, 0x55, 0x32, 0xff, 0x07,
-	0xc5, 0x0f, 0x50, 0x2e, 0xfa, 0x7d, 0x3d, 0x1d, 0xda, 0x70, 0x79, 0x1c, 0x38, 0x8a, 0x1f, 0x50,
-	0x0b, 0xca, 0x61, 0x24, 0xcc, 0x06, 0x7a, 0x1b, 0x99, 0xf7, 0x51, 0x24, 0x9c, 0xc4, 0xf1, 0x4a,
-	0x6a, 0x7a, 0x63, 0x4b, 0x4d, 0xad, 0x2c, 0xd8, 0x4b, 0x52, 0xba, 0x77, 0x4d, 0x4a, 0xfb, 0x39,
-	0xf5, 0x86, 0xe8, 0xa8, 0xf3, 0x93, 0x02, 0x6a, 0xd2, 0xa4, 0x7c, 0x9c, 0xa4, 0x30, 0xce, 0xfc,
-	0x2e, 0x52, 0xfe, 0xf0, 0x2e, 0x4a, 0x3b, 0x9b, 0x08, 0xe8, 0x1f, 0x77, 0xf6, 0xee, 0x56, 0x67,
-	0xf7, 0x0b, 0x43, 0x7c, 0xa9, 0xb7, 0xfd, 0x6b, 0xbd, 0xbd, 0x5d, 0x24, 0xdf, 0x94, 0xee, 0xfe,
-	0xa8, 0x40, 0x05, 0x5b, 0x81, 0x0f, 0xc0, 0x6a, 0xc6, 0xb3, 0xee, 0xae, 0x66, 0x9c, 0xde, 0x05,
-	0x8d, 0x79, 0xde, 0x9c, 0x2f, 0x16, 0x7c, 0x61, 0x2a, 0x78, 0xcd, 0xe9, 0x9b, 0xb5, 0x5d, 0x4b,
-	0x41, 0x27, 0xf7, 0xe6, 0x29, 0xe4, 0x73, 0x2a, 0x8d, 0x7f, 0xe1, 0x0a, 0xc4, 0xe2, 0xfe, 0xf2,
-	0x15, 0x28, 0xd9, 0x37, 0xa4, 0xb9, 0x47, 0x5f, 0x12, 0x68, 0xc8, 0x17, 0x59, 0xe0, 0x4e, 0x22,
-	0x8f, 0xd3, 0xdb, 0xd0, 0x2a, 0xda, 0x0f, 0xf9, 0x63, 0x16, 0x4d, 0x84, 0x51, 0xa2, 0x07, 0x40,
-	0x8b, 0x0e, 0xf9, 0xe9, 0x60, 0x10, 0xba, 0x07, 0xc6, 0xd6, 0x06, 0x26, 0x98, 0xa1, 0xd0, 0x16,
-	0xec, 0x14, 0x51, 0x87, 0x9d, 0x1b, 0x2a, 0xdd, 0x87, 0xdd, 0x22, 0x38, 0x4c, 0x5e, 0x41, 0x46,
-	0xfd, 0xe8, 0x73, 0xd0, 0x12, 0xc1, 0xc9, 0xfc, 0x7b, 0x60, 0x64, 0x46, 0x9e, 0xbc, 0x05, 0x3b,
-	0x19, 0x9a, 0x65, 0xde, 0x85, 0x66, 0x4e, 0x95, 0x69, 0x0d, 0x68, 0x64, 0x90, 0xcc, 0xb9, 0x03,
-	0x7a, 0x86, 0x1c, 0x07, 0x46, 0x7d, 0x8b, 0xf2, 0x28, 0x12, 0x86, 0x31, 0xf8, 0xf4, 0xe2, 0xd2,
-	0x2a, 0x3d, 0xbb, 0xb4, 0x4a, 0xcf, 0x2f, 0x2d, 0xf2, 0x45, 0x6c, 0x91, 0xef, 0x62, 0x8b, 0xfc,
-	0x10, 0x5b, 0xe4, 0x22, 0xb6, 0xc8, 0xcf, 0xb1, 0x45, 0x7e, 0x8d, 0xad, 0xd2, 0xf3, 0xd8, 0x22,
-	0x5f, 0x5d, 0x59, 0xa5, 0x8b, 0x2b, 0xab, 0xf4, 0xec, 0xca, 0x2a, 0x7d, 0xf2, 0xfa, 0xd8, 0x17,
-	0x3d, 0x37, 0xf4, 0x83, 0xc0, 0x0f, 0x3e, 0x63, 0xbd, 0x80, 0x8b, 0xfe, 0x88, 0xb9, 0x67, 0x3c,
-	0xf0, 0xfa, 0x85, 0xef, 0xb4, 0x51, 0x15, 0x3f, 0xb9, 0xde, 0xfe, 0x3d, 0x00, 0x00, 0xff, 0xff,
-	0x38, 0xf4, 0xfe, 0x42, 0xbd, 0x09, 0x00, 0x00,
+	// 1249 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x8f, 0xdb, 0xc4,
+	0x17, 0x8f, 0x1d, 0xe7, 0x87, 0x9f, 0x93, 0x8d, 0x3b, 0xd9, 0x6e, 0xfd, 0xdd, 0x2f, 0xd8, 0x69,
+	0x44, 0xd5, 0x74, 0x69, 0x13, 0xd4, 0x0a, 0x01, 0x15, 0x02, 0x11, 0x8a, 0xc4, 0x1e, 0x50, 0x25,
+	0x37, 0x27, 0x38, 0x44, 0x8e, 0x33, 0xdd, 0x9a, 0x75, 0xec, 0x28, 0x1e, 0x77, 0x9d, 0x1e, 0x10,
+	0xfc, 0x05, 0x70, 0xe4, 0x88, 0x38, 0xf1, 0x5f, 0x70, 0xe5, 0xd8, 0x03, 0x87, 0x0a, 0x24, 0x8b,
+	0xa6, 0x12, 0x42, 0x39, 0xf5, 0xcc, 0x09, 0x79, 0x66, 0x62, 0x3b, 0x9b, 0xdd, 0x42, 0x4b, 0x25,
+	0xf6, 0xb2, 0xeb, 0xf7, 0xde, 0x67, 0xde, 0xcf, 0x8f, 0xdf, 0x38, 0x70, 0x6e, 0xe4, 0xfa, 0xb6,
+	0xdd, 0xa3, 0x7f, 0xbb, 0xd3, 0x99, 0x4f, 0x7c, 0x54, 0xa2, 0xc2, 0xee, 0xb5, 0x03, 0x87, 0xdc,
+	0x0b, 0x47, 0x5d, 0xdb, 0x9f, 0xf4, 0x0e, 0xfc, 0x03, 0xbf, 0x47, 0xad, 0xa3, 0xf0, 0x2e, 0x95,
+	0xa8, 0x40, 0x9f, 0xd8, 0xa9, 0xf6, 0xf7, 0x02, 0x28, 0x7d, 0xd7, 0xb7, 0x0f, 0x3f, 0xc6, 0xd6,
+	0x18, 0xcf, 0xd0, 0x0e, 0x94, 0x83, 0xf9, 0x64, 0xe4, 0xbb, 0x9a, 0xd0, 0x12, 0x3a, 0xb2, 0xc9,
+	0x25, 0xf4, 0x3f, 0xa8, 0x26, 0xfe, 0x0f, 0x87, 0xce, 0x58, 0x13, 0xa9, 0xa5, 0x42, 0xe5, 0xfd,
+	0x31, 0x6a, 0x43, 0xf9, 0x1e, 0x76, 0x0e, 0xee, 0x11, 0xad, 0xd8, 0x12, 0x3a, 0xc5, 0x3e, 0x2c,
+	0x63, 0x83, 0x6b, 0x4c, 0xfe, 0x1f, 0xb5, 0xa1, 0x3e, 0x9d, 0xe1, 0xfb, 0xc3, 0xd4, 0x87, 0x44,
+	0x7d, 0x28, 0x89, 0xb2, 0xcf, 0xfd, 0x20, 0x90, 0x88, 0x33, 0xc1, 0x5a, 0x29, 0xf1, 0x62, 0xd2,
+	0xe7, 0x9b, 0xd2, 0xb7, 0xdf, 0x19, 0x85, 0xf6, 0xef, 0x12, 0x94, 0x28, 0xea, 0xbf, 0x4c, 0xaf,
+	0x0d, 0x75, 0x0f, 0x47, 0x24, 0xc3, 0x94, 0x18, 0x26, 0x51, 0x1e, 0x2f, 0xa1, 0x9c, 0x95, 0x90,
+	0xa4, 0x46, 0xa2, 0xa1, 0xed, 0x87, 0x1e, 0xd1, 0x2a, 0x54, 0x5f, 0x21, 0xd1, 0x87, 0x89, 0x88,
+	0x2e, 0x82, 0x14, 0x38, 0x0f, 0xb0, 0x56, 0xa5, 0x89, 0xd5, 0x17, 0xb1, 0x21, 0x53, 0x4f, 0x77,
+	0x9c, 0x07, 0xd8, 0xa4, 0x26, 0x5a, 0x30, 0xb1, 0x48, 0x18, 0x68, 0x32, 0x2f, 0x98, 0x4a, 0xa8,
+	0x0b, 0xe0, 0x78, 0xb6, 0x3f, 0x99, 0xba, 0x98, 0x60, 0x0d, 0x5a, 0x42, 0xa7, 0xda, 0xdf, 0x5a,
+	0xc6, 0x46, 0x4e, 0x6b, 0xe6, 0x9e, 0x51, 0x0b, 0xca, 0x24, 0x1a, 0x3a, 0xe3, 0x40, 0x53, 0x5a,
+	0xc5, 0x8e, 0xdc, 0x97, 0x97, 0xb1, 0x51, 0xa2, 0x1a, 0xb3, 0x44, 0xa2, 0xfd, 0x71, 0x80, 0xf6,
+	0xa0, 0x38, 0xb3, 0x8e, 0xb4, 0x7a, 0x4b, 0xe8, 0xd4, 0xfa, 0xda, 0x32, 0x36, 0xea, 0x33, 0xeb,
+	0xe8, 0xaa, 0x3f, 0x71, 0x08, 0x9e, 0x4c, 0xc9, 0xfc, 0xcf, 0xd8, 0x28, 0x9a, 0xd6, 0x91, 0x99,
+	0x80, 0xd0, 0x1e, 0x48, 0x63, 0x8b, 0x58, 0xda, 0x56, 0xab, 0xd8, 0x51, 0xae, 0xef, 0x74, 0x19,
+	0x0f, 0x69, 0xee, 0xdd, 0x5b, 0x16, 0xb1, 0x3e, 0xf2, 0xc8, 0x6c, 0x6e, 0x52, 0x0c, 0x7a, 0x03,
+	0xca, 0x13, 0x4c, 0x66, 0x8e, 0xad, 0x35, 0x28, 0x5a, 0x5b, 0x43, 0x7f, 0x42, 0x4d, 0x0c, 0xcf,
+	0x71, 0xbb, 0x6f, 0x81, 0x9c, 0x3a, 0x41, 0x2a, 0x14, 0x0f, 0xf1, 0x9c, 0x8f, 0x3b, 0x79, 0x44,
+	0xdb, 0x50, 0xba, 0x6f, 0xb9, 0x21, 0xe6, 0x83, 0x66, 0xc2, 0x4d, 0xf1, 0x6d, 0x61, 0xf7, 0x1d,
+	0x50, 0x72, 0xfe, 0xfe, 0xee, 0xa8, 0x90, 0x3b, 0xca, 0x89, 0xf6, 0xa3, 0x04, 0xe2, 0x20, 0x7a,
+	0x11, 0x96, 0x5d, 0x84, 0x1a, 0x33, 0xe5, 0xb9, 0x66, 0x2a, 0x23, 0xf6, 0x6a, 0x25, 0x2a, 0xf4,
+	0x2a, 0x00, 0x83, 0x50, 0x8a, 0x48, 0x14, 0x20, 0x53, 0xcd, 0x20, 0xe1, 0x49, 0x13, 0xd8, 0x3c,
+	0x38, 0xaf, 0xa4, 0x64, 0x2a, 0x49, 0x26, 0xdc, 0x21, 0xa3, 0x14, 0x97, 0x52, 0xa2, 0x55, 0x72,
+	0x44, 0xd3, 0x39, 0x9b, 0x64, 0x46, 0xf3, 0x45, 0x6c, 0x94, 0x07, 0x51, 0x8e, 0x4a, 0xcf, 0x4b,
+	0x99, 0xff, 0x83, 0xe8, 0x78, 0x94, 0x2e, 0xca, 0x75, 0x85, 0x0f, 0x6d, 0x10, 0xed, 0x7b, 0xa6,
+	0xe8, 0x78, 0x48, 0x87, 0xa2, 0x1f, 0x12, 0xad, 0x46, 0xad, 0xb5, 0xd4, 0x7a, 0x3b, 0x24, 0x66,
+	0x62, 0x78, 0x2e, 0x36, 0x5d, 0x5e, 0x63, 0x53, 0x33, 0x75, 0xb6, 0x41, 0xa5, 0x6b, 0xc7, 0xa8,
+	0x74, 0x3e, 0x83, 0x9e, 0x11, 0x1e, 0xb5, 0x7f, 0x15, 0x41, 0x4a, 0x9a, 0x94, 0x8d, 0x53, 0xc8,
+	0x8d, 0x33, 0xdb, 0x45, 0xe2, 0xa9, 0xbb, 0x88, 0x77, 0x36, 0x21, 0xd0, 0xbf, 0xee, 0xec, 0x95,
+	0xb5, 0xce, 0x9e, 0xcf, 0x0d, 0x71, 0xa3, 0xb7, 0xbd, 0x63, 0xbd, 0xbd, 0x90, 0x07, 0x9f, 0x95,
+	0xee, 0xfe, 0x22, 0x42, 0x89, 0xb6, 0x82, 0xbe, 0x00, 0xf3, 0x29, 0x4e, 0xbb, 0x3b, 0x9f, 0x62,
+	0x74, 0x05, 0x64, 0x6b, 0x3c, 0x9e, 0xe1, 0x20, 0xc0, 0x81, 0x26, 0xd2, 0x35, 0xa7, 0x2c, 0x63,
+	0xa3, 0xc2, 0x95, 0x66, 0x66, 0xcd, 0x42, 0xb0, 0xf7, 0x94, 0x09, 0x2f, 0x61, 0x05, 0xd2, 0xe4,
+	0xfe, 0xf1, 0x0a, 0x64, 0xe8, 0xb3, 0xd2, 0xdc, 0x85, 0x08, 0xe5, 0xdb, 0x21, 0x99, 0x86, 0xe4,
+	0xd4, 0x05, 0x98, 0x92, 0x5a, 0x3c, 0x91, 0xd4, 0xa7, 0x5f, 0xb0, 0xf9, 0xcd, 0x29, 0x3d, 0x7b,
+	0x73, 0x96, 0x36, 0x37, 0xe7, 0x49, 0xd7, 0x2a, 0x7f, 0x4d, 0x2a, 0xa7, 0xbd, 0x26, 0x3a, 0x28,
+	0xc1, 0x14, 0x7b, 0x64, 0xc8, 0x12, 0xae, 0xd2, 0xa0, 0x32, 0x55, 0x0d, 0x22, 0x16, 0x96, 0xd9,
+	0x79, 0x58, 0x99, 0x85, 0xa5, 0x3a, 0x1e, 0xf6, 0x35, 0xd8, 0x62, 0x90, 0x34, 0x75, 0xa0, 0x5e,
+	0xd8, 0xc1, 0xd5, 0x9d, 0x7f, 0x15, 0x50, 0x1e, 0xc5, 0xdd, 0x29, 0xd4, 0x9d, 0x9a, 0x21, 0x99,
+	0xcf, 0xf6, 0xcf, 0x02, 0xc8, 0x1f, 0x30, 0x1a, 0x3e, 0xe3, 0xa2, 0xd1, 0x60, 0x45, 0xda, 0xd5,
+	0x3d, 0xc3, 0xc5, 0x6c, 0x02, 0xc5, 0xdc, 0x04, 0x5e, 0x7e, 0x77, 0x77, 0xa1, 0x3a, 0xc3, 0x36,
+	0x76, 0xee, 0xe3, 0x31, 0xbf, 0x63, 0x52, 0x39, 0xc1, 0x07, 0xd8, 0x23, 0xec, 0xab, 0xc5, 0xa4,
+	0xcf, 0xed, 0xaf, 0x4b, 0x50, 0xe1, 0x65, 0xbd, 0x40, 0x51, 0x97, 0x73, 0x9f, 0x48, 0x8c, 0x43,
+	0xb5, 0x65, 0x6c, 0xa4, 0xba, 0xec, 0x83, 0xa9, 0x93, 0x4b, 0x4b, 0xca, 0x80, 0x2b, 0x5d, 0x2e,
+	0xc9, 0x57, 0x78, 0x92, 0xb4, 0xde, 0x7e, 0x75, 0x19, 0x1b, 0x54, 0x66, 0xe9, 0xa2, 0x4b, 0x50,
+	0x19, 0x59, 0xae, 0xe5, 0xd9, 0xbc, 0x6a, 0xb6, 0x27, 0xb8, 0xca, 0x5c, 0x3d, 0xa0, 0xf7, 0x40,
+	0x9d, 0xe0, 0xc9, 0xd4, 0xf7, 0xdd, 0xe1, 0xfa, 0x27, 0x5c, 0x7f, 0x7b, 0x19, 0x1b, 0x1b, 0x36,
+	0x73, 0x8b, 0x6b, 0x06, 0x3c, 0xdd, 0xf7, 0xb3, 0xf3, 0x69, 0xda, 0xd5, 0xcd, 0xf3, 0x69, 0xfa,
+	0x0d, 0xae, 0x31, 0x57, 0x55, 0xdc, 0x80, 0xda, 0x0a, 0x44, 0xab, 0x61, 0x57, 0xbb, 0xba, 0x8c,
+	0x8d, 0x35, 0xbd, 0xa9, 0x70, 0xe9, 0x4e, 0x52, 0xdc, 0xbb, 0xb0, 0xf2, 0x33, 0x5c, 0x15, 0x09,
+	0xf4, 0x5c, 0x73, 0x19, 0x1b, 0xc7, 0x4d, 0x69, 0xce, 0x7d, 0x5e, 0xf3, 0x35, 0x68, 0xde, 0x75,
+	0x66, 0x01, 0x19, 0x06, 0x18, 0x7b, 0x19, 0xf3, 0x15, 0x3a, 0x31, 0x95, 0x9a, 0xee, 0x60, 0xec,
+	0xad, 0xd8, 0xff, 0x26, 0x5c, 0xd8, 0x80, 0x73, 0xaa, 0xd5, 0x28, 0x3f, 0xb6, 0xd7, 0x8f, 0x70,
+	0xce, 0xbd, 0x0e, 0xc8, 0xb5, 0x36, 0x82, 0xd4, 0x69, 0x90, 0x86, 0x6b, 0xe5, 0x0e, 0xec, 0x27,
+	0x5d, 0xd8, 0x71, 0xad, 0x13, 0x43, 0x6c, 0xd1, 0x10, 0x4d, 0xd7, 0xda, 0x88, 0xb0, 0xf7, 0x95,
+	0x00, 0x35, 0xe6, 0xc0, 0xb3, 0xdd, 0x70, 0x8c, 0xd1, 0x05, 0x68, 0xe6, 0xe5, 0x5b, 0xf8, 0xae,
+	0x15, 0xba, 0x44, 0x2d, 0xa0, 0x1d, 0x40, 0x79, 0x03, 0xfb, 0x21, 0xa4, 0x0a, 0x68, 0x1b, 0xd4,
+	0xb5, 0x03, 0x16, 0xb1, 0x54, 0x11, 0x35, 0xa1, 0x91, 0xd7, 0x9a, 0xd6, 0x91, 0x2a, 0xa1, 0xf3,
+	0x70, 0x2e, 0xaf, 0x4c, 0x16, 0x4c, 0xa0, 0x56, 0xf7, 0xbe, 0x00, 0x79, 0x10, 0x71, 0x5d, 0xe2,
+	0x6e, 0x10, 0x6d, 0x04, 0x6f, 0x42, 0x63, 0x10, 0x1d, 0x8f, 0x7c, 0x0e, 0xea, 0x83, 0x68, 0x3d,
+	0xac, 0x0a, 0xb5, 0x41, 0xb4, 0x16, 0xb3, 0x01, 0x4a, 0xaa, 0xd9, 0xf7, 0xd4, 0xea, 0x1a, 0xe4,
+	0x76, 0x48, 0x54, 0xb5, 0xff, 0xd9, 0xc3, 0xc7, 0x7a, 0xe1, 0xd1, 0x63, 0xbd, 0xf0, 0xf4, 0xb1,
+	0x2e, 0x7c, 0xb9, 0xd0, 0x85, 0x1f, 0x16, 0xba, 0xf0, 0xd3, 0x42, 0x17, 0x1e, 0x2e, 0x74, 0xe1,
+	0xb7, 0x85, 0x2e, 0xfc, 0xb1, 0xd0, 0x0b, 0x4f, 0x17, 0xba, 0xf0, 0xcd, 0x13, 0xbd, 0xf0, 0xf0,
+	0x89, 0x5e, 0x78, 0xf4, 0x44, 0x2f, 0x7c, 0x7a, 0xe9, 0xc0, 0x21, 0x5d, 0xdb, 0x77, 0x3c, 0xcf,
+	0xf1, 0x3e, 0xb7, 0xba, 0x1e, 0x26, 0xbd, 0x91, 0x65, 0x1f, 0x62, 0x6f, 0xdc, 0xcb, 0xfd, 0xea,
+	0x1c, 0x95, 0xe9, 0x0f, 0xc8, 0x1b, 0x7f, 0x0d, 0x00, 0x90, 0x8e, 0x5a, 0xfb, 0x8b, 0x0e, 0x00,
+	0x00,
 }
 
 func (x BlockInclude) String() string {
@@ -1073,7 +1503,169 @@ func (this *TxOut) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *BlockHeader) GoString() string {
+func (this *Output) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Output)
+	if !ok {
+		that2, ok := that.(Output)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.BlockId != that1.BlockId {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if !this.Out.Equal(that1.Out) {
+		return false
+	}
+	if this.SpentTxId != that1.SpentTxId {
+		return false
+	}
+	if this.SpentHeight != that1.SpentHeight {
+		return false
+	}
+	if this.SpentBlockId != that1.SpentBlockId {
+		return false
+	}
+	if this.SpentBlockHeight != that1.SpentBlockHeight {
+		return false
+	}
+	return true
+}
+func (this *AddressTx) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressTx)
+	if !ok {
+		that2, ok := that.(AddressTx)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if this.BlockId != that1.BlockId {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if this.Received != that1.Received {
+		return false
+	}
+	if this.Sent != that1.Sent {
+		return false
+	}
+	return true
+}
+func (this *Address) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Address)
+	if !ok {
+		that2, ok := that.(Address)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.TxCount != that1.TxCount {
+		return false
+	}
+	if this.Received != that1.Received {
+		return false
+	}
+	if this.Sent != that1.Sent {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	if this.MempoolTxCount != that1.MempoolTxCount {
+		return false
+	}
+	if this.MempoolReceived != that1.MempoolReceived {
+		return false
+	}
+	if this.MempoolSent != that1.MempoolSent {
+		return false
+	}
+	if this.MempoolBalance != that1.MempoolBalance {
+		return false
+	}
+	if this.FirstSeenBlockId != that1.FirstSeenBlockId {
+		return false
+	}
+	if this.FirstSeenBlockHeight != that1.FirstSeenBlockHeight {
+		return false
+	}
+	if this.LastSeenBlockId != that1.LastSeenBlockId {
+		return false
+	}
+	if this.LastSeenBlockHeight != that1.LastSeenBlockHeight {
+		return false
+	}
+	return true
+}
+func (this *BlockHeader) GoString() string {
 	if this == nil {
 		return "nil"
 	}
@@ -1265,6 +1857,68 @@ func (this *TxOut) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *Output) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&blocc.Output{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "Height: "+fmt.Sprintf("%#v", this.Height)+",\n")
+	s = append(s, "BlockId: "+fmt.Sprintf("%#v", this.BlockId)+",\n")
+	s = append(s, "BlockHeight: "+fmt.Sprintf("%#v", this.BlockHeight)+",\n")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	if this.Out != nil {
+		s = append(s, "Out: "+fmt.Sprintf("%#v", this.Out)+",\n")
+	}
+	s = append(s, "SpentTxId: "+fmt.Sprintf("%#v", this.SpentTxId)+",\n")
+	s = append(s, "SpentHeight: "+fmt.Sprintf("%#v", this.SpentHeight)+",\n")
+	s = append(s, "SpentBlockId: "+fmt.Sprintf("%#v", this.SpentBlockId)+",\n")
+	s = append(s, "SpentBlockHeight: "+fmt.Sprintf("%#v", this.SpentBlockHeight)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddressTx) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&blocc.AddressTx{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "BlockId: "+fmt.Sprintf("%#v", this.BlockId)+",\n")
+	s = append(s, "BlockHeight: "+fmt.Sprintf("%#v", this.BlockHeight)+",\n")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	s = append(s, "Received: "+fmt.Sprintf("%#v", this.Received)+",\n")
+	s = append(s, "Sent: "+fmt.Sprintf("%#v", this.Sent)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Address) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 18)
+	s = append(s, "&blocc.Address{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "TxCount: "+fmt.Sprintf("%#v", this.TxCount)+",\n")
+	s = append(s, "Received: "+fmt.Sprintf("%#v", this.Received)+",\n")
+	s = append(s, "Sent: "+fmt.Sprintf("%#v", this.Sent)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "MempoolTxCount: "+fmt.Sprintf("%#v", this.MempoolTxCount)+",\n")
+	s = append(s, "MempoolReceived: "+fmt.Sprintf("%#v", this.MempoolReceived)+",\n")
+	s = append(s, "MempoolSent: "+fmt.Sprintf("%#v", this.MempoolSent)+",\n")
+	s = append(s, "MempoolBalance: "+fmt.Sprintf("%#v", this.MempoolBalance)+",\n")
+	s = append(s, "FirstSeenBlockId: "+fmt.Sprintf("%#v", this.FirstSeenBlockId)+",\n")
+	s = append(s, "FirstSeenBlockHeight: "+fmt.Sprintf("%#v", this.FirstSeenBlockHeight)+",\n")
+	s = append(s, "LastSeenBlockId: "+fmt.Sprintf("%#v", this.LastSeenBlockId)+",\n")
+	s = append(s, "LastSeenBlockHeight: "+fmt.Sprintf("%#v", this.LastSeenBlockHeight)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringBlocc(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1750,62 +2404,299 @@ func (m *TxOut) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintBlocc(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Output) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *BlockHeader) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Output) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovBlocc(uint64(l))
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
 	}
-	l = len(m.BlockId)
-	if l > 0 {
-		n += 1 + l + sovBlocc(uint64(l))
+	if len(m.TxId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(len(m.TxId)))
+		i += copy(dAtA[i:], m.TxId)
 	}
 	if m.Height != 0 {
-		n += 1 + sovBlocc(uint64(m.Height))
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.Height))
 	}
-	l = len(m.PrevBlockId)
-	if l > 0 {
-		n += 1 + l + sovBlocc(uint64(l))
+	if len(m.BlockId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(len(m.BlockId)))
+		i += copy(dAtA[i:], m.BlockId)
+	}
+	if m.BlockHeight != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.BlockHeight))
 	}
 	if m.Time != 0 {
-		n += 1 + sovBlocc(uint64(m.Time))
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.Time))
 	}
-	return n
-}
-
-func (m *Block) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Out != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.Out.Size()))
+		n2, err := m.Out.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
 	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovBlocc(uint64(l))
+	if len(m.SpentTxId) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(len(m.SpentTxId)))
+		i += copy(dAtA[i:], m.SpentTxId)
 	}
-	l = len(m.BlockId)
-	if l > 0 {
-		n += 1 + l + sovBlocc(uint64(l))
+	if m.SpentHeight != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.SpentHeight))
 	}
-	if m.Height != 0 {
-		n += 1 + sovBlocc(uint64(m.Height))
+	if len(m.SpentBlockId) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(len(m.SpentBlockId)))
+		i += copy(dAtA[i:], m.SpentBlockId)
 	}
-	l = len(m.PrevBlockId)
-	if l > 0 {
-		n += 1 + l + sovBlocc(uint64(l))
+	if m.SpentBlockHeight != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.SpentBlockHeight))
+	}
+	return i, nil
+}
+
+func (m *AddressTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressTx) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if len(m.TxId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(len(m.TxId)))
+		i += copy(dAtA[i:], m.TxId)
+	}
+	if len(m.BlockId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(len(m.BlockId)))
+		i += copy(dAtA[i:], m.BlockId)
+	}
+	if m.BlockHeight != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.BlockHeight))
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.Time))
+	}
+	if m.Received != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.Received))
+	}
+	if m.Sent != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.Sent))
+	}
+	return i, nil
+}
+
+func (m *Address) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Address) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if m.TxCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.TxCount))
+	}
+	if m.Received != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.Received))
+	}
+	if m.Sent != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.Sent))
+	}
+	if m.Balance != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.Balance))
+	}
+	if m.MempoolTxCount != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.MempoolTxCount))
+	}
+	if m.MempoolReceived != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.MempoolReceived))
+	}
+	if m.MempoolSent != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.MempoolSent))
+	}
+	if m.MempoolBalance != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.MempoolBalance))
+	}
+	if len(m.FirstSeenBlockId) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(len(m.FirstSeenBlockId)))
+		i += copy(dAtA[i:], m.FirstSeenBlockId)
+	}
+	if m.FirstSeenBlockHeight != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.FirstSeenBlockHeight))
+	}
+	if len(m.LastSeenBlockId) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(len(m.LastSeenBlockId)))
+		i += copy(dAtA[i:], m.LastSeenBlockId)
+	}
+	if m.LastSeenBlockHeight != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintBlocc(dAtA, i, uint64(m.LastSeenBlockHeight))
+	}
+	return i, nil
+}
+
+func encodeVarintBlocc(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *BlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	l = len(m.BlockId)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBlocc(uint64(m.Height))
+	}
+	l = len(m.PrevBlockId)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovBlocc(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *Block) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	l = len(m.BlockId)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBlocc(uint64(m.Height))
+	}
+	l = len(m.PrevBlockId)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
 	}
 	l = len(m.NextBlockId)
 	if l > 0 {
@@ -2009,76 +2900,216 @@ func (m *TxOut) Size() (n int) {
 	return n
 }
 
-func sovBlocc(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *Output) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	return n
-}
-func sozBlocc(x uint64) (n int) {
-	return sovBlocc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *BlockHeader) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
 	}
-	s := strings.Join([]string{`&BlockHeader{`,
-		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
-		`BlockId:` + fmt.Sprintf("%v", this.BlockId) + `,`,
-		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
-		`PrevBlockId:` + fmt.Sprintf("%v", this.PrevBlockId) + `,`,
-		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Block) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
 	}
-	keysForData := make([]string, 0, len(this.Data))
-	for k, _ := range this.Data {
-		keysForData = append(keysForData, k)
+	if m.Height != 0 {
+		n += 1 + sovBlocc(uint64(m.Height))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForData)
-	mapStringForData := "map[string]string{"
-	for _, k := range keysForData {
-		mapStringForData += fmt.Sprintf("%v: %v,", k, this.Data[k])
+	l = len(m.BlockId)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
 	}
-	mapStringForData += "}"
-	keysForMetric := make([]string, 0, len(this.Metric))
-	for k, _ := range this.Metric {
-		keysForMetric = append(keysForMetric, k)
+	if m.BlockHeight != 0 {
+		n += 1 + sovBlocc(uint64(m.BlockHeight))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForMetric)
-	mapStringForMetric := "map[string]float64{"
-	for _, k := range keysForMetric {
-		mapStringForMetric += fmt.Sprintf("%v: %v,", k, this.Metric[k])
+	if m.Time != 0 {
+		n += 1 + sovBlocc(uint64(m.Time))
 	}
-	mapStringForMetric += "}"
-	s := strings.Join([]string{`&Block{`,
-		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
-		`BlockId:` + fmt.Sprintf("%v", this.BlockId) + `,`,
-		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
-		`PrevBlockId:` + fmt.Sprintf("%v", this.PrevBlockId) + `,`,
-		`NextBlockId:` + fmt.Sprintf("%v", this.NextBlockId) + `,`,
-		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
-		`TxCount:` + fmt.Sprintf("%v", this.TxCount) + `,`,
-		`BlockSize:` + fmt.Sprintf("%v", this.BlockSize) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Incomplete:` + fmt.Sprintf("%v", this.Incomplete) + `,`,
-		`TxIds:` + fmt.Sprintf("%v", this.TxIds) + `,`,
-		`Raw:` + fmt.Sprintf("%v", this.Raw) + `,`,
-		`Data:` + mapStringForData + `,`,
-		`Metric:` + mapStringForMetric + `,`,
-		`}`,
-	}, "")
-	return s
-}
+	if m.Out != nil {
+		l = m.Out.Size()
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	l = len(m.SpentTxId)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	if m.SpentHeight != 0 {
+		n += 1 + sovBlocc(uint64(m.SpentHeight))
+	}
+	l = len(m.SpentBlockId)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	if m.SpentBlockHeight != 0 {
+		n += 1 + sovBlocc(uint64(m.SpentBlockHeight))
+	}
+	return n
+}
+
+func (m *AddressTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	l = len(m.BlockId)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovBlocc(uint64(m.BlockHeight))
+	}
+	if m.Time != 0 {
+		n += 1 + sovBlocc(uint64(m.Time))
+	}
+	if m.Received != 0 {
+		n += 1 + sovBlocc(uint64(m.Received))
+	}
+	if m.Sent != 0 {
+		n += 1 + sovBlocc(uint64(m.Sent))
+	}
+	return n
+}
+
+func (m *Address) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovBlocc(uint64(m.TxCount))
+	}
+	if m.Received != 0 {
+		n += 1 + sovBlocc(uint64(m.Received))
+	}
+	if m.Sent != 0 {
+		n += 1 + sovBlocc(uint64(m.Sent))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovBlocc(uint64(m.Balance))
+	}
+	if m.MempoolTxCount != 0 {
+		n += 1 + sovBlocc(uint64(m.MempoolTxCount))
+	}
+	if m.MempoolReceived != 0 {
+		n += 1 + sovBlocc(uint64(m.MempoolReceived))
+	}
+	if m.MempoolSent != 0 {
+		n += 1 + sovBlocc(uint64(m.MempoolSent))
+	}
+	if m.MempoolBalance != 0 {
+		n += 1 + sovBlocc(uint64(m.MempoolBalance))
+	}
+	l = len(m.FirstSeenBlockId)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	if m.FirstSeenBlockHeight != 0 {
+		n += 1 + sovBlocc(uint64(m.FirstSeenBlockHeight))
+	}
+	l = len(m.LastSeenBlockId)
+	if l > 0 {
+		n += 1 + l + sovBlocc(uint64(l))
+	}
+	if m.LastSeenBlockHeight != 0 {
+		n += 1 + sovBlocc(uint64(m.LastSeenBlockHeight))
+	}
+	return n
+}
+
+func sovBlocc(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozBlocc(x uint64) (n int) {
+	return sovBlocc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *BlockHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockHeader{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`BlockId:` + fmt.Sprintf("%v", this.BlockId) + `,`,
+		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
+		`PrevBlockId:` + fmt.Sprintf("%v", this.PrevBlockId) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Block) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForData := make([]string, 0, len(this.Data))
+	for k, _ := range this.Data {
+		keysForData = append(keysForData, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForData)
+	mapStringForData := "map[string]string{"
+	for _, k := range keysForData {
+		mapStringForData += fmt.Sprintf("%v: %v,", k, this.Data[k])
+	}
+	mapStringForData += "}"
+	keysForMetric := make([]string, 0, len(this.Metric))
+	for k, _ := range this.Metric {
+		keysForMetric = append(keysForMetric, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetric)
+	mapStringForMetric := "map[string]float64{"
+	for _, k := range keysForMetric {
+		mapStringForMetric += fmt.Sprintf("%v: %v,", k, this.Metric[k])
+	}
+	mapStringForMetric += "}"
+	s := strings.Join([]string{`&Block{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`BlockId:` + fmt.Sprintf("%v", this.BlockId) + `,`,
+		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
+		`PrevBlockId:` + fmt.Sprintf("%v", this.PrevBlockId) + `,`,
+		`NextBlockId:` + fmt.Sprintf("%v", this.NextBlockId) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`TxCount:` + fmt.Sprintf("%v", this.TxCount) + `,`,
+		`BlockSize:` + fmt.Sprintf("%v", this.BlockSize) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Incomplete:` + fmt.Sprintf("%v", this.Incomplete) + `,`,
+		`TxIds:` + fmt.Sprintf("%v", this.TxIds) + `,`,
+		`Raw:` + fmt.Sprintf("%v", this.Raw) + `,`,
+		`Data:` + mapStringForData + `,`,
+		`Metric:` + mapStringForMetric + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Tx) String() string {
 	if this == nil {
 		return "nil"
@@ -2192,6 +3223,66 @@ func (this *TxOut) String() string {
 	}, "")
 	return s
 }
+func (this *Output) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Output{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
+		`BlockId:` + fmt.Sprintf("%v", this.BlockId) + `,`,
+		`BlockHeight:` + fmt.Sprintf("%v", this.BlockHeight) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`Out:` + strings.Replace(fmt.Sprintf("%v", this.Out), "TxOut", "TxOut", 1) + `,`,
+		`SpentTxId:` + fmt.Sprintf("%v", this.SpentTxId) + `,`,
+		`SpentHeight:` + fmt.Sprintf("%v", this.SpentHeight) + `,`,
+		`SpentBlockId:` + fmt.Sprintf("%v", this.SpentBlockId) + `,`,
+		`SpentBlockHeight:` + fmt.Sprintf("%v", this.SpentBlockHeight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddressTx) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressTx{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`BlockId:` + fmt.Sprintf("%v", this.BlockId) + `,`,
+		`BlockHeight:` + fmt.Sprintf("%v", this.BlockHeight) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`Received:` + fmt.Sprintf("%v", this.Received) + `,`,
+		`Sent:` + fmt.Sprintf("%v", this.Sent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Address) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Address{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`TxCount:` + fmt.Sprintf("%v", this.TxCount) + `,`,
+		`Received:` + fmt.Sprintf("%v", this.Received) + `,`,
+		`Sent:` + fmt.Sprintf("%v", this.Sent) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`MempoolTxCount:` + fmt.Sprintf("%v", this.MempoolTxCount) + `,`,
+		`MempoolReceived:` + fmt.Sprintf("%v", this.MempoolReceived) + `,`,
+		`MempoolSent:` + fmt.Sprintf("%v", this.MempoolSent) + `,`,
+		`MempoolBalance:` + fmt.Sprintf("%v", this.MempoolBalance) + `,`,
+		`FirstSeenBlockId:` + fmt.Sprintf("%v", this.FirstSeenBlockId) + `,`,
+		`FirstSeenBlockHeight:` + fmt.Sprintf("%v", this.FirstSeenBlockHeight) + `,`,
+		`LastSeenBlockId:` + fmt.Sprintf("%v", this.LastSeenBlockId) + `,`,
+		`LastSeenBlockHeight:` + fmt.Sprintf("%v", this.LastSeenBlockHeight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringBlocc(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -4404,6 +5495,978 @@ func (m *TxOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Output) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Out == nil {
+				m.Out = &TxOut{}
+			}
+			if err := m.Out.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentTxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentTxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentHeight", wireType)
+			}
+			m.SpentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpentHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentBlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentBlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentBlockHeight", wireType)
+			}
+			m.SpentBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpentBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			m.Received = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Received |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			m.Sent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Address) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Address: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Address: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			m.Received = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Received |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			m.Sent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MempoolTxCount", wireType)
+			}
+			m.MempoolTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MempoolTxCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MempoolReceived", wireType)
+			}
+			m.MempoolReceived = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MempoolReceived |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MempoolSent", wireType)
+			}
+			m.MempoolSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MempoolSent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MempoolBalance", wireType)
+			}
+			m.MempoolBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MempoolBalance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSeenBlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstSeenBlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSeenBlockHeight", wireType)
+			}
+			m.FirstSeenBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstSeenBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenBlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastSeenBlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenBlockHeight", wireType)
+			}
+			m.LastSeenBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSeenBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlocc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBlocc(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,16 +27,19 @@ func (r *Raw) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON for Raw fields is parsed as base64
 func (r *Raw) UnmarshalJSON(in []byte) error {
-	var ret *[]byte
-	if in == nil || len(in) == 0 {
-		*ret = []byte{}
-		return nil
-	}
 	// Remove the beginning and ending "
 	in = bytes.Trim(in, `"`)
-	*r = make([]byte, base64.StdEncoding.DecodedLen(len(in)))
-	_, err := base64.StdEncoding.Decode(*r, in)
-	return err
+	if len(in) == 0 || string(in) == "null" {
+		*r = nil
+		return nil
+	}
+	ret := make([]byte, base64.StdEncoding.DecodedLen(len(in)))
+	n, err := base64.StdEncoding.Decode(ret, in)
+	if err != nil {
+		return err
+	}
+	*r = ret[:n]
+	return nil
 }
 
 // Used to fulfill the BinaryMarshaler interface from encoding
@@ -154,6 +157,66 @@ func (mps *MemPoolStats) UnmarshalBinary(data []byte) error {
 	return json.Unmarshal(data, mps)
 }
 
+// MarshalBinary used to store in cache
+func (d *Descriptor) MarshalBinary() (data []byte, err error) {
+	return proto.Marshal(d)
+}
+
+// UnmarshalBinary is used to rtrieve from cache
+func (d *Descriptor) UnmarshalBinary(data []byte) error {
+	return proto.Unmarshal(data, d)
+}
+
+// MarshalBinary used to store in cache
+func (mph *MemPoolHistogram) MarshalBinary() (data []byte, err error) {
+	return proto.Marshal(mph)
+}
+
+// UnmarshalBinary is used to rtrieve from cache
+func (mph *MemPoolHistogram) UnmarshalBinary(data []byte) error {
+	return proto.Unmarshal(data, mph)
+}
+
+// MarshalBinary used to store in cache
+func (pb *ProjectedBlocks) MarshalBinary() (data []byte, err error) {
+	return proto.Marshal(pb)
+}
+
+// UnmarshalBinary is used to rtrieve from cache
+func (pb *ProjectedBlocks) UnmarshalBinary(data []byte) error {
+	return proto.Unmarshal(data, pb)
+}
+
+// MarshalBinary used to store in cache
+func (cs *ConfirmationStats) MarshalBinary() (data []byte, err error) {
+	return proto.Marshal(cs)
+}
+
+// UnmarshalBinary is used to rtrieve from cache
+func (cs *ConfirmationStats) UnmarshalBinary(data []byte) error {
+	return proto.Unmarshal(data, cs)
+}
+
+// MarshalBinary used to store in redis
+func (wh *Webhook) MarshalBinary() (data []byte, err error) {
+	return proto.Marshal(wh)
+}
+
+// UnmarshalBinary is used to retrieve from redis
+func (wh *Webhook) UnmarshalBinary(data []byte) error {
+	return proto.Unmarshal(data, wh)
+}
+
+// MarshalBinary used to store in redis
+func (d *WebhookDelivery) MarshalBinary() (data []byte, err error) {
+	return proto.Marshal(d)
+}
+
+// UnmarshalBinary is used to retrieve from redis
+func (d *WebhookDelivery) UnmarshalBinary(data []byte) error {
+	return proto.Unmarshal(data, d)
+}
+
 /* Need to figue out why protobuf is still generating these with goproto_stringer = false
 func (bh *BlockHeader) String() string {
 	if bh == nil {
@@ -4,7 +4,9 @@
 package blocc
 
 import (
+	bytes "bytes"
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -289,6 +291,330 @@ func (m *Find) GetTx() bool {
 	return false
 }
 
+// OutPoint
+type OutPoint struct {
+	// The coin symbol (default: btc)
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Transaction Id
+	TxId string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// Output Height Within The Transaction
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *OutPoint) Reset()      { *m = OutPoint{} }
+func (*OutPoint) ProtoMessage() {}
+func (*OutPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{3}
+}
+func (m *OutPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutPoint.Merge(m, src)
+}
+func (m *OutPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutPoint proto.InternalMessageInfo
+
+func (m *OutPoint) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *OutPoint) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *OutPoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// RawTx - A serialized transaction
+type RawTx struct {
+	// The coin symbol (default: btc)
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The serialized transaction (hex)
+	Hex string `protobuf:"bytes,2,opt,name=hex,proto3" json:"hex,omitempty"`
+}
+
+func (m *RawTx) Reset()      { *m = RawTx{} }
+func (*RawTx) ProtoMessage() {}
+func (*RawTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{4}
+}
+func (m *RawTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RawTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RawTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RawTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawTx.Merge(m, src)
+}
+func (m *RawTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *RawTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawTx proto.InternalMessageInfo
+
+func (m *RawTx) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *RawTx) GetHex() string {
+	if m != nil {
+		return m.Hex
+	}
+	return ""
+}
+
+// SentTx - A broadcast transaction
+type SentTx struct {
+	// Symbol
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Transaction Id
+	TxId string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (m *SentTx) Reset()      { *m = SentTx{} }
+func (*SentTx) ProtoMessage() {}
+func (*SentTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{5}
+}
+func (m *SentTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SentTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SentTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SentTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SentTx.Merge(m, src)
+}
+func (m *SentTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *SentTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_SentTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SentTx proto.InternalMessageInfo
+
+func (m *SentTx) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *SentTx) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+// RawScript - A serialized script
+type RawScript struct {
+	// The coin symbol (default: btc)
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The script (hex)
+	Hex string `protobuf:"bytes,2,opt,name=hex,proto3" json:"hex,omitempty"`
+}
+
+func (m *RawScript) Reset()      { *m = RawScript{} }
+func (*RawScript) ProtoMessage() {}
+func (*RawScript) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{6}
+}
+func (m *RawScript) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RawScript) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RawScript.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RawScript) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawScript.Merge(m, src)
+}
+func (m *RawScript) XXX_Size() int {
+	return m.Size()
+}
+func (m *RawScript) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawScript.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawScript proto.InternalMessageInfo
+
+func (m *RawScript) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *RawScript) GetHex() string {
+	if m != nil {
+		return m.Hex
+	}
+	return ""
+}
+
+// Script - A decoded output script
+type Script struct {
+	// The script type
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Addresses if they could be decoded
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses"`
+	// The required signatures
+	ReqSigs int64 `protobuf:"varint,3,opt,name=req_sigs,json=reqSigs,proto3" json:"req_sigs,omitempty"`
+	// The disassembled script
+	Asm string `protobuf:"bytes,4,opt,name=asm,proto3" json:"asm,omitempty"`
+	// The signature operations in the script
+	Sigops int64 `protobuf:"varint,5,opt,name=sigops,proto3" json:"sigops"`
+	// The P2SH address paying to the script
+	P2SH string `protobuf:"bytes,6,opt,name=p2sh,proto3" json:"p2sh,omitempty"`
+	// The P2WSH address paying to the script
+	P2WSH string `protobuf:"bytes,7,opt,name=p2wsh,proto3" json:"p2wsh,omitempty"`
+}
+
+func (m *Script) Reset()      { *m = Script{} }
+func (*Script) ProtoMessage() {}
+func (*Script) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{7}
+}
+func (m *Script) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Script) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Script.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Script) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Script.Merge(m, src)
+}
+func (m *Script) XXX_Size() int {
+	return m.Size()
+}
+func (m *Script) XXX_DiscardUnknown() {
+	xxx_messageInfo_Script.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Script proto.InternalMessageInfo
+
+func (m *Script) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Script) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *Script) GetReqSigs() int64 {
+	if m != nil {
+		return m.ReqSigs
+	}
+	return 0
+}
+
+func (m *Script) GetAsm() string {
+	if m != nil {
+		return m.Asm
+	}
+	return ""
+}
+
+func (m *Script) GetSigops() int64 {
+	if m != nil {
+		return m.Sigops
+	}
+	return 0
+}
+
+func (m *Script) GetP2SH() string {
+	if m != nil {
+		return m.P2SH
+	}
+	return ""
+}
+
+func (m *Script) GetP2WSH() string {
+	if m != nil {
+		return m.P2WSH
+	}
+	return ""
+}
+
 // Blocks
 type Blocks struct {
 	// Blocks
@@ -298,7 +624,7 @@ type Blocks struct {
 func (m *Blocks) Reset()      { *m = Blocks{} }
 func (*Blocks) ProtoMessage() {}
 func (*Blocks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c9e048c06e054ff, []int{3}
+	return fileDescriptor_0c9e048c06e054ff, []int{8}
 }
 func (m *Blocks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,7 +669,7 @@ type Transactions struct {
 func (m *Transactions) Reset()      { *m = Transactions{} }
 func (*Transactions) ProtoMessage() {}
 func (*Transactions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c9e048c06e054ff, []int{4}
+	return fileDescriptor_0c9e048c06e054ff, []int{9}
 }
 func (m *Transactions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -379,27 +705,41 @@ func (m *Transactions) GetTransactions() []*Tx {
 	return nil
 }
 
-// MemPoolStats
-type MemPoolStats struct {
-	// The timestamp
-	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	// The count of transactions
-	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	// The mempool size
-	MPSize int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+// Utxo - Unspent Transaction Output
+type Utxo struct {
+	// Transaction Id
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// Output Height Within The Transaction
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height"`
+	// The value of the output
+	Value int64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	// The output type
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// Output addresses if they could be decoded
+	Addresses []string `protobuf:"bytes,5,rep,name=addresses,proto3" json:"address"`
+	// The output script (base64)
+	Script Raw `protobuf:"bytes,6,opt,name=script,proto3,casttype=Raw" json:"script,omitempty"`
+	// Block Id (mempool if unconfirmed)
+	BlockId string `protobuf:"bytes,7,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	// Block Height
+	BlockHeight int64 `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height"`
+	// The number of confirmations
+	Confirmations int64 `protobuf:"varint,9,opt,name=confirmations,proto3" json:"confirmations"`
+	// If a transaction in the mempool spends this output
+	MempoolSpent bool `protobuf:"varint,10,opt,name=mempool_spent,json=mempoolSpent,proto3" json:"mempool_spent"`
 }
 
-func (m *MemPoolStats) Reset()      { *m = MemPoolStats{} }
-func (*MemPoolStats) ProtoMessage() {}
-func (*MemPoolStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c9e048c06e054ff, []int{5}
+func (m *Utxo) Reset()      { *m = Utxo{} }
+func (*Utxo) ProtoMessage() {}
+func (*Utxo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{10}
 }
-func (m *MemPoolStats) XXX_Unmarshal(b []byte) error {
+func (m *Utxo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MemPoolStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Utxo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MemPoolStats.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Utxo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -409,1246 +749,14023 @@ func (m *MemPoolStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MemPoolStats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MemPoolStats.Merge(m, src)
+func (m *Utxo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Utxo.Merge(m, src)
 }
-func (m *MemPoolStats) XXX_Size() int {
+func (m *Utxo) XXX_Size() int {
 	return m.Size()
 }
-func (m *MemPoolStats) XXX_DiscardUnknown() {
-	xxx_messageInfo_MemPoolStats.DiscardUnknown(m)
+func (m *Utxo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Utxo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MemPoolStats proto.InternalMessageInfo
+var xxx_messageInfo_Utxo proto.InternalMessageInfo
 
-func (m *MemPoolStats) GetTime() int64 {
+func (m *Utxo) GetTxId() string {
 	if m != nil {
-		return m.Time
+		return m.TxId
 	}
-	return 0
+	return ""
 }
 
-func (m *MemPoolStats) GetCount() int64 {
+func (m *Utxo) GetHeight() int64 {
 	if m != nil {
-		return m.Count
+		return m.Height
 	}
 	return 0
 }
 
-func (m *MemPoolStats) GetMPSize() int64 {
+func (m *Utxo) GetValue() int64 {
 	if m != nil {
-		return m.MPSize
+		return m.Value
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Symbol)(nil), "blocc.Symbol")
-	proto.RegisterType((*Get)(nil), "blocc.Get")
-	proto.RegisterType((*Find)(nil), "blocc.Find")
-	proto.RegisterType((*Blocks)(nil), "blocc.Blocks")
-	proto.RegisterType((*Transactions)(nil), "blocc.Transactions")
-	proto.RegisterType((*MemPoolStats)(nil), "blocc.MemPoolStats")
+func (m *Utxo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("blocc/bloccrpc.proto", fileDescriptor_0c9e048c06e054ff) }
+func (m *Utxo) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
 
-var fileDescriptor_0c9e048c06e054ff = []byte{
-	// 828 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xbf, 0x6f, 0x23, 0x45,
-	0x14, 0xf6, 0xd8, 0xb1, 0x63, 0xbf, 0xf3, 0x25, 0xce, 0xcb, 0x5d, 0xd8, 0x4b, 0xb8, 0xc5, 0x1a,
-	0x81, 0x30, 0xa0, 0x78, 0xe0, 0xe8, 0x90, 0x28, 0x30, 0x12, 0xa6, 0x39, 0x29, 0xda, 0xa4, 0x40,
-	0x4b, 0x01, 0xeb, 0xdd, 0xb1, 0x19, 0xce, 0xde, 0x89, 0xbc, 0x13, 0xe1, 0xdc, 0x29, 0x12, 0x82,
-	0x1e, 0x21, 0x21, 0x44, 0x4d, 0xc7, 0x9f, 0x42, 0x41, 0x11, 0x89, 0xe6, 0x2a, 0x44, 0x36, 0x14,
-	0x57, 0xde, 0x9f, 0x80, 0xe6, 0xed, 0xfa, 0xbc, 0x9b, 0x5c, 0x24, 0x0a, 0x9a, 0xe8, 0xbd, 0xef,
-	0x7b, 0xbf, 0xbe, 0xf7, 0x26, 0x5e, 0xb8, 0x33, 0x9a, 0xea, 0x30, 0x14, 0xf4, 0x77, 0x7e, 0x1c,
-	0xf6, 0x8f, 0xe7, 0xda, 0x68, 0xac, 0x93, 0xbf, 0xbb, 0x3f, 0x51, 0xe6, 0xab, 0x93, 0x51, 0x3f,
-	0xd4, 0x33, 0x31, 0xd1, 0x13, 0x2d, 0x88, 0x1d, 0x9d, 0x8c, 0xc9, 0x23, 0x87, 0xac, 0x2c, 0x6b,
-	0xf7, 0xd5, 0x89, 0xd6, 0x93, 0xa9, 0x14, 0xc1, 0xb1, 0x12, 0x41, 0x1c, 0x6b, 0x13, 0x18, 0xa5,
-	0xe3, 0x24, 0x67, 0xb7, 0x0a, 0x9d, 0x32, 0x88, 0x77, 0xa1, 0x71, 0x78, 0x3a, 0x1b, 0xe9, 0x29,
-	0xee, 0x40, 0x23, 0x21, 0xcb, 0x61, 0x5d, 0xd6, 0x6b, 0x79, 0xb9, 0xc7, 0xcf, 0xa0, 0x36, 0x94,
-	0xe6, 0x26, 0x1a, 0x37, 0xa0, 0xaa, 0x22, 0xa7, 0x4a, 0x58, 0x55, 0x45, 0xe8, 0xc0, 0xba, 0x8a,
-	0xc3, 0xe9, 0x49, 0x24, 0x9d, 0xb0, 0xcb, 0x7a, 0x75, 0x6f, 0xe9, 0x22, 0xc2, 0x5a, 0x14, 0x98,
-	0xc0, 0x89, 0xba, 0xac, 0xd7, 0xf4, 0xc8, 0xc6, 0x0e, 0xd4, 0xe6, 0xc1, 0x37, 0x8e, 0x24, 0xc8,
-	0x9a, 0xb6, 0x9e, 0x59, 0x38, 0x63, 0x02, 0xaa, 0x66, 0xc1, 0x9f, 0x31, 0x58, 0xfb, 0x44, 0xc5,
-	0xd1, 0x8d, 0x03, 0x74, 0xa0, 0xa6, 0xa2, 0xc4, 0xa9, 0x76, 0x6b, 0xbd, 0x96, 0x67, 0x4d, 0xbc,
-	0x0f, 0x90, 0x98, 0x60, 0x6e, 0xbe, 0x30, 0x6a, 0x26, 0x9d, 0x5a, 0x97, 0xf5, 0x6a, 0x5e, 0x8b,
-	0x90, 0x23, 0x35, 0x93, 0x78, 0x0f, 0x9a, 0x32, 0x8e, 0x32, 0x72, 0x8d, 0xc8, 0x75, 0x19, 0x47,
-	0x44, 0xed, 0x40, 0x43, 0x8f, 0xc7, 0x89, 0x34, 0x4e, 0x9d, 0x88, 0xdc, 0xc3, 0x3b, 0x50, 0x0f,
-	0xf5, 0x49, 0x6c, 0x9c, 0x06, 0xc1, 0x99, 0xf3, 0xbf, 0x4b, 0xed, 0x43, 0x63, 0x30, 0xd5, 0xe1,
-	0xa3, 0x04, 0x5f, 0x87, 0xc6, 0x88, 0x2c, 0x87, 0x75, 0x6b, 0xbd, 0x5b, 0x0f, 0xda, 0xfd, 0xec,
-	0x66, 0x44, 0x7b, 0x39, 0xc7, 0x3f, 0x84, 0xf6, 0xd1, 0x3c, 0x88, 0x93, 0x20, 0xa4, 0x23, 0xe3,
-	0x3e, 0xb4, 0x4d, 0xc1, 0xcf, 0x73, 0x5b, 0x79, 0xee, 0xd1, 0xc2, 0x2b, 0xd1, 0xfc, 0x33, 0x68,
-	0x3f, 0x94, 0xb3, 0x03, 0xad, 0xa7, 0x87, 0x26, 0x30, 0x89, 0x1d, 0x9a, 0x76, 0xc2, 0x48, 0x23,
-	0xd9, 0x2b, 0xe1, 0xd5, 0xa2, 0x70, 0x17, 0xd6, 0x12, 0xf5, 0x38, 0x5f, 0xed, 0x00, 0xd2, 0xbf,
-	0x5e, 0x6b, 0x3c, 0x3c, 0x38, 0x54, 0x8f, 0xa5, 0x47, 0xf8, 0x83, 0x5f, 0xd6, 0xa1, 0x69, 0x47,
-	0x0d, 0xbd, 0x83, 0x8f, 0xf1, 0x10, 0x9a, 0x43, 0x69, 0x68, 0x72, 0x84, 0x7c, 0x96, 0xa1, 0x34,
-	0xbb, 0x25, 0x4d, 0x7c, 0xff, 0xbb, 0x3f, 0xff, 0xf9, 0xa9, 0xfa, 0x26, 0xb6, 0x45, 0x26, 0x4e,
-	0x3c, 0x51, 0xd1, 0x99, 0xff, 0x0a, 0xde, 0x15, 0x4f, 0xb2, 0x3b, 0x9f, 0x15, 0x09, 0x9c, 0x03,
-	0xd8, 0x47, 0x91, 0xaf, 0xeb, 0x56, 0x5e, 0xca, 0x42, 0xbb, 0xb7, 0x8b, 0x75, 0x13, 0xfe, 0x29,
-	0x15, 0x1e, 0xf0, 0xf5, 0x3c, 0xff, 0x03, 0xf6, 0xb6, 0x7f, 0x97, 0x77, 0xae, 0x96, 0xb5, 0x70,
-	0x0b, 0x97, 0x41, 0x3e, 0xe2, 0xb5, 0x08, 0xfc, 0x9e, 0xc1, 0xc6, 0x50, 0x9a, 0xc2, 0xca, 0x4b,
-	0x7a, 0x56, 0x7b, 0xe6, 0x3e, 0xf5, 0x3c, 0x42, 0x14, 0xc5, 0x85, 0x67, 0x92, 0xee, 0xe3, 0xde,
-	0xaa, 0xf2, 0x75, 0x1a, 0xb0, 0x29, 0xcc, 0x22, 0xb3, 0xb7, 0x71, 0xab, 0x10, 0x9a, 0x81, 0xf8,
-	0x07, 0x83, 0x8e, 0xd5, 0x59, 0xba, 0x7c, 0x69, 0x01, 0xdb, 0xcb, 0x41, 0x8a, 0xc7, 0xfe, 0x99,
-	0xd1, 0x4c, 0x3f, 0x30, 0x7e, 0xbb, 0xd4, 0xd5, 0xea, 0xde, 0xe3, 0x3b, 0x2f, 0x1f, 0xc9, 0x92,
-	0x9b, 0x58, 0x4e, 0xf0, 0x1d, 0xbc, 0x21, 0xda, 0x6f, 0xf2, 0x9a, 0x30, 0x0b, 0x9b, 0xb4, 0xc5,
-	0xdb, 0xc5, 0xc9, 0x2d, 0x54, 0x47, 0x4b, 0xfa, 0x1b, 0x58, 0x62, 0xf0, 0x57, 0x06, 0x7b, 0x57,
-	0xe5, 0x0c, 0x4e, 0x3f, 0x8a, 0xa2, 0xb9, 0x4c, 0x12, 0xf9, 0x5f, 0x94, 0x7d, 0x49, 0xc2, 0x7c,
-	0x0e, 0x22, 0x58, 0x66, 0xd9, 0x7e, 0x0e, 0xdf, 0x5e, 0x35, 0x2a, 0x31, 0xf6, 0xb6, 0x2f, 0x00,
-	0xbb, 0xd4, 0xe4, 0xcc, 0xdf, 0xc3, 0x7b, 0x2f, 0x89, 0xce, 0x48, 0x1c, 0xc3, 0xe6, 0x50, 0x9a,
-	0xd2, 0xff, 0xca, 0xf2, 0x91, 0x65, 0xbf, 0x9d, 0x2f, 0x06, 0x2b, 0xc6, 0x70, 0x41, 0x83, 0xbd,
-	0x85, 0x1b, 0x62, 0x26, 0x67, 0xc7, 0x5a, 0x4f, 0x45, 0x62, 0x71, 0x7a, 0xd4, 0x53, 0x39, 0x09,
-	0xc2, 0xd3, 0x32, 0x81, 0x01, 0x74, 0x8a, 0x7d, 0xe6, 0x32, 0x98, 0x5d, 0x6d, 0x54, 0x78, 0x64,
-	0xef, 0x51, 0xf9, 0x77, 0x70, 0xb3, 0x50, 0xc5, 0xa6, 0xd0, 0x81, 0xae, 0xd5, 0xb7, 0xcc, 0xbb,
-	0x6c, 0xf0, 0xf9, 0xf9, 0x85, 0x5b, 0x79, 0x7a, 0xe1, 0x56, 0x9e, 0x5f, 0xb8, 0xec, 0xdb, 0xd4,
-	0x65, 0xbf, 0xa5, 0x2e, 0xfb, 0x3d, 0x75, 0xd9, 0x79, 0xea, 0xb2, 0xbf, 0x53, 0x97, 0x3d, 0x4b,
-	0xdd, 0xca, 0xf3, 0xd4, 0x65, 0x3f, 0x5e, 0xba, 0x95, 0xf3, 0x4b, 0xb7, 0xf2, 0xf4, 0xd2, 0xad,
-	0xf8, 0x6f, 0x4c, 0x94, 0xe9, 0x87, 0x5a, 0xc5, 0xb1, 0x8a, 0xbf, 0x0e, 0xfa, 0xb1, 0x34, 0x62,
-	0x14, 0x84, 0x8f, 0x64, 0x1c, 0x89, 0xc2, 0x17, 0x65, 0xd4, 0xa0, 0x4f, 0xca, 0xfb, 0xff, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0x62, 0x9e, 0x25, 0x9c, 0xd1, 0x06, 0x00, 0x00,
+func (m *Utxo) GetScript() Raw {
+	if m != nil {
+		return m.Script
+	}
+	return nil
 }
 
-func (this *Symbol) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Utxo) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
 	}
+	return ""
+}
 
-	that1, ok := that.(*Symbol)
-	if !ok {
-		that2, ok := that.(Symbol)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+func (m *Utxo) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if this.Symbol != that1.Symbol {
-		return false
+	return 0
+}
+
+func (m *Utxo) GetConfirmations() int64 {
+	if m != nil {
+		return m.Confirmations
 	}
-	return true
+	return 0
 }
-func (this *Get) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Utxo) GetMempoolSpent() bool {
+	if m != nil {
+		return m.MempoolSpent
 	}
+	return false
+}
 
-	that1, ok := that.(*Get)
-	if !ok {
-		that2, ok := that.(Get)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Utxos
+type Utxos struct {
+	// Unspent Transaction Outputs
+	Utxos []*Utxo `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
+}
+
+func (m *Utxos) Reset()      { *m = Utxos{} }
+func (*Utxos) ProtoMessage() {}
+func (*Utxos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{11}
+}
+func (m *Utxos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Utxos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Utxos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Symbol != that1.Symbol {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.Include != that1.Include {
-		return false
-	}
-	if this.Data != that1.Data {
-		return false
+}
+func (m *Utxos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Utxos.Merge(m, src)
+}
+func (m *Utxos) XXX_Size() int {
+	return m.Size()
+}
+func (m *Utxos) XXX_DiscardUnknown() {
+	xxx_messageInfo_Utxos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Utxos proto.InternalMessageInfo
+
+func (m *Utxos) GetUtxos() []*Utxo {
+	if m != nil {
+		return m.Utxos
 	}
-	if this.Raw != that1.Raw {
-		return false
+	return nil
+}
+
+// Spender - The transaction spending an output
+type Spender struct {
+	// Spending Transaction Id
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// Input Height Within The Spending Transaction
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height"`
+	// Block Id (mempool if unconfirmed)
+	BlockId string `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	// Block Height
+	BlockHeight int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height"`
+	// The number of confirmations
+	Confirmations int64 `protobuf:"varint,5,opt,name=confirmations,proto3" json:"confirmations"`
+	// If the spending transaction is in the mempool
+	Mempool bool `protobuf:"varint,6,opt,name=mempool,proto3" json:"mempool"`
+}
+
+func (m *Spender) Reset()      { *m = Spender{} }
+func (*Spender) ProtoMessage() {}
+func (*Spender) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{12}
+}
+func (m *Spender) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Spender) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Spender.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Tx != that1.Tx {
-		return false
+}
+func (m *Spender) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Spender.Merge(m, src)
+}
+func (m *Spender) XXX_Size() int {
+	return m.Size()
+}
+func (m *Spender) XXX_DiscardUnknown() {
+	xxx_messageInfo_Spender.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Spender proto.InternalMessageInfo
+
+func (m *Spender) GetTxId() string {
+	if m != nil {
+		return m.TxId
 	}
-	return true
+	return ""
 }
-func (this *Find) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Spender) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
+	return 0
+}
 
-	that1, ok := that.(*Find)
-	if !ok {
-		that2, ok := that.(Find)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Spender) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *Spender) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if this.Symbol != that1.Symbol {
-		return false
+	return 0
+}
+
+func (m *Spender) GetConfirmations() int64 {
+	if m != nil {
+		return m.Confirmations
 	}
-	if len(this.Ids) != len(that1.Ids) {
-		return false
+	return 0
+}
+
+func (m *Spender) GetMempool() bool {
+	if m != nil {
+		return m.Mempool
 	}
-	for i := range this.Ids {
-		if this.Ids[i] != that1.Ids[i] {
-			return false
+	return false
+}
+
+// XPubScan
+type XPubScan struct {
+	// The coin symbol (default: btc)
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The extended public key (xpub, ypub or zpub)
+	Xpub string `protobuf:"bytes,2,opt,name=xpub,proto3" json:"xpub,omitempty"`
+	// The script type of the addresses (p2pkh, p2sh-p2wpkh or p2wpkh, default: from the key version)
+	ScriptType string `protobuf:"bytes,3,opt,name=script_type,json=scriptType,proto3" json:"script_type,omitempty"`
+	// The number of consecutive unused addresses to stop at (default: 20)
+	GapLimit int64 `protobuf:"varint,4,opt,name=gap_limit,json=gapLimit,proto3" json:"gap_limit,omitempty"`
+	// The offset of transactions to start from
+	Offset int64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The number of transactions to return
+	Count int64 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	// Extra flags for including fields
+	// Sepecific values
+	Include int32 `protobuf:"varint,99,opt,name=include,proto3" json:"include,omitempty"`
+	// Include the data object
+	Data bool `protobuf:"varint,100,opt,name=data,proto3" json:"data,omitempty"`
+	// Include the raw tx in base64
+	Raw bool `protobuf:"varint,101,opt,name=raw,proto3" json:"raw,omitempty"`
+}
+
+func (m *XPubScan) Reset()      { *m = XPubScan{} }
+func (*XPubScan) ProtoMessage() {}
+func (*XPubScan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{13}
+}
+func (m *XPubScan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *XPubScan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_XPubScan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.StartTime != that1.StartTime {
-		return false
+}
+func (m *XPubScan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XPubScan.Merge(m, src)
+}
+func (m *XPubScan) XXX_Size() int {
+	return m.Size()
+}
+func (m *XPubScan) XXX_DiscardUnknown() {
+	xxx_messageInfo_XPubScan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XPubScan proto.InternalMessageInfo
+
+func (m *XPubScan) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	if this.EndTime != that1.EndTime {
-		return false
+	return ""
+}
+
+func (m *XPubScan) GetXpub() string {
+	if m != nil {
+		return m.Xpub
 	}
-	if this.Offset != that1.Offset {
-		return false
+	return ""
+}
+
+func (m *XPubScan) GetScriptType() string {
+	if m != nil {
+		return m.ScriptType
 	}
-	if this.Count != that1.Count {
-		return false
+	return ""
+}
+
+func (m *XPubScan) GetGapLimit() int64 {
+	if m != nil {
+		return m.GapLimit
 	}
-	if this.Include != that1.Include {
-		return false
+	return 0
+}
+
+func (m *XPubScan) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
 	}
-	if this.Data != that1.Data {
-		return false
+	return 0
+}
+
+func (m *XPubScan) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	if this.Raw != that1.Raw {
-		return false
+	return 0
+}
+
+func (m *XPubScan) GetInclude() int32 {
+	if m != nil {
+		return m.Include
 	}
-	if this.Tx != that1.Tx {
-		return false
+	return 0
+}
+
+func (m *XPubScan) GetData() bool {
+	if m != nil {
+		return m.Data
 	}
-	return true
+	return false
 }
-func (this *Blocks) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *XPubScan) GetRaw() bool {
+	if m != nil {
+		return m.Raw
 	}
+	return false
+}
 
-	that1, ok := that.(*Blocks)
-	if !ok {
-		that2, ok := that.(Blocks)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// XPubAddress - A used address of an extended public key
+type XPubAddress struct {
+	// The address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The chain (0=receive, 1=change)
+	Chain uint32 `protobuf:"varint,2,opt,name=chain,proto3" json:"chain"`
+	// The index within the chain
+	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index"`
+	// The number of transactions (including the mempool)
+	TxCount int64 `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count"`
+	// The confirmed balance
+	Balance int64 `protobuf:"varint,5,opt,name=balance,proto3" json:"balance"`
+	// The change in balance from the mempool
+	MempoolBalance int64 `protobuf:"varint,6,opt,name=mempool_balance,json=mempoolBalance,proto3" json:"mempool_balance"`
+}
+
+func (m *XPubAddress) Reset()      { *m = XPubAddress{} }
+func (*XPubAddress) ProtoMessage() {}
+func (*XPubAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{14}
+}
+func (m *XPubAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *XPubAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_XPubAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Blocks) != len(that1.Blocks) {
-		return false
-	}
-	for i := range this.Blocks {
-		if !this.Blocks[i].Equal(that1.Blocks[i]) {
-			return false
-		}
-	}
-	return true
 }
-func (this *Transactions) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *XPubAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XPubAddress.Merge(m, src)
+}
+func (m *XPubAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *XPubAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_XPubAddress.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Transactions)
-	if !ok {
-		that2, ok := that.(Transactions)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Transactions) != len(that1.Transactions) {
-		return false
-	}
-	for i := range this.Transactions {
-		if !this.Transactions[i].Equal(that1.Transactions[i]) {
-			return false
-		}
+var xxx_messageInfo_XPubAddress proto.InternalMessageInfo
+
+func (m *XPubAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return true
+	return ""
 }
-func (this *MemPoolStats) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MemPoolStats)
-	if !ok {
-		that2, ok := that.(MemPoolStats)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Time != that1.Time {
-		return false
-	}
-	if this.Count != that1.Count {
-		return false
-	}
-	if this.MPSize != that1.MPSize {
-		return false
+func (m *XPubAddress) GetChain() uint32 {
+	if m != nil {
+		return m.Chain
 	}
-	return true
+	return 0
 }
-func (this *Symbol) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *XPubAddress) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&blocc.Symbol{")
-	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *Get) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *XPubAddress) GetTxCount() int64 {
+	if m != nil {
+		return m.TxCount
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&blocc.Get{")
-	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "Include: "+fmt.Sprintf("%#v", this.Include)+",\n")
-	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
-	s = append(s, "Raw: "+fmt.Sprintf("%#v", this.Raw)+",\n")
-	s = append(s, "Tx: "+fmt.Sprintf("%#v", this.Tx)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *Find) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *XPubAddress) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
 	}
-	s := make([]string, 0, 14)
-	s = append(s, "&blocc.Find{")
-	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
-	s = append(s, "Ids: "+fmt.Sprintf("%#v", this.Ids)+",\n")
-	s = append(s, "StartTime: "+fmt.Sprintf("%#v", this.StartTime)+",\n")
-	s = append(s, "EndTime: "+fmt.Sprintf("%#v", this.EndTime)+",\n")
-	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
-	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
-	s = append(s, "Include: "+fmt.Sprintf("%#v", this.Include)+",\n")
-	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
-	s = append(s, "Raw: "+fmt.Sprintf("%#v", this.Raw)+",\n")
-	s = append(s, "Tx: "+fmt.Sprintf("%#v", this.Tx)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *Blocks) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&blocc.Blocks{")
-	if this.Blocks != nil {
-		s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
+
+func (m *XPubAddress) GetMempoolBalance() int64 {
+	if m != nil {
+		return m.MempoolBalance
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *Transactions) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&blocc.Transactions{")
-	if this.Transactions != nil {
-		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+// XPub - The result of scanning an extended public key
+type XPub struct {
+	// The script type of the addresses
+	ScriptType string `protobuf:"bytes,1,opt,name=script_type,json=scriptType,proto3" json:"script_type,omitempty"`
+	// The used addresses
+	Addresses []*XPubAddress `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// The next unused receive index
+	NextReceiveIndex uint32 `protobuf:"varint,3,opt,name=next_receive_index,json=nextReceiveIndex,proto3" json:"next_receive_index"`
+	// The next unused change index
+	NextChangeIndex uint32 `protobuf:"varint,4,opt,name=next_change_index,json=nextChangeIndex,proto3" json:"next_change_index"`
+	// The confirmed balance of all addresses
+	Balance int64 `protobuf:"varint,5,opt,name=balance,proto3" json:"balance"`
+	// The change in balance of all addresses from the mempool
+	MempoolBalance int64 `protobuf:"varint,6,opt,name=mempool_balance,json=mempoolBalance,proto3" json:"mempool_balance"`
+	// The transactions of the used addresses
+	Transactions []*Tx `protobuf:"bytes,7,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
-func (this *MemPoolStats) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 7)
-	s = append(s, "&blocc.MemPoolStats{")
-	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
-	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
-	s = append(s, "MPSize: "+fmt.Sprintf("%#v", this.MPSize)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *XPub) Reset()      { *m = XPub{} }
+func (*XPub) ProtoMessage() {}
+func (*XPub) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{15}
 }
-func valueToGoStringBloccrpc(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (m *XPub) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *XPub) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_XPub.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// BloccRPCClient is the client API for BloccRPC service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BloccRPCClient interface {
-	// Get Block by Id
-	GetBlock(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Block, error)
-	// Find Blocks by BlockIds and/or Time
-	FindBlocks(ctx context.Context, in *Find, opts ...grpc.CallOption) (*Blocks, error)
-	// Get Transaction by TxId
-	GetTransaction(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Tx, error)
-	// Find transactions by TxId and/or Time
-	FindTransactions(ctx context.Context, in *Find, opts ...grpc.CallOption) (*Transactions, error)
-	// Find transactions by Address and/or Time
-	FindTransactionsByAddresses(ctx context.Context, in *Find, opts ...grpc.CallOption) (*Transactions, error)
-	// Get MemPool Stats
-	GetMemPoolStats(ctx context.Context, in *Symbol, opts ...grpc.CallOption) (*MemPoolStats, error)
-	// Get Transaction Stream
-	GetMemPoolStream(ctx context.Context, in *Symbol, opts ...grpc.CallOption) (BloccRPC_GetMemPoolStreamClient, error)
+func (m *XPub) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XPub.Merge(m, src)
 }
-
-type bloccRPCClient struct {
-	cc *grpc.ClientConn
+func (m *XPub) XXX_Size() int {
+	return m.Size()
 }
-
-func NewBloccRPCClient(cc *grpc.ClientConn) BloccRPCClient {
-	return &bloccRPCClient{cc}
+func (m *XPub) XXX_DiscardUnknown() {
+	xxx_messageInfo_XPub.DiscardUnknown(m)
 }
 
-func (c *bloccRPCClient) GetBlock(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Block, error) {
-	out := new(Block)
-	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/GetBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_XPub proto.InternalMessageInfo
+
+func (m *XPub) GetScriptType() string {
+	if m != nil {
+		return m.ScriptType
 	}
-	return out, nil
+	return ""
 }
 
-func (c *bloccRPCClient) FindBlocks(ctx context.Context, in *Find, opts ...grpc.CallOption) (*Blocks, error) {
-	out := new(Blocks)
-	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/FindBlocks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *XPub) GetAddresses() []*XPubAddress {
+	if m != nil {
+		return m.Addresses
 	}
-	return out, nil
+	return nil
 }
 
-func (c *bloccRPCClient) GetTransaction(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Tx, error) {
-	out := new(Tx)
-	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/GetTransaction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *XPub) GetNextReceiveIndex() uint32 {
+	if m != nil {
+		return m.NextReceiveIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bloccRPCClient) FindTransactions(ctx context.Context, in *Find, opts ...grpc.CallOption) (*Transactions, error) {
-	out := new(Transactions)
-	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/FindTransactions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *XPub) GetNextChangeIndex() uint32 {
+	if m != nil {
+		return m.NextChangeIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bloccRPCClient) FindTransactionsByAddresses(ctx context.Context, in *Find, opts ...grpc.CallOption) (*Transactions, error) {
-	out := new(Transactions)
-	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/FindTransactionsByAddresses", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *XPub) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bloccRPCClient) GetMemPoolStats(ctx context.Context, in *Symbol, opts ...grpc.CallOption) (*MemPoolStats, error) {
-	out := new(MemPoolStats)
-	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/GetMemPoolStats", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *XPub) GetMempoolBalance() int64 {
+	if m != nil {
+		return m.MempoolBalance
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bloccRPCClient) GetMemPoolStream(ctx context.Context, in *Symbol, opts ...grpc.CallOption) (BloccRPC_GetMemPoolStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_BloccRPC_serviceDesc.Streams[0], "/blocc.BloccRPC/GetMemPoolStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &bloccRPCGetMemPoolStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *XPub) GetTransactions() []*Tx {
+	if m != nil {
+		return m.Transactions
 	}
-	return x, nil
+	return nil
 }
 
-type BloccRPC_GetMemPoolStreamClient interface {
-	Recv() (*Tx, error)
-	grpc.ClientStream
+// Descriptor - A named output descriptor
+type Descriptor struct {
+	// The coin symbol (default: btc)
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The name of the descriptor
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The BIP380 output descriptor with optional checksum (returned with checksum)
+	Desc string `protobuf:"bytes,3,opt,name=descriptor,proto3" json:"descriptor,omitempty"`
+	// The first index to derive for ranged descriptors
+	RangeStart uint32 `protobuf:"varint,4,opt,name=range_start,json=rangeStart,proto3" json:"range_start"`
+	// The last index to derive for ranged descriptors (default: range_start + server.default_descriptor_range - 1)
+	RangeEnd uint32 `protobuf:"varint,5,opt,name=range_end,json=rangeEnd,proto3" json:"range_end"`
+	// The derived addresses
+	Addresses []string `protobuf:"bytes,6,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// The derived output scripts in hex
+	Scripts []string `protobuf:"bytes,7,rep,name=scripts,proto3" json:"scripts,omitempty"`
 }
 
-type bloccRPCGetMemPoolStreamClient struct {
-	grpc.ClientStream
+func (m *Descriptor) Reset()      { *m = Descriptor{} }
+func (*Descriptor) ProtoMessage() {}
+func (*Descriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{16}
 }
-
-func (x *bloccRPCGetMemPoolStreamClient) Recv() (*Tx, error) {
-	m := new(Tx)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *Descriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Descriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Descriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-// BloccRPCServer is the server API for BloccRPC service.
-type BloccRPCServer interface {
-	// Get Block by Id
-	GetBlock(context.Context, *Get) (*Block, error)
-	// Find Blocks by BlockIds and/or Time
-	FindBlocks(context.Context, *Find) (*Blocks, error)
-	// Get Transaction by TxId
-	GetTransaction(context.Context, *Get) (*Tx, error)
-	// Find transactions by TxId and/or Time
-	FindTransactions(context.Context, *Find) (*Transactions, error)
-	// Find transactions by Address and/or Time
-	FindTransactionsByAddresses(context.Context, *Find) (*Transactions, error)
-	// Get MemPool Stats
-	GetMemPoolStats(context.Context, *Symbol) (*MemPoolStats, error)
-	// Get Transaction Stream
-	GetMemPoolStream(*Symbol, BloccRPC_GetMemPoolStreamServer) error
+func (m *Descriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Descriptor.Merge(m, src)
 }
-
-func RegisterBloccRPCServer(s *grpc.Server, srv BloccRPCServer) {
-	s.RegisterService(&_BloccRPC_serviceDesc, srv)
+func (m *Descriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Descriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Descriptor.DiscardUnknown(m)
 }
 
-func _BloccRPC_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Get)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BloccRPCServer).GetBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blocc.BloccRPC/GetBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BloccRPCServer).GetBlock(ctx, req.(*Get))
+var xxx_messageInfo_Descriptor proto.InternalMessageInfo
+
+func (m *Descriptor) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _BloccRPC_FindBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Find)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BloccRPCServer).FindBlocks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blocc.BloccRPC/FindBlocks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BloccRPCServer).FindBlocks(ctx, req.(*Find))
+func (m *Descriptor) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _BloccRPC_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Get)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BloccRPCServer).GetTransaction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blocc.BloccRPC/GetTransaction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BloccRPCServer).GetTransaction(ctx, req.(*Get))
+func (m *Descriptor) GetDesc() string {
+	if m != nil {
+		return m.Desc
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _BloccRPC_FindTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Find)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BloccRPCServer).FindTransactions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blocc.BloccRPC/FindTransactions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BloccRPCServer).FindTransactions(ctx, req.(*Find))
+func (m *Descriptor) GetRangeStart() uint32 {
+	if m != nil {
+		return m.RangeStart
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BloccRPC_FindTransactionsByAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Find)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BloccRPCServer).FindTransactionsByAddresses(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blocc.BloccRPC/FindTransactionsByAddresses",
+func (m *Descriptor) GetRangeEnd() uint32 {
+	if m != nil {
+		return m.RangeEnd
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BloccRPCServer).FindTransactionsByAddresses(ctx, req.(*Find))
+	return 0
+}
+
+func (m *Descriptor) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _BloccRPC_GetMemPoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Symbol)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Descriptor) GetScripts() []string {
+	if m != nil {
+		return m.Scripts
 	}
-	if interceptor == nil {
-		return srv.(BloccRPCServer).GetMemPoolStats(ctx, in)
+	return nil
+}
+
+// MemPoolStats
+type MemPoolStats struct {
+	// The timestamp
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// The count of transactions
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// The mempool size
+	MPSize int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (m *MemPoolStats) Reset()      { *m = MemPoolStats{} }
+func (*MemPoolStats) ProtoMessage() {}
+func (*MemPoolStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{17}
+}
+func (m *MemPoolStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemPoolStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemPoolStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blocc.BloccRPC/GetMemPoolStats",
+}
+func (m *MemPoolStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemPoolStats.Merge(m, src)
+}
+func (m *MemPoolStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemPoolStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemPoolStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemPoolStats proto.InternalMessageInfo
+
+func (m *MemPoolStats) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BloccRPCServer).GetMemPoolStats(ctx, req.(*Symbol))
+	return 0
+}
+
+func (m *MemPoolStats) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BloccRPC_GetMemPoolStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Symbol)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *MemPoolStats) GetMPSize() int64 {
+	if m != nil {
+		return m.MPSize
 	}
-	return srv.(BloccRPCServer).GetMemPoolStream(m, &bloccRPCGetMemPoolStreamServer{stream})
+	return 0
 }
 
-type BloccRPC_GetMemPoolStreamServer interface {
-	Send(*Tx) error
-	grpc.ServerStream
+// MemPoolHistogram - The mempool transactions grouped by fee rate
+type MemPoolHistogram struct {
+	// The timestamp
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// The count of transactions
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// The virtual size of the transactions
+	VSize int64 `protobuf:"varint,3,opt,name=vsize,proto3" json:"vsize,omitempty"`
+	// The buckets ordered by fee rate ascending
+	Buckets []*MemPoolBucket `protobuf:"bytes,4,rep,name=buckets,proto3" json:"buckets,omitempty"`
 }
 
-type bloccRPCGetMemPoolStreamServer struct {
-	grpc.ServerStream
+func (m *MemPoolHistogram) Reset()      { *m = MemPoolHistogram{} }
+func (*MemPoolHistogram) ProtoMessage() {}
+func (*MemPoolHistogram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{18}
+}
+func (m *MemPoolHistogram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemPoolHistogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemPoolHistogram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemPoolHistogram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemPoolHistogram.Merge(m, src)
+}
+func (m *MemPoolHistogram) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemPoolHistogram) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemPoolHistogram.DiscardUnknown(m)
 }
 
-func (x *bloccRPCGetMemPoolStreamServer) Send(m *Tx) error {
-	return x.ServerStream.SendMsg(m)
+var xxx_messageInfo_MemPoolHistogram proto.InternalMessageInfo
+
+func (m *MemPoolHistogram) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
 }
 
-var _BloccRPC_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "blocc.BloccRPC",
-	HandlerType: (*BloccRPCServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetBlock",
-			Handler:    _BloccRPC_GetBlock_Handler,
-		},
-		{
-			MethodName: "FindBlocks",
-			Handler:    _BloccRPC_FindBlocks_Handler,
-		},
-		{
-			MethodName: "GetTransaction",
-			Handler:    _BloccRPC_GetTransaction_Handler,
-		},
-		{
-			MethodName: "FindTransactions",
-			Handler:    _BloccRPC_FindTransactions_Handler,
-		},
-		{
-			MethodName: "FindTransactionsByAddresses",
-			Handler:    _BloccRPC_FindTransactionsByAddresses_Handler,
-		},
-		{
-			MethodName: "GetMemPoolStats",
-			Handler:    _BloccRPC_GetMemPoolStats_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "GetMemPoolStream",
-			Handler:       _BloccRPC_GetMemPoolStream_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "blocc/bloccrpc.proto",
+func (m *MemPoolHistogram) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-func (m *Symbol) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *MemPoolHistogram) GetVSize() int64 {
+	if m != nil {
+		return m.VSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Symbol) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Symbol) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
-		i += copy(dAtA[i:], m.Symbol)
+func (m *MemPoolHistogram) GetBuckets() []*MemPoolBucket {
+	if m != nil {
+		return m.Buckets
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Get) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MemPoolBucket - The mempool transactions with a fee rate from fee_vsize up to the next bucket
+type MemPoolBucket struct {
+	// The lowest fee rate of the bucket in satoshis per vbyte
+	FeeVSize float64 `protobuf:"fixed64,1,opt,name=fee_vsize,json=feeVsize,proto3" json:"fee_vsize"`
+	// The count of transactions
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	// The virtual size of the transactions
+	VSize int64 `protobuf:"varint,3,opt,name=vsize,proto3" json:"vsize"`
+	// The fees of the transactions
+	Fee int64 `protobuf:"varint,4,opt,name=fee,proto3" json:"fee"`
 }
 
-func (m *Get) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Symbol) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
-		i += copy(dAtA[i:], m.Symbol)
+func (m *MemPoolBucket) Reset()      { *m = MemPoolBucket{} }
+func (*MemPoolBucket) ProtoMessage() {}
+func (*MemPoolBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{19}
+}
+func (m *MemPoolBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemPoolBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemPoolBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+}
+func (m *MemPoolBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemPoolBucket.Merge(m, src)
+}
+func (m *MemPoolBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemPoolBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemPoolBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemPoolBucket proto.InternalMessageInfo
+
+func (m *MemPoolBucket) GetFeeVSize() float64 {
+	if m != nil {
+		return m.FeeVSize
 	}
-	if m.Include != 0 {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Include))
+	return 0
+}
+
+func (m *MemPoolBucket) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	if m.Data {
-		dAtA[i] = 0xa0
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.Data {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return 0
+}
+
+func (m *MemPoolBucket) GetVSize() int64 {
+	if m != nil {
+		return m.VSize
 	}
-	if m.Raw {
-		dAtA[i] = 0xa8
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.Raw {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return 0
+}
+
+func (m *MemPoolBucket) GetFee() int64 {
+	if m != nil {
+		return m.Fee
 	}
-	if m.Tx {
-		dAtA[i] = 0xb0
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.Tx {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return 0
+}
+
+// BlockCount
+type BlockCount struct {
+	// The coin symbol (default: btc)
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The number of blocks (default: server.default_projected_blocks)
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *BlockCount) Reset()      { *m = BlockCount{} }
+func (*BlockCount) ProtoMessage() {}
+func (*BlockCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{20}
+}
+func (m *BlockCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i++
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *BlockCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockCount.Merge(m, src)
+}
+func (m *BlockCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockCount.DiscardUnknown(m)
 }
 
-func (m *Find) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BlockCount proto.InternalMessageInfo
+
+func (m *BlockCount) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Find) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Symbol) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
-		i += copy(dAtA[i:], m.Symbol)
+func (m *BlockCount) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	if len(m.Ids) > 0 {
-		for _, s := range m.Ids {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	return 0
+}
+
+// ProjectedBlocks - The next blocks if they were mined from the mempool by fee rate
+type ProjectedBlocks struct {
+	// The timestamp
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// The blocks, next block first
+	Blocks []*ProjectedBlock `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *ProjectedBlocks) Reset()      { *m = ProjectedBlocks{} }
+func (*ProjectedBlocks) ProtoMessage() {}
+func (*ProjectedBlocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{21}
+}
+func (m *ProjectedBlocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedBlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedBlocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.StartTime != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintBloccrpc(dAtA, i, uint64(m.StartTime))
+}
+func (m *ProjectedBlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedBlocks.Merge(m, src)
+}
+func (m *ProjectedBlocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedBlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedBlocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectedBlocks proto.InternalMessageInfo
+
+func (m *ProjectedBlocks) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	if m.EndTime != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintBloccrpc(dAtA, i, uint64(m.EndTime))
+	return 0
+}
+
+func (m *ProjectedBlocks) GetBlocks() []*ProjectedBlock {
+	if m != nil {
+		return m.Blocks
 	}
-	if m.Offset != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Offset))
-	}
-	if m.Count != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Count))
-	}
-	if m.Include != 0 {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Include))
-	}
-	if m.Data {
-		dAtA[i] = 0xa0
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.Data {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.Raw {
-		dAtA[i] = 0xa8
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.Raw {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+// ProjectedBlock - A block of mempool transactions
+type ProjectedBlock struct {
+	// The lowest fee rate in satoshis per vbyte
+	MinFeeVSize float64 `protobuf:"fixed64,1,opt,name=min_fee_vsize,json=minFeeVsize,proto3" json:"min_fee_vsize"`
+	// The median fee rate in satoshis per vbyte
+	MedianFeeVSize float64 `protobuf:"fixed64,2,opt,name=median_fee_vsize,json=medianFeeVsize,proto3" json:"median_fee_vsize"`
+	// The highest fee rate in satoshis per vbyte
+	MaxFeeVSize float64 `protobuf:"fixed64,3,opt,name=max_fee_vsize,json=maxFeeVsize,proto3" json:"max_fee_vsize"`
+	// The fees of the transactions
+	Fee int64 `protobuf:"varint,4,opt,name=fee,proto3" json:"fee"`
+	// The count of transactions
+	TxCount int64 `protobuf:"varint,5,opt,name=tx_count,json=txCount,proto3" json:"tx_count"`
+	// The virtual size of the transactions
+	VSize int64 `protobuf:"varint,6,opt,name=vsize,proto3" json:"vsize"`
+}
+
+func (m *ProjectedBlock) Reset()      { *m = ProjectedBlock{} }
+func (*ProjectedBlock) ProtoMessage() {}
+func (*ProjectedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{22}
+}
+func (m *ProjectedBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i++
+		return b[:n], nil
 	}
-	if m.Tx {
-		dAtA[i] = 0xb0
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.Tx {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+}
+func (m *ProjectedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedBlock.Merge(m, src)
+}
+func (m *ProjectedBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectedBlock proto.InternalMessageInfo
+
+func (m *ProjectedBlock) GetMinFeeVSize() float64 {
+	if m != nil {
+		return m.MinFeeVSize
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Blocks) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ProjectedBlock) GetMedianFeeVSize() float64 {
+	if m != nil {
+		return m.MedianFeeVSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Blocks) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Blocks) > 0 {
-		for _, msg := range m.Blocks {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintBloccrpc(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *ProjectedBlock) GetMaxFeeVSize() float64 {
+	if m != nil {
+		return m.MaxFeeVSize
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Transactions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ProjectedBlock) GetFee() int64 {
+	if m != nil {
+		return m.Fee
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Transactions) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Transactions) > 0 {
-		for _, msg := range m.Transactions {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintBloccrpc(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *ProjectedBlock) GetTxCount() int64 {
+	if m != nil {
+		return m.TxCount
 	}
-	return i, nil
+	return 0
 }
 
-func (m *MemPoolStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ProjectedBlock) GetVSize() int64 {
+	if m != nil {
+		return m.VSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MemPoolStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Time != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Time))
+// FeeTarget
+type FeeTarget struct {
+	// The coin symbol (default: btc)
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The number of blocks to confirm within (default: 1)
+	TargetBlocks int64 `protobuf:"varint,2,opt,name=target_blocks,json=targetBlocks,proto3" json:"target_blocks,omitempty"`
+}
+
+func (m *FeeTarget) Reset()      { *m = FeeTarget{} }
+func (*FeeTarget) ProtoMessage() {}
+func (*FeeTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{23}
+}
+func (m *FeeTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Count))
+}
+func (m *FeeTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTarget.Merge(m, src)
+}
+func (m *FeeTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTarget proto.InternalMessageInfo
+
+func (m *FeeTarget) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	if m.MPSize != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintBloccrpc(dAtA, i, uint64(m.MPSize))
+	return ""
+}
+
+func (m *FeeTarget) GetTargetBlocks() int64 {
+	if m != nil {
+		return m.TargetBlocks
 	}
-	return i, nil
+	return 0
 }
 
-func encodeVarintBloccrpc(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// FeeEstimate
+type FeeEstimate struct {
+	// The coin symbol
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The number of blocks to confirm within
+	TargetBlocks int64 `protobuf:"varint,2,opt,name=target_blocks,json=targetBlocks,proto3" json:"target_blocks,omitempty"`
+	// The estimated fee rate in satoshis per vbyte
+	FeeVSize float64 `protobuf:"fixed64,3,opt,name=fee_vsize,json=feeVsize,proto3" json:"fee_vsize,omitempty"`
+	// The fee rate from simulating blocks being filled from the mempool
+	MemPoolFeeVSize float64 `protobuf:"fixed64,4,opt,name=mempool_fee_vsize,json=mempoolFeeVsize,proto3" json:"mempool_fee_vsize,omitempty"`
+	// The fee rate paid in recent blocks
+	HistoryFeeVSize float64 `protobuf:"fixed64,5,opt,name=history_fee_vsize,json=historyFeeVsize,proto3" json:"history_fee_vsize,omitempty"`
+	// The timestamp
+	Time int64 `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *FeeEstimate) Reset()      { *m = FeeEstimate{} }
+func (*FeeEstimate) ProtoMessage() {}
+func (*FeeEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{24}
+}
+func (m *FeeEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
 }
-func (m *Symbol) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeEstimate.Merge(m, src)
+}
+func (m *FeeEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeEstimate proto.InternalMessageInfo
+
+func (m *FeeEstimate) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovBloccrpc(uint64(l))
+	return ""
+}
+
+func (m *FeeEstimate) GetTargetBlocks() int64 {
+	if m != nil {
+		return m.TargetBlocks
 	}
-	return n
+	return 0
 }
 
-func (m *Get) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeEstimate) GetFeeVSize() float64 {
+	if m != nil {
+		return m.FeeVSize
 	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovBloccrpc(uint64(l))
+	return 0
+}
+
+func (m *FeeEstimate) GetMemPoolFeeVSize() float64 {
+	if m != nil {
+		return m.MemPoolFeeVSize
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovBloccrpc(uint64(l))
+	return 0
+}
+
+func (m *FeeEstimate) GetHistoryFeeVSize() float64 {
+	if m != nil {
+		return m.HistoryFeeVSize
 	}
-	if m.Include != 0 {
-		n += 2 + sovBloccrpc(uint64(m.Include))
+	return 0
+}
+
+func (m *FeeEstimate) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	if m.Data {
-		n += 3
+	return 0
+}
+
+// ConfirmationStats - How long transactions received in the mempool took to confirm in recent blocks by fee rate
+type ConfirmationStats struct {
+	// The timestamp
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// The first block height included
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// The last block height included
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// The count of transactions
+	Count int64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// The buckets ordered by fee rate ascending
+	Buckets []*ConfirmationBucket `protobuf:"bytes,5,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (m *ConfirmationStats) Reset()      { *m = ConfirmationStats{} }
+func (*ConfirmationStats) ProtoMessage() {}
+func (*ConfirmationStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{25}
+}
+func (m *ConfirmationStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmationStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmationStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Raw {
-		n += 3
+}
+func (m *ConfirmationStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmationStats.Merge(m, src)
+}
+func (m *ConfirmationStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmationStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmationStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmationStats proto.InternalMessageInfo
+
+func (m *ConfirmationStats) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	if m.Tx {
-		n += 3
+	return 0
+}
+
+func (m *ConfirmationStats) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return n
+	return 0
 }
 
-func (m *Find) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConfirmationStats) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovBloccrpc(uint64(l))
+	return 0
+}
+
+func (m *ConfirmationStats) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	if len(m.Ids) > 0 {
-		for _, s := range m.Ids {
-			l = len(s)
-			n += 1 + l + sovBloccrpc(uint64(l))
+	return 0
+}
+
+func (m *ConfirmationStats) GetBuckets() []*ConfirmationBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// ConfirmationBucket - The confirmation delays of transactions with a fee rate from fee_vsize up to the next bucket
+type ConfirmationBucket struct {
+	// The lowest fee rate of the bucket in satoshis per vbyte
+	FeeVSize float64 `protobuf:"fixed64,1,opt,name=fee_vsize,json=feeVsize,proto3" json:"fee_vsize"`
+	// The count of transactions
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	// The blocks from being received until confirmed
+	Blocks *ConfirmationDelay `protobuf:"bytes,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// The seconds from being received until confirmed
+	Seconds *ConfirmationDelay `protobuf:"bytes,4,opt,name=seconds,proto3" json:"seconds,omitempty"`
+}
+
+func (m *ConfirmationBucket) Reset()      { *m = ConfirmationBucket{} }
+func (*ConfirmationBucket) ProtoMessage() {}
+func (*ConfirmationBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{26}
+}
+func (m *ConfirmationBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmationBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmationBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.StartTime != 0 {
-		n += 1 + sovBloccrpc(uint64(m.StartTime))
+}
+func (m *ConfirmationBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmationBucket.Merge(m, src)
+}
+func (m *ConfirmationBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmationBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmationBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmationBucket proto.InternalMessageInfo
+
+func (m *ConfirmationBucket) GetFeeVSize() float64 {
+	if m != nil {
+		return m.FeeVSize
 	}
-	if m.EndTime != 0 {
-		n += 1 + sovBloccrpc(uint64(m.EndTime))
+	return 0
+}
+
+func (m *ConfirmationBucket) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	if m.Offset != 0 {
-		n += 1 + sovBloccrpc(uint64(m.Offset))
+	return 0
+}
+
+func (m *ConfirmationBucket) GetBlocks() *ConfirmationDelay {
+	if m != nil {
+		return m.Blocks
 	}
-	if m.Count != 0 {
-		n += 1 + sovBloccrpc(uint64(m.Count))
+	return nil
+}
+
+func (m *ConfirmationBucket) GetSeconds() *ConfirmationDelay {
+	if m != nil {
+		return m.Seconds
 	}
-	if m.Include != 0 {
-		n += 2 + sovBloccrpc(uint64(m.Include))
+	return nil
+}
+
+// ConfirmationDelay - The distribution of confirmation delays
+type ConfirmationDelay struct {
+	Avg    float64 `protobuf:"fixed64,1,opt,name=avg,proto3" json:"avg"`
+	P10    float64 `protobuf:"fixed64,2,opt,name=p10,proto3" json:"p10"`
+	Median float64 `protobuf:"fixed64,3,opt,name=median,proto3" json:"median"`
+	P90    float64 `protobuf:"fixed64,4,opt,name=p90,proto3" json:"p90"`
+	Max    float64 `protobuf:"fixed64,5,opt,name=max,proto3" json:"max"`
+}
+
+func (m *ConfirmationDelay) Reset()      { *m = ConfirmationDelay{} }
+func (*ConfirmationDelay) ProtoMessage() {}
+func (*ConfirmationDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{27}
+}
+func (m *ConfirmationDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmationDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmationDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Data {
-		n += 3
+}
+func (m *ConfirmationDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmationDelay.Merge(m, src)
+}
+func (m *ConfirmationDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmationDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmationDelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmationDelay proto.InternalMessageInfo
+
+func (m *ConfirmationDelay) GetAvg() float64 {
+	if m != nil {
+		return m.Avg
 	}
-	if m.Raw {
-		n += 3
+	return 0
+}
+
+func (m *ConfirmationDelay) GetP10() float64 {
+	if m != nil {
+		return m.P10
 	}
-	if m.Tx {
-		n += 3
+	return 0
+}
+
+func (m *ConfirmationDelay) GetMedian() float64 {
+	if m != nil {
+		return m.Median
 	}
-	return n
+	return 0
 }
 
-func (m *Blocks) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConfirmationDelay) GetP90() float64 {
+	if m != nil {
+		return m.P90
 	}
-	var l int
-	_ = l
-	if len(m.Blocks) > 0 {
-		for _, e := range m.Blocks {
-			l = e.Size()
-			n += 1 + l + sovBloccrpc(uint64(l))
+	return 0
+}
+
+func (m *ConfirmationDelay) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+// EventFilter - The events to subscribe to
+type EventFilter struct {
+	// The coin symbol (default: btc)
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The event types to receive (default: all)
+	Types []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+}
+
+func (m *EventFilter) Reset()      { *m = EventFilter{} }
+func (*EventFilter) ProtoMessage() {}
+func (*EventFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{28}
+}
+func (m *EventFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFilter.Merge(m, src)
+}
+func (m *EventFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFilter.DiscardUnknown(m)
 }
 
-func (m *Transactions) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventFilter proto.InternalMessageInfo
+
+func (m *EventFilter) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	var l int
-	_ = l
-	if len(m.Transactions) > 0 {
-		for _, e := range m.Transactions {
-			l = e.Size()
-			n += 1 + l + sovBloccrpc(uint64(l))
+	return ""
+}
+
+func (m *EventFilter) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+// Event - A block or transaction event
+type Event struct {
+	// The event type: block_connected, block_disconnected, tx_added, tx_confirmed or tx_evicted
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The coin symbol
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The timestamp
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	// The block of block events
+	Block *BlockHeader `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	// The transaction of transaction events
+	Tx *Tx `protobuf:"bytes,5,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (m *Event) Reset()      { *m = Event{} }
+func (*Event) ProtoMessage() {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{29}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Event) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *Event) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *Event) GetBlock() *BlockHeader {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *Event) GetTx() *Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// AddressSubscription - Changes to the addresses and scripts of a subscription
+type AddressSubscription struct {
+	// The coin symbol (default: btc), only used from the first message
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The addresses to add
+	AddAddresses []string `protobuf:"bytes,2,rep,name=add_addresses,json=addAddresses,proto3" json:"add_addresses,omitempty"`
+	// The addresses to remove
+	RemoveAddresses []string `protobuf:"bytes,3,rep,name=remove_addresses,json=removeAddresses,proto3" json:"remove_addresses,omitempty"`
+	// The output scripts (hex) to add
+	AddScripts []string `protobuf:"bytes,4,rep,name=add_scripts,json=addScripts,proto3" json:"add_scripts,omitempty"`
+	// The output scripts (hex) to remove
+	RemoveScripts []string `protobuf:"bytes,5,rep,name=remove_scripts,json=removeScripts,proto3" json:"remove_scripts,omitempty"`
+}
+
+func (m *AddressSubscription) Reset()      { *m = AddressSubscription{} }
+func (*AddressSubscription) ProtoMessage() {}
+func (*AddressSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{30}
+}
+func (m *AddressSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressSubscription.Merge(m, src)
+}
+func (m *AddressSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressSubscription proto.InternalMessageInfo
+
+func (m *AddressSubscription) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *AddressSubscription) GetAddAddresses() []string {
+	if m != nil {
+		return m.AddAddresses
+	}
+	return nil
+}
+
+func (m *AddressSubscription) GetRemoveAddresses() []string {
+	if m != nil {
+		return m.RemoveAddresses
+	}
+	return nil
+}
+
+func (m *AddressSubscription) GetAddScripts() []string {
+	if m != nil {
+		return m.AddScripts
+	}
+	return nil
+}
+
+func (m *AddressSubscription) GetRemoveScripts() []string {
+	if m != nil {
+		return m.RemoveScripts
+	}
+	return nil
+}
+
+// AddressEvent - A transaction of subscribed addresses or scripts
+type AddressEvent struct {
+	// The event type: tx_added, tx_confirmed, tx_confirmations or tx_evicted
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The coin symbol
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The timestamp
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	// The subscribed addresses in the transaction
+	Addresses []string `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// The subscribed scripts in the transaction
+	Scripts []string `protobuf:"bytes,5,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	// The number of confirmations, 0 in the mempool
+	Confirmations int64 `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations"`
+	// The transaction, only the header for confirmation updates
+	Tx *Tx `protobuf:"bytes,7,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (m *AddressEvent) Reset()      { *m = AddressEvent{} }
+func (*AddressEvent) ProtoMessage() {}
+func (*AddressEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{31}
+}
+func (m *AddressEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressEvent.Merge(m, src)
+}
+func (m *AddressEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressEvent proto.InternalMessageInfo
+
+func (m *AddressEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AddressEvent) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *AddressEvent) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *AddressEvent) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *AddressEvent) GetScripts() []string {
+	if m != nil {
+		return m.Scripts
+	}
+	return nil
+}
+
+func (m *AddressEvent) GetConfirmations() int64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *AddressEvent) GetTx() *Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// Webhook - A URL that is sent signed JSON payloads when it's watch fires
+type Webhook struct {
+	// The webhook id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The coin symbol (default: btc)
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The URL payloads are posted to
+	URL string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// The secret payloads are signed with (HMAC-SHA256), generated if not provided
+	Secret string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Fire for transactions of these addresses
+	Addresses []string `protobuf:"bytes,5,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// Fire for these transactions
+	TxIds []string `protobuf:"bytes,6,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+	// Fire when transactions reach this many confirmations (default: 1)
+	Confirmations int64 `protobuf:"varint,7,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// Fire for every block connected or disconnected
+	Blocks bool `protobuf:"varint,8,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// When it was created (unix timestamp)
+	Time int64 `protobuf:"varint,9,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *Webhook) Reset()      { *m = Webhook{} }
+func (*Webhook) ProtoMessage() {}
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{32}
+}
+func (m *Webhook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Webhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Webhook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Webhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhook.Merge(m, src)
+}
+func (m *Webhook) XXX_Size() int {
+	return m.Size()
+}
+func (m *Webhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Webhook proto.InternalMessageInfo
+
+func (m *Webhook) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Webhook) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *Webhook) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *Webhook) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *Webhook) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *Webhook) GetTxIds() []string {
+	if m != nil {
+		return m.TxIds
+	}
+	return nil
+}
+
+func (m *Webhook) GetConfirmations() int64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *Webhook) GetBlocks() bool {
+	if m != nil {
+		return m.Blocks
+	}
+	return false
+}
+
+func (m *Webhook) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// Webhooks
+type Webhooks struct {
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+}
+
+func (m *Webhooks) Reset()      { *m = Webhooks{} }
+func (*Webhooks) ProtoMessage() {}
+func (*Webhooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{33}
+}
+func (m *Webhooks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Webhooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Webhooks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Webhooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhooks.Merge(m, src)
+}
+func (m *Webhooks) XXX_Size() int {
+	return m.Size()
+}
+func (m *Webhooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhooks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Webhooks proto.InternalMessageInfo
+
+func (m *Webhooks) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+// WebhookEvent - The payload posted to a webhook
+type WebhookEvent struct {
+	// The delivery id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The webhook id
+	WebhookId string `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// The event type: tx_added, tx_confirmed, tx_evicted, block_connected or block_disconnected
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// The coin symbol
+	Symbol string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The timestamp
+	Time int64 `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	// The number of confirmations of tx_confirmed events
+	Confirmations int64 `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// The watched addresses in the transaction
+	Addresses []string `protobuf:"bytes,7,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// The block of block events
+	Block *BlockHeader `protobuf:"bytes,8,opt,name=block,proto3" json:"block,omitempty"`
+	// The transaction of transaction events
+	Tx *Tx `protobuf:"bytes,9,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (m *WebhookEvent) Reset()      { *m = WebhookEvent{} }
+func (*WebhookEvent) ProtoMessage() {}
+func (*WebhookEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{34}
+}
+func (m *WebhookEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WebhookEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WebhookEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookEvent.Merge(m, src)
+}
+func (m *WebhookEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookEvent proto.InternalMessageInfo
+
+func (m *WebhookEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WebhookEvent) GetWebhookId() string {
+	if m != nil {
+		return m.WebhookId
+	}
+	return ""
+}
+
+func (m *WebhookEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *WebhookEvent) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *WebhookEvent) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *WebhookEvent) GetConfirmations() int64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *WebhookEvent) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *WebhookEvent) GetBlock() *BlockHeader {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *WebhookEvent) GetTx() *Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// WebhookDelivery - A queued or failed delivery of a payload to a webhook
+type WebhookDelivery struct {
+	// The delivery id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The webhook id
+	WebhookId string `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// The payload
+	Event *WebhookEvent `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	// The number of attempts
+	Attempts int64 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts"`
+	// The error of the last attempt
+	LastError string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The time of the last attempt (unix timestamp)
+	LastAttempt int64 `protobuf:"varint,6,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`
+}
+
+func (m *WebhookDelivery) Reset()      { *m = WebhookDelivery{} }
+func (*WebhookDelivery) ProtoMessage() {}
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{35}
+}
+func (m *WebhookDelivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WebhookDelivery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WebhookDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookDelivery.Merge(m, src)
+}
+func (m *WebhookDelivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookDelivery proto.InternalMessageInfo
+
+func (m *WebhookDelivery) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetWebhookId() string {
+	if m != nil {
+		return m.WebhookId
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetEvent() *WebhookEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *WebhookDelivery) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *WebhookDelivery) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetLastAttempt() int64 {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return 0
+}
+
+// WebhookDeliveries
+type WebhookDeliveries struct {
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (m *WebhookDeliveries) Reset()      { *m = WebhookDeliveries{} }
+func (*WebhookDeliveries) ProtoMessage() {}
+func (*WebhookDeliveries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c9e048c06e054ff, []int{36}
+}
+func (m *WebhookDeliveries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookDeliveries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WebhookDeliveries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WebhookDeliveries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookDeliveries.Merge(m, src)
+}
+func (m *WebhookDeliveries) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookDeliveries) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookDeliveries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookDeliveries proto.InternalMessageInfo
+
+func (m *WebhookDeliveries) GetDeliveries() []*WebhookDelivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Symbol)(nil), "blocc.Symbol")
+	proto.RegisterType((*Get)(nil), "blocc.Get")
+	proto.RegisterType((*Find)(nil), "blocc.Find")
+	proto.RegisterType((*OutPoint)(nil), "blocc.OutPoint")
+	proto.RegisterType((*RawTx)(nil), "blocc.RawTx")
+	proto.RegisterType((*SentTx)(nil), "blocc.SentTx")
+	proto.RegisterType((*RawScript)(nil), "blocc.RawScript")
+	proto.RegisterType((*Script)(nil), "blocc.Script")
+	proto.RegisterType((*Blocks)(nil), "blocc.Blocks")
+	proto.RegisterType((*Transactions)(nil), "blocc.Transactions")
+	proto.RegisterType((*Utxo)(nil), "blocc.Utxo")
+	proto.RegisterType((*Utxos)(nil), "blocc.Utxos")
+	proto.RegisterType((*Spender)(nil), "blocc.Spender")
+	proto.RegisterType((*XPubScan)(nil), "blocc.XPubScan")
+	proto.RegisterType((*XPubAddress)(nil), "blocc.XPubAddress")
+	proto.RegisterType((*XPub)(nil), "blocc.XPub")
+	proto.RegisterType((*Descriptor)(nil), "blocc.Descriptor")
+	proto.RegisterType((*MemPoolStats)(nil), "blocc.MemPoolStats")
+	proto.RegisterType((*MemPoolHistogram)(nil), "blocc.MemPoolHistogram")
+	proto.RegisterType((*MemPoolBucket)(nil), "blocc.MemPoolBucket")
+	proto.RegisterType((*BlockCount)(nil), "blocc.BlockCount")
+	proto.RegisterType((*ProjectedBlocks)(nil), "blocc.ProjectedBlocks")
+	proto.RegisterType((*ProjectedBlock)(nil), "blocc.ProjectedBlock")
+	proto.RegisterType((*FeeTarget)(nil), "blocc.FeeTarget")
+	proto.RegisterType((*FeeEstimate)(nil), "blocc.FeeEstimate")
+	proto.RegisterType((*ConfirmationStats)(nil), "blocc.ConfirmationStats")
+	proto.RegisterType((*ConfirmationBucket)(nil), "blocc.ConfirmationBucket")
+	proto.RegisterType((*ConfirmationDelay)(nil), "blocc.ConfirmationDelay")
+	proto.RegisterType((*EventFilter)(nil), "blocc.EventFilter")
+	proto.RegisterType((*Event)(nil), "blocc.Event")
+	proto.RegisterType((*AddressSubscription)(nil), "blocc.AddressSubscription")
+	proto.RegisterType((*AddressEvent)(nil), "blocc.AddressEvent")
+	proto.RegisterType((*Webhook)(nil), "blocc.Webhook")
+	proto.RegisterType((*Webhooks)(nil), "blocc.Webhooks")
+	proto.RegisterType((*WebhookEvent)(nil), "blocc.WebhookEvent")
+	proto.RegisterType((*WebhookDelivery)(nil), "blocc.WebhookDelivery")
+	proto.RegisterType((*WebhookDeliveries)(nil), "blocc.WebhookDeliveries")
+}
+
+func init() { proto.RegisterFile("blocc/bloccrpc.proto", fileDescriptor_0c9e048c06e054ff) }
+
+var fileDescriptor_0c9e048c06e054ff = []byte{
+	// 3314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3a, 0x4d, 0x6f, 0x1c, 0x47,
+	0x76, 0x6a, 0xce, 0xf7, 0x9b, 0xe1, 0x57, 0x51, 0xa2, 0x86, 0x23, 0x69, 0x86, 0xaa, 0xb5, 0x56,
+	0x14, 0xbd, 0x52, 0x53, 0x14, 0xec, 0x85, 0xbc, 0x59, 0x04, 0xa2, 0x28, 0x91, 0xc2, 0x5a, 0x30,
+	0xb7, 0x49, 0xaf, 0x84, 0x59, 0xac, 0x99, 0xe6, 0x74, 0x71, 0xd8, 0xd6, 0x4c, 0xf7, 0xb8, 0xbb,
+	0x87, 0x1a, 0x5a, 0x21, 0x10, 0x38, 0x40, 0x0e, 0x8e, 0x13, 0x04, 0x08, 0x02, 0xe4, 0x98, 0x20,
+	0x97, 0xe4, 0x90, 0x5b, 0xfe, 0x42, 0x80, 0x1c, 0x72, 0x30, 0xe0, 0x43, 0x9c, 0x20, 0x18, 0xc4,
+	0xe3, 0x1c, 0x0c, 0x9e, 0x8c, 0x1c, 0x9c, 0x20, 0xa7, 0xa0, 0x5e, 0x55, 0x77, 0x57, 0xcf, 0x0c,
+	0x29, 0xcb, 0xf1, 0xfa, 0x32, 0xac, 0x7a, 0xef, 0xd5, 0xfb, 0xae, 0xd7, 0xaf, 0x9e, 0x04, 0xe7,
+	0xf7, 0x5a, 0x6e, 0xa3, 0xa1, 0xe3, 0xaf, 0xd7, 0x69, 0xdc, 0xea, 0x78, 0x6e, 0xe0, 0x92, 0x0c,
+	0xee, 0x2b, 0x37, 0x9b, 0x76, 0x70, 0xd0, 0xdd, 0xbb, 0xd5, 0x70, 0xdb, 0x7a, 0xd3, 0x6d, 0xba,
+	0x3a, 0x62, 0xf7, 0xba, 0xfb, 0xb8, 0xc3, 0x0d, 0xae, 0xc4, 0xa9, 0xca, 0xe5, 0xa6, 0xeb, 0x36,
+	0x5b, 0x4c, 0x37, 0x3b, 0xb6, 0x6e, 0x3a, 0x8e, 0x1b, 0x98, 0x81, 0xed, 0x3a, 0xbe, 0xc4, 0xce,
+	0x2a, 0x92, 0x04, 0x88, 0x2e, 0x42, 0x76, 0xfb, 0xa8, 0xbd, 0xe7, 0xb6, 0xc8, 0x3c, 0x64, 0x7d,
+	0x5c, 0x95, 0xb5, 0x45, 0x6d, 0xa9, 0x60, 0xc8, 0x1d, 0x3d, 0x86, 0xd4, 0x06, 0x0b, 0x4e, 0x43,
+	0x93, 0x29, 0x98, 0xb0, 0xad, 0xf2, 0x04, 0xc2, 0x26, 0x6c, 0x8b, 0x94, 0x21, 0x67, 0x3b, 0x8d,
+	0x56, 0xd7, 0x62, 0xe5, 0xc6, 0xa2, 0xb6, 0x94, 0x31, 0xc2, 0x2d, 0x21, 0x90, 0xb6, 0xcc, 0xc0,
+	0x2c, 0x5b, 0x8b, 0xda, 0x52, 0xde, 0xc0, 0x35, 0x99, 0x81, 0x94, 0x67, 0x3e, 0x2f, 0x33, 0x04,
+	0xf1, 0x25, 0xe7, 0x17, 0xf4, 0xca, 0xfb, 0x08, 0x98, 0x08, 0x7a, 0xf4, 0x2b, 0x0d, 0xd2, 0x0f,
+	0x6d, 0xc7, 0x3a, 0x55, 0x81, 0x19, 0x48, 0xd9, 0x96, 0x5f, 0x9e, 0x58, 0x4c, 0x2d, 0x15, 0x0c,
+	0xbe, 0x24, 0x57, 0x00, 0xfc, 0xc0, 0xf4, 0x82, 0xdd, 0xc0, 0x6e, 0xb3, 0x72, 0x6a, 0x51, 0x5b,
+	0x4a, 0x19, 0x05, 0x84, 0xec, 0xd8, 0x6d, 0x46, 0x16, 0x20, 0xcf, 0x1c, 0x4b, 0x20, 0xd3, 0x88,
+	0xcc, 0x31, 0xc7, 0x42, 0xd4, 0x3c, 0x64, 0xdd, 0xfd, 0x7d, 0x9f, 0x05, 0xe5, 0x0c, 0x22, 0xe4,
+	0x8e, 0x9c, 0x87, 0x4c, 0xc3, 0xed, 0x3a, 0x41, 0x39, 0x8b, 0x60, 0xb1, 0xf9, 0xde, 0x4d, 0x7d,
+	0x07, 0xf2, 0xef, 0x74, 0x83, 0x2d, 0xd7, 0x76, 0x4e, 0x77, 0xf7, 0x1c, 0x64, 0x82, 0xde, 0x6e,
+	0xe4, 0xf1, 0x74, 0xd0, 0x7b, 0x84, 0xae, 0x39, 0x60, 0x76, 0xf3, 0x20, 0x90, 0xc6, 0xca, 0x1d,
+	0xbd, 0x0d, 0x19, 0xc3, 0x7c, 0xbe, 0xd3, 0x3b, 0xcb, 0x77, 0x07, 0xac, 0x27, 0x79, 0xf1, 0x25,
+	0x7d, 0x03, 0xb2, 0xdb, 0xcc, 0x09, 0x76, 0x7a, 0xaf, 0xa4, 0x01, 0x7d, 0x03, 0x0a, 0x86, 0xf9,
+	0x7c, 0xbb, 0xe1, 0xd9, 0x9d, 0xe0, 0x15, 0xa4, 0xfd, 0xab, 0x06, 0x59, 0x79, 0x88, 0x40, 0x3a,
+	0x38, 0xea, 0x30, 0x79, 0x04, 0xd7, 0xe4, 0x75, 0x28, 0x98, 0x96, 0xe5, 0x31, 0xdf, 0x67, 0x32,
+	0xc0, 0x6b, 0x93, 0x27, 0xfd, 0x5a, 0x0c, 0x34, 0xe2, 0x25, 0x0f, 0xab, 0xc7, 0x3e, 0xd8, 0xf5,
+	0xed, 0xa6, 0x2f, 0xdd, 0x90, 0xf3, 0xd8, 0x07, 0xdb, 0x76, 0xd3, 0xe7, 0x82, 0x4d, 0xbf, 0x8d,
+	0xc1, 0x2e, 0x18, 0x7c, 0x49, 0x28, 0x64, 0x7d, 0xbb, 0xe9, 0x76, 0x7c, 0x11, 0xe8, 0x35, 0x38,
+	0xe9, 0xd7, 0x24, 0xc4, 0x90, 0x7f, 0xc9, 0x65, 0x48, 0x77, 0x56, 0xfd, 0x03, 0x8c, 0x79, 0x61,
+	0x2d, 0x3f, 0xe8, 0xd7, 0xd2, 0x5b, 0xab, 0xdb, 0x9b, 0x06, 0x42, 0x49, 0x0d, 0x32, 0x9d, 0xd5,
+	0xe7, 0xfe, 0x41, 0x39, 0x87, 0xe8, 0xc2, 0xa0, 0x5f, 0xcb, 0x6c, 0xad, 0x3e, 0xd9, 0xde, 0x34,
+	0x04, 0x9c, 0xde, 0x82, 0xec, 0x5a, 0xcb, 0x6d, 0x3c, 0xf3, 0xc9, 0x6b, 0x90, 0xdd, 0xc3, 0x55,
+	0x59, 0x5b, 0x4c, 0x2d, 0x15, 0x57, 0x4b, 0xb7, 0xc4, 0x0d, 0x44, 0xb4, 0x21, 0x71, 0xf4, 0xe7,
+	0x50, 0xda, 0xf1, 0x4c, 0xc7, 0x37, 0x1b, 0x78, 0x65, 0xc9, 0x4d, 0x28, 0x05, 0xca, 0x5e, 0x9e,
+	0x2d, 0xc8, 0xb3, 0x3b, 0x3d, 0x23, 0x81, 0xa6, 0x1f, 0xa7, 0x20, 0xfd, 0x6e, 0xd0, 0x73, 0xe3,
+	0xf8, 0x68, 0x4a, 0x86, 0xd0, 0x28, 0x43, 0x26, 0x62, 0x7b, 0x05, 0x24, 0xcc, 0x16, 0x9e, 0xe4,
+	0x87, 0x66, 0xab, 0x1b, 0xde, 0x18, 0xb1, 0x89, 0xe2, 0x92, 0x56, 0xe2, 0x72, 0x43, 0x8d, 0x4b,
+	0x06, 0xe3, 0x52, 0x3c, 0xe9, 0xd7, 0x72, 0x12, 0xa8, 0x46, 0xe5, 0x0e, 0x64, 0x7d, 0x0c, 0x30,
+	0xba, 0xb1, 0xb4, 0x76, 0xe9, 0xa4, 0x5f, 0x9b, 0x11, 0x90, 0x9f, 0xb8, 0x6d, 0x3b, 0x60, 0xed,
+	0x4e, 0x70, 0xf4, 0xbf, 0xfd, 0x5a, 0xca, 0x30, 0x9f, 0x1b, 0x92, 0x94, 0x87, 0x12, 0x9d, 0xc2,
+	0xad, 0x40, 0xf7, 0x1a, 0x39, 0xdc, 0x3f, 0xb2, 0xc8, 0x1d, 0x28, 0x09, 0x94, 0x34, 0x27, 0x8f,
+	0xe6, 0xcc, 0x9c, 0xf4, 0x6b, 0x09, 0xb8, 0x51, 0xc4, 0xdd, 0xa6, 0xb0, 0xec, 0xa7, 0x30, 0xd9,
+	0x70, 0x9d, 0x7d, 0xdb, 0x6b, 0x8b, 0x72, 0x58, 0x2e, 0xe0, 0xa9, 0xd9, 0x93, 0x7e, 0x2d, 0x89,
+	0x30, 0x92, 0x5b, 0xf2, 0x26, 0x4c, 0xb6, 0x59, 0xbb, 0xe3, 0xba, 0xad, 0x5d, 0xbf, 0xc3, 0x9c,
+	0xa0, 0x0c, 0xfc, 0xb2, 0x8a, 0x83, 0x09, 0x84, 0x51, 0x92, 0xdb, 0x6d, 0xbe, 0xa3, 0xcb, 0x90,
+	0xe1, 0xb1, 0xf0, 0xc9, 0x55, 0xc8, 0x74, 0xf9, 0x42, 0x46, 0xaf, 0x28, 0xa3, 0xc7, 0x91, 0x86,
+	0xc0, 0xd0, 0xff, 0xd2, 0x20, 0xc7, 0x4f, 0x59, 0xcc, 0xfb, 0xee, 0xb1, 0x53, 0x3d, 0x96, 0x3a,
+	0xdb, 0x63, 0xe9, 0xef, 0xe4, 0xb1, 0xcc, 0xb7, 0xf4, 0xd8, 0x35, 0xc8, 0x49, 0x4f, 0x60, 0xc0,
+	0xf3, 0x22, 0x31, 0x24, 0xc8, 0x08, 0x17, 0x74, 0xa0, 0x41, 0xfe, 0xe9, 0x56, 0x77, 0x6f, 0xbb,
+	0x61, 0x3a, 0xa7, 0xd6, 0x0b, 0x02, 0xe9, 0x5e, 0xa7, 0xbb, 0x17, 0x16, 0x1a, 0xbe, 0x26, 0x35,
+	0x28, 0x8a, 0x24, 0xd9, 0xc5, 0xac, 0x14, 0xb6, 0x82, 0x00, 0xed, 0xf0, 0xdc, 0xbc, 0x04, 0x85,
+	0xa6, 0xd9, 0xd9, 0x6d, 0xd9, 0x6d, 0x5b, 0xda, 0x6a, 0xe4, 0x9b, 0x66, 0xe7, 0x6d, 0xbe, 0xff,
+	0x61, 0xeb, 0x3b, 0xfd, 0x6f, 0x0d, 0x8a, 0xdc, 0xc8, 0x7b, 0xe2, 0x36, 0x70, 0x7e, 0xf2, 0x62,
+	0x48, 0x43, 0xc3, 0x2d, 0x2f, 0x26, 0x8d, 0x03, 0xd3, 0x76, 0xd0, 0xd4, 0xc9, 0xb5, 0xc2, 0x49,
+	0xbf, 0x26, 0x00, 0x86, 0xf8, 0xc3, 0x09, 0x6c, 0xc7, 0x62, 0xbd, 0x72, 0x2a, 0x26, 0x40, 0x80,
+	0x21, 0xfe, 0x90, 0xeb, 0x90, 0x0f, 0x7a, 0xbb, 0xc2, 0x08, 0x11, 0xe1, 0xd2, 0x49, 0xbf, 0x16,
+	0xc1, 0x8c, 0x5c, 0xd0, 0xbb, 0x8f, 0x46, 0x5d, 0x83, 0xdc, 0x9e, 0xd9, 0x32, 0x9d, 0x06, 0x93,
+	0x31, 0xc5, 0x00, 0x49, 0x90, 0x11, 0x2e, 0xc8, 0xef, 0xc0, 0x74, 0x98, 0xe0, 0x21, 0x39, 0xfa,
+	0x66, 0x6d, 0xee, 0xa4, 0x5f, 0x1b, 0x46, 0x19, 0x53, 0x12, 0xb0, 0x26, 0xf6, 0xf4, 0x9b, 0x09,
+	0x48, 0x3f, 0xdd, 0x1a, 0x0d, 0x97, 0x36, 0x12, 0xae, 0x95, 0xe1, 0x12, 0x5f, 0x5c, 0x25, 0xf2,
+	0x92, 0x28, 0xae, 0x53, 0x2b, 0xca, 0x3a, 0x10, 0x87, 0xf5, 0x82, 0x5d, 0x8f, 0x35, 0x98, 0x7d,
+	0xc8, 0x76, 0x55, 0xbf, 0xcc, 0x9f, 0xf4, 0x6b, 0x63, 0xb0, 0xc6, 0x0c, 0x87, 0x19, 0x02, 0xf4,
+	0x08, 0xfd, 0x75, 0x0f, 0x66, 0x91, 0xae, 0x71, 0x60, 0x3a, 0xcd, 0x90, 0x49, 0x1a, 0x99, 0x5c,
+	0x38, 0xe9, 0xd7, 0x46, 0x91, 0xc6, 0x34, 0x07, 0xdd, 0x47, 0x88, 0x60, 0xf1, 0x43, 0x78, 0x72,
+	0xe4, 0x2b, 0x90, 0x3b, 0xfb, 0x2b, 0xf0, 0x3f, 0x1a, 0xc0, 0x3a, 0x13, 0xfe, 0x75, 0xbd, 0xb3,
+	0x6e, 0x96, 0x63, 0xb6, 0x59, 0x78, 0xb3, 0xf8, 0x9a, 0x2c, 0x01, 0x58, 0xd1, 0xc9, 0x72, 0x2a,
+	0xfe, 0xe8, 0x71, 0x7e, 0x86, 0x82, 0x23, 0x2b, 0x50, 0xf4, 0xd0, 0x31, 0xd8, 0x53, 0x49, 0xaf,
+	0x4d, 0x9f, 0xf4, 0x6b, 0x2a, 0xd8, 0x00, 0xdc, 0x6c, 0xf3, 0x35, 0x59, 0x86, 0x82, 0x40, 0x31,
+	0xc7, 0x42, 0x67, 0x4d, 0x8a, 0x0f, 0x79, 0x04, 0x34, 0xf2, 0xb8, 0x7c, 0xe0, 0x58, 0xe4, 0xb2,
+	0x9a, 0x11, 0x59, 0xec, 0xea, 0x62, 0x00, 0xbf, 0x43, 0x42, 0x0f, 0xe1, 0x8a, 0x82, 0x11, 0x6e,
+	0xe9, 0x53, 0x28, 0x3d, 0x66, 0xed, 0x2d, 0x5e, 0x83, 0x03, 0x33, 0xf0, 0xf1, 0xc3, 0xc5, 0x5b,
+	0x3c, 0x0d, 0xaf, 0x34, 0xae, 0xe3, 0x7b, 0x3e, 0xa1, 0xde, 0xf3, 0x2a, 0xa4, 0x7d, 0xfb, 0x43,
+	0xf9, 0xdd, 0x5b, 0x83, 0x41, 0xbf, 0x96, 0x7d, 0xbc, 0xb5, 0x6d, 0x7f, 0xc8, 0x0c, 0x84, 0xd3,
+	0x3f, 0xd1, 0x60, 0x46, 0xb2, 0xde, 0xb4, 0xfd, 0xc0, 0x6d, 0x7a, 0x66, 0xfb, 0x15, 0xd8, 0xd7,
+	0x20, 0x73, 0xa8, 0xf0, 0xc7, 0x4e, 0xe1, 0x57, 0xc8, 0x5e, 0xc0, 0xc9, 0x2d, 0xc8, 0xed, 0x75,
+	0x1b, 0xcf, 0x58, 0xe0, 0x97, 0xd3, 0x18, 0xde, 0xf3, 0x32, 0xbc, 0x52, 0xe8, 0x1a, 0x22, 0x8d,
+	0x90, 0x88, 0xfe, 0xbd, 0x06, 0x93, 0x09, 0x14, 0x79, 0x13, 0x0a, 0xfb, 0x8c, 0xed, 0x0a, 0x31,
+	0x5c, 0x23, 0x6d, 0x6d, 0x61, 0xd0, 0xaf, 0xe5, 0x1f, 0x32, 0x86, 0x92, 0xb8, 0xaf, 0x23, 0x02,
+	0x23, 0xbf, 0xcf, 0xd8, 0xaf, 0x50, 0x72, 0x2d, 0xa1, 0xb0, 0xac, 0x3b, 0x1c, 0x10, 0xea, 0xbe,
+	0x94, 0xd4, 0x9d, 0x44, 0xba, 0x73, 0x4a, 0xc1, 0x4d, 0xfc, 0x21, 0x0b, 0x90, 0xda, 0x67, 0xb2,
+	0xa1, 0x5e, 0xcb, 0x9d, 0xf4, 0x6b, 0x7c, 0x6b, 0xf0, 0x1f, 0xfa, 0x16, 0x00, 0xb6, 0x3a, 0xa2,
+	0x00, 0x9d, 0x96, 0x93, 0x63, 0x9d, 0x47, 0x77, 0x60, 0x7a, 0xcb, 0x73, 0xdf, 0x67, 0x8d, 0x80,
+	0x59, 0xb2, 0x9d, 0x1a, 0xe7, 0xf9, 0x9b, 0x51, 0x8b, 0x25, 0x6a, 0xc8, 0x05, 0xe9, 0xc1, 0xe4,
+	0xd9, 0xa8, 0xd7, 0xfa, 0xf7, 0x09, 0x98, 0x4a, 0xa2, 0xc8, 0x3a, 0x4c, 0xb6, 0x6d, 0x67, 0x77,
+	0xd8, 0x8d, 0x8b, 0x83, 0x7e, 0xad, 0xf8, 0xd8, 0x76, 0x14, 0x4f, 0x26, 0xe9, 0x8c, 0x62, 0x5b,
+	0x60, 0xf9, 0x86, 0x6c, 0xc1, 0x4c, 0x9b, 0x59, 0xb6, 0xa9, 0x32, 0x9a, 0x40, 0x46, 0x3f, 0x1e,
+	0xf4, 0x6b, 0x53, 0x8f, 0x11, 0xa7, 0xf0, 0x1a, 0xa1, 0x36, 0xa6, 0x04, 0x24, 0xe2, 0xc8, 0xf5,
+	0x32, 0x7b, 0x0a, 0xbb, 0x94, 0xa2, 0x97, 0xd9, 0x4b, 0xe8, 0xa5, 0xd2, 0x19, 0xc5, 0xb6, 0xc0,
+	0xbe, 0x24, 0x3a, 0x89, 0x2f, 0x47, 0xe6, 0xac, 0x2f, 0x47, 0x94, 0x0b, 0xd9, 0x97, 0xe4, 0x02,
+	0xdd, 0x84, 0xc2, 0x43, 0xc6, 0x76, 0x4c, 0xaf, 0x79, 0xc6, 0xc3, 0xf1, 0x47, 0x30, 0x19, 0x20,
+	0xc5, 0x6e, 0x14, 0x39, 0x1e, 0xcf, 0x92, 0x00, 0x8a, 0x58, 0xd3, 0x3f, 0x9e, 0x80, 0xe2, 0x43,
+	0xc6, 0x1e, 0xf8, 0x81, 0xdd, 0x36, 0x03, 0xf6, 0xff, 0x62, 0xc6, 0xdb, 0xd6, 0x61, 0x37, 0x96,
+	0xd4, 0x5b, 0xa2, 0x5c, 0x8c, 0xdf, 0x85, 0xd9, 0xb0, 0x32, 0xc7, 0x47, 0xd2, 0x78, 0x64, 0x6e,
+	0xd0, 0xaf, 0x4d, 0xcb, 0xeb, 0x17, 0x9d, 0x0c, 0xeb, 0xf8, 0x43, 0x85, 0xc1, 0x01, 0xaf, 0x15,
+	0xde, 0x91, 0xc2, 0x20, 0x13, 0x33, 0xd8, 0x14, 0xc8, 0x98, 0xc1, 0x41, 0x0c, 0x40, 0x06, 0x61,
+	0x96, 0x67, 0xe3, 0x2c, 0xa7, 0xff, 0xa0, 0xc1, 0xec, 0x7d, 0xa5, 0xdd, 0x3a, 0xbd, 0xd0, 0x5d,
+	0x85, 0x92, 0x78, 0x02, 0xab, 0x9d, 0xa3, 0x51, 0x44, 0x98, 0x6c, 0xf1, 0xae, 0x00, 0xf0, 0x67,
+	0x70, 0xe2, 0xe1, 0x58, 0x60, 0x8e, 0xb5, 0x19, 0xbd, 0x06, 0x94, 0x6e, 0x22, 0xac, 0x07, 0x77,
+	0xe2, 0x52, 0x95, 0xc1, 0x8b, 0xb6, 0x20, 0x2f, 0x9a, 0xaa, 0xd6, 0x70, 0xbd, 0xfa, 0x4c, 0x03,
+	0x32, 0x8a, 0xff, 0xed, 0x15, 0xad, 0x95, 0xa8, 0x18, 0x70, 0xab, 0x8a, 0xab, 0xe5, 0x31, 0x3a,
+	0xae, 0xb3, 0x96, 0x79, 0x14, 0xd6, 0x03, 0xb2, 0x0a, 0x39, 0x9f, 0x35, 0x5c, 0xc7, 0xf2, 0xcb,
+	0xe9, 0x97, 0x1c, 0x09, 0x09, 0xe9, 0xdf, 0x0c, 0x05, 0x03, 0xd1, 0xfc, 0xa2, 0x99, 0x87, 0x4d,
+	0x69, 0x0e, 0x5e, 0x34, 0xf3, 0xb0, 0x69, 0xf0, 0x1f, 0x8e, 0xea, 0xdc, 0x5e, 0x29, 0x4f, 0xc4,
+	0xa8, 0xce, 0xed, 0x15, 0x83, 0xff, 0xf0, 0x16, 0x5f, 0x5c, 0x7b, 0x99, 0x96, 0xd8, 0xe2, 0x0b,
+	0x88, 0x21, 0xff, 0xe2, 0xf1, 0xbb, 0x2b, 0xe5, 0xb4, 0x72, 0xfc, 0x2e, 0x3f, 0x7e, 0x77, 0x85,
+	0xa3, 0xda, 0x66, 0xaf, 0x9c, 0x89, 0x51, 0x6d, 0xb3, 0x67, 0xf0, 0x1f, 0xfa, 0x33, 0x28, 0x3e,
+	0x38, 0x64, 0x4e, 0xf0, 0xd0, 0x6e, 0x05, 0xcc, 0x3b, 0xab, 0xf8, 0xf2, 0x06, 0x2d, 0x1c, 0xa3,
+	0x88, 0x0d, 0xfd, 0x44, 0x83, 0x0c, 0x9e, 0x1e, 0xfb, 0x3a, 0x8f, 0x79, 0x4d, 0x0c, 0x37, 0x17,
+	0xca, 0xe0, 0x05, 0xd7, 0xbc, 0x76, 0xa0, 0xab, 0xa5, 0x7b, 0x89, 0xfa, 0x02, 0xde, 0x64, 0xa6,
+	0xc5, 0x3c, 0x43, 0x10, 0x90, 0x05, 0x1c, 0x8a, 0x64, 0x16, 0xb5, 0x64, 0x9b, 0xc3, 0xe7, 0x23,
+	0xff, 0xa8, 0xc1, 0x9c, 0x6c, 0x08, 0xb7, 0xbb, 0x7b, 0xe2, 0xbb, 0x6f, 0xbb, 0xce, 0x59, 0x45,
+	0xc1, 0xb4, 0xac, 0xdd, 0xa1, 0x11, 0x82, 0x51, 0x32, 0x2d, 0xeb, 0x5e, 0x08, 0x23, 0x37, 0x60,
+	0xc6, 0x63, 0x6d, 0xf7, 0x90, 0x29, 0x74, 0x29, 0xa4, 0x9b, 0x16, 0xf0, 0x98, 0xb4, 0x06, 0x45,
+	0xce, 0x2f, 0xec, 0x3f, 0xd2, 0x48, 0x05, 0xa6, 0x65, 0x89, 0x11, 0x06, 0x7f, 0xfc, 0x4c, 0x49,
+	0x5e, 0x21, 0x0d, 0x3e, 0x8e, 0x8d, 0x49, 0x01, 0x95, 0x64, 0xf4, 0xdf, 0x34, 0x28, 0x49, 0xae,
+	0xdf, 0x8f, 0x77, 0x13, 0x2d, 0x53, 0xfa, 0x8c, 0x96, 0x29, 0x93, 0x68, 0x99, 0x46, 0x5f, 0x79,
+	0xd9, 0x6f, 0xf9, 0xca, 0x13, 0x41, 0xca, 0x8d, 0x0b, 0xd2, 0x37, 0x1a, 0xe4, 0x9e, 0xb0, 0xbd,
+	0x03, 0xd7, 0x7d, 0x26, 0x67, 0x83, 0x5a, 0x34, 0x1b, 0x3c, 0xcd, 0xa6, 0x05, 0x48, 0x75, 0xbd,
+	0x96, 0xec, 0x39, 0x73, 0x83, 0x7e, 0x2d, 0xf5, 0xae, 0xf1, 0xb6, 0xc1, 0x61, 0x78, 0x84, 0x35,
+	0x3c, 0x16, 0xc8, 0x01, 0x84, 0xdc, 0x25, 0x4d, 0xce, 0x0c, 0x9b, 0xbc, 0x08, 0x59, 0x7c, 0x47,
+	0xcb, 0x06, 0x52, 0xf4, 0x5c, 0x3b, 0xbd, 0x47, 0x96, 0x6f, 0x64, 0xf8, 0x9b, 0x9a, 0xcf, 0x64,
+	0x86, 0x4c, 0xcf, 0xa1, 0x3f, 0x87, 0xec, 0x9c, 0x8f, 0x2a, 0x49, 0x1e, 0x9f, 0x75, 0x72, 0x17,
+	0x05, 0xa1, 0xa0, 0x14, 0xe7, 0x37, 0x21, 0x2f, 0xed, 0xf6, 0xc9, 0x32, 0xe4, 0x9f, 0xcb, 0xb5,
+	0x7c, 0xf9, 0x4f, 0x49, 0x2f, 0x49, 0x12, 0x23, 0xc2, 0xd3, 0x3f, 0x9a, 0x80, 0x92, 0x84, 0x8a,
+	0x6c, 0x18, 0xf6, 0xda, 0x15, 0x00, 0x49, 0x1c, 0x4f, 0xdd, 0x0a, 0x12, 0xf2, 0xc8, 0x8a, 0x92,
+	0x27, 0x35, 0x36, 0x79, 0xd2, 0x63, 0x93, 0x27, 0xa3, 0x24, 0xcf, 0x6b, 0x63, 0x93, 0x60, 0xd8,
+	0x13, 0x09, 0x7f, 0xe7, 0x86, 0xfd, 0x1d, 0x5d, 0xef, 0xfc, 0xb7, 0xbb, 0xde, 0x85, 0x71, 0x99,
+	0xf3, 0x2f, 0x1a, 0x4c, 0x4b, 0x47, 0xac, 0xb3, 0x96, 0x7d, 0xc8, 0xbc, 0xa3, 0x57, 0xf5, 0xc5,
+	0x0d, 0xc8, 0x30, 0xee, 0x43, 0x59, 0xf8, 0xe7, 0x92, 0x4e, 0x47, 0xf7, 0x1a, 0x82, 0x82, 0x2c,
+	0x41, 0xde, 0x0c, 0x70, 0xfc, 0xe4, 0xab, 0x0f, 0xe6, 0x10, 0x66, 0x44, 0x2b, 0x2e, 0xb3, 0x65,
+	0xfa, 0xc1, 0x2e, 0xf3, 0x3c, 0xd7, 0x43, 0xd7, 0x15, 0x8c, 0x02, 0x87, 0x3c, 0xe0, 0x00, 0xfe,
+	0xa9, 0x45, 0xb4, 0xa4, 0x97, 0xee, 0x2b, 0x72, 0xd8, 0x3d, 0x01, 0xa2, 0xbf, 0x80, 0xd9, 0xa4,
+	0x61, 0x36, 0xe3, 0xb3, 0x25, 0xb0, 0xa2, 0x9d, 0xcc, 0x92, 0xf9, 0xa4, 0xc2, 0xa1, 0x1b, 0x0c,
+	0x85, 0x72, 0xf5, 0xaf, 0x16, 0x20, 0xcf, 0x1d, 0xdb, 0x30, 0xb6, 0xee, 0x93, 0x6d, 0xc8, 0x6f,
+	0xc8, 0xfe, 0x86, 0x80, 0x3c, 0xbc, 0xc1, 0x82, 0x4a, 0x62, 0xc4, 0x48, 0x6f, 0x7e, 0xf4, 0xd9,
+	0x7f, 0xfe, 0xf9, 0xc4, 0x75, 0x52, 0xd2, 0x45, 0xfe, 0xea, 0x2f, 0x6c, 0xeb, 0xb8, 0x7e, 0x91,
+	0x5c, 0xd0, 0x5f, 0x88, 0xc4, 0x38, 0x56, 0x11, 0xc4, 0x03, 0xe0, 0x13, 0x77, 0xd9, 0x35, 0x85,
+	0x33, 0x2b, 0x0e, 0xaa, 0x4c, 0xaa, 0x7c, 0x7d, 0xba, 0x89, 0x8c, 0xd7, 0x68, 0x4e, 0x9e, 0x7f,
+	0x4b, 0x5b, 0xae, 0x5f, 0xa0, 0x33, 0xc3, 0x6c, 0x39, 0xb8, 0x40, 0x42, 0xa2, 0x3a, 0x21, 0x23,
+	0x14, 0xe4, 0x0f, 0x35, 0x98, 0xda, 0x60, 0x81, 0x32, 0x01, 0x4d, 0xd8, 0x13, 0xa7, 0x0a, 0xad,
+	0xa3, 0xcc, 0x1d, 0x42, 0x74, 0xf5, 0xe5, 0x2b, 0x4c, 0xba, 0x42, 0x2e, 0xc5, 0x9c, 0x47, 0xd1,
+	0x40, 0xf2, 0x7a, 0xd0, 0x13, 0xeb, 0x39, 0x32, 0xab, 0x90, 0x0a, 0x20, 0xf9, 0x67, 0x0d, 0x66,
+	0xb8, 0x9d, 0x89, 0x41, 0x6c, 0xc2, 0x01, 0x61, 0x4a, 0xa9, 0x14, 0xf4, 0x2f, 0x34, 0xd4, 0xe9,
+	0x4f, 0x35, 0x3a, 0x99, 0x90, 0xca, 0xed, 0xbe, 0x44, 0xe7, 0xc7, 0xab, 0xc4, 0x91, 0xd3, 0x24,
+	0x79, 0xa0, 0x5e, 0x26, 0xa7, 0x50, 0xd7, 0xf3, 0x34, 0xa5, 0x07, 0x3d, 0x7e, 0x68, 0x96, 0x96,
+	0x54, 0xcd, 0x39, 0x28, 0x43, 0x38, 0xb2, 0x3e, 0x45, 0x12, 0x18, 0xf2, 0xd7, 0x1a, 0x5c, 0x1a,
+	0x36, 0x67, 0xed, 0x28, 0xfe, 0xa0, 0xbd, 0xdc, 0xb2, 0xdf, 0x43, 0xc3, 0xea, 0x14, 0xf4, 0xe8,
+	0xb2, 0x73, 0x79, 0x65, 0x3a, 0x17, 0x0b, 0x4a, 0x60, 0x78, 0x6c, 0x23, 0x00, 0x77, 0xaa, 0x7f,
+	0x5c, 0xbf, 0x44, 0x16, 0xc6, 0x50, 0x0b, 0x24, 0xf9, 0x3b, 0x0d, 0x08, 0x97, 0xff, 0xae, 0x83,
+	0x83, 0xd4, 0x77, 0xba, 0x41, 0xa7, 0x1b, 0x0c, 0xa9, 0x56, 0x52, 0xc6, 0xa6, 0x3e, 0xed, 0xa1,
+	0x4e, 0x1e, 0x55, 0x05, 0xe1, 0x28, 0x95, 0xcb, 0xaf, 0xd2, 0xb1, 0xb2, 0x22, 0x3c, 0x77, 0xf0,
+	0x90, 0x0a, 0x02, 0x59, 0xbf, 0x4a, 0x6a, 0xa7, 0x6a, 0x29, 0x48, 0xc8, 0x7b, 0x40, 0xb6, 0xf9,
+	0xb0, 0xc2, 0x7c, 0xae, 0xe6, 0x69, 0xa8, 0x1d, 0x07, 0xf7, 0xa2, 0x1b, 0x22, 0xfe, 0x15, 0x85,
+	0xbe, 0x8e, 0xca, 0x5e, 0xa3, 0x98, 0x78, 0x3e, 0x73, 0x2c, 0xae, 0xc4, 0x3c, 0x4d, 0xe6, 0x9e,
+	0x84, 0x93, 0xdf, 0xc0, 0xec, 0x3a, 0x6b, 0xb8, 0x16, 0x3b, 0x9d, 0xbd, 0x72, 0x11, 0x74, 0x64,
+	0x7d, 0x83, 0x02, 0x67, 0x61, 0xe1, 0xb9, 0x91, 0xd8, 0xa8, 0x18, 0x72, 0x00, 0x25, 0xc1, 0x5e,
+	0xfe, 0x93, 0xcb, 0x4c, 0xcc, 0x59, 0x40, 0x62, 0xe5, 0x71, 0x4b, 0x7f, 0x8a, 0x12, 0x6e, 0xd3,
+	0x29, 0x5d, 0x74, 0x0c, 0x8a, 0x94, 0xcb, 0xf4, 0x62, 0x2c, 0x65, 0x18, 0x4b, 0x3e, 0xd1, 0x60,
+	0x66, 0x83, 0xc9, 0x60, 0x86, 0xc3, 0xed, 0x69, 0xc9, 0x3c, 0xfc, 0x37, 0xae, 0x4a, 0xf8, 0x4d,
+	0x94, 0x04, 0xf4, 0x09, 0x8a, 0xfb, 0x25, 0xb9, 0xaa, 0xbb, 0x78, 0xd0, 0xd7, 0x5f, 0xe0, 0x57,
+	0xfc, 0x58, 0x7f, 0x21, 0x5e, 0x29, 0xc7, 0xba, 0x2f, 0x48, 0xeb, 0x3f, 0x21, 0xcb, 0xb1, 0xf8,
+	0x97, 0x51, 0x93, 0x36, 0xc0, 0x06, 0x0b, 0xc2, 0x31, 0xac, 0x5a, 0x57, 0x42, 0x15, 0x24, 0x8e,
+	0xde, 0x47, 0x15, 0x7e, 0x4e, 0x2e, 0x26, 0x33, 0xe0, 0x58, 0xf7, 0xbb, 0xed, 0xb6, 0xe9, 0x1d,
+	0xd5, 0x29, 0x59, 0x3c, 0x25, 0x4b, 0x22, 0x1a, 0xf2, 0x91, 0x06, 0x79, 0x3e, 0xd8, 0xc6, 0x09,
+	0xe8, 0xb4, 0x32, 0xcd, 0xe4, 0xc0, 0x4a, 0x51, 0x01, 0xd0, 0xa7, 0x28, 0xcf, 0xa0, 0xa0, 0xf3,
+	0xe9, 0xb6, 0xee, 0x37, 0x4c, 0x67, 0x24, 0x86, 0x09, 0x0c, 0xbf, 0xe2, 0x08, 0x78, 0xc1, 0x7f,
+	0x87, 0x8a, 0xb8, 0x82, 0x20, 0x2e, 0x10, 0x83, 0x35, 0x6d, 0x3f, 0x60, 0x9e, 0x32, 0x10, 0x9c,
+	0x95, 0xc2, 0x63, 0x50, 0x65, 0x14, 0x44, 0xef, 0xa0, 0x56, 0x37, 0x69, 0x49, 0x8f, 0xa7, 0x7e,
+	0x78, 0x7b, 0x2a, 0x54, 0x91, 0x96, 0xc4, 0x11, 0x1b, 0xa6, 0x7f, 0xd9, 0x65, 0xde, 0x91, 0x22,
+	0x4d, 0xf5, 0xf4, 0x18, 0x31, 0x32, 0xbd, 0xc8, 0xac, 0xca, 0x4a, 0x54, 0xe7, 0xcb, 0xa4, 0x32,
+	0x56, 0x10, 0x62, 0xc9, 0xef, 0xc3, 0xf9, 0x0d, 0x16, 0x8c, 0xbe, 0x84, 0xa3, 0xf4, 0xc5, 0x83,
+	0x95, 0x71, 0x8f, 0x38, 0x24, 0xa4, 0x3f, 0x43, 0xc9, 0x6f, 0x90, 0x39, 0x7d, 0x9f, 0x31, 0x5f,
+	0x4f, 0xb4, 0x3a, 0xf5, 0x2a, 0xb9, 0x1c, 0xcb, 0x1e, 0xc5, 0x93, 0x7d, 0x98, 0xde, 0x60, 0x41,
+	0x62, 0xd6, 0x38, 0x24, 0x78, 0x2e, 0x39, 0xbf, 0x13, 0x32, 0xe5, 0x75, 0x25, 0x53, 0xba, 0x9c,
+	0x15, 0xe8, 0x3e, 0x87, 0x63, 0x04, 0x5b, 0xac, 0x69, 0x36, 0x8e, 0x92, 0x08, 0xf2, 0x97, 0x1a,
+	0x14, 0xc3, 0xc1, 0xc7, 0x43, 0xc6, 0xa2, 0xeb, 0x1a, 0x8d, 0x56, 0x2a, 0x24, 0x86, 0x84, 0x84,
+	0xb4, 0x81, 0x62, 0x7e, 0x43, 0xaa, 0x42, 0x75, 0x26, 0xe1, 0xfa, 0x8b, 0xc4, 0x7c, 0xe4, 0xb8,
+	0x7e, 0x83, 0x5c, 0x1f, 0xb2, 0xf2, 0x54, 0xd2, 0x19, 0x32, 0x95, 0xa4, 0x20, 0x3d, 0x98, 0x8b,
+	0x5d, 0x10, 0xcf, 0x44, 0x87, 0xdc, 0x70, 0x31, 0xe9, 0x86, 0x88, 0x8e, 0xde, 0x45, 0x1d, 0xef,
+	0x10, 0x12, 0x59, 0x7c, 0x10, 0xe2, 0x92, 0x9f, 0xf0, 0x11, 0x34, 0x79, 0x01, 0x0b, 0xb1, 0xe4,
+	0xe1, 0xc9, 0xe0, 0xac, 0xda, 0x9d, 0xe0, 0xd0, 0xaa, 0x32, 0x3f, 0x76, 0x10, 0xe8, 0x87, 0x29,
+	0x4e, 0xa6, 0x23, 0x19, 0xb2, 0x39, 0xa9, 0x90, 0xf2, 0xa8, 0x7c, 0x81, 0x23, 0x26, 0xcc, 0xc4,
+	0xc2, 0xb7, 0x03, 0x8f, 0x8d, 0xda, 0xac, 0xd4, 0xe7, 0xdb, 0x28, 0xe2, 0x75, 0x45, 0x84, 0x8f,
+	0x47, 0xf0, 0x1b, 0x34, 0x12, 0x71, 0x8e, 0x59, 0xd1, 0xc8, 0x0e, 0x14, 0xe4, 0xdb, 0x76, 0x8f,
+	0x91, 0x30, 0xbe, 0xca, 0x0b, 0xbe, 0x52, 0x52, 0x61, 0xf4, 0x47, 0x28, 0xe3, 0x0a, 0xc9, 0xe9,
+	0xd8, 0xd5, 0x0e, 0xf5, 0x56, 0x02, 0xb6, 0xa2, 0x91, 0xf7, 0x81, 0x44, 0x5c, 0xe3, 0xcf, 0x7f,
+	0x25, 0x59, 0xfc, 0xd4, 0x37, 0x75, 0x65, 0x2e, 0x89, 0x13, 0xd2, 0x6a, 0x28, 0x6d, 0x81, 0x9e,
+	0x57, 0x2a, 0x9f, 0x1f, 0xf2, 0x7d, 0x4b, 0x5b, 0x5e, 0xd2, 0x56, 0x34, 0xf2, 0x1e, 0x4c, 0xde,
+	0xf7, 0x98, 0x19, 0xb0, 0xe8, 0x15, 0x98, 0x6c, 0x6a, 0x2b, 0x43, 0xfb, 0xb0, 0x3b, 0xa5, 0x05,
+	0x3d, 0x7c, 0x13, 0xf1, 0x52, 0x73, 0x91, 0x92, 0xd8, 0x10, 0x05, 0x41, 0x7e, 0x8d, 0xc5, 0x3c,
+	0x64, 0x3e, 0xae, 0x98, 0x87, 0x8c, 0xc3, 0x00, 0x4c, 0x45, 0xe7, 0x45, 0x71, 0x59, 0x20, 0x17,
+	0x47, 0x59, 0x23, 0x8a, 0x3c, 0x81, 0x12, 0xef, 0x38, 0xa2, 0x87, 0xdc, 0x50, 0x74, 0xa7, 0x93,
+	0x12, 0x7c, 0x7a, 0x1d, 0x45, 0x5c, 0x25, 0xb1, 0xee, 0xf5, 0xf3, 0x64, 0x8c, 0xe2, 0xdc, 0x2b,
+	0xeb, 0xac, 0xc5, 0x02, 0xf6, 0x0a, 0x8a, 0x2f, 0x8f, 0x28, 0xbe, 0x7c, 0xaa, 0xe2, 0x1f, 0x6b,
+	0x70, 0x21, 0x76, 0xcb, 0x3a, 0x33, 0xad, 0xb7, 0x59, 0x10, 0x30, 0x2f, 0xf9, 0xb9, 0x2b, 0x8f,
+	0x7d, 0x5f, 0xd8, 0xcc, 0xa7, 0xbf, 0x40, 0x91, 0x0f, 0xc8, 0xa5, 0x24, 0x5f, 0xdd, 0x62, 0xa6,
+	0xb5, 0xdb, 0x12, 0xac, 0xea, 0x3f, 0x26, 0xaf, 0x9d, 0x22, 0x3f, 0x41, 0xb7, 0xf6, 0xeb, 0x4f,
+	0xbf, 0xa8, 0x9e, 0xfb, 0xfc, 0x8b, 0xea, 0xb9, 0xaf, 0xbf, 0xa8, 0x6a, 0x7f, 0x30, 0xa8, 0x6a,
+	0x7f, 0x3b, 0xa8, 0x6a, 0xff, 0x34, 0xa8, 0x6a, 0x9f, 0x0e, 0xaa, 0xda, 0x7f, 0x0c, 0xaa, 0xda,
+	0x57, 0x83, 0xea, 0xb9, 0xaf, 0x07, 0x55, 0xed, 0xcf, 0xbe, 0xac, 0x9e, 0xfb, 0xf4, 0xcb, 0xea,
+	0xb9, 0xcf, 0xbf, 0xac, 0x9e, 0xab, 0x5f, 0x6b, 0xda, 0xc1, 0xad, 0x86, 0x6b, 0x3b, 0x8e, 0xed,
+	0xbc, 0x6f, 0xde, 0x72, 0x58, 0xa0, 0xef, 0x99, 0x8d, 0x67, 0xcc, 0xb1, 0x74, 0xe5, 0xff, 0x2d,
+	0xed, 0x65, 0xf1, 0x3f, 0x2e, 0xdd, 0xf9, 0xbf, 0x01, 0x00, 0x53, 0xce, 0x51, 0x98, 0x37, 0x25,
+	0x00, 0x00,
+}
+
+func (this *Symbol) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Symbol)
+	if !ok {
+		that2, ok := that.(Symbol)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	return true
+}
+func (this *Get) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Get)
+	if !ok {
+		that2, ok := that.(Get)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Include != that1.Include {
+		return false
+	}
+	if this.Data != that1.Data {
+		return false
+	}
+	if this.Raw != that1.Raw {
+		return false
+	}
+	if this.Tx != that1.Tx {
+		return false
+	}
+	return true
+}
+func (this *Find) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Find)
+	if !ok {
+		that2, ok := that.(Find)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if len(this.Ids) != len(that1.Ids) {
+		return false
+	}
+	for i := range this.Ids {
+		if this.Ids[i] != that1.Ids[i] {
+			return false
+		}
+	}
+	if this.StartTime != that1.StartTime {
+		return false
+	}
+	if this.EndTime != that1.EndTime {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.Include != that1.Include {
+		return false
+	}
+	if this.Data != that1.Data {
+		return false
+	}
+	if this.Raw != that1.Raw {
+		return false
+	}
+	if this.Tx != that1.Tx {
+		return false
+	}
+	return true
+}
+func (this *OutPoint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutPoint)
+	if !ok {
+		that2, ok := that.(OutPoint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *RawTx) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RawTx)
+	if !ok {
+		that2, ok := that.(RawTx)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Hex != that1.Hex {
+		return false
+	}
+	return true
+}
+func (this *SentTx) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SentTx)
+	if !ok {
+		that2, ok := that.(SentTx)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	return true
+}
+func (this *RawScript) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RawScript)
+	if !ok {
+		that2, ok := that.(RawScript)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Hex != that1.Hex {
+		return false
+	}
+	return true
+}
+func (this *Script) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Script)
+	if !ok {
+		that2, ok := that.(Script)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	if this.ReqSigs != that1.ReqSigs {
+		return false
+	}
+	if this.Asm != that1.Asm {
+		return false
+	}
+	if this.Sigops != that1.Sigops {
+		return false
+	}
+	if this.P2SH != that1.P2SH {
+		return false
+	}
+	if this.P2WSH != that1.P2WSH {
+		return false
+	}
+	return true
+}
+func (this *Blocks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Blocks)
+	if !ok {
+		that2, ok := that.(Blocks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Blocks) != len(that1.Blocks) {
+		return false
+	}
+	for i := range this.Blocks {
+		if !this.Blocks[i].Equal(that1.Blocks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Transactions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Transactions)
+	if !ok {
+		that2, ok := that.(Transactions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Utxo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Utxo)
+	if !ok {
+		that2, ok := that.(Utxo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Script, that1.Script) {
+		return false
+	}
+	if this.BlockId != that1.BlockId {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if this.Confirmations != that1.Confirmations {
+		return false
+	}
+	if this.MempoolSpent != that1.MempoolSpent {
+		return false
+	}
+	return true
+}
+func (this *Utxos) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Utxos)
+	if !ok {
+		that2, ok := that.(Utxos)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Utxos) != len(that1.Utxos) {
+		return false
+	}
+	for i := range this.Utxos {
+		if !this.Utxos[i].Equal(that1.Utxos[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Spender) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Spender)
+	if !ok {
+		that2, ok := that.(Spender)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.BlockId != that1.BlockId {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if this.Confirmations != that1.Confirmations {
+		return false
+	}
+	if this.Mempool != that1.Mempool {
+		return false
+	}
+	return true
+}
+func (this *XPubScan) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*XPubScan)
+	if !ok {
+		that2, ok := that.(XPubScan)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Xpub != that1.Xpub {
+		return false
+	}
+	if this.ScriptType != that1.ScriptType {
+		return false
+	}
+	if this.GapLimit != that1.GapLimit {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.Include != that1.Include {
+		return false
+	}
+	if this.Data != that1.Data {
+		return false
+	}
+	if this.Raw != that1.Raw {
+		return false
+	}
+	return true
+}
+func (this *XPubAddress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*XPubAddress)
+	if !ok {
+		that2, ok := that.(XPubAddress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Chain != that1.Chain {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.TxCount != that1.TxCount {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	if this.MempoolBalance != that1.MempoolBalance {
+		return false
+	}
+	return true
+}
+func (this *XPub) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*XPub)
+	if !ok {
+		that2, ok := that.(XPub)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScriptType != that1.ScriptType {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if !this.Addresses[i].Equal(that1.Addresses[i]) {
+			return false
+		}
+	}
+	if this.NextReceiveIndex != that1.NextReceiveIndex {
+		return false
+	}
+	if this.NextChangeIndex != that1.NextChangeIndex {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	if this.MempoolBalance != that1.MempoolBalance {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Descriptor) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Descriptor)
+	if !ok {
+		that2, ok := that.(Descriptor)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Desc != that1.Desc {
+		return false
+	}
+	if this.RangeStart != that1.RangeStart {
+		return false
+	}
+	if this.RangeEnd != that1.RangeEnd {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	if len(this.Scripts) != len(that1.Scripts) {
+		return false
+	}
+	for i := range this.Scripts {
+		if this.Scripts[i] != that1.Scripts[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *MemPoolStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MemPoolStats)
+	if !ok {
+		that2, ok := that.(MemPoolStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.MPSize != that1.MPSize {
+		return false
+	}
+	return true
+}
+func (this *MemPoolHistogram) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MemPoolHistogram)
+	if !ok {
+		that2, ok := that.(MemPoolHistogram)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.VSize != that1.VSize {
+		return false
+	}
+	if len(this.Buckets) != len(that1.Buckets) {
+		return false
+	}
+	for i := range this.Buckets {
+		if !this.Buckets[i].Equal(that1.Buckets[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MemPoolBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MemPoolBucket)
+	if !ok {
+		that2, ok := that.(MemPoolBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FeeVSize != that1.FeeVSize {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.VSize != that1.VSize {
+		return false
+	}
+	if this.Fee != that1.Fee {
+		return false
+	}
+	return true
+}
+func (this *BlockCount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockCount)
+	if !ok {
+		that2, ok := that.(BlockCount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *ProjectedBlocks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProjectedBlocks)
+	if !ok {
+		that2, ok := that.(ProjectedBlocks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if len(this.Blocks) != len(that1.Blocks) {
+		return false
+	}
+	for i := range this.Blocks {
+		if !this.Blocks[i].Equal(that1.Blocks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ProjectedBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProjectedBlock)
+	if !ok {
+		that2, ok := that.(ProjectedBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MinFeeVSize != that1.MinFeeVSize {
+		return false
+	}
+	if this.MedianFeeVSize != that1.MedianFeeVSize {
+		return false
+	}
+	if this.MaxFeeVSize != that1.MaxFeeVSize {
+		return false
+	}
+	if this.Fee != that1.Fee {
+		return false
+	}
+	if this.TxCount != that1.TxCount {
+		return false
+	}
+	if this.VSize != that1.VSize {
+		return false
+	}
+	return true
+}
+func (this *FeeTarget) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeTarget)
+	if !ok {
+		that2, ok := that.(FeeTarget)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.TargetBlocks != that1.TargetBlocks {
+		return false
+	}
+	return true
+}
+func (this *FeeEstimate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeEstimate)
+	if !ok {
+		that2, ok := that.(FeeEstimate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.TargetBlocks != that1.TargetBlocks {
+		return false
+	}
+	if this.FeeVSize != that1.FeeVSize {
+		return false
+	}
+	if this.MemPoolFeeVSize != that1.MemPoolFeeVSize {
+		return false
+	}
+	if this.HistoryFeeVSize != that1.HistoryFeeVSize {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	return true
+}
+func (this *ConfirmationStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConfirmationStats)
+	if !ok {
+		that2, ok := that.(ConfirmationStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if len(this.Buckets) != len(that1.Buckets) {
+		return false
+	}
+	for i := range this.Buckets {
+		if !this.Buckets[i].Equal(that1.Buckets[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ConfirmationBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConfirmationBucket)
+	if !ok {
+		that2, ok := that.(ConfirmationBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FeeVSize != that1.FeeVSize {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if !this.Blocks.Equal(that1.Blocks) {
+		return false
+	}
+	if !this.Seconds.Equal(that1.Seconds) {
+		return false
+	}
+	return true
+}
+func (this *ConfirmationDelay) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConfirmationDelay)
+	if !ok {
+		that2, ok := that.(ConfirmationDelay)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Avg != that1.Avg {
+		return false
+	}
+	if this.P10 != that1.P10 {
+		return false
+	}
+	if this.Median != that1.Median {
+		return false
+	}
+	if this.P90 != that1.P90 {
+		return false
+	}
+	if this.Max != that1.Max {
+		return false
+	}
+	return true
+}
+func (this *EventFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EventFilter)
+	if !ok {
+		that2, ok := that.(EventFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if len(this.Types) != len(that1.Types) {
+		return false
+	}
+	for i := range this.Types {
+		if this.Types[i] != that1.Types[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *Event) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Event)
+	if !ok {
+		that2, ok := that.(Event)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if !this.Block.Equal(that1.Block) {
+		return false
+	}
+	if !this.Tx.Equal(that1.Tx) {
+		return false
+	}
+	return true
+}
+func (this *AddressSubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressSubscription)
+	if !ok {
+		that2, ok := that.(AddressSubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if len(this.AddAddresses) != len(that1.AddAddresses) {
+		return false
+	}
+	for i := range this.AddAddresses {
+		if this.AddAddresses[i] != that1.AddAddresses[i] {
+			return false
+		}
+	}
+	if len(this.RemoveAddresses) != len(that1.RemoveAddresses) {
+		return false
+	}
+	for i := range this.RemoveAddresses {
+		if this.RemoveAddresses[i] != that1.RemoveAddresses[i] {
+			return false
+		}
+	}
+	if len(this.AddScripts) != len(that1.AddScripts) {
+		return false
+	}
+	for i := range this.AddScripts {
+		if this.AddScripts[i] != that1.AddScripts[i] {
+			return false
+		}
+	}
+	if len(this.RemoveScripts) != len(that1.RemoveScripts) {
+		return false
+	}
+	for i := range this.RemoveScripts {
+		if this.RemoveScripts[i] != that1.RemoveScripts[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *AddressEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressEvent)
+	if !ok {
+		that2, ok := that.(AddressEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	if len(this.Scripts) != len(that1.Scripts) {
+		return false
+	}
+	for i := range this.Scripts {
+		if this.Scripts[i] != that1.Scripts[i] {
+			return false
+		}
+	}
+	if this.Confirmations != that1.Confirmations {
+		return false
+	}
+	if !this.Tx.Equal(that1.Tx) {
+		return false
+	}
+	return true
+}
+func (this *Webhook) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Webhook)
+	if !ok {
+		that2, ok := that.(Webhook)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if this.Secret != that1.Secret {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	if len(this.TxIds) != len(that1.TxIds) {
+		return false
+	}
+	for i := range this.TxIds {
+		if this.TxIds[i] != that1.TxIds[i] {
+			return false
+		}
+	}
+	if this.Confirmations != that1.Confirmations {
+		return false
+	}
+	if this.Blocks != that1.Blocks {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	return true
+}
+func (this *Webhooks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Webhooks)
+	if !ok {
+		that2, ok := that.(Webhooks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Webhooks) != len(that1.Webhooks) {
+		return false
+	}
+	for i := range this.Webhooks {
+		if !this.Webhooks[i].Equal(that1.Webhooks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *WebhookEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WebhookEvent)
+	if !ok {
+		that2, ok := that.(WebhookEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.WebhookId != that1.WebhookId {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if this.Confirmations != that1.Confirmations {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	if !this.Block.Equal(that1.Block) {
+		return false
+	}
+	if !this.Tx.Equal(that1.Tx) {
+		return false
+	}
+	return true
+}
+func (this *WebhookDelivery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WebhookDelivery)
+	if !ok {
+		that2, ok := that.(WebhookDelivery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.WebhookId != that1.WebhookId {
+		return false
+	}
+	if !this.Event.Equal(that1.Event) {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	if this.LastAttempt != that1.LastAttempt {
+		return false
+	}
+	return true
+}
+func (this *WebhookDeliveries) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WebhookDeliveries)
+	if !ok {
+		that2, ok := that.(WebhookDeliveries)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Deliveries) != len(that1.Deliveries) {
+		return false
+	}
+	for i := range this.Deliveries {
+		if !this.Deliveries[i].Equal(that1.Deliveries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Symbol) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&blocc.Symbol{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Get) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&blocc.Get{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Include: "+fmt.Sprintf("%#v", this.Include)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Raw: "+fmt.Sprintf("%#v", this.Raw)+",\n")
+	s = append(s, "Tx: "+fmt.Sprintf("%#v", this.Tx)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Find) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&blocc.Find{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "Ids: "+fmt.Sprintf("%#v", this.Ids)+",\n")
+	s = append(s, "StartTime: "+fmt.Sprintf("%#v", this.StartTime)+",\n")
+	s = append(s, "EndTime: "+fmt.Sprintf("%#v", this.EndTime)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "Include: "+fmt.Sprintf("%#v", this.Include)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Raw: "+fmt.Sprintf("%#v", this.Raw)+",\n")
+	s = append(s, "Tx: "+fmt.Sprintf("%#v", this.Tx)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OutPoint) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&blocc.OutPoint{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "Height: "+fmt.Sprintf("%#v", this.Height)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RawTx) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&blocc.RawTx{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "Hex: "+fmt.Sprintf("%#v", this.Hex)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SentTx) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&blocc.SentTx{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RawScript) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&blocc.RawScript{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "Hex: "+fmt.Sprintf("%#v", this.Hex)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Script) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&blocc.Script{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "ReqSigs: "+fmt.Sprintf("%#v", this.ReqSigs)+",\n")
+	s = append(s, "Asm: "+fmt.Sprintf("%#v", this.Asm)+",\n")
+	s = append(s, "Sigops: "+fmt.Sprintf("%#v", this.Sigops)+",\n")
+	s = append(s, "P2SH: "+fmt.Sprintf("%#v", this.P2SH)+",\n")
+	s = append(s, "P2WSH: "+fmt.Sprintf("%#v", this.P2WSH)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Blocks) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&blocc.Blocks{")
+	if this.Blocks != nil {
+		s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Transactions) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&blocc.Transactions{")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Utxo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&blocc.Utxo{")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "Height: "+fmt.Sprintf("%#v", this.Height)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "Script: "+fmt.Sprintf("%#v", this.Script)+",\n")
+	s = append(s, "BlockId: "+fmt.Sprintf("%#v", this.BlockId)+",\n")
+	s = append(s, "BlockHeight: "+fmt.Sprintf("%#v", this.BlockHeight)+",\n")
+	s = append(s, "Confirmations: "+fmt.Sprintf("%#v", this.Confirmations)+",\n")
+	s = append(s, "MempoolSpent: "+fmt.Sprintf("%#v", this.MempoolSpent)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Utxos) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&blocc.Utxos{")
+	if this.Utxos != nil {
+		s = append(s, "Utxos: "+fmt.Sprintf("%#v", this.Utxos)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Spender) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&blocc.Spender{")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "Height: "+fmt.Sprintf("%#v", this.Height)+",\n")
+	s = append(s, "BlockId: "+fmt.Sprintf("%#v", this.BlockId)+",\n")
+	s = append(s, "BlockHeight: "+fmt.Sprintf("%#v", this.BlockHeight)+",\n")
+	s = append(s, "Confirmations: "+fmt.Sprintf("%#v", this.Confirmations)+",\n")
+	s = append(s, "Mempool: "+fmt.Sprintf("%#v", this.Mempool)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *XPubScan) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&blocc.XPubScan{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "Xpub: "+fmt.Sprintf("%#v", this.Xpub)+",\n")
+	s = append(s, "ScriptType: "+fmt.Sprintf("%#v", this.ScriptType)+",\n")
+	s = append(s, "GapLimit: "+fmt.Sprintf("%#v", this.GapLimit)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "Include: "+fmt.Sprintf("%#v", this.Include)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Raw: "+fmt.Sprintf("%#v", this.Raw)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *XPubAddress) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&blocc.XPubAddress{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Chain: "+fmt.Sprintf("%#v", this.Chain)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "TxCount: "+fmt.Sprintf("%#v", this.TxCount)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "MempoolBalance: "+fmt.Sprintf("%#v", this.MempoolBalance)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *XPub) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&blocc.XPub{")
+	s = append(s, "ScriptType: "+fmt.Sprintf("%#v", this.ScriptType)+",\n")
+	if this.Addresses != nil {
+		s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	}
+	s = append(s, "NextReceiveIndex: "+fmt.Sprintf("%#v", this.NextReceiveIndex)+",\n")
+	s = append(s, "NextChangeIndex: "+fmt.Sprintf("%#v", this.NextChangeIndex)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "MempoolBalance: "+fmt.Sprintf("%#v", this.MempoolBalance)+",\n")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Descriptor) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&blocc.Descriptor{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Desc: "+fmt.Sprintf("%#v", this.Desc)+",\n")
+	s = append(s, "RangeStart: "+fmt.Sprintf("%#v", this.RangeStart)+",\n")
+	s = append(s, "RangeEnd: "+fmt.Sprintf("%#v", this.RangeEnd)+",\n")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "Scripts: "+fmt.Sprintf("%#v", this.Scripts)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MemPoolStats) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&blocc.MemPoolStats{")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "MPSize: "+fmt.Sprintf("%#v", this.MPSize)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MemPoolHistogram) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&blocc.MemPoolHistogram{")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "VSize: "+fmt.Sprintf("%#v", this.VSize)+",\n")
+	if this.Buckets != nil {
+		s = append(s, "Buckets: "+fmt.Sprintf("%#v", this.Buckets)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MemPoolBucket) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&blocc.MemPoolBucket{")
+	s = append(s, "FeeVSize: "+fmt.Sprintf("%#v", this.FeeVSize)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "VSize: "+fmt.Sprintf("%#v", this.VSize)+",\n")
+	s = append(s, "Fee: "+fmt.Sprintf("%#v", this.Fee)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockCount) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&blocc.BlockCount{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ProjectedBlocks) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&blocc.ProjectedBlocks{")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	if this.Blocks != nil {
+		s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ProjectedBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&blocc.ProjectedBlock{")
+	s = append(s, "MinFeeVSize: "+fmt.Sprintf("%#v", this.MinFeeVSize)+",\n")
+	s = append(s, "MedianFeeVSize: "+fmt.Sprintf("%#v", this.MedianFeeVSize)+",\n")
+	s = append(s, "MaxFeeVSize: "+fmt.Sprintf("%#v", this.MaxFeeVSize)+",\n")
+	s = append(s, "Fee: "+fmt.Sprintf("%#v", this.Fee)+",\n")
+	s = append(s, "TxCount: "+fmt.Sprintf("%#v", this.TxCount)+",\n")
+	s = append(s, "VSize: "+fmt.Sprintf("%#v", this.VSize)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FeeTarget) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&blocc.FeeTarget{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "TargetBlocks: "+fmt.Sprintf("%#v", this.TargetBlocks)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FeeEstimate) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&blocc.FeeEstimate{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "TargetBlocks: "+fmt.Sprintf("%#v", this.TargetBlocks)+",\n")
+	s = append(s, "FeeVSize: "+fmt.Sprintf("%#v", this.FeeVSize)+",\n")
+	s = append(s, "MemPoolFeeVSize: "+fmt.Sprintf("%#v", this.MemPoolFeeVSize)+",\n")
+	s = append(s, "HistoryFeeVSize: "+fmt.Sprintf("%#v", this.HistoryFeeVSize)+",\n")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConfirmationStats) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&blocc.ConfirmationStats{")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	s = append(s, "StartHeight: "+fmt.Sprintf("%#v", this.StartHeight)+",\n")
+	s = append(s, "EndHeight: "+fmt.Sprintf("%#v", this.EndHeight)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	if this.Buckets != nil {
+		s = append(s, "Buckets: "+fmt.Sprintf("%#v", this.Buckets)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConfirmationBucket) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&blocc.ConfirmationBucket{")
+	s = append(s, "FeeVSize: "+fmt.Sprintf("%#v", this.FeeVSize)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	if this.Blocks != nil {
+		s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
+	}
+	if this.Seconds != nil {
+		s = append(s, "Seconds: "+fmt.Sprintf("%#v", this.Seconds)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConfirmationDelay) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&blocc.ConfirmationDelay{")
+	s = append(s, "Avg: "+fmt.Sprintf("%#v", this.Avg)+",\n")
+	s = append(s, "P10: "+fmt.Sprintf("%#v", this.P10)+",\n")
+	s = append(s, "Median: "+fmt.Sprintf("%#v", this.Median)+",\n")
+	s = append(s, "P90: "+fmt.Sprintf("%#v", this.P90)+",\n")
+	s = append(s, "Max: "+fmt.Sprintf("%#v", this.Max)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EventFilter) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&blocc.EventFilter{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "Types: "+fmt.Sprintf("%#v", this.Types)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Event) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&blocc.Event{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	if this.Block != nil {
+		s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	}
+	if this.Tx != nil {
+		s = append(s, "Tx: "+fmt.Sprintf("%#v", this.Tx)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddressSubscription) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&blocc.AddressSubscription{")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "AddAddresses: "+fmt.Sprintf("%#v", this.AddAddresses)+",\n")
+	s = append(s, "RemoveAddresses: "+fmt.Sprintf("%#v", this.RemoveAddresses)+",\n")
+	s = append(s, "AddScripts: "+fmt.Sprintf("%#v", this.AddScripts)+",\n")
+	s = append(s, "RemoveScripts: "+fmt.Sprintf("%#v", this.RemoveScripts)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddressEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&blocc.AddressEvent{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "Scripts: "+fmt.Sprintf("%#v", this.Scripts)+",\n")
+	s = append(s, "Confirmations: "+fmt.Sprintf("%#v", this.Confirmations)+",\n")
+	if this.Tx != nil {
+		s = append(s, "Tx: "+fmt.Sprintf("%#v", this.Tx)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Webhook) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&blocc.Webhook{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "URL: "+fmt.Sprintf("%#v", this.URL)+",\n")
+	s = append(s, "Secret: "+fmt.Sprintf("%#v", this.Secret)+",\n")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "TxIds: "+fmt.Sprintf("%#v", this.TxIds)+",\n")
+	s = append(s, "Confirmations: "+fmt.Sprintf("%#v", this.Confirmations)+",\n")
+	s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Webhooks) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&blocc.Webhooks{")
+	if this.Webhooks != nil {
+		s = append(s, "Webhooks: "+fmt.Sprintf("%#v", this.Webhooks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WebhookEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&blocc.WebhookEvent{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "WebhookId: "+fmt.Sprintf("%#v", this.WebhookId)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Symbol: "+fmt.Sprintf("%#v", this.Symbol)+",\n")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	s = append(s, "Confirmations: "+fmt.Sprintf("%#v", this.Confirmations)+",\n")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	if this.Block != nil {
+		s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	}
+	if this.Tx != nil {
+		s = append(s, "Tx: "+fmt.Sprintf("%#v", this.Tx)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WebhookDelivery) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&blocc.WebhookDelivery{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "WebhookId: "+fmt.Sprintf("%#v", this.WebhookId)+",\n")
+	if this.Event != nil {
+		s = append(s, "Event: "+fmt.Sprintf("%#v", this.Event)+",\n")
+	}
+	s = append(s, "Attempts: "+fmt.Sprintf("%#v", this.Attempts)+",\n")
+	s = append(s, "LastError: "+fmt.Sprintf("%#v", this.LastError)+",\n")
+	s = append(s, "LastAttempt: "+fmt.Sprintf("%#v", this.LastAttempt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WebhookDeliveries) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&blocc.WebhookDeliveries{")
+	if this.Deliveries != nil {
+		s = append(s, "Deliveries: "+fmt.Sprintf("%#v", this.Deliveries)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringBloccrpc(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BloccRPCClient is the client API for BloccRPC service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BloccRPCClient interface {
+	// Get Block by Id
+	GetBlock(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Block, error)
+	// Find Blocks by BlockIds and/or Time
+	FindBlocks(ctx context.Context, in *Find, opts ...grpc.CallOption) (*Blocks, error)
+	// Get Transaction by TxId. Mempool transactions include their ancestor and descendant package (ancestor_fee_vsize,
+	// descendant_fee_vsize, ...) in the data.
+	GetTransaction(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Tx, error)
+	// Find transactions by TxId and/or Time
+	FindTransactions(ctx context.Context, in *Find, opts ...grpc.CallOption) (*Transactions, error)
+	// Find transactions by Address and/or Time
+	FindTransactionsByAddresses(ctx context.Context, in *Find, opts ...grpc.CallOption) (*Transactions, error)
+	// Find unspent transaction outputs by Address
+	FindUnspentOutputs(ctx context.Context, in *Find, opts ...grpc.CallOption) (*Utxos, error)
+	// Broadcast a raw transaction to the network through the transaction extractor's peer
+	SendRawTransaction(ctx context.Context, in *RawTx, opts ...grpc.CallOption) (*SentTx, error)
+	// Decode a raw transaction, inputs found in the store are resolved for the fee
+	DecodeTransaction(ctx context.Context, in *RawTx, opts ...grpc.CallOption) (*Tx, error)
+	// Decode an output script
+	DecodeScript(ctx context.Context, in *RawScript, opts ...grpc.CallOption) (*Script, error)
+	// Get the transaction spending an output
+	GetOutputSpender(ctx context.Context, in *OutPoint, opts ...grpc.CallOption) (*Spender, error)
+	// Get the summary of an Address
+	GetAddress(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Address, error)
+	// Scan the addresses of an extended public key
+	ScanXPub(ctx context.Context, in *XPubScan, opts ...grpc.CallOption) (*XPub, error)
+	// Register an output descriptor by name for address queries (ids descriptor:{name})
+	RegisterDescriptor(ctx context.Context, in *Descriptor, opts ...grpc.CallOption) (*Descriptor, error)
+	// Get a registered output descriptor by name
+	QueryDescriptor(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Descriptor, error)
+	// Get how long transactions took to confirm by fee rate in recent blocks
+	GetConfirmationStats(ctx context.Context, in *Symbol, opts ...grpc.CallOption) (*ConfirmationStats, error)
+	// Get MemPool Stats
+	GetMemPoolStats(ctx context.Context, in *Symbol, opts ...grpc.CallOption) (*MemPoolStats, error)
+	// Estimate the fee rate for confirmation within a number of blocks
+	EstimateFee(ctx context.Context, in *FeeTarget, opts ...grpc.CallOption) (*FeeEstimate, error)
+	// Get the mempool grouped by fee rate
+	GetMemPoolHistogram(ctx context.Context, in *Symbol, opts ...grpc.CallOption) (*MemPoolHistogram, error)
+	// Get the next blocks projected from the mempool by fee rate
+	GetMemPoolProjectedBlocks(ctx context.Context, in *BlockCount, opts ...grpc.CallOption) (*ProjectedBlocks, error)
+	// Get Transaction Stream
+	GetMemPoolStream(ctx context.Context, in *Symbol, opts ...grpc.CallOption) (BloccRPC_GetMemPoolStreamClient, error)
+	// Subscribe to block and transaction events
+	Subscribe(ctx context.Context, in *EventFilter, opts ...grpc.CallOption) (BloccRPC_SubscribeClient, error)
+	// Subscribe to transactions of addresses and scripts, send updates to change them
+	SubscribeAddresses(ctx context.Context, opts ...grpc.CallOption) (BloccRPC_SubscribeAddressesClient, error)
+	// Register a webhook, the secret is only returned here
+	CreateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error)
+	// Get a webhook
+	GetWebhook(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Webhook, error)
+	// Get all of the webhooks
+	FindWebhooks(ctx context.Context, in *Symbol, opts ...grpc.CallOption) (*Webhooks, error)
+	// Remove a webhook
+	DeleteWebhook(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Webhook, error)
+	// Get the deliveries of a webhook that failed every attempt
+	GetWebhookDeadLetters(ctx context.Context, in *Get, opts ...grpc.CallOption) (*WebhookDeliveries, error)
+}
+
+type bloccRPCClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBloccRPCClient(cc *grpc.ClientConn) BloccRPCClient {
+	return &bloccRPCClient{cc}
+}
+
+func (c *bloccRPCClient) GetBlock(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) FindBlocks(ctx context.Context, in *Find, opts ...grpc.CallOption) (*Blocks, error) {
+	out := new(Blocks)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/FindBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) GetTransaction(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Tx, error) {
+	out := new(Tx)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/GetTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) FindTransactions(ctx context.Context, in *Find, opts ...grpc.CallOption) (*Transactions, error) {
+	out := new(Transactions)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/FindTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) FindTransactionsByAddresses(ctx context.Context, in *Find, opts ...grpc.CallOption) (*Transactions, error) {
+	out := new(Transactions)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/FindTransactionsByAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) FindUnspentOutputs(ctx context.Context, in *Find, opts ...grpc.CallOption) (*Utxos, error) {
+	out := new(Utxos)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/FindUnspentOutputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) SendRawTransaction(ctx context.Context, in *RawTx, opts ...grpc.CallOption) (*SentTx, error) {
+	out := new(SentTx)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/SendRawTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) DecodeTransaction(ctx context.Context, in *RawTx, opts ...grpc.CallOption) (*Tx, error) {
+	out := new(Tx)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/DecodeTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) DecodeScript(ctx context.Context, in *RawScript, opts ...grpc.CallOption) (*Script, error) {
+	out := new(Script)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/DecodeScript", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) GetOutputSpender(ctx context.Context, in *OutPoint, opts ...grpc.CallOption) (*Spender, error) {
+	out := new(Spender)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/GetOutputSpender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) GetAddress(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Address, error) {
+	out := new(Address)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/GetAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) ScanXPub(ctx context.Context, in *XPubScan, opts ...grpc.CallOption) (*XPub, error) {
+	out := new(XPub)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/ScanXPub", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) RegisterDescriptor(ctx context.Context, in *Descriptor, opts ...grpc.CallOption) (*Descriptor, error) {
+	out := new(Descriptor)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/RegisterDescriptor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) QueryDescriptor(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Descriptor, error) {
+	out := new(Descriptor)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/QueryDescriptor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) GetConfirmationStats(ctx context.Context, in *Symbol, opts ...grpc.CallOption) (*ConfirmationStats, error) {
+	out := new(ConfirmationStats)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/GetConfirmationStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) GetMemPoolStats(ctx context.Context, in *Symbol, opts ...grpc.CallOption) (*MemPoolStats, error) {
+	out := new(MemPoolStats)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/GetMemPoolStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) EstimateFee(ctx context.Context, in *FeeTarget, opts ...grpc.CallOption) (*FeeEstimate, error) {
+	out := new(FeeEstimate)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) GetMemPoolHistogram(ctx context.Context, in *Symbol, opts ...grpc.CallOption) (*MemPoolHistogram, error) {
+	out := new(MemPoolHistogram)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/GetMemPoolHistogram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) GetMemPoolProjectedBlocks(ctx context.Context, in *BlockCount, opts ...grpc.CallOption) (*ProjectedBlocks, error) {
+	out := new(ProjectedBlocks)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/GetMemPoolProjectedBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) GetMemPoolStream(ctx context.Context, in *Symbol, opts ...grpc.CallOption) (BloccRPC_GetMemPoolStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BloccRPC_serviceDesc.Streams[0], "/blocc.BloccRPC/GetMemPoolStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bloccRPCGetMemPoolStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BloccRPC_GetMemPoolStreamClient interface {
+	Recv() (*Tx, error)
+	grpc.ClientStream
+}
+
+type bloccRPCGetMemPoolStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *bloccRPCGetMemPoolStreamClient) Recv() (*Tx, error) {
+	m := new(Tx)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bloccRPCClient) Subscribe(ctx context.Context, in *EventFilter, opts ...grpc.CallOption) (BloccRPC_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BloccRPC_serviceDesc.Streams[1], "/blocc.BloccRPC/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bloccRPCSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BloccRPC_SubscribeClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type bloccRPCSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *bloccRPCSubscribeClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bloccRPCClient) SubscribeAddresses(ctx context.Context, opts ...grpc.CallOption) (BloccRPC_SubscribeAddressesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BloccRPC_serviceDesc.Streams[2], "/blocc.BloccRPC/SubscribeAddresses", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bloccRPCSubscribeAddressesClient{stream}
+	return x, nil
+}
+
+type BloccRPC_SubscribeAddressesClient interface {
+	Send(*AddressSubscription) error
+	Recv() (*AddressEvent, error)
+	grpc.ClientStream
+}
+
+type bloccRPCSubscribeAddressesClient struct {
+	grpc.ClientStream
+}
+
+func (x *bloccRPCSubscribeAddressesClient) Send(m *AddressSubscription) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *bloccRPCSubscribeAddressesClient) Recv() (*AddressEvent, error) {
+	m := new(AddressEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bloccRPCClient) CreateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/CreateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) GetWebhook(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/GetWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) FindWebhooks(ctx context.Context, in *Symbol, opts ...grpc.CallOption) (*Webhooks, error) {
+	out := new(Webhooks)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/FindWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) DeleteWebhook(ctx context.Context, in *Get, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloccRPCClient) GetWebhookDeadLetters(ctx context.Context, in *Get, opts ...grpc.CallOption) (*WebhookDeliveries, error) {
+	out := new(WebhookDeliveries)
+	err := c.cc.Invoke(ctx, "/blocc.BloccRPC/GetWebhookDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BloccRPCServer is the server API for BloccRPC service.
+type BloccRPCServer interface {
+	// Get Block by Id
+	GetBlock(context.Context, *Get) (*Block, error)
+	// Find Blocks by BlockIds and/or Time
+	FindBlocks(context.Context, *Find) (*Blocks, error)
+	// Get Transaction by TxId. Mempool transactions include their ancestor and descendant package (ancestor_fee_vsize,
+	// descendant_fee_vsize, ...) in the data.
+	GetTransaction(context.Context, *Get) (*Tx, error)
+	// Find transactions by TxId and/or Time
+	FindTransactions(context.Context, *Find) (*Transactions, error)
+	// Find transactions by Address and/or Time
+	FindTransactionsByAddresses(context.Context, *Find) (*Transactions, error)
+	// Find unspent transaction outputs by Address
+	FindUnspentOutputs(context.Context, *Find) (*Utxos, error)
+	// Broadcast a raw transaction to the network through the transaction extractor's peer
+	SendRawTransaction(context.Context, *RawTx) (*SentTx, error)
+	// Decode a raw transaction, inputs found in the store are resolved for the fee
+	DecodeTransaction(context.Context, *RawTx) (*Tx, error)
+	// Decode an output script
+	DecodeScript(context.Context, *RawScript) (*Script, error)
+	// Get the transaction spending an output
+	GetOutputSpender(context.Context, *OutPoint) (*Spender, error)
+	// Get the summary of an Address
+	GetAddress(context.Context, *Get) (*Address, error)
+	// Scan the addresses of an extended public key
+	ScanXPub(context.Context, *XPubScan) (*XPub, error)
+	// Register an output descriptor by name for address queries (ids descriptor:{name})
+	RegisterDescriptor(context.Context, *Descriptor) (*Descriptor, error)
+	// Get a registered output descriptor by name
+	QueryDescriptor(context.Context, *Get) (*Descriptor, error)
+	// Get how long transactions took to confirm by fee rate in recent blocks
+	GetConfirmationStats(context.Context, *Symbol) (*ConfirmationStats, error)
+	// Get MemPool Stats
+	GetMemPoolStats(context.Context, *Symbol) (*MemPoolStats, error)
+	// Estimate the fee rate for confirmation within a number of blocks
+	EstimateFee(context.Context, *FeeTarget) (*FeeEstimate, error)
+	// Get the mempool grouped by fee rate
+	GetMemPoolHistogram(context.Context, *Symbol) (*MemPoolHistogram, error)
+	// Get the next blocks projected from the mempool by fee rate
+	GetMemPoolProjectedBlocks(context.Context, *BlockCount) (*ProjectedBlocks, error)
+	// Get Transaction Stream
+	GetMemPoolStream(*Symbol, BloccRPC_GetMemPoolStreamServer) error
+	// Subscribe to block and transaction events
+	Subscribe(*EventFilter, BloccRPC_SubscribeServer) error
+	// Subscribe to transactions of addresses and scripts, send updates to change them
+	SubscribeAddresses(BloccRPC_SubscribeAddressesServer) error
+	// Register a webhook, the secret is only returned here
+	CreateWebhook(context.Context, *Webhook) (*Webhook, error)
+	// Get a webhook
+	GetWebhook(context.Context, *Get) (*Webhook, error)
+	// Get all of the webhooks
+	FindWebhooks(context.Context, *Symbol) (*Webhooks, error)
+	// Remove a webhook
+	DeleteWebhook(context.Context, *Get) (*Webhook, error)
+	// Get the deliveries of a webhook that failed every attempt
+	GetWebhookDeadLetters(context.Context, *Get) (*WebhookDeliveries, error)
+}
+
+func RegisterBloccRPCServer(s *grpc.Server, srv BloccRPCServer) {
+	s.RegisterService(&_BloccRPC_serviceDesc, srv)
+}
+
+func _BloccRPC_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Get)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).GetBlock(ctx, req.(*Get))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_FindBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Find)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).FindBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/FindBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).FindBlocks(ctx, req.(*Find))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Get)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).GetTransaction(ctx, req.(*Get))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_FindTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Find)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).FindTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/FindTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).FindTransactions(ctx, req.(*Find))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_FindTransactionsByAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Find)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).FindTransactionsByAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/FindTransactionsByAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).FindTransactionsByAddresses(ctx, req.(*Find))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_FindUnspentOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Find)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).FindUnspentOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/FindUnspentOutputs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).FindUnspentOutputs(ctx, req.(*Find))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RawTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).SendRawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/SendRawTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).SendRawTransaction(ctx, req.(*RawTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_DecodeTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RawTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).DecodeTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/DecodeTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).DecodeTransaction(ctx, req.(*RawTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_DecodeScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RawScript)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).DecodeScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/DecodeScript",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).DecodeScript(ctx, req.(*RawScript))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_GetOutputSpender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OutPoint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).GetOutputSpender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/GetOutputSpender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).GetOutputSpender(ctx, req.(*OutPoint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_GetAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Get)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).GetAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/GetAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).GetAddress(ctx, req.(*Get))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_ScanXPub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XPubScan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).ScanXPub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/ScanXPub",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).ScanXPub(ctx, req.(*XPubScan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_RegisterDescriptor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Descriptor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).RegisterDescriptor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/RegisterDescriptor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).RegisterDescriptor(ctx, req.(*Descriptor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_QueryDescriptor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Get)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).QueryDescriptor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/QueryDescriptor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).QueryDescriptor(ctx, req.(*Get))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_GetConfirmationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Symbol)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).GetConfirmationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/GetConfirmationStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).GetConfirmationStats(ctx, req.(*Symbol))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_GetMemPoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Symbol)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).GetMemPoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/GetMemPoolStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).GetMemPoolStats(ctx, req.(*Symbol))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).EstimateFee(ctx, req.(*FeeTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_GetMemPoolHistogram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Symbol)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).GetMemPoolHistogram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/GetMemPoolHistogram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).GetMemPoolHistogram(ctx, req.(*Symbol))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_GetMemPoolProjectedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockCount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).GetMemPoolProjectedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/GetMemPoolProjectedBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).GetMemPoolProjectedBlocks(ctx, req.(*BlockCount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_GetMemPoolStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Symbol)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BloccRPCServer).GetMemPoolStream(m, &bloccRPCGetMemPoolStreamServer{stream})
+}
+
+type BloccRPC_GetMemPoolStreamServer interface {
+	Send(*Tx) error
+	grpc.ServerStream
+}
+
+type bloccRPCGetMemPoolStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *bloccRPCGetMemPoolStreamServer) Send(m *Tx) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BloccRPC_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BloccRPCServer).Subscribe(m, &bloccRPCSubscribeServer{stream})
+}
+
+type BloccRPC_SubscribeServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type bloccRPCSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *bloccRPCSubscribeServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BloccRPC_SubscribeAddresses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BloccRPCServer).SubscribeAddresses(&bloccRPCSubscribeAddressesServer{stream})
+}
+
+type BloccRPC_SubscribeAddressesServer interface {
+	Send(*AddressEvent) error
+	Recv() (*AddressSubscription, error)
+	grpc.ServerStream
+}
+
+type bloccRPCSubscribeAddressesServer struct {
+	grpc.ServerStream
+}
+
+func (x *bloccRPCSubscribeAddressesServer) Send(m *AddressEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *bloccRPCSubscribeAddressesServer) Recv() (*AddressSubscription, error) {
+	m := new(AddressSubscription)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BloccRPC_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/CreateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).CreateWebhook(ctx, req.(*Webhook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_GetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Get)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).GetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/GetWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).GetWebhook(ctx, req.(*Get))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_FindWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Symbol)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).FindWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/FindWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).FindWebhooks(ctx, req.(*Symbol))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Get)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).DeleteWebhook(ctx, req.(*Get))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloccRPC_GetWebhookDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Get)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloccRPCServer).GetWebhookDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocc.BloccRPC/GetWebhookDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloccRPCServer).GetWebhookDeadLetters(ctx, req.(*Get))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BloccRPC_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "blocc.BloccRPC",
+	HandlerType: (*BloccRPCServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBlock",
+			Handler:    _BloccRPC_GetBlock_Handler,
+		},
+		{
+			MethodName: "FindBlocks",
+			Handler:    _BloccRPC_FindBlocks_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _BloccRPC_GetTransaction_Handler,
+		},
+		{
+			MethodName: "FindTransactions",
+			Handler:    _BloccRPC_FindTransactions_Handler,
+		},
+		{
+			MethodName: "FindTransactionsByAddresses",
+			Handler:    _BloccRPC_FindTransactionsByAddresses_Handler,
+		},
+		{
+			MethodName: "FindUnspentOutputs",
+			Handler:    _BloccRPC_FindUnspentOutputs_Handler,
+		},
+		{
+			MethodName: "SendRawTransaction",
+			Handler:    _BloccRPC_SendRawTransaction_Handler,
+		},
+		{
+			MethodName: "DecodeTransaction",
+			Handler:    _BloccRPC_DecodeTransaction_Handler,
+		},
+		{
+			MethodName: "DecodeScript",
+			Handler:    _BloccRPC_DecodeScript_Handler,
+		},
+		{
+			MethodName: "GetOutputSpender",
+			Handler:    _BloccRPC_GetOutputSpender_Handler,
+		},
+		{
+			MethodName: "GetAddress",
+			Handler:    _BloccRPC_GetAddress_Handler,
+		},
+		{
+			MethodName: "ScanXPub",
+			Handler:    _BloccRPC_ScanXPub_Handler,
+		},
+		{
+			MethodName: "RegisterDescriptor",
+			Handler:    _BloccRPC_RegisterDescriptor_Handler,
+		},
+		{
+			MethodName: "QueryDescriptor",
+			Handler:    _BloccRPC_QueryDescriptor_Handler,
+		},
+		{
+			MethodName: "GetConfirmationStats",
+			Handler:    _BloccRPC_GetConfirmationStats_Handler,
+		},
+		{
+			MethodName: "GetMemPoolStats",
+			Handler:    _BloccRPC_GetMemPoolStats_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _BloccRPC_EstimateFee_Handler,
+		},
+		{
+			MethodName: "GetMemPoolHistogram",
+			Handler:    _BloccRPC_GetMemPoolHistogram_Handler,
+		},
+		{
+			MethodName: "GetMemPoolProjectedBlocks",
+			Handler:    _BloccRPC_GetMemPoolProjectedBlocks_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _BloccRPC_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhook",
+			Handler:    _BloccRPC_GetWebhook_Handler,
+		},
+		{
+			MethodName: "FindWebhooks",
+			Handler:    _BloccRPC_FindWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _BloccRPC_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeadLetters",
+			Handler:    _BloccRPC_GetWebhookDeadLetters_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetMemPoolStream",
+			Handler:       _BloccRPC_GetMemPoolStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _BloccRPC_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeAddresses",
+			Handler:       _BloccRPC_SubscribeAddresses_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "blocc/bloccrpc.proto",
+}
+
+func (m *Symbol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Symbol) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	return i, nil
+}
+
+func (m *Get) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Get) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if len(m.Id) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Include != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Include))
+	}
+	if m.Data {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.Data {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Raw {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.Raw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Tx {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.Tx {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Find) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Find) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.StartTime != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.EndTime))
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Offset))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Count))
+	}
+	if m.Include != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Include))
+	}
+	if m.Data {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.Data {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Raw {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.Raw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Tx {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.Tx {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *OutPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutPoint) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if len(m.TxId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.TxId)))
+		i += copy(dAtA[i:], m.TxId)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Height))
+	}
+	return i, nil
+}
+
+func (m *RawTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawTx) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if len(m.Hex) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Hex)))
+		i += copy(dAtA[i:], m.Hex)
+	}
+	return i, nil
+}
+
+func (m *SentTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SentTx) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if len(m.TxId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.TxId)))
+		i += copy(dAtA[i:], m.TxId)
+	}
+	return i, nil
+}
+
+func (m *RawScript) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawScript) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if len(m.Hex) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Hex)))
+		i += copy(dAtA[i:], m.Hex)
+	}
+	return i, nil
+}
+
+func (m *Script) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Script) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.ReqSigs != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.ReqSigs))
+	}
+	if len(m.Asm) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Asm)))
+		i += copy(dAtA[i:], m.Asm)
+	}
+	if m.Sigops != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Sigops))
+	}
+	if len(m.P2SH) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.P2SH)))
+		i += copy(dAtA[i:], m.P2SH)
+	}
+	if len(m.P2WSH) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.P2WSH)))
+		i += copy(dAtA[i:], m.P2WSH)
+	}
+	return i, nil
+}
+
+func (m *Blocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Blocks) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, msg := range m.Blocks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBloccrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Transactions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transactions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for _, msg := range m.Transactions {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBloccrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Utxo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Utxo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.TxId)))
+		i += copy(dAtA[i:], m.TxId)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Height))
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Value))
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Script) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Script)))
+		i += copy(dAtA[i:], m.Script)
+	}
+	if len(m.BlockId) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.BlockId)))
+		i += copy(dAtA[i:], m.BlockId)
+	}
+	if m.BlockHeight != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.BlockHeight))
+	}
+	if m.Confirmations != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Confirmations))
+	}
+	if m.MempoolSpent {
+		dAtA[i] = 0x50
+		i++
+		if m.MempoolSpent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Utxos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Utxos) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for _, msg := range m.Utxos {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBloccrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Spender) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Spender) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.TxId)))
+		i += copy(dAtA[i:], m.TxId)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Height))
+	}
+	if len(m.BlockId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.BlockId)))
+		i += copy(dAtA[i:], m.BlockId)
+	}
+	if m.BlockHeight != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.BlockHeight))
+	}
+	if m.Confirmations != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Confirmations))
+	}
+	if m.Mempool {
+		dAtA[i] = 0x30
+		i++
+		if m.Mempool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *XPubScan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *XPubScan) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if len(m.Xpub) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Xpub)))
+		i += copy(dAtA[i:], m.Xpub)
+	}
+	if len(m.ScriptType) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.ScriptType)))
+		i += copy(dAtA[i:], m.ScriptType)
+	}
+	if m.GapLimit != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.GapLimit))
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Offset))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Count))
+	}
+	if m.Include != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Include))
+	}
+	if m.Data {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.Data {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Raw {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.Raw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *XPubAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *XPubAddress) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if m.Chain != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Chain))
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Index))
+	}
+	if m.TxCount != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.TxCount))
+	}
+	if m.Balance != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Balance))
+	}
+	if m.MempoolBalance != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.MempoolBalance))
+	}
+	return i, nil
+}
+
+func (m *XPub) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *XPub) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScriptType) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.ScriptType)))
+		i += copy(dAtA[i:], m.ScriptType)
+	}
+	if len(m.Addresses) > 0 {
+		for _, msg := range m.Addresses {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBloccrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.NextReceiveIndex != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.NextReceiveIndex))
+	}
+	if m.NextChangeIndex != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.NextChangeIndex))
+	}
+	if m.Balance != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Balance))
+	}
+	if m.MempoolBalance != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.MempoolBalance))
+	}
+	if len(m.Transactions) > 0 {
+		for _, msg := range m.Transactions {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintBloccrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Descriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Descriptor) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Desc) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Desc)))
+		i += copy(dAtA[i:], m.Desc)
+	}
+	if m.RangeStart != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.RangeStart))
+	}
+	if m.RangeEnd != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.RangeEnd))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Scripts) > 0 {
+		for _, s := range m.Scripts {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *MemPoolStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemPoolStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Time))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Count))
+	}
+	if m.MPSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.MPSize))
+	}
+	return i, nil
+}
+
+func (m *MemPoolHistogram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemPoolHistogram) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Time))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Count))
+	}
+	if m.VSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.VSize))
+	}
+	if len(m.Buckets) > 0 {
+		for _, msg := range m.Buckets {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintBloccrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *MemPoolBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemPoolBucket) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.FeeVSize != 0 {
+		dAtA[i] = 0x9
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FeeVSize))))
+		i += 8
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Count))
+	}
+	if m.VSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.VSize))
+	}
+	if m.Fee != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Fee))
+	}
+	return i, nil
+}
+
+func (m *BlockCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockCount) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Count))
+	}
+	return i, nil
+}
+
+func (m *ProjectedBlocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedBlocks) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Time))
+	}
+	if len(m.Blocks) > 0 {
+		for _, msg := range m.Blocks {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBloccrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ProjectedBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedBlock) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MinFeeVSize != 0 {
+		dAtA[i] = 0x9
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MinFeeVSize))))
+		i += 8
+	}
+	if m.MedianFeeVSize != 0 {
+		dAtA[i] = 0x11
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MedianFeeVSize))))
+		i += 8
+	}
+	if m.MaxFeeVSize != 0 {
+		dAtA[i] = 0x19
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxFeeVSize))))
+		i += 8
+	}
+	if m.Fee != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Fee))
+	}
+	if m.TxCount != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.TxCount))
+	}
+	if m.VSize != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.VSize))
+	}
+	return i, nil
+}
+
+func (m *FeeTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTarget) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if m.TargetBlocks != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.TargetBlocks))
+	}
+	return i, nil
+}
+
+func (m *FeeEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeEstimate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if m.TargetBlocks != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.TargetBlocks))
+	}
+	if m.FeeVSize != 0 {
+		dAtA[i] = 0x19
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FeeVSize))))
+		i += 8
+	}
+	if m.MemPoolFeeVSize != 0 {
+		dAtA[i] = 0x21
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MemPoolFeeVSize))))
+		i += 8
+	}
+	if m.HistoryFeeVSize != 0 {
+		dAtA[i] = 0x29
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.HistoryFeeVSize))))
+		i += 8
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Time))
+	}
+	return i, nil
+}
+
+func (m *ConfirmationStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmationStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Time))
+	}
+	if m.StartHeight != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.EndHeight))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Count))
+	}
+	if len(m.Buckets) > 0 {
+		for _, msg := range m.Buckets {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintBloccrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ConfirmationBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmationBucket) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.FeeVSize != 0 {
+		dAtA[i] = 0x9
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FeeVSize))))
+		i += 8
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Count))
+	}
+	if m.Blocks != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Blocks.Size()))
+		n1, err := m.Blocks.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.Seconds != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Seconds.Size()))
+		n2, err := m.Seconds.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+
+func (m *ConfirmationDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmationDelay) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Avg != 0 {
+		dAtA[i] = 0x9
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Avg))))
+		i += 8
+	}
+	if m.P10 != 0 {
+		dAtA[i] = 0x11
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P10))))
+		i += 8
+	}
+	if m.Median != 0 {
+		dAtA[i] = 0x19
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Median))))
+		i += 8
+	}
+	if m.P90 != 0 {
+		dAtA[i] = 0x21
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P90))))
+		i += 8
+	}
+	if m.Max != 0 {
+		dAtA[i] = 0x29
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Max))))
+		i += 8
+	}
+	return i, nil
+}
+
+func (m *EventFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFilter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Time))
+	}
+	if m.Block != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Block.Size()))
+		n3, err := m.Block.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Tx != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Tx.Size()))
+		n4, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+
+func (m *AddressSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressSubscription) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if len(m.AddAddresses) > 0 {
+		for _, s := range m.AddAddresses {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.RemoveAddresses) > 0 {
+		for _, s := range m.RemoveAddresses {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.AddScripts) > 0 {
+		for _, s := range m.AddScripts {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.RemoveScripts) > 0 {
+		for _, s := range m.RemoveScripts {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *AddressEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Time))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Scripts) > 0 {
+		for _, s := range m.Scripts {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Confirmations != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Confirmations))
+	}
+	if m.Tx != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Tx.Size()))
+		n5, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func (m *Webhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Webhook) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if len(m.URL) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
+	}
+	if len(m.Secret) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Secret)))
+		i += copy(dAtA[i:], m.Secret)
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.TxIds) > 0 {
+		for _, s := range m.TxIds {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Confirmations != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Confirmations))
+	}
+	if m.Blocks {
+		dAtA[i] = 0x40
+		i++
+		if m.Blocks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Time))
+	}
+	return i, nil
+}
+
+func (m *Webhooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Webhooks) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Webhooks) > 0 {
+		for _, msg := range m.Webhooks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBloccrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *WebhookEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.WebhookId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.WebhookId)))
+		i += copy(dAtA[i:], m.WebhookId)
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Symbol) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Symbol)))
+		i += copy(dAtA[i:], m.Symbol)
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Time))
+	}
+	if m.Confirmations != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Confirmations))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Block != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Block.Size()))
+		n6, err := m.Block.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Tx != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Tx.Size()))
+		n7, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+
+func (m *WebhookDelivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookDelivery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.WebhookId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.WebhookId)))
+		i += copy(dAtA[i:], m.WebhookId)
+	}
+	if m.Event != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Event.Size()))
+		n8, err := m.Event.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.Attempts != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.Attempts))
+	}
+	if len(m.LastError) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(len(m.LastError)))
+		i += copy(dAtA[i:], m.LastError)
+	}
+	if m.LastAttempt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBloccrpc(dAtA, i, uint64(m.LastAttempt))
+	}
+	return i, nil
+}
+
+func (m *WebhookDeliveries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookDeliveries) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deliveries) > 0 {
+		for _, msg := range m.Deliveries {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBloccrpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintBloccrpc(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Symbol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *Get) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Include != 0 {
+		n += 2 + sovBloccrpc(uint64(m.Include))
+	}
+	if m.Data {
+		n += 3
+	}
+	if m.Raw {
+		n += 3
+	}
+	if m.Tx {
+		n += 3
+	}
+	return n
+}
+
+func (m *Find) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovBloccrpc(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovBloccrpc(uint64(m.EndTime))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Offset))
+	}
+	if m.Count != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Count))
+	}
+	if m.Include != 0 {
+		n += 2 + sovBloccrpc(uint64(m.Include))
+	}
+	if m.Data {
+		n += 3
+	}
+	if m.Raw {
+		n += 3
+	}
+	if m.Tx {
+		n += 3
+	}
+	return n
+}
+
+func (m *OutPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RawTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.Hex)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *SentTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *RawScript) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.Hex)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *Script) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	if m.ReqSigs != 0 {
+		n += 1 + sovBloccrpc(uint64(m.ReqSigs))
+	}
+	l = len(m.Asm)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Sigops != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Sigops))
+	}
+	l = len(m.P2SH)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.P2WSH)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *Blocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Transactions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Utxo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Height))
+	}
+	if m.Value != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Value))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	l = len(m.Script)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.BlockId)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovBloccrpc(uint64(m.BlockHeight))
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Confirmations))
+	}
+	if m.MempoolSpent {
+		n += 2
+	}
+	return n
+}
+
+func (m *Utxos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for _, e := range m.Utxos {
+			l = e.Size()
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Spender) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Height))
+	}
+	l = len(m.BlockId)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovBloccrpc(uint64(m.BlockHeight))
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Confirmations))
+	}
+	if m.Mempool {
+		n += 2
+	}
+	return n
+}
+
+func (m *XPubScan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.Xpub)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.ScriptType)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.GapLimit != 0 {
+		n += 1 + sovBloccrpc(uint64(m.GapLimit))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Offset))
+	}
+	if m.Count != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Count))
+	}
+	if m.Include != 0 {
+		n += 2 + sovBloccrpc(uint64(m.Include))
+	}
+	if m.Data {
+		n += 3
+	}
+	if m.Raw {
+		n += 3
+	}
+	return n
+}
+
+func (m *XPubAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Chain != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Chain))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Index))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovBloccrpc(uint64(m.TxCount))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Balance))
+	}
+	if m.MempoolBalance != 0 {
+		n += 1 + sovBloccrpc(uint64(m.MempoolBalance))
+	}
+	return n
+}
+
+func (m *XPub) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptType)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, e := range m.Addresses {
+			l = e.Size()
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	if m.NextReceiveIndex != 0 {
+		n += 1 + sovBloccrpc(uint64(m.NextReceiveIndex))
+	}
+	if m.NextChangeIndex != 0 {
+		n += 1 + sovBloccrpc(uint64(m.NextChangeIndex))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Balance))
+	}
+	if m.MempoolBalance != 0 {
+		n += 1 + sovBloccrpc(uint64(m.MempoolBalance))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Descriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.Desc)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.RangeStart != 0 {
+		n += 1 + sovBloccrpc(uint64(m.RangeStart))
+	}
+	if m.RangeEnd != 0 {
+		n += 1 + sovBloccrpc(uint64(m.RangeEnd))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	if len(m.Scripts) > 0 {
+		for _, s := range m.Scripts {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MemPoolStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Time))
+	}
+	if m.Count != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Count))
+	}
+	if m.MPSize != 0 {
+		n += 1 + sovBloccrpc(uint64(m.MPSize))
+	}
+	return n
+}
+
+func (m *MemPoolHistogram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Time))
+	}
+	if m.Count != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Count))
+	}
+	if m.VSize != 0 {
+		n += 1 + sovBloccrpc(uint64(m.VSize))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MemPoolBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeVSize != 0 {
+		n += 9
+	}
+	if m.Count != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Count))
+	}
+	if m.VSize != 0 {
+		n += 1 + sovBloccrpc(uint64(m.VSize))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Fee))
+	}
+	return n
+}
+
+func (m *BlockCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *ProjectedBlocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Time))
+	}
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinFeeVSize != 0 {
+		n += 9
+	}
+	if m.MedianFeeVSize != 0 {
+		n += 9
+	}
+	if m.MaxFeeVSize != 0 {
+		n += 9
+	}
+	if m.Fee != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Fee))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovBloccrpc(uint64(m.TxCount))
+	}
+	if m.VSize != 0 {
+		n += 1 + sovBloccrpc(uint64(m.VSize))
+	}
+	return n
+}
+
+func (m *FeeTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.TargetBlocks != 0 {
+		n += 1 + sovBloccrpc(uint64(m.TargetBlocks))
+	}
+	return n
+}
+
+func (m *FeeEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.TargetBlocks != 0 {
+		n += 1 + sovBloccrpc(uint64(m.TargetBlocks))
+	}
+	if m.FeeVSize != 0 {
+		n += 9
+	}
+	if m.MemPoolFeeVSize != 0 {
+		n += 9
+	}
+	if m.HistoryFeeVSize != 0 {
+		n += 9
+	}
+	if m.Time != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *ConfirmationStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Time))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovBloccrpc(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovBloccrpc(uint64(m.EndHeight))
+	}
+	if m.Count != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Count))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConfirmationBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeVSize != 0 {
+		n += 9
+	}
+	if m.Count != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Count))
+	}
+	if m.Blocks != nil {
+		l = m.Blocks.Size()
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Seconds != nil {
+		l = m.Seconds.Size()
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfirmationDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Avg != 0 {
+		n += 9
+	}
+	if m.P10 != 0 {
+		n += 9
+	}
+	if m.Median != 0 {
+		n += 9
+	}
+	if m.P90 != 0 {
+		n += 9
+	}
+	if m.Max != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *EventFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Time))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if len(m.AddAddresses) > 0 {
+		for _, s := range m.AddAddresses {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	if len(m.RemoveAddresses) > 0 {
+		for _, s := range m.RemoveAddresses {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	if len(m.AddScripts) > 0 {
+		for _, s := range m.AddScripts {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	if len(m.RemoveScripts) > 0 {
+		for _, s := range m.RemoveScripts {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddressEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Time))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	if len(m.Scripts) > 0 {
+		for _, s := range m.Scripts {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Confirmations))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *Webhook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	if len(m.TxIds) > 0 {
+		for _, s := range m.TxIds {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Confirmations))
+	}
+	if m.Blocks {
+		n += 2
+	}
+	if m.Time != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *Webhooks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Webhooks) > 0 {
+		for _, e := range m.Webhooks {
+			l = e.Size()
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WebhookEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.WebhookId)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Time))
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Confirmations))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	return n
+}
+
+func (m *WebhookDelivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	l = len(m.WebhookId)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovBloccrpc(uint64(m.Attempts))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovBloccrpc(uint64(l))
+	}
+	if m.LastAttempt != 0 {
+		n += 1 + sovBloccrpc(uint64(m.LastAttempt))
+	}
+	return n
+}
+
+func (m *WebhookDeliveries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deliveries) > 0 {
+		for _, e := range m.Deliveries {
+			l = e.Size()
+			n += 1 + l + sovBloccrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBloccrpc(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozBloccrpc(x uint64) (n int) {
+	return sovBloccrpc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Symbol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Symbol{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Get) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Get{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Include:` + fmt.Sprintf("%v", this.Include) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Raw:` + fmt.Sprintf("%v", this.Raw) + `,`,
+		`Tx:` + fmt.Sprintf("%v", this.Tx) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Find) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Find{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`Ids:` + fmt.Sprintf("%v", this.Ids) + `,`,
+		`StartTime:` + fmt.Sprintf("%v", this.StartTime) + `,`,
+		`EndTime:` + fmt.Sprintf("%v", this.EndTime) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Include:` + fmt.Sprintf("%v", this.Include) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Raw:` + fmt.Sprintf("%v", this.Raw) + `,`,
+		`Tx:` + fmt.Sprintf("%v", this.Tx) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OutPoint) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OutPoint{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RawTx) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RawTx{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`Hex:` + fmt.Sprintf("%v", this.Hex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SentTx) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SentTx{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RawScript) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RawScript{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`Hex:` + fmt.Sprintf("%v", this.Hex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Script) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Script{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`ReqSigs:` + fmt.Sprintf("%v", this.ReqSigs) + `,`,
+		`Asm:` + fmt.Sprintf("%v", this.Asm) + `,`,
+		`Sigops:` + fmt.Sprintf("%v", this.Sigops) + `,`,
+		`P2SH:` + fmt.Sprintf("%v", this.P2SH) + `,`,
+		`P2WSH:` + fmt.Sprintf("%v", this.P2WSH) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Blocks) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Blocks{`,
+		`Blocks:` + strings.Replace(fmt.Sprintf("%v", this.Blocks), "Block", "Block", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Transactions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Transactions{`,
+		`Transactions:` + strings.Replace(fmt.Sprintf("%v", this.Transactions), "Tx", "Tx", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Utxo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Utxo{`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`Script:` + fmt.Sprintf("%v", this.Script) + `,`,
+		`BlockId:` + fmt.Sprintf("%v", this.BlockId) + `,`,
+		`BlockHeight:` + fmt.Sprintf("%v", this.BlockHeight) + `,`,
+		`Confirmations:` + fmt.Sprintf("%v", this.Confirmations) + `,`,
+		`MempoolSpent:` + fmt.Sprintf("%v", this.MempoolSpent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Utxos) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Utxos{`,
+		`Utxos:` + strings.Replace(fmt.Sprintf("%v", this.Utxos), "Utxo", "Utxo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Spender) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Spender{`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
+		`BlockId:` + fmt.Sprintf("%v", this.BlockId) + `,`,
+		`BlockHeight:` + fmt.Sprintf("%v", this.BlockHeight) + `,`,
+		`Confirmations:` + fmt.Sprintf("%v", this.Confirmations) + `,`,
+		`Mempool:` + fmt.Sprintf("%v", this.Mempool) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *XPubScan) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&XPubScan{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`Xpub:` + fmt.Sprintf("%v", this.Xpub) + `,`,
+		`ScriptType:` + fmt.Sprintf("%v", this.ScriptType) + `,`,
+		`GapLimit:` + fmt.Sprintf("%v", this.GapLimit) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Include:` + fmt.Sprintf("%v", this.Include) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Raw:` + fmt.Sprintf("%v", this.Raw) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *XPubAddress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&XPubAddress{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Chain:` + fmt.Sprintf("%v", this.Chain) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`TxCount:` + fmt.Sprintf("%v", this.TxCount) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`MempoolBalance:` + fmt.Sprintf("%v", this.MempoolBalance) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *XPub) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&XPub{`,
+		`ScriptType:` + fmt.Sprintf("%v", this.ScriptType) + `,`,
+		`Addresses:` + strings.Replace(fmt.Sprintf("%v", this.Addresses), "XPubAddress", "XPubAddress", 1) + `,`,
+		`NextReceiveIndex:` + fmt.Sprintf("%v", this.NextReceiveIndex) + `,`,
+		`NextChangeIndex:` + fmt.Sprintf("%v", this.NextChangeIndex) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`MempoolBalance:` + fmt.Sprintf("%v", this.MempoolBalance) + `,`,
+		`Transactions:` + strings.Replace(fmt.Sprintf("%v", this.Transactions), "Tx", "Tx", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Descriptor) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Descriptor{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Desc:` + fmt.Sprintf("%v", this.Desc) + `,`,
+		`RangeStart:` + fmt.Sprintf("%v", this.RangeStart) + `,`,
+		`RangeEnd:` + fmt.Sprintf("%v", this.RangeEnd) + `,`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`Scripts:` + fmt.Sprintf("%v", this.Scripts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemPoolStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MemPoolStats{`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`MPSize:` + fmt.Sprintf("%v", this.MPSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemPoolHistogram) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MemPoolHistogram{`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`VSize:` + fmt.Sprintf("%v", this.VSize) + `,`,
+		`Buckets:` + strings.Replace(fmt.Sprintf("%v", this.Buckets), "MemPoolBucket", "MemPoolBucket", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemPoolBucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MemPoolBucket{`,
+		`FeeVSize:` + fmt.Sprintf("%v", this.FeeVSize) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`VSize:` + fmt.Sprintf("%v", this.VSize) + `,`,
+		`Fee:` + fmt.Sprintf("%v", this.Fee) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockCount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockCount{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectedBlocks) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectedBlocks{`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`Blocks:` + strings.Replace(fmt.Sprintf("%v", this.Blocks), "ProjectedBlock", "ProjectedBlock", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectedBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectedBlock{`,
+		`MinFeeVSize:` + fmt.Sprintf("%v", this.MinFeeVSize) + `,`,
+		`MedianFeeVSize:` + fmt.Sprintf("%v", this.MedianFeeVSize) + `,`,
+		`MaxFeeVSize:` + fmt.Sprintf("%v", this.MaxFeeVSize) + `,`,
+		`Fee:` + fmt.Sprintf("%v", this.Fee) + `,`,
+		`TxCount:` + fmt.Sprintf("%v", this.TxCount) + `,`,
+		`VSize:` + fmt.Sprintf("%v", this.VSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FeeTarget) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FeeTarget{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`TargetBlocks:` + fmt.Sprintf("%v", this.TargetBlocks) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FeeEstimate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FeeEstimate{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`TargetBlocks:` + fmt.Sprintf("%v", this.TargetBlocks) + `,`,
+		`FeeVSize:` + fmt.Sprintf("%v", this.FeeVSize) + `,`,
+		`MemPoolFeeVSize:` + fmt.Sprintf("%v", this.MemPoolFeeVSize) + `,`,
+		`HistoryFeeVSize:` + fmt.Sprintf("%v", this.HistoryFeeVSize) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfirmationStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfirmationStats{`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`StartHeight:` + fmt.Sprintf("%v", this.StartHeight) + `,`,
+		`EndHeight:` + fmt.Sprintf("%v", this.EndHeight) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Buckets:` + strings.Replace(fmt.Sprintf("%v", this.Buckets), "ConfirmationBucket", "ConfirmationBucket", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfirmationBucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfirmationBucket{`,
+		`FeeVSize:` + fmt.Sprintf("%v", this.FeeVSize) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Blocks:` + strings.Replace(fmt.Sprintf("%v", this.Blocks), "ConfirmationDelay", "ConfirmationDelay", 1) + `,`,
+		`Seconds:` + strings.Replace(fmt.Sprintf("%v", this.Seconds), "ConfirmationDelay", "ConfirmationDelay", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfirmationDelay) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfirmationDelay{`,
+		`Avg:` + fmt.Sprintf("%v", this.Avg) + `,`,
+		`P10:` + fmt.Sprintf("%v", this.P10) + `,`,
+		`Median:` + fmt.Sprintf("%v", this.Median) + `,`,
+		`P90:` + fmt.Sprintf("%v", this.P90) + `,`,
+		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventFilter{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`Types:` + fmt.Sprintf("%v", this.Types) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Event) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Event{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`Block:` + strings.Replace(fmt.Sprintf("%v", this.Block), "BlockHeader", "BlockHeader", 1) + `,`,
+		`Tx:` + strings.Replace(fmt.Sprintf("%v", this.Tx), "Tx", "Tx", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddressSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressSubscription{`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`AddAddresses:` + fmt.Sprintf("%v", this.AddAddresses) + `,`,
+		`RemoveAddresses:` + fmt.Sprintf("%v", this.RemoveAddresses) + `,`,
+		`AddScripts:` + fmt.Sprintf("%v", this.AddScripts) + `,`,
+		`RemoveScripts:` + fmt.Sprintf("%v", this.RemoveScripts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddressEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressEvent{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`Scripts:` + fmt.Sprintf("%v", this.Scripts) + `,`,
+		`Confirmations:` + fmt.Sprintf("%v", this.Confirmations) + `,`,
+		`Tx:` + strings.Replace(fmt.Sprintf("%v", this.Tx), "Tx", "Tx", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Webhook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Webhook{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Secret:` + fmt.Sprintf("%v", this.Secret) + `,`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`TxIds:` + fmt.Sprintf("%v", this.TxIds) + `,`,
+		`Confirmations:` + fmt.Sprintf("%v", this.Confirmations) + `,`,
+		`Blocks:` + fmt.Sprintf("%v", this.Blocks) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Webhooks) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Webhooks{`,
+		`Webhooks:` + strings.Replace(fmt.Sprintf("%v", this.Webhooks), "Webhook", "Webhook", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookEvent{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`WebhookId:` + fmt.Sprintf("%v", this.WebhookId) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`Confirmations:` + fmt.Sprintf("%v", this.Confirmations) + `,`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`Block:` + strings.Replace(fmt.Sprintf("%v", this.Block), "BlockHeader", "BlockHeader", 1) + `,`,
+		`Tx:` + strings.Replace(fmt.Sprintf("%v", this.Tx), "Tx", "Tx", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookDelivery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookDelivery{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`WebhookId:` + fmt.Sprintf("%v", this.WebhookId) + `,`,
+		`Event:` + strings.Replace(fmt.Sprintf("%v", this.Event), "WebhookEvent", "WebhookEvent", 1) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`LastAttempt:` + fmt.Sprintf("%v", this.LastAttempt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookDeliveries) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookDeliveries{`,
+		`Deliveries:` + strings.Replace(fmt.Sprintf("%v", this.Deliveries), "WebhookDelivery", "WebhookDelivery", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringBloccrpc(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Symbol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Symbol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Symbol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Get) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Get: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Get: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+			}
+			m.Include = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Include |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 100:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Data = bool(v != 0)
+		case 101:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Raw = bool(v != 0)
+		case 102:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tx = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Find) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Find: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Find: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+			}
+			m.Include = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Include |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 100:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Data = bool(v != 0)
+		case 101:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Raw = bool(v != 0)
+		case 102:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tx = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SentTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SentTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SentTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawScript) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawScript: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawScript: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Script) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Script: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Script: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqSigs", wireType)
+			}
+			m.ReqSigs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReqSigs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sigops", wireType)
+			}
+			m.Sigops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sigops |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P2SH", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.P2SH = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P2WSH", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.P2WSH = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Blocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Blocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Blocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &Block{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transactions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transactions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transactions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &Tx{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Utxo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Utxo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Utxo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Script = append(m.Script[:0], dAtA[iNdEx:postIndex]...)
+			if m.Script == nil {
+				m.Script = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MempoolSpent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MempoolSpent = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Utxos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Utxos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Utxos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Utxos = append(m.Utxos, &Utxo{})
+			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Spender) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Spender: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Spender: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mempool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mempool = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XPubScan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XPubScan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XPubScan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xpub", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Xpub = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GapLimit", wireType)
+			}
+			m.GapLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GapLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+			}
+			m.Include = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Include |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 100:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Data = bool(v != 0)
+		case 101:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Raw = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XPubAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XPubAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XPubAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			m.Chain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MempoolBalance", wireType)
+			}
+			m.MempoolBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MempoolBalance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XPub) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XPub: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XPub: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, &XPubAddress{})
+			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextReceiveIndex", wireType)
+			}
+			m.NextReceiveIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextReceiveIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextChangeIndex", wireType)
+			}
+			m.NextChangeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextChangeIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MempoolBalance", wireType)
+			}
+			m.MempoolBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MempoolBalance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &Tx{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Descriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Descriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Descriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Desc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeStart", wireType)
+			}
+			m.RangeStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeStart |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			}
+			m.RangeEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeEnd |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scripts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scripts = append(m.Scripts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemPoolStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemPoolStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemPoolStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MPSize", wireType)
+			}
+			m.MPSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MPSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemPoolHistogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemPoolHistogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemPoolHistogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VSize", wireType)
+			}
+			m.VSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, &MemPoolBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemPoolBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemPoolBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemPoolBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeVSize", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FeeVSize = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VSize", wireType)
+			}
+			m.VSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectedBlocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectedBlocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectedBlocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &ProjectedBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeeVSize", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MinFeeVSize = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianFeeVSize", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MedianFeeVSize = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeVSize", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MaxFeeVSize = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VSize", wireType)
+			}
+			m.VSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlocks", wireType)
+			}
+			m.TargetBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlocks", wireType)
+			}
+			m.TargetBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeVSize", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FeeVSize = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemPoolFeeVSize", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MemPoolFeeVSize = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryFeeVSize", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.HistoryFeeVSize = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmationStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmationStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmationStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, &ConfirmationBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmationBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmationBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeVSize", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FeeVSize = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Blocks == nil {
+				m.Blocks = &ConfirmationDelay{}
+			}
+			if err := m.Blocks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Seconds == nil {
+				m.Seconds = &ConfirmationDelay{}
+			}
+			if err := m.Seconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmationDelay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmationDelay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmationDelay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Avg", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Avg = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P10", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P10 = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Median = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P90 = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Max = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &BlockHeader{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddAddresses = append(m.AddAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddScripts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddScripts = append(m.AddScripts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveScripts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveScripts = append(m.RemoveScripts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scripts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scripts = append(m.Scripts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *MemPoolStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Time != 0 {
-		n += 1 + sovBloccrpc(uint64(m.Time))
-	}
-	if m.Count != 0 {
-		n += 1 + sovBloccrpc(uint64(m.Count))
-	}
-	if m.MPSize != 0 {
-		n += 1 + sovBloccrpc(uint64(m.MPSize))
-	}
-	return n
-}
 
-func sovBloccrpc(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
-	}
-	return n
-}
-func sozBloccrpc(x uint64) (n int) {
-	return sovBloccrpc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *Symbol) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Symbol{`,
-		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Get) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Get{`,
-		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Include:` + fmt.Sprintf("%v", this.Include) + `,`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`Raw:` + fmt.Sprintf("%v", this.Raw) + `,`,
-		`Tx:` + fmt.Sprintf("%v", this.Tx) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Find) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Find{`,
-		`Symbol:` + fmt.Sprintf("%v", this.Symbol) + `,`,
-		`Ids:` + fmt.Sprintf("%v", this.Ids) + `,`,
-		`StartTime:` + fmt.Sprintf("%v", this.StartTime) + `,`,
-		`EndTime:` + fmt.Sprintf("%v", this.EndTime) + `,`,
-		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
-		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
-		`Include:` + fmt.Sprintf("%v", this.Include) + `,`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`Raw:` + fmt.Sprintf("%v", this.Raw) + `,`,
-		`Tx:` + fmt.Sprintf("%v", this.Tx) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Blocks) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Blocks{`,
-		`Blocks:` + strings.Replace(fmt.Sprintf("%v", this.Blocks), "Block", "Block", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Transactions) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Transactions{`,
-		`Transactions:` + strings.Replace(fmt.Sprintf("%v", this.Transactions), "Tx", "Tx", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MemPoolStats) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&MemPoolStats{`,
-		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
-		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
-		`MPSize:` + fmt.Sprintf("%v", this.MPSize) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringBloccrpc(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *Symbol) Unmarshal(dAtA []byte) error {
+func (m *Webhook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1671,15 +14788,111 @@ func (m *Symbol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Symbol: wiretype end group for non-group")
+			return fmt.Errorf("proto: Webhook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Symbol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Webhook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1707,64 +14920,11 @@ func (m *Symbol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Secret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBloccrpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBloccrpc
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBloccrpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Get) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBloccrpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Get: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Get: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1792,11 +14952,11 @@ func (m *Get) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1824,13 +14984,13 @@ func (m *Get) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.TxIds = append(m.TxIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 99:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
 			}
-			m.Include = 0
+			m.Confirmations = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBloccrpc
@@ -1840,14 +15000,14 @@ func (m *Get) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Include |= int32(b&0x7F) << shift
+				m.Confirmations |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 100:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1864,12 +15024,12 @@ func (m *Get) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Data = bool(v != 0)
-		case 101:
+			m.Blocks = bool(v != 0)
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var v int
+			m.Time = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBloccrpc
@@ -1879,17 +15039,69 @@ func (m *Get) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Time |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Raw = bool(v != 0)
-		case 102:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloccrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if skippy < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Webhooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloccrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Webhooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Webhooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBloccrpc
@@ -1899,12 +15111,26 @@ func (m *Get) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tx = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Webhooks = append(m.Webhooks, &Webhook{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBloccrpc(dAtA[iNdEx:])
@@ -1929,7 +15155,7 @@ func (m *Get) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Find) Unmarshal(dAtA []byte) error {
+func (m *WebhookEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1952,15 +15178,15 @@ func (m *Find) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Find: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Find: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1988,11 +15214,11 @@ func (m *Find) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2020,13 +15246,13 @@ func (m *Find) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			m.WebhookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.StartTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBloccrpc
@@ -2036,16 +15262,29 @@ func (m *Find) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			m.EndTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBloccrpc
@@ -2055,16 +15294,29 @@ func (m *Find) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndTime |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.Offset = 0
+			m.Time = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBloccrpc
@@ -2074,16 +15326,16 @@ func (m *Find) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int64(b&0x7F) << shift
+				m.Time |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
 			}
-			m.Count = 0
+			m.Confirmations = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBloccrpc
@@ -2093,16 +15345,16 @@ func (m *Find) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				m.Confirmations |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 99:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			m.Include = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBloccrpc
@@ -2112,36 +15364,29 @@ func (m *Find) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Include |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 100:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBloccrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Data = bool(v != 0)
-		case 101:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBloccrpc
@@ -2151,17 +15396,33 @@ func (m *Find) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Raw = bool(v != 0)
-		case 102:
-			if wireType != 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &BlockHeader{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBloccrpc
@@ -2171,12 +15432,28 @@ func (m *Find) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tx = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBloccrpc(dAtA[iNdEx:])
@@ -2201,7 +15478,7 @@ func (m *Find) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Blocks) Unmarshal(dAtA []byte) error {
+func (m *WebhookDelivery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2224,17 +15501,17 @@ func (m *Blocks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Blocks: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookDelivery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Blocks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBloccrpc
@@ -2244,82 +15521,59 @@ func (m *Blocks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBloccrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBloccrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blocks = append(m.Blocks, &Block{})
-			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBloccrpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookId", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthBloccrpc
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBloccrpc
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Transactions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBloccrpc
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Transactions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transactions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.WebhookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2346,11 +15600,83 @@ func (m *Transactions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transactions = append(m.Transactions, &Tx{})
-			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Event == nil {
+				m.Event = &WebhookEvent{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttempt", wireType)
+			}
+			m.LastAttempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloccrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAttempt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBloccrpc(dAtA[iNdEx:])
@@ -2375,7 +15701,7 @@ func (m *Transactions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemPoolStats) Unmarshal(dAtA []byte) error {
+func (m *WebhookDeliveries) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2398,17 +15724,17 @@ func (m *MemPoolStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemPoolStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookDeliveries: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemPoolStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookDeliveries: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deliveries", wireType)
 			}
-			m.Time = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBloccrpc
@@ -2418,49 +15744,26 @@ func (m *MemPoolStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Time |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthBloccrpc
 			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBloccrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloccrpc
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MPSize", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MPSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBloccrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MPSize |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Deliveries = append(m.Deliveries, &WebhookDelivery{})
+			if err := m.Deliveries[len(m.Deliveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBloccrpc(dAtA[iNdEx:])
@@ -0,0 +1,40 @@
+package blocc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRawJSON(t *testing.T) {
+
+	// Every length of padding round trips without the padding bytes
+	for _, raw := range []Raw{{}, {0x01}, {0x01, 0x02}, {0x01, 0x02, 0x03}, {0x01, 0x02, 0x03, 0x04}, {0x01, 0x02, 0x03, 0x04, 0x05}} {
+		b, err := json.Marshal(&raw)
+		assert.Nil(t, err)
+		var out Raw
+		assert.Nil(t, json.Unmarshal(b, &out), string(b))
+		if len(raw) == 0 {
+			assert.Nil(t, out)
+		} else {
+			assert.Equal(t, raw, out, string(b))
+		}
+	}
+
+	// Empty and null are nil
+	for _, in := range []string{`""`, `null`} {
+		out := Raw{0x01}
+		assert.Nil(t, json.Unmarshal([]byte(in), &out), in)
+		assert.Nil(t, out, in)
+	}
+
+	// Embedded in a transaction
+	var tx Tx
+	assert.Nil(t, json.Unmarshal([]byte(`{"tx_id":"a","raw":"AQID"}`), &tx))
+	assert.Equal(t, []byte{0x01, 0x02, 0x03}, []byte(tx.Raw))
+
+	var out Raw
+	assert.NotNil(t, json.Unmarshal([]byte(`"not base64!"`), &out))
+
+}
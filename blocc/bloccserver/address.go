@@ -0,0 +1,35 @@
+package bloccserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// GetAddress gets the balance and history summary of an address
+func (s *Server) GetAddress(ctx context.Context, input *blocc.Get) (*blocc.Address, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	if input.Id == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Address is required")
+	}
+	input.Id = s.normalizeAddresses(input.Symbol, []string{input.Id})[0]
+
+	a, err := s.blockChainStore.GetAddress(input.Symbol, input.Id)
+	if err == blocc.ErrNotFound {
+		// An address without transactions has an empty summary
+		return &blocc.Address{Symbol: input.Symbol, Address: input.Id}, nil
+	} else if err != nil {
+		s.logger.Errorw("Could not blockChainStore.GetAddress", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not get address")
+	}
+
+	return a, nil
+
+}
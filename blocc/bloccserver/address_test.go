@@ -0,0 +1,70 @@
+package bloccserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	_ "git.coinninja.net/backend/blocc/blocc/btc/bch"
+)
+
+func TestGetAddress(t *testing.T) {
+
+	s, m := newTestServer(t)
+
+	a := &blocc.Address{
+		Symbol:               "btc",
+		Address:              "address1",
+		TxCount:              2,
+		Received:             300,
+		Sent:                 100,
+		Balance:              200,
+		MempoolTxCount:       1,
+		MempoolSent:          50,
+		MempoolBalance:       -50,
+		FirstSeenBlockId:     "block1",
+		FirstSeenBlockHeight: 98,
+		LastSeenBlockId:      "block2",
+		LastSeenBlockHeight:  99,
+	}
+
+	// Mock call to item store
+	m.bcs.On("GetAddress", "btc", "address1").Once().Return(a, nil)
+
+	response, err := s.GetAddress(context.Background(), &blocc.Get{Symbol: "btc", Id: "address1"})
+	assert.Nil(t, err)
+	assert.Equal(t, a, response)
+
+	// An address without transactions has an empty summary
+	m.bcs.On("GetAddress", "btc", "address2").Once().Return(nil, blocc.ErrNotFound)
+
+	response, err = s.GetAddress(context.Background(), &blocc.Get{Symbol: "btc", Id: "address2"})
+	assert.Nil(t, err)
+	assert.Equal(t, &blocc.Address{Symbol: "btc", Address: "address2"}, response)
+
+	// The address is required
+	_, err = s.GetAddress(context.Background(), &blocc.Get{Symbol: "btc"})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// Only registered chains
+	_, err = s.GetAddress(context.Background(), &blocc.Get{Symbol: "test", Id: "address1"})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	assert.Contains(t, err.Error(), blocc.ErrUnknownSymbol.Error())
+
+	// Legacy Bitcoin Cash addresses are searched for as CashAddr
+	bch := &blocc.Address{Symbol: "bch", Address: "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", TxCount: 1}
+	m.bcs.On("GetAddress", "bch", bch.Address).Twice().Return(bch, nil)
+
+	for _, id := range []string{"1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu", "qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"} {
+		response, err = s.GetAddress(context.Background(), &blocc.Get{Symbol: "bch", Id: id})
+		assert.Nil(t, err)
+		assert.Equal(t, bch, response)
+	}
+
+	m.AssertExpectations(t)
+
+}
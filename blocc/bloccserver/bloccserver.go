@@ -1,41 +1,123 @@
 package bloccserver
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	config "github.com/spf13/viper"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/btc"
+	"git.coinninja.net/backend/blocc/blocc/feeest"
 	"git.coinninja.net/backend/blocc/store"
 )
 
 type Server struct {
 	logger *zap.SugaredLogger
 
-	defaultSymbol string
-	defaultCount  int
+	defaultSymbol   string
+	defaultCount    int
+	defaultGapLimit int
+	maxGapLimit     int
+
+	defaultDescriptorRange int
+	maxDescriptorRange     int
+
+	defaultProjectedBlocks int
+	maxProjectedBlocks     int
+
+	maxSubscribeAddresses           int
+	subscribeAddressesConfirmations int64
+
+	sendTxMinFeeVSize float64
+	sendTxTimeout     time.Duration
 
 	distCache    store.DistCache
 	cacheTimeout time.Duration
 
+	feeEstimator *feeest.Estimator
+
 	blockChainStore blocc.BlockChainStore
 	txBus           blocc.TxBus
+	eventBus        blocc.EventBus
+	webhookStore    blocc.WebhookStore
+	txRelay         blocc.TxRelay
 }
 
-func New(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus, distCache store.DistCache) (*Server, error) {
+func New(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus, eventBus blocc.EventBus, webhookStore blocc.WebhookStore, txRelay blocc.TxRelay, distCache store.DistCache) (*Server, error) {
+
+	if _, err := btc.GetChain(config.GetString("server.default_symbol")); err != nil {
+		return nil, fmt.Errorf("Invalid server.default_symbol %s: %v", config.GetString("server.default_symbol"), err)
+	}
 
 	return &Server{
 		logger: zap.S().With("package", "bloccserver"),
 
-		defaultSymbol: config.GetString("server.default_symbol"),
-		defaultCount:  config.GetInt("server.default_count"),
+		defaultSymbol:   config.GetString("server.default_symbol"),
+		defaultCount:    config.GetInt("server.default_count"),
+		defaultGapLimit: config.GetInt("server.default_gap_limit"),
+		maxGapLimit:     config.GetInt("server.max_gap_limit"),
+
+		defaultDescriptorRange: config.GetInt("server.default_descriptor_range"),
+		maxDescriptorRange:     config.GetInt("server.max_descriptor_range"),
+
+		defaultProjectedBlocks: config.GetInt("server.default_projected_blocks"),
+		maxProjectedBlocks:     config.GetInt("server.max_projected_blocks"),
+
+		maxSubscribeAddresses:           config.GetInt("server.max_subscribe_addresses"),
+		subscribeAddressesConfirmations: config.GetInt64("server.subscribe_addresses_confirmations"),
+
+		sendTxMinFeeVSize: config.GetFloat64("server.send_tx_min_fee_vsize"),
+		sendTxTimeout:     config.GetDuration("server.send_tx_timeout"),
 
 		distCache:    distCache,
 		cacheTimeout: config.GetDuration("server.cache_duration"),
 
+		feeEstimator: feeest.New(blockChainStore, distCache),
+
 		blockChainStore: blockChainStore,
 		txBus:           txBus,
+		eventBus:        eventBus,
+		webhookStore:    webhookStore,
+		txRelay:         txRelay,
 	}, nil
 
 }
+
+// checkSymbol defaults the symbol to server.default_symbol and checks it's a registered chain
+func (s *Server) checkSymbol(symbol *string) error {
+	if *symbol == "" {
+		*symbol = s.defaultSymbol
+	}
+	if _, err := btc.GetChain(*symbol); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "%v %s", err, *symbol)
+	}
+	return nil
+}
+
+// chainParams returns the chain and it's parameters for the network of extractor.btc.chain
+func (s *Server) chainParams(symbol string) (*btc.Chain, *chaincfg.Params, error) {
+	chain, err := btc.GetChain(symbol)
+	if err != nil {
+		return nil, nil, err
+	}
+	params, err := chain.Params(config.GetString("extractor.btc.chain"))
+	if err != nil {
+		return nil, nil, err
+	}
+	return chain, params, nil
+}
+
+// normalizeAddresses returns the addresses the way the chain stores them so other encodings can be searched for, ie
+// legacy Bitcoin Cash addresses are stored as CashAddr
+func (s *Server) normalizeAddresses(symbol string, addresses []string) []string {
+	chain, params, err := s.chainParams(symbol)
+	if err != nil {
+		return addresses
+	}
+	return chain.NormalizeAddresses(addresses, params)
+}
@@ -22,8 +22,8 @@ var (
 // GetBlock returns a block by Id
 func (s *Server) GetBlock(ctx context.Context, input *blocc.Get) (*blocc.Block, error) {
 
-	if input.Symbol == "" {
-		input.Symbol = s.defaultSymbol
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
 	}
 
 	// Set the bit values
@@ -76,8 +76,8 @@ func (s *Server) GetBlock(ctx context.Context, input *blocc.Get) (*blocc.Block,
 // FindBlocks returns blocks by blockIds (with time and pagination)
 func (s *Server) FindBlocks(ctx context.Context, input *blocc.Find) (*blocc.Blocks, error) {
 
-	if input.Symbol == "" {
-		input.Symbol = s.defaultSymbol
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
 	}
 
 	if input.Count == 0 {
@@ -0,0 +1,78 @@
+package bloccserver
+
+import (
+	"context"
+	"encoding/hex"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/btc"
+)
+
+// DecodeTransaction decodes a raw transaction, the inputs found in the store are resolved so it shows the fee
+func (s *Server) DecodeTransaction(ctx context.Context, input *blocc.RawTx) (*blocc.Tx, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	raw, err := hex.DecodeString(input.Hex)
+	if err != nil || len(raw) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Invalid transaction hex")
+	}
+
+	chain, params, err := s.chainParams(input.Symbol)
+	if err != nil {
+		s.logger.Errorw("Could not get chain params", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not DecodeTransaction")
+	}
+
+	var storeErr error
+	tx, err := btc.DecodeTransaction(raw, chain, params, func(txIds []string) ([]*blocc.Tx, error) {
+		prevTxs, err := s.blockChainStore.GetTxsByTxIds(input.Symbol, txIds, blocc.TxIncludeHeader|blocc.TxIncludeOut)
+		if err == blocc.ErrNotFound {
+			return nil, nil
+		} else if err != nil {
+			storeErr = err
+		}
+		return prevTxs, err
+	})
+	if storeErr != nil {
+		s.logger.Errorw("Could not blockChainStore.GetTxsByTxIds", "error", storeErr)
+		return nil, grpc.Errorf(codes.Internal, "Could not DecodeTransaction")
+	} else if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Could not decode transaction: %v", err)
+	}
+
+	return tx, nil
+
+}
+
+// DecodeScript decodes an output script
+func (s *Server) DecodeScript(ctx context.Context, input *blocc.RawScript) (*blocc.Script, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	script, err := hex.DecodeString(input.Hex)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Invalid script hex")
+	}
+
+	chain, params, err := s.chainParams(input.Symbol)
+	if err != nil {
+		s.logger.Errorw("Could not get chain params", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not DecodeScript")
+	}
+
+	ds, err := btc.DecodeScript(script, chain, params)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Could not decode script: %v", err)
+	}
+
+	return ds, nil
+
+}
@@ -0,0 +1,95 @@
+package bloccserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+func TestDecodeTransaction(t *testing.T) {
+
+	s, m := newTestServer(t)
+
+	// Spend a P2WPKH output to a P2PKH output
+	p2wpkh, _ := hex.DecodeString("0014751e76e8199196d454941c45d1b3a323f1433bd6")
+	p2pkh, _ := hex.DecodeString("76a914751e76e8199196d454941c45d1b3a323f1433bd688ac")
+	prevHash := chainhash.Hash{1}
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 1), nil, wire.TxWitness{{0x30, 0x01}, {0x02, 0x03}}))
+	msgTx.AddTxOut(wire.NewTxOut(50000, p2pkh))
+	var buf bytes.Buffer
+	assert.Nil(t, msgTx.Serialize(&buf))
+	prevTxId := prevHash.String()
+
+	m.bcs.On("GetTxsByTxIds", "btc", []string{prevTxId}, blocc.TxIncludeHeader|blocc.TxIncludeOut).Once().Return([]*blocc.Tx{
+		{TxId: prevTxId, Out: []*blocc.TxOut{{Value: 1}, {Value: 60000, Raw: p2wpkh, Type: "witness_v0_keyhash"}}},
+	}, nil)
+
+	tx, err := s.DecodeTransaction(context.Background(), &blocc.RawTx{Hex: hex.EncodeToString(buf.Bytes())})
+	assert.Nil(t, err)
+	assert.Equal(t, msgTx.TxHash().String(), tx.TxId)
+	assert.False(t, tx.Incomplete)
+	assert.Equal(t, "10000", tx.Data["fee"])
+	assert.Equal(t, "60000", tx.Data["in_value"])
+	assert.Equal(t, "pubkeyhash", tx.Out[0].Type)
+	assert.Equal(t, []string{"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"}, tx.Out[0].Addresses)
+	assert.Equal(t, "OP_DUP OP_HASH160 751e76e8199196d454941c45d1b3a323f1433bd6 OP_EQUALVERIFY OP_CHECKSIG", tx.Out[0].Data["asm"])
+	assert.Equal(t, "3001 0203", tx.In[0].Data["witness"])
+	assert.Equal(t, int64(60000), tx.In[0].Out.Value)
+	// A P2WPKH input is 1 and the P2PKH output is 4
+	assert.Equal(t, "1", tx.In[0].Data["sigop_cost"])
+	assert.Equal(t, "5", tx.Data["sigop_cost"])
+
+	// The input is not in the store
+	m.bcs.On("GetTxsByTxIds", "btc", []string{prevTxId}, blocc.TxIncludeHeader|blocc.TxIncludeOut).Once().Return(nil, blocc.ErrNotFound)
+	tx, err = s.DecodeTransaction(context.Background(), &blocc.RawTx{Hex: hex.EncodeToString(buf.Bytes())})
+	assert.Nil(t, err)
+	assert.True(t, tx.Incomplete)
+	assert.Equal(t, "0", tx.Data["fee"])
+
+	// Not a transaction
+	_, err = s.DecodeTransaction(context.Background(), &blocc.RawTx{Hex: "0100"})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// Check remaining expectations
+	m.AssertExpectations(t)
+
+}
+
+func TestDecodeScript(t *testing.T) {
+
+	s, m := newTestServer(t)
+
+	script, err := s.DecodeScript(context.Background(), &blocc.RawScript{Hex: "76a914751e76e8199196d454941c45d1b3a323f1433bd688ac"})
+	assert.Nil(t, err)
+	assert.Equal(t, "pubkeyhash", script.Type)
+	assert.Equal(t, []string{"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"}, script.Addresses)
+	assert.Equal(t, int64(1), script.ReqSigs)
+	assert.Equal(t, int64(1), script.Sigops)
+	assert.NotEmpty(t, script.P2SH)
+	assert.NotEmpty(t, script.P2WSH)
+
+	// A witness program can only be wrapped in P2SH
+	script, err = s.DecodeScript(context.Background(), &blocc.RawScript{Hex: "0014751e76e8199196d454941c45d1b3a323f1433bd6"})
+	assert.Nil(t, err)
+	assert.Equal(t, "witness_v0_keyhash", script.Type)
+	assert.Equal(t, []string{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"}, script.Addresses)
+	assert.NotEmpty(t, script.P2SH)
+	assert.Empty(t, script.P2WSH)
+
+	_, err = s.DecodeScript(context.Background(), &blocc.RawScript{Hex: "zz"})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// Check remaining expectations
+	m.AssertExpectations(t)
+
+}
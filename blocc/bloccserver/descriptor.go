@@ -0,0 +1,171 @@
+package bloccserver
+
+import (
+	"context"
+	"encoding/hex"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/btc/descriptor"
+)
+
+const (
+	// DescriptorBucket is the DistCache bucket registered descriptors are kept in
+	DescriptorBucket = "descriptor"
+	// DescriptorIdPrefix selects the addresses of a registered descriptor in address queries (descriptor:{name})
+	DescriptorIdPrefix = "descriptor:"
+)
+
+var descriptorNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+
+// RegisterDescriptor parses an output descriptor, derives it's addresses and stores it by name
+func (s *Server) RegisterDescriptor(ctx context.Context, input *blocc.Descriptor) (*blocc.Descriptor, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	if !descriptorNameRegexp.MatchString(input.Name) {
+		return nil, grpc.Errorf(codes.InvalidArgument, "The name must be 1-64 letters, numbers, '_', '.' or '-'")
+	}
+
+	chain, params, err := s.chainParams(input.Symbol)
+	if err != nil {
+		s.logger.Errorw("Could not get chain params", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not register descriptor")
+	}
+
+	d, err := descriptor.Parse(input.Desc, params)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Invalid descriptor: %v", err)
+	}
+
+	// Only ranged descriptors use the range
+	if d.IsRange() {
+		if input.RangeEnd == 0 {
+			input.RangeEnd = input.RangeStart + uint32(s.defaultDescriptorRange) - 1
+		}
+		if input.RangeEnd < input.RangeStart || int64(input.RangeEnd-input.RangeStart) >= int64(s.maxDescriptorRange) {
+			return nil, grpc.Errorf(codes.InvalidArgument, "The range must contain between 1 and %d indexes", s.maxDescriptorRange)
+		}
+	} else {
+		input.RangeStart = 0
+		input.RangeEnd = 0
+	}
+
+	ret := &blocc.Descriptor{
+		Symbol:     input.Symbol,
+		Name:       input.Name,
+		Desc:       d.String(),
+		RangeStart: input.RangeStart,
+		RangeEnd:   input.RangeEnd,
+		Addresses:  make([]string, 0),
+		Scripts:    make([]string, 0, input.RangeEnd-input.RangeStart+1),
+	}
+
+	seen := make(map[string]struct{})
+	for index := uint64(input.RangeStart); index <= uint64(input.RangeEnd); index++ {
+		script, err := d.Script(uint32(index))
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "Could not derive index %d: %v", index, err)
+		}
+		ret.Scripts = append(ret.Scripts, hex.EncodeToString(script))
+
+		addresses, err := d.Addresses(uint32(index))
+		if err == descriptor.ErrNoAddress {
+			continue
+		} else if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "Could not derive index %d: %v", index, err)
+		}
+		for _, address := range chain.NormalizeAddresses(addresses, params) {
+			if _, ok := seen[address]; !ok {
+				seen[address] = struct{}{}
+				ret.Addresses = append(ret.Addresses, address)
+			}
+		}
+	}
+
+	if len(ret.Addresses) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Invalid descriptor: %v", descriptor.ErrNoAddress)
+	}
+
+	// Registered descriptors do not expire
+	err = s.distCache.Set(DescriptorBucket, descriptorKey(ret.Symbol, ret.Name), ret, 0)
+	if err != nil {
+		s.logger.Errorw("Could not set DistCache descriptor", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not register descriptor")
+	}
+
+	return ret, nil
+
+}
+
+// QueryDescriptor returns a registered descriptor and it's addresses by name
+func (s *Server) QueryDescriptor(ctx context.Context, input *blocc.Get) (*blocc.Descriptor, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	if input.Id == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Missing descriptor name")
+	}
+
+	d, err := s.getDescriptor(input.Symbol, input.Id)
+	if err == blocc.ErrNotFound {
+		return nil, grpc.Errorf(codes.NotFound, "Not Found")
+	} else if err != nil {
+		s.logger.Errorw("Could not get DistCache descriptor", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not get descriptor")
+	}
+
+	return d, nil
+
+}
+
+// expandAddresses replaces the descriptor:{name} ids with the addresses of the registered descriptor, the addresses are
+// normalized for the chain
+func (s *Server) expandAddresses(symbol string, ids []string) ([]string, error) {
+
+	var ret []string
+	for x, id := range ids {
+		if !strings.HasPrefix(id, DescriptorIdPrefix) {
+			if ret != nil {
+				ret = append(ret, id)
+			}
+			continue
+		}
+		// Only copy if there is a descriptor
+		if ret == nil {
+			ret = append(make([]string, 0, len(ids)), ids[:x]...)
+		}
+		d, err := s.getDescriptor(symbol, strings.TrimPrefix(id, DescriptorIdPrefix))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, d.Addresses...)
+	}
+
+	if ret == nil {
+		ret = ids
+	}
+	return s.normalizeAddresses(symbol, ret), nil
+
+}
+
+func (s *Server) getDescriptor(symbol string, name string) (*blocc.Descriptor, error) {
+	d := new(blocc.Descriptor)
+	if err := s.distCache.GetScan(DescriptorBucket, descriptorKey(symbol, name), d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// descriptorKey is the DistCache key of a registered descriptor
+func descriptorKey(symbol string, name string) string {
+	return symbol + ":" + name
+}
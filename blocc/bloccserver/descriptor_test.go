@@ -0,0 +1,104 @@
+package bloccserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// The BIP84 account key of the test mnemonic "abandon abandon ... about" in xpub form
+const testDescriptor = "wpkh(xpub6CatWdiZiodmUeTDp8LT5or8nmbKNcuyvz7WyksVFkKB4RHwCD3XyuvPEbvqAQY3rAPshWcMLoP2fMFMKHPJ4ZeZXYVUhLv1VMrjPC7PW6V/0/*)"
+
+func TestRegisterDescriptor(t *testing.T) {
+
+	s, m := newTestServer(t)
+
+	expected := &blocc.Descriptor{
+		Symbol:     "btc",
+		Name:       "wallet",
+		Desc:       testDescriptor + "#kj7aqcx6",
+		RangeStart: 0,
+		RangeEnd:   1,
+		Addresses:  []string{"bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", testZPubAddr},
+		Scripts:    []string{"0014c0cebcd6c3d3ca8c75dc5ec62ebe55330ef910e2", "00149c90f934ea51fa0f6504177043e0908da6929983"},
+	}
+
+	m.dc.On("Set", DescriptorBucket, "btc:wallet", expected, time.Duration(0)).Once().Return(nil)
+
+	response, err := s.RegisterDescriptor(context.Background(), &blocc.Descriptor{Name: "wallet", Desc: testDescriptor, RangeEnd: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, response)
+
+	// Bad requests
+	for _, input := range []*blocc.Descriptor{
+		{Name: "", Desc: testDescriptor},
+		{Name: "has space", Desc: testDescriptor},
+		{Name: "wallet", Desc: "wpkh(notakey)"},
+		{Name: "wallet", Desc: testDescriptor, RangeStart: 5, RangeEnd: 4},
+		{Name: "wallet", Desc: testDescriptor, RangeEnd: 100000},
+		{Name: "wallet", Desc: "raw(deadbeef)"},
+		{Symbol: "test", Name: "wallet", Desc: testDescriptor},
+	} {
+		_, err = s.RegisterDescriptor(context.Background(), input)
+		assert.Equal(t, codes.InvalidArgument, grpc.Code(err), input.Name+" "+input.Desc)
+	}
+
+	// Check remaining expectations
+	m.AssertExpectations(t)
+
+}
+
+func TestQueryDescriptor(t *testing.T) {
+
+	s, m := newTestServer(t)
+
+	registered := &blocc.Descriptor{
+		Symbol:    "btc",
+		Name:      "wallet",
+		Desc:      testDescriptor + "#kj7aqcx6",
+		Addresses: []string{"addr1", "addr2"},
+	}
+
+	m.dc.On("GetScan", DescriptorBucket, "btc:wallet", mock.AnythingOfType("*blocc.Descriptor")).Times(3).Run(func(args mock.Arguments) {
+		*args.Get(2).(*blocc.Descriptor) = *registered
+	}).Return(nil)
+	m.dc.On("GetScan", DescriptorBucket, "btc:missing", mock.AnythingOfType("*blocc.Descriptor")).Twice().Return(blocc.ErrNotFound)
+
+	response, err := s.QueryDescriptor(context.Background(), &blocc.Get{Id: "wallet"})
+	assert.Nil(t, err)
+	assert.Equal(t, registered, response)
+
+	_, err = s.QueryDescriptor(context.Background(), &blocc.Get{Id: "missing"})
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
+
+	_, err = s.QueryDescriptor(context.Background(), &blocc.Get{})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// Address queries expand the descriptor ids
+	var noTime *time.Time
+	txs := []*blocc.Tx{{TxId: "tx1"}}
+	m.bcs.On("FindTxsByAddressesAndTime", "btc", []string{"addr0", "addr1", "addr2"}, noTime, noTime, blocc.TxFilterAddressInputOutput, txIncludeDefault, 0, 20).Once().Return(txs, nil)
+	m.bcs.On("FindUnspentOutputsByAddresses", "btc", []string{"addr1", "addr2"}, 0, 20).Once().Return(nil, blocc.ErrNotFound)
+
+	transactions, err := s.FindTransactionsByAddresses(context.Background(), &blocc.Find{Ids: []string{"addr0", DescriptorIdPrefix + "wallet"}})
+	assert.Nil(t, err)
+	assert.Equal(t, txs, transactions.Transactions)
+
+	utxos, err := s.FindUnspentOutputs(context.Background(), &blocc.Find{Ids: []string{DescriptorIdPrefix + "wallet"}})
+	assert.Nil(t, err)
+	assert.Len(t, utxos.Utxos, 0)
+
+	_, err = s.FindTransactionsByAddresses(context.Background(), &blocc.Find{Ids: []string{DescriptorIdPrefix + "missing"}})
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
+
+	// Check remaining expectations
+	m.AssertExpectations(t)
+
+}
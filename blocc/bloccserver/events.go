@@ -0,0 +1,169 @@
+package bloccserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gorilla/websocket"
+	config "github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/btc"
+	"git.coinninja.net/backend/blocc/server"
+)
+
+// Subscribe streams block and transaction events
+func (s *Server) Subscribe(input *blocc.EventFilter, stream blocc.BloccRPC_SubscribeServer) error {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return err
+	}
+
+	types, err := eventTypes(input.Types)
+	if err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	err = s.subscribe(stream.Context(), input.Symbol, types, stream.Send)
+	if err != nil {
+		return grpc.Errorf(codes.Internal, "Could not Subscribe")
+	}
+
+	return nil
+
+}
+
+// EventsWebSocket bridges Subscribe to a WebSocket for browsers. The symbol is taken from the path and the event
+// types from the types query parameter (comma separated or repeated). Each event is sent as a JSON text message.
+func (s *Server) EventsWebSocket() http.HandlerFunc {
+
+	upgrader := websocket.Upgrader{
+		// Events are public, allow any origin the same as the grpc gateway websocket proxy
+		CheckOrigin: func(r *http.Request) bool { return true },
+	}
+
+	// Marshal the same as the grpc gateway
+	marshaler := &jsonpb.Marshaler{
+		EnumsAsInts:  config.GetBool("server.rest.enums_as_ints"),
+		EmitDefaults: config.GetBool("server.rest.emit_defaults"),
+		OrigName:     config.GetBool("server.rest.orig_names"),
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		symbol := chi.URLParam(r, "symbol")
+		if symbol == "" {
+			symbol = s.defaultSymbol
+		}
+		if _, err := btc.GetChain(symbol); err != nil {
+			render.Render(w, r, server.ErrInvalidRequest(err))
+			return
+		}
+
+		var input []string
+		for _, t := range r.URL.Query()["types"] {
+			input = append(input, strings.Split(t, ",")...)
+		}
+		types, err := eventTypes(input)
+		if err != nil {
+			render.Render(w, r, server.ErrInvalidRequest(err))
+			return
+		}
+
+		// The upgrader responds with the error
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		// Nothing is read from the client, read until it closes the connection
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		go func() {
+			defer cancel()
+			for {
+				if _, _, err := conn.NextReader(); err != nil {
+					return
+				}
+			}
+		}()
+
+		err = s.subscribe(ctx, symbol, types, func(event *blocc.Event) error {
+			data, err := marshaler.MarshalToString(event)
+			if err != nil {
+				return err
+			}
+			return conn.WriteMessage(websocket.TextMessage, []byte(data))
+		})
+		if err != nil {
+			conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInternalServerErr, "Could not Subscribe"))
+		}
+
+	}
+
+}
+
+// subscribe sends the events of types until ctx is done or send fails
+func (s *Server) subscribe(ctx context.Context, symbol string, types map[string]struct{}, send func(*blocc.Event) error) error {
+
+	sub, err := s.eventBus.SubscribeEvents(symbol)
+	if err != nil {
+		s.logger.Errorw("Could not EventBus.SubscribeEvents", "error", err)
+		return err
+	}
+	defer sub.Close()
+	subChan := sub.Channel()
+
+	for {
+		select {
+		case event, ok := <-subChan:
+			if !ok {
+				return nil
+			}
+			if _, ok := types[event.Type]; !ok {
+				continue
+			}
+			// The client went away
+			if send(event) != nil {
+				return nil
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+}
+
+// eventTypes returns the requested event types as a set, all of them if none were requested
+func eventTypes(input []string) (map[string]struct{}, error) {
+
+	if len(input) == 0 {
+		input = blocc.EventTypes
+	}
+
+	types := make(map[string]struct{})
+	for _, t := range input {
+		valid := false
+		for _, eventType := range blocc.EventTypes {
+			if t == eventType {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("Invalid event type: %s", t)
+		}
+		types[t] = struct{}{}
+	}
+
+	return types, nil
+
+}
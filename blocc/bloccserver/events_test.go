@@ -0,0 +1,61 @@
+package bloccserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/mocks"
+)
+
+// subscribeServer collects the events sent on a Subscribe stream
+type subscribeServer struct {
+	grpc.ServerStream
+	events []*blocc.Event
+}
+
+func (ss *subscribeServer) Context() context.Context {
+	return context.Background()
+}
+
+func (ss *subscribeServer) Send(event *blocc.Event) error {
+	ss.events = append(ss.events, event)
+	return nil
+}
+
+func TestSubscribe(t *testing.T) {
+
+	s, m := newTestServer(t)
+
+	events := make(chan *blocc.Event, 3)
+	events <- blocc.NewBlockEvent(blocc.EventBlockConnected, "btc", &blocc.BlockHeader{BlockId: "block"})
+	events <- blocc.NewTxEvent(blocc.EventTxAdded, "btc", &blocc.Tx{TxId: "tx1"})
+	events <- blocc.NewTxEvent(blocc.EventTxEvicted, "btc", &blocc.Tx{TxId: "tx2"})
+	close(events)
+
+	ec := new(mocks.EventChannel)
+	ec.On("Channel").Once().Return((<-chan *blocc.Event)(events))
+	ec.On("Close").Once()
+	m.eb.On("SubscribeEvents", "btc").Once().Return(ec, nil)
+
+	// Only the requested types are sent
+	ss := new(subscribeServer)
+	err := s.Subscribe(&blocc.EventFilter{Types: []string{blocc.EventTxAdded, blocc.EventTxEvicted}}, ss)
+	assert.Nil(t, err)
+	assert.Len(t, ss.events, 2)
+	assert.Equal(t, "tx1", ss.events[0].Tx.TxId)
+	assert.Equal(t, blocc.EventTxEvicted, ss.events[1].Type)
+
+	// Unknown types are rejected
+	err = s.Subscribe(&blocc.EventFilter{Types: []string{"tx_mined"}}, new(subscribeServer))
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// Check remaining expectations
+	m.AssertExpectations(t)
+	ec.AssertExpectations(t)
+
+}
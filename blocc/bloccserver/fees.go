@@ -0,0 +1,52 @@
+package bloccserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// EstimateFee estimates the fee rate for confirmation within the target number of blocks
+func (s *Server) EstimateFee(ctx context.Context, input *blocc.FeeTarget) (*blocc.FeeEstimate, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	if input.TargetBlocks == 0 {
+		input.TargetBlocks = 1
+	} else if input.TargetBlocks < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "The target must be at least 1 block")
+	}
+
+	fe, err := s.feeEstimator.EstimateFee(input.Symbol, input.TargetBlocks)
+	if err != nil {
+		s.logger.Errorw("Could not feeEstimator.EstimateFee", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not EstimateFee")
+	}
+
+	return fe, nil
+
+}
+
+// GetConfirmationStats returns how long transactions took to confirm by fee rate in recent blocks
+func (s *Server) GetConfirmationStats(ctx context.Context, input *blocc.Symbol) (*blocc.ConfirmationStats, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	cs, err := s.feeEstimator.ConfirmationStats(input.Symbol)
+	if err == blocc.ErrNotFound {
+		return nil, grpc.Errorf(codes.NotFound, "Not Found")
+	} else if err != nil {
+		s.logger.Errorw("Could not feeEstimator.ConfirmationStats", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not GetConfirmationStats")
+	}
+
+	return cs, nil
+
+}
@@ -0,0 +1,50 @@
+package bloccserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"git.coinninja.net/backend/blocc/mocks"
+)
+
+// testMocks are the mocks a test server is built with
+type testMocks struct {
+	bcs *mocks.BlockChainStore
+	txb *mocks.TxBus
+	eb  *mocks.EventBus
+	whs *mocks.WebhookStore
+	tr  *mocks.TxRelay
+	dc  *mocks.DistCache
+}
+
+// newTestServer returns a server built with new mocks
+func newTestServer(t *testing.T) (*Server, *testMocks) {
+
+	m := &testMocks{
+		bcs: new(mocks.BlockChainStore),
+		txb: new(mocks.TxBus),
+		eb:  new(mocks.EventBus),
+		whs: new(mocks.WebhookStore),
+		tr:  new(mocks.TxRelay),
+		dc:  new(mocks.DistCache),
+	}
+
+	s, err := New(m.bcs, m.txb, m.eb, m.whs, m.tr, m.dc)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	return s, m
+
+}
+
+// AssertExpectations checks the remaining expectations of all of the mocks
+func (m *testMocks) AssertExpectations(t *testing.T) {
+	m.bcs.AssertExpectations(t)
+	m.txb.AssertExpectations(t)
+	m.eb.AssertExpectations(t)
+	m.whs.AssertExpectations(t)
+	m.tr.AssertExpectations(t)
+	m.dc.AssertExpectations(t)
+}
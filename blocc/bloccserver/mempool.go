@@ -13,14 +13,14 @@ import (
 // GetMemPoolStats returns mempool statistics
 func (s *Server) GetMemPoolStats(ctx context.Context, input *blocc.Symbol) (*blocc.MemPoolStats, error) {
 
-	if input.Symbol == "" {
-		input.Symbol = s.defaultSymbol
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
 	}
 
 	mps := new(blocc.MemPoolStats)
 
 	// Check the cache
-	err := s.distCache.GetScan("mempool", "stats", mps)
+	err := s.distCache.GetScan(input.Symbol+":mempool", "stats", mps)
 	if err == nil {
 		return mps, nil
 	} else if err != nil && err != blocc.ErrNotFound {
@@ -40,7 +40,7 @@ func (s *Server) GetMemPoolStats(ctx context.Context, input *blocc.Symbol) (*blo
 	mps.MPSize = size
 
 	// Set it in the cache
-	err = s.distCache.Set("mempool", "stats", mps, s.cacheTimeout)
+	err = s.distCache.Set(input.Symbol+":mempool", "stats", mps, s.cacheTimeout)
 	if err != nil {
 		s.logger.Errorw("Could not set DistCache stats", "error", err)
 	}
@@ -49,11 +49,57 @@ func (s *Server) GetMemPoolStats(ctx context.Context, input *blocc.Symbol) (*blo
 
 }
 
+// GetMemPoolHistogram returns the mempool grouped by fee rate
+func (s *Server) GetMemPoolHistogram(ctx context.Context, input *blocc.Symbol) (*blocc.MemPoolHistogram, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	h, err := s.feeEstimator.MemPoolHistogram(input.Symbol)
+	if err != nil {
+		s.logger.Errorw("Could not feeEstimator.MemPoolHistogram", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not GetMemPoolHistogram")
+	}
+
+	return h, nil
+
+}
+
+// GetMemPoolProjectedBlocks returns the next blocks projected from the mempool
+func (s *Server) GetMemPoolProjectedBlocks(ctx context.Context, input *blocc.BlockCount) (*blocc.ProjectedBlocks, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	if input.Count == 0 {
+		input.Count = int64(s.defaultProjectedBlocks)
+	}
+	if input.Count < 0 || input.Count > int64(s.maxProjectedBlocks) {
+		return nil, grpc.Errorf(codes.InvalidArgument, "The count must be between 1 and %d", s.maxProjectedBlocks)
+	}
+
+	pb, err := s.feeEstimator.MemPoolProjectedBlocks(input.Symbol)
+	if err != nil {
+		s.logger.Errorw("Could not feeEstimator.MemPoolProjectedBlocks", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not GetMemPoolProjectedBlocks")
+	}
+
+	// The server.max_projected_blocks are cached
+	if int64(len(pb.Blocks)) > input.Count {
+		pb.Blocks = pb.Blocks[:input.Count]
+	}
+
+	return pb, nil
+
+}
+
 // GetMemPoolStream streams mempool data
 func (s *Server) GetMemPoolStream(input *blocc.Symbol, server blocc.BloccRPC_GetMemPoolStreamServer) error {
 
-	if input.Symbol == "" {
-		input.Symbol = s.defaultSymbol
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return err
 	}
 
 	sub, err := s.txBus.Subscribe(input.Symbol, "stream")
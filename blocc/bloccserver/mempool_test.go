@@ -6,37 +6,75 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	"git.coinninja.net/backend/blocc/blocc"
-	"git.coinninja.net/backend/blocc/mocks"
 )
 
 func TestMempoolStats(t *testing.T) {
 
-	// Mock Store and server
-	bcs := new(mocks.BlockChainStore)
-	txb := new(mocks.TxBus)
-	dc := new(mocks.DistCache)
-	s, err := New(bcs, txb, dc)
-	assert.Nil(t, err)
+	s, m := newTestServer(t)
 
-	i := &blocc.Symbol{Symbol: "test"}
+	i := &blocc.Symbol{Symbol: "btc"}
 
 	// Mock request to cache
-	dc.On("GetScan", "mempool", "stats", mock.AnythingOfType("*blocc.MemPoolStats")).Once().Return(blocc.ErrNotFound)
-	dc.On("Set", "mempool", "stats", mock.AnythingOfType("*blocc.MemPoolStats"), mock.AnythingOfType("time.Duration")).Once().Return(nil)
+	m.dc.On("GetScan", "btc:mempool", "stats", mock.AnythingOfType("*blocc.MemPoolStats")).Once().Return(blocc.ErrNotFound)
+	m.dc.On("Set", "btc:mempool", "stats", mock.AnythingOfType("*blocc.MemPoolStats"), mock.AnythingOfType("time.Duration")).Once().Return(nil)
 
 	// Mock call to item store
-	bcs.On("GetMemPoolStats", "test").Once().Return(int64(234), int64(123), nil)
+	m.bcs.On("GetMemPoolStats", "btc").Once().Return(int64(234), int64(123), nil)
 
 	response, err := s.GetMemPoolStats(context.Background(), i)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(234), response.MPSize)
 	assert.Equal(t, int64(123), response.Count)
 
+	// Each symbol is cached on it's own
+	m.dc.On("GetScan", "bch:mempool", "stats", mock.AnythingOfType("*blocc.MemPoolStats")).Once().Return(blocc.ErrNotFound)
+	m.dc.On("Set", "bch:mempool", "stats", mock.AnythingOfType("*blocc.MemPoolStats"), mock.AnythingOfType("time.Duration")).Once().Return(nil)
+	m.bcs.On("GetMemPoolStats", "bch").Once().Return(int64(45), int64(6), nil)
+
+	response, err = s.GetMemPoolStats(context.Background(), &blocc.Symbol{Symbol: "bch"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(45), response.MPSize)
+	assert.Equal(t, int64(6), response.Count)
+
+	m.dc.On("GetScan", "btc:mempool", "stats", mock.AnythingOfType("*blocc.MemPoolStats")).Once().Run(func(args mock.Arguments) {
+		*args.Get(2).(*blocc.MemPoolStats) = blocc.MemPoolStats{MPSize: 234, Count: 123}
+	}).Return(nil)
+
+	response, err = s.GetMemPoolStats(context.Background(), i)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(234), response.MPSize)
+
+	// Check remaining expectations
+	m.AssertExpectations(t)
+
+}
+
+func TestMemPoolProjectedBlocks(t *testing.T) {
+
+	s, m := newTestServer(t)
+
+	// Cached blocks
+	m.dc.On("GetScan", "btc:mempool", "blocks", mock.AnythingOfType("*blocc.ProjectedBlocks")).Twice().Run(func(args mock.Arguments) {
+		*args.Get(2).(*blocc.ProjectedBlocks) = blocc.ProjectedBlocks{Time: 1, Blocks: []*blocc.ProjectedBlock{{TxCount: 1}, {TxCount: 2}, {TxCount: 3}}}
+	}).Return(nil)
+
+	response, err := s.GetMemPoolProjectedBlocks(context.Background(), &blocc.BlockCount{Count: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []*blocc.ProjectedBlock{{TxCount: 1}, {TxCount: 2}}, response.Blocks)
+
+	// The default is more than there are
+	response, err = s.GetMemPoolProjectedBlocks(context.Background(), &blocc.BlockCount{})
+	assert.Nil(t, err)
+	assert.Len(t, response.Blocks, 3)
+
+	_, err = s.GetMemPoolProjectedBlocks(context.Background(), &blocc.BlockCount{Count: 1000})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
 	// Check remaining expectations
-	bcs.AssertExpectations(t)
-	txb.AssertExpectations(t)
-	dc.AssertExpectations(t)
+	m.AssertExpectations(t)
 
 }
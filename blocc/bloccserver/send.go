@@ -0,0 +1,123 @@
+package bloccserver
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/btcsuite/btcd/wire"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/btc"
+)
+
+// SendRawTransaction checks a transaction and sends it to the transaction extractor to relay through it's peer. The
+// transaction must be standard, spend known outputs that are not already spent, have valid signatures and pay at least
+// server.send_tx_min_fee_vsize
+func (s *Server) SendRawTransaction(ctx context.Context, input *blocc.RawTx) (*blocc.SentTx, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+	chain, err := btc.GetChain(input.Symbol)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%v %s", err, input.Symbol)
+	}
+
+	raw, err := hex.DecodeString(input.Hex)
+	if err != nil || len(raw) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Invalid transaction hex")
+	}
+
+	msgTx, err := btc.DecodeTx(raw)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Could not decode transaction: %v", err)
+	}
+
+	err = btc.CheckStandard(msgTx)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Invalid transaction: %v", err)
+	}
+
+	txId := msgTx.TxHash().String()
+
+	// Get the transactions of the outputs being spent
+	prevTxIds := make([]string, 0, len(msgTx.TxIn))
+	seen := make(map[string]struct{})
+	for _, txIn := range msgTx.TxIn {
+		prevTxId := txIn.PreviousOutPoint.Hash.String()
+		if _, ok := seen[prevTxId]; !ok {
+			seen[prevTxId] = struct{}{}
+			prevTxIds = append(prevTxIds, prevTxId)
+		}
+	}
+	prevTxs, err := s.blockChainStore.GetTxsByTxIds(input.Symbol, prevTxIds, blocc.TxIncludeHeader|blocc.TxIncludeOut)
+	if err != nil && err != blocc.ErrNotFound {
+		s.logger.Errorw("Could not blockChainStore.GetTxsByTxIds", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not SendRawTransaction")
+	}
+	prevTxsById := make(map[string]*blocc.Tx, len(prevTxs))
+	for _, prevTx := range prevTxs {
+		prevTxsById[prevTx.TxId] = prevTx
+	}
+
+	var inValue int64
+	prevOuts := make([]*wire.TxOut, 0, len(msgTx.TxIn))
+	for _, txIn := range msgTx.TxIn {
+		prevTxId := txIn.PreviousOutPoint.Hash.String()
+		height := int64(txIn.PreviousOutPoint.Index)
+
+		prevTx, ok := prevTxsById[prevTxId]
+		if !ok || height >= int64(len(prevTx.Out)) || prevTx.Out[height] == nil {
+			return nil, grpc.Errorf(codes.FailedPrecondition, "Unknown input %s:%d", prevTxId, height)
+		}
+		inValue += prevTx.Out[height].Value
+		prevOuts = append(prevOuts, wire.NewTxOut(prevTx.Out[height].Value, prevTx.Out[height].Raw))
+
+		// Check nothing else spends it, the outputs are only found if they are tracked
+		o, err := s.blockChainStore.GetOutputByOutPoint(input.Symbol, prevTxId, height)
+		if err != nil && err != blocc.ErrNotFound {
+			s.logger.Errorw("Could not blockChainStore.GetOutputByOutPoint", "error", err)
+			return nil, grpc.Errorf(codes.Internal, "Could not SendRawTransaction")
+		} else if err == nil && o.SpentTxId != "" && o.SpentTxId != txId {
+			return nil, grpc.Errorf(codes.FailedPrecondition, "Input %s:%d is already spent by %s", prevTxId, height, o.SpentTxId)
+		}
+	}
+
+	var outValue int64
+	for _, txOut := range msgTx.TxOut {
+		outValue += txOut.Value
+	}
+
+	fee := inValue - outValue
+	if fee < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Output value %d is more than input value %d", outValue, inValue)
+	}
+	feeVSize := float64(fee) / float64(btc.TxVSize(msgTx))
+	if feeVSize < s.sendTxMinFeeVSize {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "Fee rate %.2f is below the minimum %.2f", feeVSize, s.sendTxMinFeeVSize)
+	}
+
+	// The signatures are checked last, it's the most work
+	if !chain.NoVerifyScripts {
+		err = btc.VerifyScripts(msgTx, prevOuts)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "Invalid transaction: %v", err)
+		}
+	}
+
+	err = s.txRelay.RelayTx(input.Symbol, raw, s.sendTxTimeout)
+	if err == blocc.ErrNoTxRelay {
+		return nil, grpc.Errorf(codes.Unavailable, "No transaction extractor is available")
+	} else if err != nil {
+		s.logger.Errorw("Could not txRelay.RelayTx", "error", err, "tx_id", txId)
+		return nil, grpc.Errorf(codes.Unavailable, "Could not SendRawTransaction: %v", err)
+	}
+
+	return &blocc.SentTx{
+		Symbol: input.Symbol,
+		TxId:   txId,
+	}, nil
+
+}
@@ -0,0 +1,102 @@
+package bloccserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+func TestSendRawTransaction(t *testing.T) {
+
+	s, m := newTestServer(t)
+	s.sendTxTimeout = time.Second
+
+	// Spend output 0 of prevtx, a P2PKH output of key, to a P2PKH output
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+	prevScript, err := txscript.NewScriptBuilder().AddOp(txscript.OP_DUP).AddOp(txscript.OP_HASH160).
+		AddData(btcutil.Hash160(key.PubKey().SerializeCompressed())).AddOp(txscript.OP_EQUALVERIFY).AddOp(txscript.OP_CHECKSIG).Script()
+	assert.Nil(t, err)
+	prevHash := chainhash.Hash{1}
+	prevTxId := prevHash.String()
+
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 0), nil, nil))
+	msgTx.AddTxOut(wire.NewTxOut(50000, append(append([]byte{0x76, 0xa9, 0x14}, make([]byte, 20)...), 0x88, 0xac)))
+	serialize := func(sigScript []byte) ([]byte, string) {
+		msgTx.TxIn[0].SignatureScript = sigScript
+		var buf bytes.Buffer
+		assert.Nil(t, msgTx.Serialize(&buf))
+		return buf.Bytes(), msgTx.TxHash().String()
+	}
+	sigScript, err := txscript.SignatureScript(msgTx, 0, prevScript, txscript.SigHashAll, key, true)
+	assert.Nil(t, err)
+	raw, txId := serialize(sigScript)
+	unsigned, _ := serialize([]byte{0x01, 0x02})
+
+	prevTx := func(value int64) []*blocc.Tx {
+		return []*blocc.Tx{{TxId: prevTxId, Out: []*blocc.TxOut{{Value: value, Raw: prevScript}}}}
+	}
+	include := blocc.TxIncludeHeader | blocc.TxIncludeOut
+
+	// Relayed
+	m.bcs.On("GetTxsByTxIds", "btc", []string{prevTxId}, include).Once().Return(prevTx(60000), nil)
+	m.bcs.On("GetOutputByOutPoint", "btc", prevTxId, int64(0)).Once().Return(nil, blocc.ErrNotFound)
+	m.tr.On("RelayTx", "btc", raw, time.Second).Once().Return(nil)
+	sent, err := s.SendRawTransaction(context.Background(), &blocc.RawTx{Hex: hex.EncodeToString(raw)})
+	assert.Nil(t, err)
+	assert.Equal(t, &blocc.SentTx{Symbol: "btc", TxId: txId}, sent)
+
+	// Not hex or not a transaction
+	_, err = s.SendRawTransaction(context.Background(), &blocc.RawTx{Hex: "zz"})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	_, err = s.SendRawTransaction(context.Background(), &blocc.RawTx{Hex: "0100"})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// Unknown input
+	m.bcs.On("GetTxsByTxIds", "btc", []string{prevTxId}, include).Once().Return(nil, blocc.ErrNotFound)
+	_, err = s.SendRawTransaction(context.Background(), &blocc.RawTx{Hex: hex.EncodeToString(raw)})
+	assert.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+
+	// Already spent by another transaction
+	m.bcs.On("GetTxsByTxIds", "btc", []string{prevTxId}, include).Once().Return(prevTx(60000), nil)
+	m.bcs.On("GetOutputByOutPoint", "btc", prevTxId, int64(0)).Once().Return(&blocc.Output{SpentTxId: "other", SpentBlockId: blocc.BlockIdMempool}, nil)
+	_, err = s.SendRawTransaction(context.Background(), &blocc.RawTx{Hex: hex.EncodeToString(raw)})
+	assert.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+
+	// Fee too low
+	m.bcs.On("GetTxsByTxIds", "btc", []string{prevTxId}, include).Once().Return(prevTx(50010), nil)
+	m.bcs.On("GetOutputByOutPoint", "btc", prevTxId, int64(0)).Once().Return(nil, blocc.ErrNotFound)
+	_, err = s.SendRawTransaction(context.Background(), &blocc.RawTx{Hex: hex.EncodeToString(raw)})
+	assert.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+
+	// Not signed by the key of the output
+	m.bcs.On("GetTxsByTxIds", "btc", []string{prevTxId}, include).Once().Return(prevTx(60000), nil)
+	m.bcs.On("GetOutputByOutPoint", "btc", prevTxId, int64(0)).Once().Return(nil, blocc.ErrNotFound)
+	_, err = s.SendRawTransaction(context.Background(), &blocc.RawTx{Hex: hex.EncodeToString(unsigned)})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// No transaction extractor
+	m.bcs.On("GetTxsByTxIds", "btc", []string{prevTxId}, include).Once().Return(prevTx(60000), nil)
+	m.bcs.On("GetOutputByOutPoint", "btc", prevTxId, int64(0)).Once().Return(nil, blocc.ErrNotFound)
+	m.tr.On("RelayTx", "btc", raw, time.Second).Once().Return(blocc.ErrNoTxRelay)
+	_, err = s.SendRawTransaction(context.Background(), &blocc.RawTx{Hex: hex.EncodeToString(raw)})
+	assert.Equal(t, codes.Unavailable, grpc.Code(err))
+
+	// Check remaining expectations
+	m.AssertExpectations(t)
+
+}
@@ -0,0 +1,295 @@
+package bloccserver
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// SubscribeAddresses streams the mempool and confirmed transactions of a set of addresses and scripts. The first message
+// selects the symbol, it and any later messages add or remove addresses and scripts. Confirmed transactions are
+// followed with confirmation updates until server.subscribe_addresses_confirmations.
+func (s *Server) SubscribeAddresses(stream blocc.BloccRPC_SubscribeAddressesServer) error {
+
+	input, err := stream.Recv()
+	if err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	symbol := input.Symbol
+	if err := s.checkSymbol(&symbol); err != nil {
+		return err
+	}
+
+	aw := newAddressWatch(s.maxSubscribeAddresses)
+	s.normalizeSubscription(symbol, input)
+	err = aw.update(input)
+	if err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	sub, err := s.eventBus.SubscribeEvents(symbol)
+	if err != nil {
+		s.logger.Errorw("Could not EventBus.SubscribeEvents", "error", err)
+		return grpc.Errorf(codes.Internal, "Could not SubscribeAddresses")
+	}
+	defer sub.Close()
+	subChan := sub.Channel()
+
+	// Apply updates as they arrive, a client that is done sending updates still receives events
+	updateErr := make(chan error, 1)
+	go func() {
+		for {
+			input, err := stream.Recv()
+			if err == io.EOF {
+				return
+			} else if err != nil {
+				updateErr <- err
+				return
+			}
+			s.normalizeSubscription(symbol, input)
+			err = aw.update(input)
+			if err != nil {
+				updateErr <- grpc.Errorf(codes.InvalidArgument, "%v", err)
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case event, ok := <-subChan:
+			if !ok {
+				return nil
+			}
+			for _, ae := range s.addressEvents(symbol, aw, event) {
+				// The client went away
+				if stream.Send(ae) != nil {
+					return nil
+				}
+			}
+		case err := <-updateErr:
+			return err
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+
+}
+
+// addressEvents returns the address events for an event
+func (s *Server) addressEvents(symbol string, aw *addressWatch, event *blocc.Event) []*blocc.AddressEvent {
+
+	var aes []*blocc.AddressEvent
+
+	switch event.Type {
+	case blocc.EventTxAdded, blocc.EventTxEvicted:
+		if addresses, scripts := aw.match(event.Tx); len(addresses) > 0 || len(scripts) > 0 {
+			aes = append(aes, blocc.NewAddressEvent(event.Type, symbol, event.Tx, addresses, scripts, 0))
+		}
+
+	case blocc.EventBlockConnected:
+		// Update the transactions already confirmed
+		aes = append(aes, aw.confirm(symbol, event.Block.Height, s.subscribeAddressesConfirmations)...)
+
+		// Find the transactions confirmed in this block
+		txs, err := s.blockChainStore.GetTxsByBlockId(symbol, event.Block.BlockId, blocc.TxIncludeAllButRaw)
+		if err != nil && err != blocc.ErrNotFound {
+			s.logger.Errorw("Could not blockChainStore.GetTxsByBlockId", "error", err, "block_id", event.Block.BlockId)
+			break
+		}
+		for _, tx := range txs {
+			if addresses, scripts := aw.match(tx); len(addresses) > 0 || len(scripts) > 0 {
+				aes = append(aes, blocc.NewAddressEvent(blocc.EventTxConfirmed, symbol, tx, addresses, scripts, 1))
+				if s.subscribeAddressesConfirmations > 1 {
+					aw.addConfirming(tx, addresses, scripts)
+				}
+			}
+		}
+
+	case blocc.EventBlockDisconnected:
+		aes = append(aes, aw.disconnect(symbol, event.Block.BlockId)...)
+	}
+
+	return aes
+
+}
+
+// addressWatch is the set of addresses and scripts of a SubscribeAddresses stream with the transactions that are being
+// followed until they have enough confirmations
+type addressWatch struct {
+	max        int
+	addresses  map[string]struct{}
+	scripts    map[string]struct{}
+	confirming map[string]*addressWatchTx
+	sync.Mutex
+}
+
+// addressWatchTx is a confirmed transaction and what it matched
+type addressWatchTx struct {
+	tx        *blocc.Tx
+	addresses []string
+	scripts   []string
+}
+
+// normalizeSubscription normalizes the addresses to add and remove the way events have them
+func (s *Server) normalizeSubscription(symbol string, input *blocc.AddressSubscription) {
+	input.AddAddresses = s.normalizeAddresses(symbol, input.AddAddresses)
+	input.RemoveAddresses = s.normalizeAddresses(symbol, input.RemoveAddresses)
+}
+
+func newAddressWatch(max int) *addressWatch {
+	return &addressWatch{
+		max:        max,
+		addresses:  make(map[string]struct{}),
+		scripts:    make(map[string]struct{}),
+		confirming: make(map[string]*addressWatchTx),
+	}
+}
+
+// update adds and removes addresses and scripts, it's an error to watch more than max of them
+func (aw *addressWatch) update(input *blocc.AddressSubscription) error {
+
+	aw.Lock()
+	defer aw.Unlock()
+
+	for _, address := range input.RemoveAddresses {
+		delete(aw.addresses, address)
+	}
+	for _, script := range input.RemoveScripts {
+		delete(aw.scripts, strings.ToLower(script))
+	}
+	for _, address := range input.AddAddresses {
+		aw.addresses[address] = struct{}{}
+	}
+	for _, script := range input.AddScripts {
+		if _, err := hex.DecodeString(script); err != nil {
+			return fmt.Errorf("Invalid script: %s", script)
+		}
+		aw.scripts[strings.ToLower(script)] = struct{}{}
+	}
+
+	if len(aw.addresses)+len(aw.scripts) > aw.max {
+		return fmt.Errorf("You can subscribe to at most %d addresses and scripts", aw.max)
+	}
+
+	return nil
+
+}
+
+// match returns the watched addresses and scripts in the inputs and outputs of a transaction
+func (aw *addressWatch) match(tx *blocc.Tx) ([]string, []string) {
+
+	if tx == nil {
+		return nil, nil
+	}
+
+	aw.Lock()
+	defer aw.Unlock()
+
+	var addresses, scripts []string
+	seen := make(map[string]struct{})
+	check := func(out *blocc.TxOut) {
+		if out == nil {
+			return
+		}
+		for _, address := range out.Addresses {
+			if _, ok := aw.addresses[address]; ok {
+				if _, ok := seen[address]; !ok {
+					seen[address] = struct{}{}
+					addresses = append(addresses, address)
+				}
+			}
+		}
+		if len(out.Raw) > 0 {
+			script := hex.EncodeToString(out.Raw)
+			if _, ok := aw.scripts[script]; ok {
+				if _, ok := seen[script]; !ok {
+					seen[script] = struct{}{}
+					scripts = append(scripts, script)
+				}
+			}
+		}
+	}
+	for _, in := range tx.In {
+		if in != nil {
+			check(in.Out)
+		}
+	}
+	for _, out := range tx.Out {
+		check(out)
+	}
+
+	return addresses, scripts
+
+}
+
+// addConfirming follows a confirmed transaction for confirmation updates
+func (aw *addressWatch) addConfirming(tx *blocc.Tx, addresses []string, scripts []string) {
+
+	aw.Lock()
+	defer aw.Unlock()
+
+	aw.confirming[tx.TxId] = &addressWatchTx{
+		tx: &blocc.Tx{
+			Symbol:      tx.Symbol,
+			TxId:        tx.TxId,
+			BlockId:     tx.BlockId,
+			BlockHeight: tx.BlockHeight,
+			BlockTime:   tx.BlockTime,
+		},
+		addresses: addresses,
+		scripts:   scripts,
+	}
+
+}
+
+// confirm returns the confirmation updates for a new block at height, transactions reaching depth are no longer followed
+func (aw *addressWatch) confirm(symbol string, height int64, depth int64) []*blocc.AddressEvent {
+
+	aw.Lock()
+	defer aw.Unlock()
+
+	var aes []*blocc.AddressEvent
+	for txId, awtx := range aw.confirming {
+		confirmations := height - awtx.tx.BlockHeight + 1
+		if confirmations <= 1 {
+			continue
+		}
+		aes = append(aes, blocc.NewAddressEvent(blocc.EventTxConfirmations, symbol, awtx.tx, awtx.addresses, awtx.scripts, confirmations))
+		if confirmations >= depth {
+			delete(aw.confirming, txId)
+		}
+	}
+
+	return aes
+
+}
+
+// disconnect returns the updates for the transactions of an orphaned block which no longer have any confirmations
+func (aw *addressWatch) disconnect(symbol string, blockId string) []*blocc.AddressEvent {
+
+	aw.Lock()
+	defer aw.Unlock()
+
+	var aes []*blocc.AddressEvent
+	for txId, awtx := range aw.confirming {
+		if awtx.tx.BlockId != blockId {
+			continue
+		}
+		aes = append(aes, blocc.NewAddressEvent(blocc.EventTxConfirmations, symbol, awtx.tx, awtx.addresses, awtx.scripts, 0))
+		delete(aw.confirming, txId)
+	}
+
+	return aes
+
+}
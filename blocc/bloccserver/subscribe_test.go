@@ -0,0 +1,135 @@
+package bloccserver
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/mocks"
+)
+
+// subscribeAddressesServer receives the subscriptions and collects the address events of a SubscribeAddresses stream
+type subscribeAddressesServer struct {
+	grpc.ServerStream
+	inputs []*blocc.AddressSubscription
+	events []*blocc.AddressEvent
+}
+
+func (ss *subscribeAddressesServer) Context() context.Context {
+	return context.Background()
+}
+
+func (ss *subscribeAddressesServer) Recv() (*blocc.AddressSubscription, error) {
+	if len(ss.inputs) == 0 {
+		return nil, io.EOF
+	}
+	input := ss.inputs[0]
+	ss.inputs = ss.inputs[1:]
+	return input, nil
+}
+
+func (ss *subscribeAddressesServer) Send(ae *blocc.AddressEvent) error {
+	ss.events = append(ss.events, ae)
+	return nil
+}
+
+func TestSubscribeAddresses(t *testing.T) {
+
+	s, m := newTestServer(t)
+	s.subscribeAddressesConfirmations = 3
+
+	payTx := &blocc.Tx{TxId: "pay", Out: []*blocc.TxOut{{Addresses: []string{"other"}}, {Addresses: []string{"mine"}}}}
+	spendTx := &blocc.Tx{TxId: "spend", In: []*blocc.TxIn{{Out: &blocc.TxOut{Raw: []byte{0xab, 0xcd}}}}}
+
+	events := make(chan *blocc.Event, 10)
+	events <- blocc.NewTxEvent(blocc.EventTxAdded, "btc", payTx)
+	events <- blocc.NewTxEvent(blocc.EventTxAdded, "btc", &blocc.Tx{TxId: "unrelated", Out: []*blocc.TxOut{{Addresses: []string{"other"}}}})
+	events <- blocc.NewBlockEvent(blocc.EventBlockConnected, "btc", &blocc.BlockHeader{BlockId: "block1", Height: 100})
+	events <- blocc.NewBlockEvent(blocc.EventBlockConnected, "btc", &blocc.BlockHeader{BlockId: "block2", Height: 101})
+	events <- blocc.NewBlockEvent(blocc.EventBlockDisconnected, "btc", &blocc.BlockHeader{BlockId: "block2", Height: 101})
+	events <- blocc.NewBlockEvent(blocc.EventBlockConnected, "btc", &blocc.BlockHeader{BlockId: "block3", Height: 101})
+	events <- blocc.NewBlockEvent(blocc.EventBlockConnected, "btc", &blocc.BlockHeader{BlockId: "block4", Height: 102})
+	events <- blocc.NewBlockEvent(blocc.EventBlockConnected, "btc", &blocc.BlockHeader{BlockId: "block5", Height: 103})
+	close(events)
+
+	ec := new(mocks.EventChannel)
+	ec.On("Channel").Once().Return((<-chan *blocc.Event)(events))
+	ec.On("Close").Once()
+	m.eb.On("SubscribeEvents", "btc").Once().Return(ec, nil)
+
+	confirmedPayTx := &blocc.Tx{TxId: "pay", BlockId: "block1", BlockHeight: 100, Out: payTx.Out}
+	confirmedSpendTx := &blocc.Tx{TxId: "spend", BlockId: "block2", BlockHeight: 101, In: spendTx.In}
+	m.bcs.On("GetTxsByBlockId", "btc", "block1", blocc.TxIncludeAllButRaw).Once().Return([]*blocc.Tx{confirmedPayTx}, nil)
+	m.bcs.On("GetTxsByBlockId", "btc", "block2", blocc.TxIncludeAllButRaw).Once().Return([]*blocc.Tx{confirmedSpendTx}, nil)
+	m.bcs.On("GetTxsByBlockId", "btc", "block3", blocc.TxIncludeAllButRaw).Once().Return(nil, blocc.ErrNotFound)
+	m.bcs.On("GetTxsByBlockId", "btc", "block4", blocc.TxIncludeAllButRaw).Once().Return(nil, blocc.ErrNotFound)
+	m.bcs.On("GetTxsByBlockId", "btc", "block5", blocc.TxIncludeAllButRaw).Once().Return(nil, blocc.ErrNotFound)
+
+	ss := &subscribeAddressesServer{inputs: []*blocc.AddressSubscription{
+		{AddAddresses: []string{"mine"}, AddScripts: []string{"ABCD"}},
+	}}
+	err := s.SubscribeAddresses(ss)
+	assert.Nil(t, err)
+
+	type result struct {
+		Type          string
+		TxId          string
+		Confirmations int64
+		Matched       []string
+	}
+	var results []result
+	for _, ae := range ss.events {
+		results = append(results, result{ae.Type, ae.Tx.TxId, ae.Confirmations, append(ae.Addresses, ae.Scripts...)})
+	}
+	assert.Equal(t, []result{
+		{blocc.EventTxAdded, "pay", 0, []string{"mine"}},
+		{blocc.EventTxConfirmed, "pay", 1, []string{"mine"}},
+		{blocc.EventTxConfirmations, "pay", 2, []string{"mine"}},
+		{blocc.EventTxConfirmed, "spend", 1, []string{"abcd"}},
+		// The block was orphaned
+		{blocc.EventTxConfirmations, "spend", 0, []string{"abcd"}},
+		{blocc.EventTxConfirmations, "pay", 2, []string{"mine"}},
+		// It reached the depth and is no longer followed
+		{blocc.EventTxConfirmations, "pay", 3, []string{"mine"}},
+	}, results)
+
+	// Check remaining expectations
+	m.AssertExpectations(t)
+	ec.AssertExpectations(t)
+
+}
+
+func TestAddressWatchUpdate(t *testing.T) {
+
+	aw := newAddressWatch(2)
+	assert.Nil(t, aw.update(&blocc.AddressSubscription{AddAddresses: []string{"a", "b"}}))
+	assert.NotNil(t, aw.update(&blocc.AddressSubscription{AddScripts: []string{"abcd"}}))
+	assert.Nil(t, aw.update(&blocc.AddressSubscription{RemoveAddresses: []string{"a", "b"}, AddScripts: []string{"ABCD"}}))
+	assert.NotNil(t, aw.update(&blocc.AddressSubscription{AddScripts: []string{"xyz"}}))
+
+	addresses, scripts := aw.match(&blocc.Tx{Out: []*blocc.TxOut{{Addresses: []string{"a"}, Raw: []byte{0xab, 0xcd}}}})
+	assert.Empty(t, addresses)
+	assert.Equal(t, []string{"abcd"}, scripts)
+
+}
+
+func TestSubscribeAddressesInvalid(t *testing.T) {
+
+	s, m := newTestServer(t)
+	s.maxSubscribeAddresses = 1
+
+	err := s.SubscribeAddresses(&subscribeAddressesServer{inputs: []*blocc.AddressSubscription{{AddScripts: []string{"xyz"}}}})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	err = s.SubscribeAddresses(&subscribeAddressesServer{inputs: []*blocc.AddressSubscription{{AddAddresses: []string{"a", "b"}}}})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// Check remaining expectations
+	m.AssertExpectations(t)
+
+}
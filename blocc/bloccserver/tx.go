@@ -16,8 +16,8 @@ const (
 // GetTransaction gets a transaction by Id
 func (s *Server) GetTransaction(ctx context.Context, input *blocc.Get) (*blocc.Tx, error) {
 
-	if input.Symbol == "" {
-		input.Symbol = s.defaultSymbol
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
 	}
 
 	// Set the bit values
@@ -39,6 +39,13 @@ func (s *Server) GetTransaction(ctx context.Context, input *blocc.Get) (*blocc.T
 		return nil, grpc.Errorf(codes.Internal, "Could not get transaction")
 	}
 
+	// The package is only meaningful in the mempool, stores merge the data so confirmed transactions may still have it
+	if tx.Data != nil && !blocc.IsMemPool(tx.BlockId) {
+		for _, field := range blocc.TxPackageDataFields {
+			delete(tx.Data, field)
+		}
+	}
+
 	return tx, nil
 
 }
@@ -46,8 +53,8 @@ func (s *Server) GetTransaction(ctx context.Context, input *blocc.Get) (*blocc.T
 // FindTransactions finds transaction by transaction ids
 func (s *Server) FindTransactions(ctx context.Context, input *blocc.Find) (*blocc.Transactions, error) {
 
-	if input.Symbol == "" {
-		input.Symbol = s.defaultSymbol
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
 	}
 
 	if input.Count == 0 {
@@ -84,8 +91,8 @@ func (s *Server) FindTransactions(ctx context.Context, input *blocc.Find) (*bloc
 // FindTransactionsByAddresses finds transactions by addresses
 func (s *Server) FindTransactionsByAddresses(ctx context.Context, input *blocc.Find) (*blocc.Transactions, error) {
 
-	if input.Symbol == "" {
-		input.Symbol = s.defaultSymbol
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
 	}
 
 	if input.Count == 0 {
@@ -107,7 +114,15 @@ func (s *Server) FindTransactionsByAddresses(ctx context.Context, input *blocc.F
 		include |= blocc.TxIncludeRaw
 	}
 
-	txs, err := s.blockChainStore.FindTxsByAddressesAndTime(input.Symbol, input.Ids, start, end, blocc.TxFilterAddressInputOutput, include, int(input.Offset), int(input.Count))
+	ids, err := s.expandAddresses(input.Symbol, input.Ids)
+	if err == blocc.ErrNotFound {
+		return nil, grpc.Errorf(codes.NotFound, "Descriptor Not Found")
+	} else if err != nil {
+		s.logger.Errorw("Could not expandAddresses", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not get blocks")
+	}
+
+	txs, err := s.blockChainStore.FindTxsByAddressesAndTime(input.Symbol, ids, start, end, blocc.TxFilterAddressInputOutput, include, int(input.Offset), int(input.Count))
 	if err != nil && err != blocc.ErrNotFound {
 		s.logger.Errorw("Could not blockChainStore.FindTransactionsByAddresses", "error", err)
 		return nil, grpc.Errorf(codes.Internal, "Could not get blocks")
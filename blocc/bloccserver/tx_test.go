@@ -0,0 +1,47 @@
+package bloccserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+func TestGetTransactionPackage(t *testing.T) {
+
+	s, m := newTestServer(t)
+
+	data := func() map[string]string {
+		d := map[string]string{"fee_vsize": "1"}
+		(&blocc.TxPackage{
+			AncestorCount:   1,
+			AncestorVSize:   100,
+			AncestorFee:     100,
+			DescendantCount: 2,
+			DescendantVSize: 200,
+			DescendantFee:   5100,
+		}).SetData(d)
+		return d
+	}
+
+	m.bcs.On("GetTxByTxId", "btc", "mempooltx", txIncludeDefault).Once().Return(&blocc.Tx{TxId: "mempooltx", BlockId: blocc.BlockIdMempool, Data: data()}, nil)
+	m.bcs.On("GetTxByTxId", "btc", "blocktx", txIncludeDefault).Once().Return(&blocc.Tx{TxId: "blocktx", BlockId: "block", Data: data()}, nil)
+
+	// Mempool transactions have their package
+	tx, err := s.GetTransaction(context.Background(), &blocc.Get{Id: "mempooltx"})
+	assert.Nil(t, err)
+	assert.Equal(t, "1", tx.Data["ancestor_fee_vsize"])
+	assert.Equal(t, "25.5", tx.Data["descendant_fee_vsize"])
+	assert.Equal(t, "2", tx.Data["descendant_count"])
+
+	// Confirmed ones no longer do
+	tx, err = s.GetTransaction(context.Background(), &blocc.Get{Id: "blocktx"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"fee_vsize": "1"}, tx.Data)
+
+	// Check remaining expectations
+	m.AssertExpectations(t)
+
+}
@@ -0,0 +1,129 @@
+package bloccserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// FindUnspentOutputs finds the unspent transaction outputs of addresses
+func (s *Server) FindUnspentOutputs(ctx context.Context, input *blocc.Find) (*blocc.Utxos, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	if input.Count == 0 {
+		input.Count = int64(s.defaultCount)
+	}
+
+	ids, err := s.expandAddresses(input.Symbol, input.Ids)
+	if err == blocc.ErrNotFound {
+		return nil, grpc.Errorf(codes.NotFound, "Descriptor Not Found")
+	} else if err != nil {
+		s.logger.Errorw("Could not expandAddresses", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not get unspent outputs")
+	}
+
+	outputs, err := s.blockChainStore.FindUnspentOutputsByAddresses(input.Symbol, ids, int(input.Offset), int(input.Count))
+	if err != nil && err != blocc.ErrNotFound {
+		s.logger.Errorw("Could not blockChainStore.FindUnspentOutputsByAddresses", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not get unspent outputs")
+	}
+
+	utxos := &blocc.Utxos{
+		Utxos: make([]*blocc.Utxo, 0, len(outputs)),
+	}
+	if len(outputs) == 0 {
+		return utxos, nil
+	}
+
+	// The top block is used to calculate the confirmations
+	topHeight, err := s.topHeight(input.Symbol)
+	if err != nil {
+		s.logger.Errorw("Could not blockChainStore.GetBlockHeaderTopByStatuses", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not get unspent outputs")
+	}
+
+	for _, o := range outputs {
+		utxo := &blocc.Utxo{
+			TxId:         o.TxId,
+			Height:       o.Height,
+			BlockId:      o.BlockId,
+			BlockHeight:  o.BlockHeight,
+			MempoolSpent: o.SpentTxId != "" && blocc.IsMemPool(o.SpentBlockId),
+		}
+		if o.Out != nil {
+			utxo.Value = o.Out.Value
+			utxo.Type = o.Out.Type
+			utxo.Addresses = o.Out.Addresses
+			utxo.Script = o.Out.Raw
+		}
+		utxo.Confirmations = confirmations(topHeight, o.BlockId, o.BlockHeight)
+		utxos.Utxos = append(utxos.Utxos, utxo)
+	}
+
+	return utxos, nil
+
+}
+
+// GetOutputSpender gets the transaction spending an output
+func (s *Server) GetOutputSpender(ctx context.Context, input *blocc.OutPoint) (*blocc.Spender, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	o, err := s.blockChainStore.GetOutputByOutPoint(input.Symbol, input.TxId, input.Height)
+	if err == blocc.ErrNotFound || (err == nil && o.SpentTxId == "") {
+		return nil, grpc.Errorf(codes.NotFound, "Not Found")
+	} else if err != nil {
+		s.logger.Errorw("Could not blockChainStore.GetOutputByOutPoint", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not get output spender")
+	}
+
+	spender := &blocc.Spender{
+		TxId:        o.SpentTxId,
+		Height:      o.SpentHeight,
+		BlockId:     o.SpentBlockId,
+		BlockHeight: o.SpentBlockHeight,
+		Mempool:     blocc.IsMemPool(o.SpentBlockId),
+	}
+
+	if !spender.Mempool {
+		topHeight, err := s.topHeight(input.Symbol)
+		if err != nil {
+			s.logger.Errorw("Could not blockChainStore.GetBlockHeaderTopByStatuses", "error", err)
+			return nil, grpc.Errorf(codes.Internal, "Could not get output spender")
+		}
+		spender.Confirmations = confirmations(topHeight, o.SpentBlockId, o.SpentBlockHeight)
+	}
+
+	return spender, nil
+
+}
+
+// topHeight returns the height of the top block or HeightUnknown if there are no blocks. Missing blocks (ie starting
+// at block_start_height) are a validation error that still returns the top.
+func (s *Server) topHeight(symbol string) (int64, error) {
+	top, err := s.blockChainStore.GetBlockHeaderTopByStatuses(symbol, []string{blocc.StatusNew, blocc.StatusValid})
+	if err == blocc.ErrNotFound {
+		return blocc.HeightUnknown, nil
+	} else if err != nil && !blocc.IsValidationError(err) {
+		return blocc.HeightUnknown, err
+	} else if top == nil {
+		return blocc.HeightUnknown, nil
+	}
+	return top.Height, nil
+}
+
+// confirmations returns the number of confirmations of a block given the top height, zero for the mempool
+func confirmations(topHeight int64, blockId string, blockHeight int64) int64 {
+	if blocc.IsMemPool(blockId) || topHeight < blockHeight {
+		return 0
+	}
+	return topHeight - blockHeight + 1
+}
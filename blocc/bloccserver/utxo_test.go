@@ -0,0 +1,168 @@
+package bloccserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+func TestFindUnspentOutputs(t *testing.T) {
+
+	s, m := newTestServer(t)
+
+	i := &blocc.Find{Symbol: "btc", Ids: []string{"address1"}, Count: 10}
+
+	// Mock call to item store
+	m.bcs.On("FindUnspentOutputsByAddresses", "btc", []string{"address1"}, 0, 10).Once().Return([]*blocc.Output{
+		{
+			TxId:        "tx2",
+			Height:      0,
+			BlockId:     blocc.BlockIdMempool,
+			BlockHeight: blocc.HeightUnknown,
+			Out:         &blocc.TxOut{Type: "pubkeyhash", Addresses: []string{"address1"}, Value: 200},
+		},
+		{
+			TxId:             "tx1",
+			Height:           1,
+			BlockId:          "block1",
+			BlockHeight:      98,
+			Out:              &blocc.TxOut{Type: "pubkeyhash", Addresses: []string{"address1"}, Value: 100, Raw: []byte{0x76}},
+			SpentTxId:        "tx2",
+			SpentBlockId:     blocc.BlockIdMempool,
+			SpentBlockHeight: blocc.HeightUnknown,
+		},
+	}, nil)
+	m.bcs.On("GetBlockHeaderTopByStatuses", "btc", []string{blocc.StatusNew, blocc.StatusValid}).Once().Return(&blocc.BlockHeader{Height: 100}, nil)
+
+	response, err := s.FindUnspentOutputs(context.Background(), i)
+	assert.Nil(t, err)
+	if assert.Len(t, response.Utxos, 2) {
+		assert.Equal(t, &blocc.Utxo{
+			TxId:        "tx2",
+			Height:      0,
+			Value:       200,
+			Type:        "pubkeyhash",
+			Addresses:   []string{"address1"},
+			BlockId:     blocc.BlockIdMempool,
+			BlockHeight: blocc.HeightUnknown,
+		}, response.Utxos[0])
+		assert.Equal(t, &blocc.Utxo{
+			TxId:          "tx1",
+			Height:        1,
+			Value:         100,
+			Type:          "pubkeyhash",
+			Addresses:     []string{"address1"},
+			Script:        []byte{0x76},
+			BlockId:       "block1",
+			BlockHeight:   98,
+			Confirmations: 3,
+			MempoolSpent:  true,
+		}, response.Utxos[1])
+	}
+
+	// Nothing found is an empty result
+	m.bcs.On("FindUnspentOutputsByAddresses", "btc", []string{"address2"}, 0, 10).Once().Return(nil, blocc.ErrNotFound)
+	response, err = s.FindUnspentOutputs(context.Background(), &blocc.Find{Symbol: "btc", Ids: []string{"address2"}, Count: 10})
+	assert.Nil(t, err)
+	assert.Len(t, response.Utxos, 0)
+
+	// Missing blocks still return the top with a validation error
+	m.bcs.On("FindUnspentOutputsByAddresses", "btc", []string{"address3"}, 0, 10).Once().Return([]*blocc.Output{
+		{TxId: "tx3", BlockId: "block3", BlockHeight: 150, Out: &blocc.TxOut{Value: 10}},
+	}, nil)
+	m.bcs.On("GetBlockHeaderTopByStatuses", "btc", []string{blocc.StatusNew, blocc.StatusValid}).Once().Return(&blocc.BlockHeader{Height: 151}, fmt.Errorf("Validation Error: Missing Blocks Detected height:151 blocks:2"))
+	response, err = s.FindUnspentOutputs(context.Background(), &blocc.Find{Symbol: "btc", Ids: []string{"address3"}, Count: 10})
+	assert.Nil(t, err)
+	if assert.Len(t, response.Utxos, 1) {
+		assert.Equal(t, int64(2), response.Utxos[0].Confirmations)
+	}
+
+	// Any other error fails
+	m.bcs.On("FindUnspentOutputsByAddresses", "btc", []string{"address3"}, 0, 10).Once().Return([]*blocc.Output{{TxId: "tx3"}}, nil)
+	m.bcs.On("GetBlockHeaderTopByStatuses", "btc", []string{blocc.StatusNew, blocc.StatusValid}).Once().Return(nil, fmt.Errorf("store down"))
+	_, err = s.FindUnspentOutputs(context.Background(), &blocc.Find{Symbol: "btc", Ids: []string{"address3"}, Count: 10})
+	assert.Equal(t, codes.Internal, grpc.Code(err))
+
+	// Check remaining expectations
+	m.AssertExpectations(t)
+
+}
+
+func TestGetOutputSpender(t *testing.T) {
+
+	s, m := newTestServer(t)
+
+	// Spent in a block
+	m.bcs.On("GetOutputByOutPoint", "btc", "tx1", int64(1)).Once().Return(&blocc.Output{
+		TxId:             "tx1",
+		Height:           1,
+		BlockId:          "block1",
+		BlockHeight:      97,
+		SpentTxId:        "tx2",
+		SpentHeight:      3,
+		SpentBlockId:     "block2",
+		SpentBlockHeight: 98,
+	}, nil)
+	m.bcs.On("GetBlockHeaderTopByStatuses", "btc", []string{blocc.StatusNew, blocc.StatusValid}).Once().Return(&blocc.BlockHeader{Height: 100}, nil)
+
+	response, err := s.GetOutputSpender(context.Background(), &blocc.OutPoint{Symbol: "btc", TxId: "tx1", Height: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, &blocc.Spender{
+		TxId:          "tx2",
+		Height:        3,
+		BlockId:       "block2",
+		BlockHeight:   98,
+		Confirmations: 3,
+	}, response)
+
+	// Spent in the mempool
+	m.bcs.On("GetOutputByOutPoint", "btc", "tx1", int64(2)).Once().Return(&blocc.Output{
+		TxId:             "tx1",
+		Height:           2,
+		SpentTxId:        "tx3",
+		SpentBlockId:     blocc.BlockIdMempool,
+		SpentBlockHeight: blocc.HeightUnknown,
+	}, nil)
+
+	response, err = s.GetOutputSpender(context.Background(), &blocc.OutPoint{Symbol: "btc", TxId: "tx1", Height: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, &blocc.Spender{
+		TxId:        "tx3",
+		BlockId:     blocc.BlockIdMempool,
+		BlockHeight: blocc.HeightUnknown,
+		Mempool:     true,
+	}, response)
+
+	// A confirmed spender when blocks are missing still has it's confirmations from the top
+	m.bcs.On("GetOutputByOutPoint", "btc", "tx1", int64(3)).Once().Return(&blocc.Output{
+		TxId:             "tx1",
+		Height:           3,
+		SpentTxId:        "tx4",
+		SpentBlockId:     "block4",
+		SpentBlockHeight: 99,
+	}, nil)
+	m.bcs.On("GetBlockHeaderTopByStatuses", "btc", []string{blocc.StatusNew, blocc.StatusValid}).Once().Return(&blocc.BlockHeader{Height: 100}, fmt.Errorf("Validation Error: Missing Blocks Detected height:100 blocks:10"))
+
+	response, err = s.GetOutputSpender(context.Background(), &blocc.OutPoint{Symbol: "btc", TxId: "tx1", Height: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), response.Confirmations)
+
+	// Unspent and unknown outputs are not found
+	m.bcs.On("GetOutputByOutPoint", "btc", "tx1", int64(0)).Once().Return(&blocc.Output{TxId: "tx1", BlockId: "block1"}, nil)
+	_, err = s.GetOutputSpender(context.Background(), &blocc.OutPoint{Symbol: "btc", TxId: "tx1", Height: 0})
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
+
+	m.bcs.On("GetOutputByOutPoint", "btc", "tx9", int64(0)).Once().Return(nil, blocc.ErrNotFound)
+	_, err = s.GetOutputSpender(context.Background(), &blocc.OutPoint{Symbol: "btc", TxId: "tx9", Height: 0})
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
+
+	// Check remaining expectations
+	m.AssertExpectations(t)
+
+}
@@ -0,0 +1,108 @@
+package bloccserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/webhook"
+)
+
+// CreateWebhook registers a webhook
+func (s *Server) CreateWebhook(ctx context.Context, input *blocc.Webhook) (*blocc.Webhook, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	err := webhook.Prepare(input)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	err = s.webhookStore.InsertWebhook(input.Symbol, input)
+	if err != nil {
+		s.logger.Errorw("Could not webhookStore.InsertWebhook", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not CreateWebhook")
+	}
+
+	return input, nil
+
+}
+
+// GetWebhook returns a webhook without it's secret
+func (s *Server) GetWebhook(ctx context.Context, input *blocc.Get) (*blocc.Webhook, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	wh, err := s.webhookStore.GetWebhook(input.Symbol, input.Id)
+	if err == blocc.ErrNotFound {
+		return nil, grpc.Errorf(codes.NotFound, "Not Found")
+	} else if err != nil {
+		s.logger.Errorw("Could not webhookStore.GetWebhook", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not GetWebhook")
+	}
+	wh.Secret = ""
+
+	return wh, nil
+
+}
+
+// FindWebhooks returns all of the webhooks without their secrets
+func (s *Server) FindWebhooks(ctx context.Context, input *blocc.Symbol) (*blocc.Webhooks, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	whs, err := s.webhookStore.FindWebhooks(input.Symbol)
+	if err != nil {
+		s.logger.Errorw("Could not webhookStore.FindWebhooks", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not FindWebhooks")
+	}
+	for _, wh := range whs {
+		wh.Secret = ""
+	}
+
+	return &blocc.Webhooks{Webhooks: whs}, nil
+
+}
+
+// DeleteWebhook removes a webhook and returns it without it's secret
+func (s *Server) DeleteWebhook(ctx context.Context, input *blocc.Get) (*blocc.Webhook, error) {
+
+	wh, err := s.GetWebhook(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.webhookStore.DeleteWebhook(input.Symbol, input.Id)
+	if err != nil {
+		s.logger.Errorw("Could not webhookStore.DeleteWebhook", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not DeleteWebhook")
+	}
+
+	return wh, nil
+
+}
+
+// GetWebhookDeadLetters returns the deliveries of a webhook that failed every attempt
+func (s *Server) GetWebhookDeadLetters(ctx context.Context, input *blocc.Get) (*blocc.WebhookDeliveries, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	ds, err := s.webhookStore.GetWebhookDeadLetters(input.Symbol, input.Id)
+	if err != nil {
+		s.logger.Errorw("Could not webhookStore.GetWebhookDeadLetters", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not GetWebhookDeadLetters")
+	}
+
+	return &blocc.WebhookDeliveries{Deliveries: ds}, nil
+
+}
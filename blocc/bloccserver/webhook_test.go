@@ -0,0 +1,43 @@
+package bloccserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+func TestWebhooks(t *testing.T) {
+
+	s, m := newTestServer(t)
+
+	// The secret is only returned when created
+	m.whs.On("InsertWebhook", "btc", mock.AnythingOfType("*blocc.Webhook")).Once().Return(nil)
+	wh, err := s.CreateWebhook(context.Background(), &blocc.Webhook{URL: "https://example.com/hook", TxIds: []string{"tx"}})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, wh.Id)
+	assert.NotEmpty(t, wh.Secret)
+
+	m.whs.On("GetWebhook", "btc", wh.Id).Once().Return(&blocc.Webhook{Id: wh.Id, Secret: wh.Secret}, nil)
+	got, err := s.GetWebhook(context.Background(), &blocc.Get{Id: wh.Id})
+	assert.Nil(t, err)
+	assert.Equal(t, &blocc.Webhook{Id: wh.Id}, got)
+
+	// Invalid webhooks
+	_, err = s.CreateWebhook(context.Background(), &blocc.Webhook{URL: "example.com", Blocks: true})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// Deleting one that does not exist
+	m.whs.On("GetWebhook", "btc", "missing").Once().Return(nil, blocc.ErrNotFound)
+	_, err = s.DeleteWebhook(context.Background(), &blocc.Get{Id: "missing"})
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
+
+	// Check remaining expectations
+	m.AssertExpectations(t)
+
+}
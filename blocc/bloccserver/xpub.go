@@ -0,0 +1,153 @@
+package bloccserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/btc/xpub"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// ScanXPub finds the used addresses, balances and transactions of an extended public key
+func (s *Server) ScanXPub(ctx context.Context, input *blocc.XPubScan) (*blocc.XPub, error) {
+
+	if err := s.checkSymbol(&input.Symbol); err != nil {
+		return nil, err
+	}
+
+	if input.Count == 0 {
+		input.Count = int64(s.defaultCount)
+	}
+
+	gapLimit := int(input.GapLimit)
+	if gapLimit == 0 {
+		gapLimit = s.defaultGapLimit
+	}
+	if gapLimit < 1 || gapLimit > s.maxGapLimit {
+		return nil, grpc.Errorf(codes.InvalidArgument, "The gap limit must be between 1 and %d", s.maxGapLimit)
+	}
+
+	_, params, err := s.chainParams(input.Symbol)
+	if err != nil {
+		s.logger.Errorw("Could not get chain params", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not scan xpub")
+	}
+
+	key, err := xpub.Parse(input.Xpub, input.ScriptType, params)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Invalid xpub: %v", err)
+	}
+
+	ret := &blocc.XPub{
+		ScriptType: key.ScriptType(),
+		Addresses:  make([]*blocc.XPubAddress, 0),
+	}
+
+	// Walk each chain until gapLimit addresses in a row are unused
+	for _, chain := range []uint32{xpub.ChainReceive, xpub.ChainChange} {
+		addresses, next, err := s.scanXPubChain(input.Symbol, key, chain, gapLimit)
+		if err != nil {
+			s.logger.Errorw("Could not scan xpub chain", "error", err)
+			return nil, grpc.Errorf(codes.Internal, "Could not scan xpub")
+		}
+		ret.Addresses = append(ret.Addresses, addresses...)
+		if chain == xpub.ChainReceive {
+			ret.NextReceiveIndex = next
+		} else {
+			ret.NextChangeIndex = next
+		}
+	}
+
+	if len(ret.Addresses) == 0 {
+		return ret, nil
+	}
+
+	used := make([]string, len(ret.Addresses))
+	for x, a := range ret.Addresses {
+
+		// Get the balances from the address summary
+		summary, err := s.blockChainStore.GetAddress(input.Symbol, a.Address)
+		if err == nil {
+			a.TxCount = summary.TxCount + summary.MempoolTxCount
+			a.Balance = summary.Balance
+			a.MempoolBalance = summary.MempoolBalance
+		} else if err != blocc.ErrNotFound {
+			s.logger.Errorw("Could not blockChainStore.GetAddress", "error", err)
+			return nil, grpc.Errorf(codes.Internal, "Could not scan xpub")
+		}
+		ret.Balance += a.Balance
+		ret.MempoolBalance += a.MempoolBalance
+
+		used[x] = a.Address
+	}
+
+	// Set the bit values
+	include := txIncludeDefault
+	if blocc.TxInclude(input.Include) != blocc.TxIncludeDefault {
+		include = blocc.TxInclude(input.Include)
+	}
+	if input.Data {
+		include |= blocc.TxIncludeData
+	}
+	if input.Raw {
+		include |= blocc.TxIncludeRaw
+	}
+
+	ret.Transactions, err = s.blockChainStore.FindTxsByAddressesAndTime(input.Symbol, used, nil, nil, blocc.TxFilterAddressInputOutput, include, int(input.Offset), int(input.Count))
+	if err != nil && err != blocc.ErrNotFound {
+		s.logger.Errorw("Could not blockChainStore.FindTxsByAddressesAndTime", "error", err)
+		return nil, grpc.Errorf(codes.Internal, "Could not scan xpub")
+	}
+
+	return ret, nil
+
+}
+
+// scanXPubChain derives addresses of a chain gapLimit at a time until gapLimit in a row have no transactions.
+// It returns the used addresses and the next unused index.
+func (s *Server) scanXPubChain(symbol string, key *xpub.Key, chain uint32, gapLimit int) ([]*blocc.XPubAddress, uint32, error) {
+
+	used := make([]*blocc.XPubAddress, 0)
+	var next uint32
+
+	for index := uint32(0); index-next < uint32(gapLimit); index += uint32(gapLimit) {
+
+		addresses, err := key.Addresses(chain, index, gapLimit)
+		if err != nil {
+			return nil, 0, err
+		}
+		addresses = s.normalizeAddresses(symbol, addresses)
+
+		txs, err := s.blockChainStore.FindTxsByAddressesAndTime(symbol, addresses, nil, nil, blocc.TxFilterAddressInputOutput, blocc.TxIncludeIn|blocc.TxIncludeOut, 0, store.CountMax)
+		if err != nil && err != blocc.ErrNotFound {
+			return nil, 0, err
+		}
+
+		// Count the transactions of each address
+		txCounts := make(map[string]int64)
+		for _, tx := range txs {
+			for _, address := range store.TxAddresses(tx, blocc.TxFilterAddressInputOutput) {
+				txCounts[address]++
+			}
+		}
+
+		for x, address := range addresses {
+			if txCount, ok := txCounts[address]; ok {
+				used = append(used, &blocc.XPubAddress{
+					Address: address,
+					Chain:   chain,
+					Index:   index + uint32(x),
+					TxCount: txCount,
+				})
+				next = index + uint32(x) + 1
+			}
+		}
+
+	}
+
+	return used, next, nil
+
+}
@@ -0,0 +1,63 @@
+package bloccserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// The BIP84 account key of the test mnemonic "abandon abandon ... about" and it's second receive address
+const (
+	testZPub     = "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs"
+	testZPubAddr = "bc1qnjg0jd8228aq7egyzacy8cys3knf9xvrerkf9g"
+)
+
+func TestScanXPub(t *testing.T) {
+
+	s, m := newTestServer(t)
+
+	var noTime *time.Time
+	txs := []*blocc.Tx{{TxId: "tx1", BlockId: "block1", Out: []*blocc.TxOut{{Addresses: []string{testZPubAddr}, Value: 1000}}}}
+
+	// Only the second receive address has been used
+	m.bcs.On("FindTxsByAddressesAndTime", "btc", mock.MatchedBy(func(addresses []string) bool {
+		return len(addresses) == 2 && addresses[1] == testZPubAddr
+	}), noTime, noTime, blocc.TxFilterAddressInputOutput, blocc.TxIncludeIn|blocc.TxIncludeOut, 0, store.CountMax).Once().Return(txs, nil)
+	m.bcs.On("FindTxsByAddressesAndTime", "btc", mock.Anything, noTime, noTime, blocc.TxFilterAddressInputOutput, blocc.TxIncludeIn|blocc.TxIncludeOut, 0, store.CountMax).Twice().Return(nil, blocc.ErrNotFound)
+	m.bcs.On("GetAddress", "btc", testZPubAddr).Once().Return(&blocc.Address{TxCount: 1, Balance: 1000, MempoolTxCount: 1, MempoolBalance: -400}, nil)
+	m.bcs.On("FindTxsByAddressesAndTime", "btc", []string{testZPubAddr}, noTime, noTime, blocc.TxFilterAddressInputOutput, txIncludeDefault, 0, 10).Once().Return(txs, nil)
+
+	response, err := s.ScanXPub(context.Background(), &blocc.XPubScan{Xpub: testZPub, GapLimit: 2, Count: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, &blocc.XPub{
+		ScriptType: "p2wpkh",
+		Addresses: []*blocc.XPubAddress{
+			{Address: testZPubAddr, Chain: 0, Index: 1, TxCount: 2, Balance: 1000, MempoolBalance: -400},
+		},
+		NextReceiveIndex: 2,
+		NextChangeIndex:  0,
+		Balance:          1000,
+		MempoolBalance:   -400,
+		Transactions:     txs,
+	}, response)
+
+	// Bad requests
+	_, err = s.ScanXPub(context.Background(), &blocc.XPubScan{Xpub: "notakey"})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	_, err = s.ScanXPub(context.Background(), &blocc.XPubScan{Xpub: testZPub, GapLimit: 100000})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	_, err = s.ScanXPub(context.Background(), &blocc.XPubScan{Symbol: "ltc", Xpub: testZPub})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// Check remaining expectations
+	m.AssertExpectations(t)
+
+}
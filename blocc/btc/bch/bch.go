@@ -0,0 +1,179 @@
+// Package bch registers Bitcoin Cash with the btc extractor. Bitcoin Cash uses the bitcoin wire protocol and block
+// format without segwit, it's own network magic and CashAddr addresses. Import it for it's side effect.
+//
+// Bitcoin Cash also has it's own difficulty adjustment and signs transactions with SIGHASH_FORKID for replay
+// protection. The extractor trusts the node it's connected to for both, neither changes what is stored.
+package bch
+
+import (
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+
+	"git.coinninja.net/backend/blocc/blocc/btc"
+)
+
+const Symbol = "bch"
+
+// MainNetParams are the parameters of the Bitcoin Cash main network, it shares the Bitcoin genesis block
+var MainNetParams = func() chaincfg.Params {
+	params := chaincfg.MainNetParams
+	params.Net = 0xe8f3e1e3
+	params.DNSSeeds = []chaincfg.DNSSeed{
+		{Host: "seed.flowee.cash", HasFiltering: false},
+		{Host: "seed-bch.bitcoinforks.org", HasFiltering: false},
+		{Host: "btccash-seeder.bitcoinunlimited.info", HasFiltering: false},
+		{Host: "seed.bchd.cash", HasFiltering: false},
+		{Host: "seed.bch.loping.net", HasFiltering: false},
+		{Host: "dnsseed.electroncash.de", HasFiltering: false},
+	}
+	params.Bech32HRPSegwit = ""
+	return params
+}()
+
+// TestNet3Params are the parameters of the Bitcoin Cash test network
+var TestNet3Params = func() chaincfg.Params {
+	params := chaincfg.TestNet3Params
+	params.Net = 0xf4f3e5f4
+	params.DNSSeeds = []chaincfg.DNSSeed{
+		{Host: "testnet-seed.bchd.cash", HasFiltering: false},
+		{Host: "testnet-seed-bch.bitcoinforks.org", HasFiltering: false},
+	}
+	params.Bech32HRPSegwit = ""
+	return params
+}()
+
+// RegressionNetParams are the parameters of the Bitcoin Cash regression test network
+var RegressionNetParams = func() chaincfg.Params {
+	params := chaincfg.RegressionNetParams
+	params.Net = 0xfabfb5da
+	params.Bech32HRPSegwit = ""
+	return params
+}()
+
+// Prefix returns the CashAddr prefix of the network
+func Prefix(params *chaincfg.Params) string {
+	switch params.Net {
+	case TestNet3Params.Net:
+		return "bchtest"
+	case RegressionNetParams.Net:
+		return "bchreg"
+	}
+	return "bitcoincash"
+}
+
+// ExtractAddresses returns the script type, CashAddr addresses and required signatures of an output script. Witness
+// programs are not spendable as such without segwit and are nonstandard.
+func ExtractAddresses(script []byte, params *chaincfg.Params) (string, []string, int, error) {
+
+	scriptType, addresses, reqSigs, err := txscript.ExtractPkScriptAddrs(script, params)
+	if err != nil {
+		return "", nil, 0, err
+	}
+
+	switch scriptType {
+	case txscript.WitnessV0PubKeyHashTy, txscript.WitnessV0ScriptHashTy:
+		return txscript.NonStandardTy.String(), nil, 0, nil
+	}
+
+	var ret []string
+	for _, address := range addresses {
+		var encoded string
+		switch a := address.(type) {
+		case *btcutil.AddressPubKeyHash:
+			encoded, err = EncodeCashAddr(Prefix(params), CashAddrP2PKH, a.ScriptAddress())
+		case *btcutil.AddressPubKey:
+			encoded, err = EncodeCashAddr(Prefix(params), CashAddrP2PKH, btcutil.Hash160(a.ScriptAddress()))
+		case *btcutil.AddressScriptHash:
+			encoded, err = EncodeCashAddr(Prefix(params), CashAddrP2SH, a.ScriptAddress())
+		default:
+			encoded = address.EncodeAddress()
+		}
+		if err != nil {
+			return "", nil, 0, err
+		}
+		ret = append(ret, encoded)
+	}
+
+	return scriptType.String(), ret, reqSigs, nil
+
+}
+
+// NormalizeAddress returns the CashAddr address of a legacy address or a CashAddr address without it's prefix so
+// both can be searched for. Anything else is returned as is.
+func NormalizeAddress(address string, params *chaincfg.Params) string {
+
+	prefix := Prefix(params)
+	if p, addrType, hash, err := DecodeCashAddr(address, prefix); err == nil && p == prefix {
+		if ret, err := EncodeCashAddr(prefix, addrType, hash); err == nil {
+			return ret
+		}
+		return address
+	}
+
+	legacy, err := btcutil.DecodeAddress(address, params)
+	if err != nil || !legacy.IsForNet(params) {
+		return address
+	}
+
+	var ret string
+	switch legacy.(type) {
+	case *btcutil.AddressPubKeyHash:
+		ret, err = EncodeCashAddr(prefix, CashAddrP2PKH, legacy.ScriptAddress())
+	case *btcutil.AddressScriptHash:
+		ret, err = EncodeCashAddr(prefix, CashAddrP2SH, legacy.ScriptAddress())
+	default:
+		return address
+	}
+	if err != nil {
+		return address
+	}
+	return ret
+
+}
+
+// LegacyAddress returns the legacy encoding of a CashAddr address
+func LegacyAddress(address string, params *chaincfg.Params) (string, error) {
+
+	_, addrType, hash, err := DecodeCashAddr(address, Prefix(params))
+	if err != nil {
+		return "", err
+	}
+
+	var legacy btcutil.Address
+	switch addrType {
+	case CashAddrP2PKH:
+		legacy, err = btcutil.NewAddressPubKeyHash(hash, params)
+	case CashAddrP2SH:
+		legacy, err = btcutil.NewAddressScriptHashFromHash(hash, params)
+	default:
+		return "", btcutil.ErrUnknownAddressType
+	}
+	if err != nil {
+		return "", err
+	}
+	return legacy.EncodeAddress(), nil
+
+}
+
+func init() {
+
+	// Legacy addresses are only decoded for registered networks
+	for _, params := range []*chaincfg.Params{&MainNetParams, &TestNet3Params, &RegressionNetParams} {
+		if err := chaincfg.Register(params); err != nil {
+			panic(err)
+		}
+	}
+
+	btc.RegisterChain(&btc.Chain{
+		Symbol:           Symbol,
+		Name:             "Bitcoin Cash",
+		Networks:         []*chaincfg.Params{&MainNetParams, &RegressionNetParams, &TestNet3Params},
+		ExtractAddresses: ExtractAddresses,
+		NormalizeAddress: NormalizeAddress,
+		MaxBlockVSize:    32000000,
+		NoVerifyScripts:  true,
+		NoSegwit:         true,
+	})
+
+}
@@ -0,0 +1,121 @@
+package bch
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/assert"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/btc"
+)
+
+func TestChain(t *testing.T) {
+
+	chain, err := btc.GetChain(Symbol)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, "Bitcoin Cash", chain.Name)
+	assert.True(t, chain.NoSegwit)
+
+	params, err := chain.Params("mainnet")
+	assert.Nil(t, err)
+	assert.Equal(t, &MainNetParams, params)
+	assert.Equal(t, chaincfg.MainNetParams.GenesisHash, params.GenesisHash)
+	assert.NotEqual(t, chaincfg.MainNetParams.Net, params.Net)
+
+	for _, test := range []struct {
+		script     string
+		scriptType string
+		addresses  []string
+	}{
+		{"76a91476a04053bda0a88bda5177b86a15c3b29f55987388ac", "pubkeyhash", []string{"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"}},
+		{"a91476a04053bda0a88bda5177b86a15c3b29f55987387", "scripthash", []string{"bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq"}},
+		// Pay to pubkey is the pubkey hash address
+		{"210279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798ac", "pubkey", []string{"bitcoincash:qp63uahgrxged4z5jswyt5dn5v3lzsem6cy4spdc2h"}},
+		// There is no segwit
+		{"0014751e76e8199196d454941c45d1b3a323f1433bd6", "nonstandard", nil},
+		{"6a0568656c6c6f", "nulldata", nil},
+	} {
+		script, _ := hex.DecodeString(test.script)
+		scriptType, addresses, _, err := ExtractAddresses(script, params)
+		assert.Nil(t, err, test.script)
+		assert.Equal(t, test.scriptType, scriptType, test.script)
+		assert.Equal(t, test.addresses, addresses, test.script)
+	}
+
+}
+
+func TestNormalizeAddress(t *testing.T) {
+
+	for _, test := range []struct {
+		address string
+		params  *chaincfg.Params
+		want    string
+	}{
+		{"1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu", &MainNetParams, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"},
+		{"3CWFddi6m4ndiGyKqzYvsFYagqDLPVMTzC", &MainNetParams, "bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq"},
+		{"1KXrWXciRDZUpQwQmuM1DbwsKDLYAYsVLR", &MainNetParams, "bitcoincash:qr95sy3j9xwd2ap32xkykttr4cvcu7as4y0qverfuy"},
+		{"qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", &MainNetParams, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"},
+		{"BITCOINCASH:QPM2QSZNHKS23Z7629MMS6S4CWEF74VCWVY22GDX6A", &MainNetParams, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"},
+		{"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", &MainNetParams, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"},
+		// Other networks and anything else are left alone
+		{"mrLC19Je2BuWQDkWSTriGYPyQJXKkkBmCx", &MainNetParams, "mrLC19Je2BuWQDkWSTriGYPyQJXKkkBmCx"},
+		{"bchtest:pr6m7j9njldwwzlg9v7v53unlr4jkmx6eyvwc0uz5t", &MainNetParams, "bchtest:pr6m7j9njldwwzlg9v7v53unlr4jkmx6eyvwc0uz5t"},
+		{"address1", &MainNetParams, "address1"},
+		{"bchtest:pr6m7j9njldwwzlg9v7v53unlr4jkmx6eyvwc0uz5t", &TestNet3Params, "bchtest:pr6m7j9njldwwzlg9v7v53unlr4jkmx6eyvwc0uz5t"},
+	} {
+		assert.Equal(t, test.want, NormalizeAddress(test.address, test.params), test.address)
+	}
+
+	// And back
+	legacy, err := LegacyAddress("bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq", &MainNetParams)
+	assert.Nil(t, err)
+	assert.Equal(t, "3CWFddi6m4ndiGyKqzYvsFYagqDLPVMTzC", legacy)
+	legacy, err = LegacyAddress("qr95sy3j9xwd2ap32xkykttr4cvcu7as4y0qverfuy", &MainNetParams)
+	assert.Nil(t, err)
+	assert.Equal(t, "1KXrWXciRDZUpQwQmuM1DbwsKDLYAYsVLR", legacy)
+	_, err = LegacyAddress("1KXrWXciRDZUpQwQmuM1DbwsKDLYAYsVLR", &MainNetParams)
+	assert.NotNil(t, err)
+
+}
+
+func TestDecodeTransaction(t *testing.T) {
+
+	chain, _ := btc.GetChain(Symbol)
+	script, _ := hex.DecodeString("76a91476a04053bda0a88bda5177b86a15c3b29f55987388ac")
+
+	prevTx := &blocc.Tx{TxId: chainhash.Hash{1}.String(), Out: []*blocc.TxOut{{Value: 10000, Raw: script}}}
+
+	msgTx := wire.NewMsgTx(2)
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), []byte{0x51}, nil))
+	msgTx.AddTxOut(wire.NewTxOut(9000, script))
+	var raw bytes.Buffer
+	assert.Nil(t, msgTx.Serialize(&raw))
+
+	tx, err := btc.DecodeTransaction(raw.Bytes(), chain, &MainNetParams, func(txIds []string) ([]*blocc.Tx, error) {
+		return []*blocc.Tx{prevTx}, nil
+	})
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	assert.Equal(t, Symbol, tx.Symbol)
+	assert.Equal(t, []string{"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"}, tx.Out[0].Addresses)
+
+	// No witness metrics and the fee rate is per byte
+	for _, field := range []string{"weight", "vsize", "stripped_size"} {
+		_, ok := tx.Data[field]
+		assert.False(t, ok, field)
+	}
+	assert.Equal(t, "1000", tx.Data["fee"])
+	assert.Equal(t, 1000/float64(raw.Len()), cast.ToFloat64(tx.Data["fee_vsize"]))
+	assert.Equal(t, "1", tx.Out[0].Data["sigop_cost"])
+
+}
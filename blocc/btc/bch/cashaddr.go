@@ -0,0 +1,128 @@
+package bch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+// The CashAddr address types
+const (
+	CashAddrP2PKH byte = 0
+	CashAddrP2SH  byte = 1
+)
+
+const cashAddrCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// The hash sizes in bits by the size code of the version byte
+var cashAddrHashSizes = []int{160, 192, 224, 256, 320, 384, 448, 512}
+
+// EncodeCashAddr encodes a hash as a CashAddr address with the prefix, ie bitcoincash:qp...
+func EncodeCashAddr(prefix string, addrType byte, hash []byte) (string, error) {
+
+	sizeCode := -1
+	for code, size := range cashAddrHashSizes {
+		if len(hash)*8 == size {
+			sizeCode = code
+		}
+	}
+	if sizeCode < 0 {
+		return "", fmt.Errorf("Invalid hash size %d", len(hash))
+	}
+	if addrType > 15 {
+		return "", fmt.Errorf("Invalid address type %d", addrType)
+	}
+
+	// The version byte is the type and the size code
+	payload, err := bech32.ConvertBits(append([]byte{addrType<<3 | byte(sizeCode)}, hash...), 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	checksum := cashAddrPolymod(append(cashAddrPrefixData(prefix), append(payload, 0, 0, 0, 0, 0, 0, 0, 0)...))
+
+	var ret strings.Builder
+	ret.WriteString(prefix)
+	ret.WriteByte(':')
+	for _, b := range payload {
+		ret.WriteByte(cashAddrCharset[b])
+	}
+	for x := 0; x < 8; x++ {
+		ret.WriteByte(cashAddrCharset[(checksum>>uint(5*(7-x)))&0x1f])
+	}
+
+	return ret.String(), nil
+
+}
+
+// DecodeCashAddr decodes a CashAddr address returning the prefix, address type and hash. The prefix is optional in
+// the address, defaultPrefix is used when it's missing.
+func DecodeCashAddr(address string, defaultPrefix string) (string, byte, []byte, error) {
+
+	// Either all lower or all upper case
+	lower := strings.ToLower(address)
+	if lower != address && strings.ToUpper(address) != address {
+		return "", 0, nil, fmt.Errorf("Mixed case address")
+	}
+
+	prefix, data := defaultPrefix, lower
+	if x := strings.LastIndexByte(lower, ':'); x >= 0 {
+		prefix, data = lower[:x], lower[x+1:]
+	}
+	if prefix == "" || len(data) < 8 {
+		return "", 0, nil, fmt.Errorf("Invalid address")
+	}
+
+	values := make([]byte, len(data))
+	for x := range data {
+		v := strings.IndexByte(cashAddrCharset, data[x])
+		if v < 0 {
+			return "", 0, nil, fmt.Errorf("Invalid character %q", data[x])
+		}
+		values[x] = byte(v)
+	}
+	if cashAddrPolymod(append(cashAddrPrefixData(prefix), values...)) != 0 {
+		return "", 0, nil, fmt.Errorf("Invalid checksum")
+	}
+
+	payload, err := bech32.ConvertBits(values[:len(values)-8], 5, 8, false)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if len(payload) == 0 || payload[0]&0x80 != 0 {
+		return "", 0, nil, fmt.Errorf("Invalid version")
+	}
+	hash := payload[1:]
+	if len(hash)*8 != cashAddrHashSizes[payload[0]&0x07] {
+		return "", 0, nil, fmt.Errorf("Invalid hash size %d", len(hash))
+	}
+
+	return prefix, payload[0] >> 3, hash, nil
+
+}
+
+// cashAddrPrefixData is the lower 5 bits of each character of the prefix followed by the separator
+func cashAddrPrefixData(prefix string) []byte {
+	ret := make([]byte, len(prefix)+1)
+	for x := range prefix {
+		ret[x] = prefix[x] & 0x1f
+	}
+	return ret
+}
+
+// cashAddrPolymod is the BCH code checksum of CashAddr
+func cashAddrPolymod(values []byte) uint64 {
+	generator := []uint64{0x98f2bc8e61, 0x79b76d99e2, 0xf33e5fb3c4, 0xae2eabe2a8, 0x1e4f43e470}
+	c := uint64(1)
+	for _, v := range values {
+		c0 := c >> 35
+		c = ((c & 0x07ffffffff) << 5) ^ uint64(v)
+		for x, g := range generator {
+			if (c0>>uint(x))&1 == 1 {
+				c ^= g
+			}
+		}
+	}
+	return c ^ 1
+}
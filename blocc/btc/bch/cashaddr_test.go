@@ -0,0 +1,58 @@
+package bch
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCashAddr(t *testing.T) {
+
+	for _, test := range []struct {
+		address  string
+		addrType byte
+		hash     string
+	}{
+		{"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", CashAddrP2PKH, "76a04053bda0a88bda5177b86a15c3b29f559873"},
+		{"bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq", CashAddrP2SH, "76a04053bda0a88bda5177b86a15c3b29f559873"},
+		{"bchtest:pr6m7j9njldwwzlg9v7v53unlr4jkmx6eyvwc0uz5t", CashAddrP2SH, "f5bf48b397dae70be82b3cca4793f8eb2b6cdac9"},
+		{"bitcoincash:qr6m7j9njldwwzlg9v7v53unlr4jkmx6eylep8ekg2", CashAddrP2PKH, "f5bf48b397dae70be82b3cca4793f8eb2b6cdac9"},
+		{"pref:pr6m7j9njldwwzlg9v7v53unlr4jkmx6ey65nvtks5", CashAddrP2SH, "f5bf48b397dae70be82b3cca4793f8eb2b6cdac9"},
+		{"prefix:0r6m7j9njldwwzlg9v7v53unlr4jkmx6ey3qnjwsrf", 15, "f5bf48b397dae70be82b3cca4793f8eb2b6cdac9"},
+	} {
+		hash, _ := hex.DecodeString(test.hash)
+
+		prefix, addrType, decoded, err := DecodeCashAddr(test.address, "")
+		assert.Nil(t, err, test.address)
+		assert.Equal(t, test.addrType, addrType, test.address)
+		assert.Equal(t, hash, decoded, test.address)
+
+		address, err := EncodeCashAddr(prefix, addrType, hash)
+		assert.Nil(t, err)
+		assert.Equal(t, test.address, address)
+	}
+
+	// The prefix is optional and upper case is allowed
+	prefix, addrType, hash, err := DecodeCashAddr("QPM2QSZNHKS23Z7629MMS6S4CWEF74VCWVY22GDX6A", "bitcoincash")
+	assert.Nil(t, err)
+	assert.Equal(t, "bitcoincash", prefix)
+	assert.Equal(t, CashAddrP2PKH, addrType)
+	assert.Equal(t, "76a04053bda0a88bda5177b86a15c3b29f559873", hex.EncodeToString(hash))
+
+	// Mixed case, a bad checksum or the wrong prefix
+	for _, address := range []string{
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvY22gdx6a",
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6q",
+		"bchtest:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdxb",
+	} {
+		_, _, _, err := DecodeCashAddr(address, "")
+		assert.NotNil(t, err, address)
+	}
+
+	// Only the CashAddr hash sizes
+	_, err = EncodeCashAddr("bitcoincash", CashAddrP2PKH, make([]byte, 21))
+	assert.NotNil(t, err)
+
+}
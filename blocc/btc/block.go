@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/montanaflynn/stats"
 	"github.com/spf13/cast"
@@ -26,6 +27,10 @@ type blockStat struct {
 	MinFee int64
 	MaxFee int64
 
+	// The fees and fee rates of the transactions for the median and percentiles
+	feeList      []float64
+	feeVSizeList []float64
+
 	sync.Mutex
 }
 
@@ -39,49 +44,20 @@ func (e *Extractor) handleBlock(wBlk *wire.MsgBlock) {
 	e.logger.Debugw("Handling Block", "block_id", wBlk.BlockHash().String())
 
 	// Build the blocc.Block
-	blk := &blocc.Block{
-		Symbol:      Symbol,
-		BlockId:     wBlk.BlockHash().String(),
-		PrevBlockId: wBlk.Header.PrevBlock.String(),
-		Height:      blocc.HeightUnknown,
-		Time:        wBlk.Header.Timestamp.UTC().Unix(),
-		TxCount:     int64(len(wBlk.Transactions)),
-		TxIds:       make([]string, len(wBlk.Transactions), len(wBlk.Transactions)),
-		BlockSize:   int64(wBlk.SerializeSize()),
-		Status:      blocc.StatusNew,
-		Data:        make(map[string]string),
-		Metric:      make(map[string]float64),
-	}
-
-	// Write the raw block
-	if e.blockStoreRaw {
-		var r = new(bytes.Buffer)
-		wBlk.Serialize(r)
-		blk.Raw = r.Bytes()
-	}
-
-	// Metrics
-	blk.Data["stripped_size"] = cast.ToString(wBlk.SerializeSizeStripped())
-	blk.Data["weight"] = cast.ToString((wBlk.SerializeSizeStripped() * (4 - 1)) + wBlk.SerializeSize()) // WitnessScaleFactor = 4
-	blk.Data["bits"] = cast.ToString(wBlk.Header.Bits)
-	blk.Data["difficulty"] = cast.ToString(e.getDifficultyRatio(wBlk.Header.Bits))
-	blk.Data["version"] = cast.ToString(wBlk.Header.Version)
-	blk.Data["version_hex"] = fmt.Sprintf("%08x", wBlk.Header.Version)
-	blk.Data["merkle_root"] = wBlk.Header.MerkleRoot.String()
-	blk.Data["nonce"] = cast.ToString(wBlk.Header.Nonce)
+	blk := newBlock(wBlk, e.chain, e.chainParams, e.blockStoreRaw)
 
 	// WaitGroup while we are parsing transactions in parallel
 	var parsingTransactions sync.WaitGroup
 
 	// Get the block height from the block header cache which should remain ahead of fetching the block chain
-	if bh, err := e.blockHeaderCache.GetBlockHeaderByBlockId(Symbol, blk.BlockId); bh != nil && err == nil {
+	if bh, err := e.blockHeaderCache.GetBlockHeaderByBlockId(e.symbol, blk.BlockId); bh != nil && err == nil {
 		blk.Height = bh.Height
 	} else if err == blocc.ErrNotFound {
 		// See if we have the previous block header, we can then determine the height from that
-		if pbh, err := e.blockHeaderCache.GetBlockHeaderByBlockId(Symbol, blk.PrevBlockId); pbh != nil && err == nil {
+		if pbh, err := e.blockHeaderCache.GetBlockHeaderByBlockId(e.symbol, blk.PrevBlockId); pbh != nil && err == nil {
 			// We determined the height, put it in the cache in case it's not already there (we are current on the block chain)
 			blk.Height = pbh.Height + 1
-			if err = e.blockHeaderCache.InsertBlockHeader(Symbol, blk.BlockHeader(), e.blockHeaderCacheLifetime); err != nil {
+			if err = e.blockHeaderCache.InsertBlockHeader(e.symbol, blk.BlockHeader(), e.blockHeaderCacheLifetime); err != nil {
 				e.logger.Fatalf("Could not blockHeaderCache.InsertBlockHeader", "error", err)
 			}
 		}
@@ -90,13 +66,13 @@ func (e *Extractor) handleBlock(wBlk *wire.MsgBlock) {
 	}
 
 	// If we can fetch the NextBlockId from the header cache, do so
-	if bh, err := e.blockHeaderCache.GetBlockHeaderByPrevBlockId(Symbol, blk.BlockId); err == nil {
+	if bh, err := e.blockHeaderCache.GetBlockHeaderByPrevBlockId(e.symbol, blk.BlockId); err == nil {
 		blk.NextBlockId = bh.BlockId
 	}
 
 	// If the height of this block is greater than the peer height, update it
-	if blk.Height > int64(e.peer.LastBlock()) {
-		e.peer.UpdateLastBlockHeight(int32(blk.Height))
+	if blk.Height > int64(e.source.LastBlock()) {
+		e.source.UpdateLastBlockHeight(int32(blk.Height))
 	}
 
 	// If we are following the block chain and we know the height, only handle e.blockConcurrent at a time
@@ -121,10 +97,9 @@ func (e *Extractor) handleBlock(wBlk *wire.MsgBlock) {
 	prevOutPoints := make(map[string]*blocc.Tx)
 	txIdsInThisBlock := make(map[string]struct{})
 	for x, wTx := range wBlk.Transactions {
-		txId := wTx.TxHash().String()
+		txId := blk.TxIds[x]
 
-		// Save the txId in the block as well as a lookup table
-		blk.TxIds[x] = txId
+		// Save the txId in a lookup table
 		txIdsInThisBlock[txId] = struct{}{}
 
 		// Attempt to fetch the prevOutPoints by txId from the monitor without any wait if we're tracking blocks
@@ -184,50 +159,14 @@ func (e *Extractor) handleBlock(wBlk *wire.MsgBlock) {
 		close(chainCompleteToThisBlock)
 	}
 
-	var blks blockStat
-	var txFeeList []float64
-	var txFeeVSizeList []float64
-
-	blks.MinFee = int64((^uint64(0)) >> 1) // Max int64 = 9223372036854775807
+	blks := newBlockStat()
 
 	// Iterate through and process transactions
 	for x, wTx := range wBlk.Transactions {
-		// Capture block stats
-		blks.TxCount++
-
 		// Parse the transaction
 		parsingTransactions.Add(1)
 		go func(txHeight int64, t *wire.MsgTx) {
-			txs := e.handleTx(blk, txHeight, t, prevOutPoints)
-
-			// Handle block stats from this transaction
-			blks.Lock()
-			blks.InputValue += txs.InputValue
-			blks.OutputValue += txs.OutputValue
-			if !txs.Coinbase {
-				blks.HasFee = true
-				blks.Fee += txs.Fee
-				if txs.Fee > 0 {
-					txFeeList = append(txFeeList, float64(txs.Fee))
-				}
-				if txs.FeeVSize > 0 {
-					txFeeVSizeList = append(txFeeVSizeList, txs.FeeVSize)
-				}
-
-				if txs.Fee < blks.MinFee {
-					blks.MinFee = txs.Fee
-				}
-				if txs.Fee > blks.MaxFee {
-					blks.MaxFee = txs.Fee
-				}
-			} else {
-				blk.Data["coinbase_value"] = cast.ToString(txs.OutputValue)
-			}
-			if txs.Incomplete {
-				blk.Incomplete = true
-				blk.Status = blocc.StatusInvalid
-			}
-			blks.Unlock()
+			blks.add(blk, e.handleTx(blk, txHeight, t, prevOutPoints))
 			parsingTransactions.Done()
 		}(int64(x), wTx)
 	}
@@ -239,35 +178,7 @@ func (e *Extractor) handleBlock(wBlk *wire.MsgBlock) {
 	<-chainCompleteToThisBlock
 
 	// Store block/tx stats
-	blk.Data["input_value"] = cast.ToString(blks.InputValue)
-	blk.Data["output_value"] = cast.ToString(blks.OutputValue)
-	if blks.HasFee && blks.TxCount > 1 { // There is a transaction that is non-coinbase
-		blk.Data["fee"] = cast.ToString(blks.Fee)
-		blk.Data["fee_min"] = cast.ToString(blks.MinFee)
-		blk.Data["fee_max"] = cast.ToString(blks.MaxFee)
-		blk.Data["fee_avg"] = cast.ToString(float32(blks.Fee) / float32(blks.TxCount-1))
-
-		// Get median fee. If this fails for some reason, fall back to mean calculation.
-		if medianFee, err := stats.Median(txFeeList); err != nil {
-			// Use the average fee as a fallback
-			blk.Data["fee_median"] = cast.ToString(float32(blks.Fee) / float32(blks.TxCount-1))
-		} else {
-			blk.Data["fee_median"] = cast.ToString(float32(medianFee))
-		}
-
-		// Calculate a few percentiles of the fee/vsize
-		if p10, err := stats.Percentile(txFeeVSizeList, 10.0); err == nil {
-			blk.Data["fee_vsize_p10"] = cast.ToString(p10)
-		}
-
-	} else {
-		// No fees, OVER THE LINE! MARK IT ZERO DUDE
-		blk.Data["fee"] = "0"
-		blk.Data["fee_min"] = "0"
-		blk.Data["fee_max"] = "0"
-		blk.Data["fee_avg"] = "0"
-		blk.Data["fee_median"] = "0"
-	}
+	blks.setData(blk)
 
 	e.logger.Infow("Handled Block", "block_id", blk.BlockId, "height", blk.Height)
 
@@ -279,7 +190,7 @@ func (e *Extractor) handleBlock(wBlk *wire.MsgBlock) {
 		e.lastBlockHeightUnknown = false
 		e.Unlock()
 
-		err := e.blockChainStore.InsertBlock(Symbol, blk)
+		err := e.blockChainStore.InsertBlock(e.symbol, blk)
 		if err != nil {
 			e.logger.Errorw("Could not blockChainStore.InsertBlock", "error", err)
 		}
@@ -290,17 +201,17 @@ func (e *Extractor) handleBlock(wBlk *wire.MsgBlock) {
 		}
 
 		// If this is the top block, ensure everything is immediately flushed to disk
-		if blk.Height >= int64(e.peer.LastBlock()) {
+		if blk.Height >= int64(e.source.LastBlock()) {
 
 			// Update the NextBlockId of the previous block to point to this block
-			err = e.blockChainStore.UpdateBlock(Symbol, blk.PrevBlockId, "", blk.BlockId, nil, nil)
+			err = e.blockChainStore.UpdateBlock(e.symbol, blk.PrevBlockId, "", blk.BlockId, nil, nil)
 			if err != nil {
 				e.logger.Errorw("Could not update PrevBlock.NextBlockId", "error", err)
 			}
 
-			e.logger.Debugw("Flushing blockChainStore blocks and transactions", "block_height", blk.Height, "peer_height", e.peer.LastBlock())
-			e.blockChainStore.FlushBlocks(Symbol)
-			e.blockChainStore.FlushTransactions(Symbol)
+			e.logger.Debugw("Flushing blockChainStore blocks and transactions", "block_height", blk.Height, "peer_height", e.source.LastBlock())
+			e.blockChainStore.FlushBlocks(e.symbol)
+			e.blockChainStore.FlushTransactions(e.symbol)
 		}
 	} else {
 		// Mark that the last processed block has height unknown
@@ -314,6 +225,148 @@ func (e *Extractor) handleBlock(wBlk *wire.MsgBlock) {
 
 }
 
+// ConvertBlock builds the blocc.Block and transactions of a block at height the way the extractor stores them with the
+// raw block and transactions. The inputs are resolved from prevTxs by transaction id and the transactions of the block
+// are added to it so the next block can spend them.
+func ConvertBlock(wBlk *wire.MsgBlock, height int64, chain *Chain, chainParams *chaincfg.Params, prevTxs map[string]*blocc.Tx) (*blocc.Block, []*blocc.Tx) {
+
+	blk := newBlock(wBlk, chain, chainParams, true)
+	blk.Height = height
+
+	blks := newBlockStat()
+	txs := make([]*blocc.Tx, len(wBlk.Transactions))
+	for x, wTx := range wBlk.Transactions {
+		tx, stat := convertTx(wTx, int64(x), blk, chain, chainParams, prevTxs)
+		prevTxs[tx.TxId] = tx
+		blks.add(blk, stat)
+		txs[x] = tx
+	}
+	blks.setData(blk)
+
+	return blk, txs
+
+}
+
+// newBlock builds the blocc.Block of a block with a height unknown and without the stats of it's transactions
+func newBlock(wBlk *wire.MsgBlock, chain *Chain, chainParams *chaincfg.Params, storeRaw bool) *blocc.Block {
+
+	blk := &blocc.Block{
+		Symbol:      chain.Symbol,
+		BlockId:     wBlk.BlockHash().String(),
+		PrevBlockId: wBlk.Header.PrevBlock.String(),
+		Height:      blocc.HeightUnknown,
+		Time:        wBlk.Header.Timestamp.UTC().Unix(),
+		TxCount:     int64(len(wBlk.Transactions)),
+		TxIds:       make([]string, len(wBlk.Transactions), len(wBlk.Transactions)),
+		BlockSize:   int64(wBlk.SerializeSize()),
+		Status:      blocc.StatusNew,
+		Data:        make(map[string]string),
+		Metric:      make(map[string]float64),
+	}
+	for x, wTx := range wBlk.Transactions {
+		blk.TxIds[x] = wTx.TxHash().String()
+	}
+
+	// Write the raw block
+	if storeRaw {
+		var r = new(bytes.Buffer)
+		wBlk.Serialize(r)
+		blk.Raw = r.Bytes()
+	}
+
+	// Metrics
+	if !chain.NoSegwit {
+		blk.Data["stripped_size"] = cast.ToString(wBlk.SerializeSizeStripped())
+		blk.Data["weight"] = cast.ToString((wBlk.SerializeSizeStripped() * (4 - 1)) + wBlk.SerializeSize()) // WitnessScaleFactor = 4
+	}
+	blk.Data["bits"] = cast.ToString(wBlk.Header.Bits)
+	blk.Data["difficulty"] = cast.ToString(getDifficultyRatio(wBlk.Header.Bits, chainParams))
+	blk.Data["version"] = cast.ToString(wBlk.Header.Version)
+	blk.Data["version_hex"] = fmt.Sprintf("%08x", wBlk.Header.Version)
+	blk.Data["merkle_root"] = wBlk.Header.MerkleRoot.String()
+	blk.Data["nonce"] = cast.ToString(wBlk.Header.Nonce)
+
+	return blk
+
+}
+
+// newBlockStat returns the stats of a block before any transactions are added
+func newBlockStat() *blockStat {
+	return &blockStat{
+		MinFee: int64((^uint64(0)) >> 1), // Max int64 = 9223372036854775807
+	}
+}
+
+// add adds the stats of a transaction of the block
+func (blks *blockStat) add(blk *blocc.Block, txs *txStat) {
+
+	blks.Lock()
+	defer blks.Unlock()
+
+	blks.TxCount++
+	blks.InputValue += txs.InputValue
+	blks.OutputValue += txs.OutputValue
+	if !txs.Coinbase {
+		blks.HasFee = true
+		blks.Fee += txs.Fee
+		if txs.Fee > 0 {
+			blks.feeList = append(blks.feeList, float64(txs.Fee))
+		}
+		if txs.FeeVSize > 0 {
+			blks.feeVSizeList = append(blks.feeVSizeList, txs.FeeVSize)
+		}
+
+		if txs.Fee < blks.MinFee {
+			blks.MinFee = txs.Fee
+		}
+		if txs.Fee > blks.MaxFee {
+			blks.MaxFee = txs.Fee
+		}
+	} else {
+		blk.Data["coinbase_value"] = cast.ToString(txs.OutputValue)
+	}
+	if txs.Incomplete {
+		blk.Incomplete = true
+		blk.Status = blocc.StatusInvalid
+	}
+
+}
+
+// setData sets the stats of the block once every transaction is added
+func (blks *blockStat) setData(blk *blocc.Block) {
+
+	blk.Data["input_value"] = cast.ToString(blks.InputValue)
+	blk.Data["output_value"] = cast.ToString(blks.OutputValue)
+	if blks.HasFee && blks.TxCount > 1 { // There is a transaction that is non-coinbase
+		blk.Data["fee"] = cast.ToString(blks.Fee)
+		blk.Data["fee_min"] = cast.ToString(blks.MinFee)
+		blk.Data["fee_max"] = cast.ToString(blks.MaxFee)
+		blk.Data["fee_avg"] = cast.ToString(float32(blks.Fee) / float32(blks.TxCount-1))
+
+		// Get median fee. If this fails for some reason, fall back to mean calculation.
+		if medianFee, err := stats.Median(blks.feeList); err != nil {
+			// Use the average fee as a fallback
+			blk.Data["fee_median"] = cast.ToString(float32(blks.Fee) / float32(blks.TxCount-1))
+		} else {
+			blk.Data["fee_median"] = cast.ToString(float32(medianFee))
+		}
+
+		// Calculate a few percentiles of the fee/vsize
+		if p10, err := stats.Percentile(blks.feeVSizeList, 10.0); err == nil {
+			blk.Data["fee_vsize_p10"] = cast.ToString(p10)
+		}
+
+	} else {
+		// No fees, OVER THE LINE! MARK IT ZERO DUDE
+		blk.Data["fee"] = "0"
+		blk.Data["fee_min"] = "0"
+		blk.Data["fee_max"] = "0"
+		blk.Data["fee_avg"] = "0"
+		blk.Data["fee_median"] = "0"
+	}
+
+}
+
 // This converts [][]byte (witnesses) to []string
 func parseWitness(in [][]byte) []string {
 	ret := make([]string, len(in), len(in))
@@ -325,12 +378,12 @@ func parseWitness(in [][]byte) []string {
 
 // getDifficultyRatio returns the proof-of-work difficulty as a multiple of the
 // minimum difficulty using the passed bits field from the header of a block.
-func (e *Extractor) getDifficultyRatio(bits uint32) string {
+func getDifficultyRatio(bits uint32, chainParams *chaincfg.Params) string {
 	// The minimum difficulty is the max possible proof-of-work limit bits
 	// converted back to a number.  Note this is not the same as the proof of
 	// work limit directly because the block difficulty is encoded in a block
 	// with the compact form which loses precision.
-	max := blockchain.CompactToBig(e.chainParams.PowLimitBits)
+	max := blockchain.CompactToBig(chainParams.PowLimitBits)
 	target := blockchain.CompactToBig(bits)
 	difficulty := new(big.Rat).SetFrac(max, target)
 	return difficulty.FloatString(8)
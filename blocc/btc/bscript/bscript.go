@@ -0,0 +1,125 @@
+// Package bscript decodes the addresses of output scripts including the script types not yet known to txscript
+package bscript
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+// ScriptTypeWitnessV1Taproot is the type of a BIP341 pay to taproot output
+const ScriptTypeWitnessV1Taproot = "witness_v1_taproot"
+
+// bech32mConst is the BIP350 checksum constant used for witness version 1 and above
+const bech32mConst = 0x2bc830a3
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// ExtractAddresses returns the script type, addresses and required signatures of an output script
+func ExtractAddresses(script []byte, params *chaincfg.Params) (string, []string, int, error) {
+
+	if IsTaproot(script) {
+		address, err := EncodeTaprootAddress(script[2:], params)
+		if err != nil {
+			return "", nil, 0, err
+		}
+		return ScriptTypeWitnessV1Taproot, []string{address}, 1, nil
+	}
+
+	scriptType, addresses, reqSigs, err := txscript.ExtractPkScriptAddrs(script, params)
+	if err != nil {
+		return "", nil, 0, err
+	}
+
+	var ret []string
+	for _, address := range addresses {
+		ret = append(ret, address.EncodeAddress())
+	}
+
+	return scriptType.String(), ret, reqSigs, nil
+
+}
+
+// IsTaproot checks if the script is OP_1 followed by a 32 byte push
+func IsTaproot(script []byte) bool {
+	return len(script) == 34 && script[0] == txscript.OP_1 && script[1] == txscript.OP_DATA_32
+}
+
+// TaprootScript returns the output script paying to the 32 byte x-only output key
+func TaprootScript(outputKey []byte) ([]byte, error) {
+	if len(outputKey) != 32 {
+		return nil, fmt.Errorf("Taproot output key must be 32 bytes")
+	}
+	return txscript.NewScriptBuilder().AddOp(txscript.OP_1).AddData(outputKey).Script()
+}
+
+// EncodeTaprootAddress encodes the 32 byte x-only output key as a bech32m segwit version 1 address
+func EncodeTaprootAddress(outputKey []byte, params *chaincfg.Params) (string, error) {
+
+	if len(outputKey) != 32 {
+		return "", fmt.Errorf("Taproot output key must be 32 bytes")
+	}
+
+	program, err := bech32.ConvertBits(outputKey, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	data := append([]byte{1}, program...)
+
+	hrp := strings.ToLower(params.Bech32HRPSegwit)
+	checksum := bech32mChecksum(hrp, data)
+
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, b := range append(data, checksum...) {
+		sb.WriteByte(bech32Charset[b])
+	}
+
+	return sb.String(), nil
+
+}
+
+// bech32mChecksum returns the 6 checksum characters (as 5 bit values) of data
+func bech32mChecksum(hrp string, data []byte) []byte {
+
+	values := make([]int, 0, len(hrp)*2+1+len(data)+6)
+	for _, c := range hrp {
+		values = append(values, int(c>>5))
+	}
+	values = append(values, 0)
+	for _, c := range hrp {
+		values = append(values, int(c&31))
+	}
+	for _, b := range data {
+		values = append(values, int(b))
+	}
+	values = append(values, 0, 0, 0, 0, 0, 0)
+
+	polymod := bech32Polymod(values) ^ bech32mConst
+	checksum := make([]byte, 6)
+	for x := range checksum {
+		checksum[x] = byte((polymod >> uint(5*(5-x))) & 31)
+	}
+
+	return checksum
+
+}
+
+func bech32Polymod(values []int) int {
+	generator := []int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := 1
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ v
+		for x := 0; x < 5; x++ {
+			if (top>>uint(x))&1 == 1 {
+				chk ^= generator[x]
+			}
+		}
+	}
+	return chk
+}
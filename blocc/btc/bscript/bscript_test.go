@@ -0,0 +1,34 @@
+package bscript
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractAddresses(t *testing.T) {
+
+	// BIP350 witness version 1 test vector
+	script, _ := hex.DecodeString("512079be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	scriptType, addresses, reqSigs, err := ExtractAddresses(script, &chaincfg.MainNetParams)
+	assert.Nil(t, err)
+	assert.Equal(t, ScriptTypeWitnessV1Taproot, scriptType)
+	assert.Equal(t, []string{"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0"}, addresses)
+	assert.Equal(t, 1, reqSigs)
+
+	script, _ = hex.DecodeString("0014751e76e8199196d454941c45d1b3a323f1433bd6")
+	scriptType, addresses, _, err = ExtractAddresses(script, &chaincfg.MainNetParams)
+	assert.Nil(t, err)
+	assert.Equal(t, "witness_v0_keyhash", scriptType)
+	assert.Equal(t, []string{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"}, addresses)
+
+	// No addresses are nil
+	script, _ = hex.DecodeString("6a04deadbeef")
+	scriptType, addresses, _, err = ExtractAddresses(script, &chaincfg.MainNetParams)
+	assert.Nil(t, err)
+	assert.Equal(t, "nulldata", scriptType)
+	assert.Nil(t, addresses)
+
+}
@@ -2,12 +2,10 @@ package btc
 
 import (
 	"fmt"
-	"net"
 	"reflect"
 	"sync"
 	"time"
 
-	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/peer"
@@ -17,6 +15,7 @@ import (
 
 	"git.coinninja.net/backend/blocc/blocc"
 	"git.coinninja.net/backend/blocc/blocc/btools"
+	"git.coinninja.net/backend/blocc/blocc/webhook"
 	"git.coinninja.net/backend/blocc/conf"
 )
 
@@ -28,13 +27,19 @@ const (
 type Extractor struct {
 	// Internal stuff
 	logger      *zap.SugaredLogger
-	peer        *peer.Peer
+	source      Source
+	peers       *peerManager // The source when it's p2p
+	chain       *Chain
 	chainParams *chaincfg.Params
+	symbol      string
 
 	// Stores/Pool/Bus
 	blockChainStore blocc.BlockChainStore
 	validBlockStore blocc.ValidBlockStore
 	txBus           blocc.TxBus
+	eventBus        blocc.EventBus
+	txRelay         blocc.TxRelay
+	webhooks        *webhook.Dispatcher
 
 	// Frequently Used Settings
 	blockFetch                   bool
@@ -44,11 +49,21 @@ type Extractor struct {
 	blockValidationHeightDelta   int64
 	blockValidationHeightHoldOff int64
 
+	// Downloading blocks in parallel from the peers
+	blockDownloadParallel bool
+	blockDownloadRange    int
+	blockDownloadWindow   int
+	blockDownloadTimeout  time.Duration
+	download              *blockDownload
+	downloadLock          sync.Mutex
+
 	txFetch                 bool
 	txConcurrent            chan struct{}
 	txStoreRaw              bool
 	txResolvePrevious       bool
 	txIgnoreMissingPrevious bool
+	txTrackOutputs          bool
+	txTrackAddresses        bool
 
 	// BlockHeaderCache
 	blockHeaderCache         blocc.BlockHeaderCache
@@ -63,6 +78,20 @@ type Extractor struct {
 	// How long transactions will sit in the txPool
 	txPoolLifetime time.Duration
 
+	// The outputs spent by mempool transactions for detecting conflicts
+	memPoolSpends blocc.MemPoolSpends
+	// The parents and children of mempool transactions for package fee rates
+	memPoolPackages blocc.MemPoolPackages
+	// When the mempool transactions were first received by txId, kept when they are received again
+	memPoolReceived map[string]*memPoolReceived
+	// Serializes storing mempool transactions with tracking their spends and packages
+	memPoolLock sync.Mutex
+
+	// Transactions announced to the peer until it requests them
+	relayTxs        map[chainhash.Hash]*relayTx
+	relayTxsLock    sync.Mutex
+	txRelayLifetime time.Duration
+
 	// Sync Setting for requesting/waiting for headers to be returns
 	waitHeaders chan struct{}
 
@@ -73,13 +102,16 @@ type Extractor struct {
 	sync.RWMutex
 }
 
-func Extract(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus) (*Extractor, error) {
+func Extract(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus, eventBus blocc.EventBus, txRelay blocc.TxRelay, webhooks *webhook.Dispatcher) (*Extractor, error) {
 
 	e := &Extractor{
 		logger:          zap.S().With("package", "blocc.btc"),
 		blockChainStore: blockChainStore,
 		validBlockStore: btools.NewValidBlockStoreMem(),
 		txBus:           txBus,
+		eventBus:        eventBus,
+		txRelay:         txRelay,
+		webhooks:        webhooks,
 
 		blockFetch:                   txBus == nil,
 		blockStoreRaw:                config.GetBool("extractor.btc.block_store_raw"),
@@ -88,10 +120,17 @@ func Extract(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus) (*Extract
 		blockValidationHeightDelta:   config.GetInt64("extractor.btc.block_validation_height_delta"),
 		blockValidationHeightHoldOff: config.GetInt64("extractor.btc.block_validation_height_holdoff"),
 
+		blockDownloadParallel: config.GetBool("extractor.btc.block_download_parallel"),
+		blockDownloadRange:    config.GetInt("extractor.btc.block_download_range"),
+		blockDownloadWindow:   config.GetInt("extractor.btc.block_download_window"),
+		blockDownloadTimeout:  config.GetDuration("extractor.btc.block_download_timeout"),
+
 		txFetch:           txBus != nil,
 		txConcurrent:      make(chan struct{}, config.GetInt64("extractor.btc.transaction_concurrent")),
 		txStoreRaw:        config.GetBool("extractor.btc.transaction_store_raw"),
 		txResolvePrevious: config.GetBool("extractor.btc.transaction_resolve_previous"),
+		txTrackOutputs:    config.GetBool("extractor.btc.transaction_track_outputs"),
+		txTrackAddresses:  config.GetBool("extractor.btc.transaction_track_addresses"),
 
 		blockHeaderCache:         btools.NewBlockHeaderCacheMem(),
 		blockHeaderCacheLifetime: config.GetDuration("extractor.btc.bhcache_lifetime"),
@@ -106,14 +145,40 @@ func Extract(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus) (*Extract
 		blockHeaderTxMonTxLifetime:       config.GetDuration("extractor.btc.bhtxn_monitor_transaction_lifetime"),
 
 		txPoolLifetime: config.GetDuration("extractor.btc.transaction_pool_lifetime"),
+
+		memPoolSpends:   btools.NewMemPoolSpendsMem(),
+		memPoolPackages: btools.NewMemPoolPackagesMem(),
+		memPoolReceived: make(map[string]*memPoolReceived),
+
+		relayTxs:        make(map[chainhash.Hash]*relayTx),
+		txRelayLifetime: config.GetDuration("extractor.btc.transaction_relay_lifetime"),
+	}
+
+	// Find the selected chain
+	var err error
+	e.chain, err = GetChain(config.GetString("extractor.btc.symbol"))
+	if err != nil {
+		return nil, fmt.Errorf("Could not find chain %s: %v", config.GetString("extractor.btc.symbol"), err)
+	}
+	e.symbol = e.chain.Symbol
+	e.chainParams, err = e.chain.Params(config.GetString("extractor.btc.chain"))
+	if err != nil {
+		return nil, err
 	}
 
 	// Output Config
 	e.logger.Infow("Starting Extractor",
 
+		"extractor.btc.symbol", e.symbol,
+		"extractor.btc.chain", e.chainParams.Name,
+
 		"extractor.btc.block_store_raw", e.blockStoreRaw,
 		"extractor.btc.block_concurrent", e.blockConcurrent,
 		"extractor.btc.block_validation_interval", e.blockValidationInterval,
+		"extractor.btc.block_download_parallel", e.blockDownloadParallel,
+		"extractor.btc.block_download_range", e.blockDownloadRange,
+		"extractor.btc.block_download_window", e.blockDownloadWindow,
+		"extractor.btc.block_download_timeout", e.blockDownloadTimeout,
 		"extractor.btc.transaction_resolve_previous", e.txResolvePrevious,
 
 		"extractor.btc.bhcache_lifetime", e.blockHeaderCacheLifetime,
@@ -124,63 +189,55 @@ func Extract(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus) (*Extract
 
 		"extractor.btc.transaction_pool_lifetime", e.txPoolLifetime,
 		"extractor.btc.transaction_store_raw", e.txStoreRaw,
+		"extractor.btc.transaction_track_outputs", e.txTrackOutputs,
+		"extractor.btc.transaction_track_addresses", e.txTrackAddresses,
+		"extractor.btc.transaction_relay_lifetime", e.txRelayLifetime,
 	)
 	time.Sleep(2 * time.Second)
 
-	var err error
-
-	// Initialize the BlockChainStore for BTC
+	// Initialize the BlockChainStore for the chain
 	if e.blockChainStore != nil {
-		err = e.blockChainStore.Init(Symbol)
+		err = e.blockChainStore.Init(e.symbol)
 		if err != nil {
 			return nil, fmt.Errorf("Could not Init BlockChainStore: %s", err)
 		}
 	}
 
 	if e.blockHeaderCache != nil {
-		err = e.blockHeaderCache.Init(Symbol)
+		err = e.blockHeaderCache.Init(e.symbol)
 		if err != nil {
 			return nil, fmt.Errorf("Could not Init BlockHeaderCache: %s", err)
 		}
 	}
 
-	// Initialize the TxBus for BTC
+	// Initialize the TxBus for the chain
 	if e.txBus != nil {
-		err = e.txBus.Init(Symbol)
+		err = e.txBus.Init(e.symbol)
 		if err != nil {
 			return nil, fmt.Errorf("Could not Init TxBus: %s", err)
 		}
 	}
 
-	// Create an array of chains such that we can pick the one we want
-	chains := []*chaincfg.Params{
-		&chaincfg.MainNetParams,
-		&chaincfg.RegressionNetParams,
-		&chaincfg.SimNetParams,
-		&chaincfg.TestNet3Params,
-	}
-	// Find the selected chain
-	for _, cp := range chains {
-		if config.GetString("extractor.btc.chain") == cp.Name {
-			e.chainParams = cp
-			break
-		}
-	}
-	if e.chainParams == nil {
-		return nil, fmt.Errorf("Could not find chain %s", config.GetString("extractor.btc.chain"))
+	// Connect to the source and keep it connected
+	e.source, err = e.newSource(config.GetString("extractor.btc.source"))
+	if err != nil {
+		return nil, err
 	}
-
-	// Connect to the peer
 	err = e.Connect()
 	if err != nil {
-		return nil, err
+		// Importing from block files doesn't need a peer until it's done, it will reconnect after
+		if !e.blockFetch || config.GetString("extractor.btc.import_datadir") == "" {
+			return nil, err
+		}
+		e.logger.Warnw("Could not connect to peers, importing anyways", "error", err)
 	}
+	go e.source.Run()
 
 	// Did we provide a blockchain store? If so, we're processing blocks, go fetch the block chain
 	if e.blockFetch {
 
 		// Starting point for fetching data - get the highest validated block
-		validBlockHeader, err := e.blockChainStore.GetBlockHeaderTopByStatuses(Symbol, []string{blocc.StatusValid})
+		validBlockHeader, err := e.blockChainStore.GetBlockHeaderTopByStatuses(e.symbol, []string{blocc.StatusValid})
 		if err != nil && err != blocc.ErrNotFound {
 			e.logger.Fatalw("blockChainStore.GetBlockHeaderTopByStatuses", "error", err)
 		}
@@ -196,7 +253,7 @@ func Extract(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus) (*Extract
 		// If we're starting at the genesis block, insert it
 		if validBlockHeader.Height == blocc.HeightUnknown { // = -1 = Genesis
 			// Store the block ID when we need to reference it
-			e.blockHeaderCache.InsertBlockHeader(Symbol, &blocc.BlockHeader{
+			e.blockHeaderCache.InsertBlockHeader(e.symbol, &blocc.BlockHeader{
 				BlockId: e.chainParams.GenesisBlock.BlockHash().String(),
 				Time:    e.chainParams.GenesisBlock.Header.Timestamp.Unix(),
 				Height:  0,
@@ -207,13 +264,21 @@ func Extract(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus) (*Extract
 			e.handleBlock(e.chainParams.GenesisBlock)
 		} else {
 			// Otherwise we're at another/existing block chain height, the blockChainStore is at that height either natrually for forced
-			e.blockHeaderCache.InsertBlockHeader(Symbol, validBlockHeader, e.blockHeaderCacheLifetime)
+			e.blockHeaderCache.InsertBlockHeader(e.symbol, validBlockHeader, e.blockHeaderCacheLifetime)
 			e.blockHeaderTxMon.AddBlockHeader(validBlockHeader, e.blockHeaderTxMonBHLifetime)
 			e.validBlockStore.SetValidBlock(validBlockHeader)
 		}
 
-		// Fetch the block chain from here
-		go e.fetchBlockChain()
+		// Fetch the block chain from here, importing what's in the block files first
+		go func() {
+			if datadir := config.GetString("extractor.btc.import_datadir"); datadir != "" {
+				err := e.ImportBlocks(datadir)
+				if err != nil {
+					e.logger.Fatalw("Could not import blocks", "datadir", datadir, "error", err)
+				}
+			}
+			e.fetchBlockChain()
+		}()
 
 	}
 
@@ -221,6 +286,7 @@ func Extract(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus) (*Extract
 	if e.txFetch {
 
 		var lastMempoolUpdate time.Time
+		var lastMempoolPeer *peer.Peer
 
 		go func() {
 
@@ -228,9 +294,9 @@ func Extract(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus) (*Extract
 			for {
 
 				// If we're not connected to the peer, reconnect
-				if !e.peer.Connected() {
+				if !e.source.Connected() {
 					// Ensure we're disconnected
-					e.peer.Disconnect()
+					e.source.Disconnect()
 					// Reconnect
 					e.logger.Warn("Attempting peer reconnect")
 					err := e.Connect()
@@ -244,26 +310,51 @@ func Extract(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus) (*Extract
 					lastMempoolUpdate = time.Time{}
 				}
 
-				// Refresh the mempool
-				if time.Since(lastMempoolUpdate) > config.GetDuration("extractor.btc.transaction_mempool_refresh_interval") {
+				// We failed over to another peer - refresh the mempool from it
+				if e.peers != nil {
+					if best := e.peers.Best(); best != lastMempoolPeer {
+						lastMempoolPeer = best
+						lastMempoolUpdate = time.Time{}
+					}
+				}
+
+				// Refresh the mempool, a source that reports transactions leaving the mempool only needs it when connecting
+				if lastMempoolUpdate.IsZero() || (!e.source.MemPoolEvents() && time.Since(lastMempoolUpdate) > config.GetDuration("extractor.btc.transaction_mempool_refresh_interval")) {
 
 					e.logger.Info("Reloading mempool")
 
 					// Update anything in the mempool to the mempool-update flag
-					err = e.blockChainStore.UpdateTxBlockIdByBlockId(Symbol, blocc.BlockIdMempool, blocc.BlockIdMempoolUpdate)
+					err = e.blockChainStore.UpdateTxBlockIdByBlockId(e.symbol, blocc.BlockIdMempool, blocc.BlockIdMempoolUpdate)
 					if err != nil {
 						e.logger.Errorf("Could update mempool to the mempool-update flag:%v", err)
 						continue
 					}
+					if e.txTrackOutputs {
+						err = e.blockChainStore.UpdateOutputBlockIdByBlockId(e.symbol, blocc.BlockIdMempool, blocc.BlockIdMempoolUpdate)
+						if err != nil {
+							e.logger.Errorf("Could update mempool outputs to the mempool-update flag:%v", err)
+							continue
+						}
+					}
+					if e.txTrackAddresses {
+						err = e.blockChainStore.UpdateAddressTxBlockIdByBlockId(e.symbol, blocc.BlockIdMempool, blocc.BlockIdMempoolUpdate)
+						if err != nil {
+							e.logger.Errorf("Could update mempool address txs to the mempool-update flag:%v", err)
+							continue
+						}
+					}
 
 					// Ensure everything is written to disk
-					err = e.blockChainStore.FlushTransactions(Symbol)
+					err = e.blockChainStore.FlushTransactions(e.symbol)
 					if err != nil {
 						e.logger.Errorf("Could not flush mempool-update changes:%v", err)
 						continue
 					}
 
-					// Fetch the active mempool
+					// Fetch the active mempool, the spends and packages will be tracked again as it's transactions arrive
+					e.memPoolSpends.Clear()
+					e.memPoolPackages.Clear()
+					e.loadMemPoolReceived()
 					e.RequestMemPool()
 
 					go func() {
@@ -271,25 +362,43 @@ func Extract(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus) (*Extract
 						time.Sleep(config.GetDuration("extractor.btc.transaction_mempool_load_time"))
 
 						// Make sure no errors in the meantime
-						if !e.peer.Connected() {
+						if !e.source.Connected() {
 							return
 						}
 
 						e.logger.Info("Scrubbing mempool of deleted transactions")
 
 						// Ensure everything is written to disk
-						err = e.blockChainStore.FlushTransactions(Symbol)
+						err = e.blockChainStore.FlushTransactions(e.symbol)
 						if err != nil {
 							e.logger.Errorf("Could not flush mempool-update changes:%v", err)
 							return
 						}
 
 						// Delete any transactions still marked with the update flag
-						err = e.blockChainStore.DeleteTransactionsByBlockIdAndTime(Symbol, blocc.BlockIdMempoolUpdate, nil, nil)
+						err = e.blockChainStore.DeleteTransactionsByBlockIdAndTime(e.symbol, blocc.BlockIdMempoolUpdate, nil, nil)
 						if err != nil {
 							e.logger.Errorf("Could not delete expired mempool-update transactions flag:%v", err)
 							return
 						}
+
+						// Rollback any outputs created or spent by those transactions
+						if e.txTrackOutputs {
+							err = e.blockChainStore.RollbackOutputsByBlockId(e.symbol, blocc.BlockIdMempoolUpdate)
+							if err != nil {
+								e.logger.Errorf("Could not rollback expired mempool-update outputs:%v", err)
+								return
+							}
+						}
+
+						// Remove those transactions from the address summaries
+						if e.txTrackAddresses {
+							err = e.blockChainStore.RollbackAddressTxsByBlockId(e.symbol, blocc.BlockIdMempoolUpdate)
+							if err != nil {
+								e.logger.Errorf("Could not rollback expired mempool-update address txs:%v", err)
+								return
+							}
+						}
 					}()
 
 					lastMempoolUpdate = time.Now()
@@ -299,7 +408,7 @@ func Extract(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus) (*Extract
 				time.Sleep(time.Minute)
 
 				// This will attempt to resolve any of the transactions in the mempool missing data
-				err = e.ResolveTxInputs(Symbol, blocc.BlockIdMempool)
+				err = e.ResolveTxInputs(e.symbol, blocc.BlockIdMempool)
 				if err != nil {
 					e.logger.Fatalf("Could not empty the mempool:%v", err)
 				}
@@ -307,18 +416,28 @@ func Extract(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus) (*Extract
 		}()
 	}
 
+	// Relay the transactions sent by the API server through the peer
+	var txRelayHandler blocc.TxRelayHandler
+	if e.txFetch && e.txRelay != nil {
+		txRelayHandler, err = e.txRelay.HandleRelayTxs(e.symbol, e.RelayTx)
+		if err != nil {
+			return nil, fmt.Errorf("Could not HandleRelayTxs: %s", err)
+		}
+	}
+
 	// Close the peer if stop signal comes in and clean everything up
-	go func() {
-		conf.Stop.Add(1) // Hold shutdown until everything flushed
-		<-conf.Stop.Chan()
-		e.peer.Disconnect()
+	conf.Stop.Hold(func() { // Hold shutdown until everything flushed
+		if txRelayHandler != nil {
+			txRelayHandler.Close()
+		}
+		e.source.Disconnect()
 		e.Wait()                      // Wait until all in progress blocks are handled
 		e.blockHeaderTxMon.Shutdown() // Shutdown the monitor
 		if e.blockFetch {             // We're tracking blocks
 			// Get the current valid block
 			valid := e.validBlockStore.GetValidBlock()
 			// If we're behind more than blockValidationHeightDelta blocks mark them valid if they are stored
-			if valid != nil && valid.Height < int64(e.peer.LastBlock())-e.blockValidationHeightDelta {
+			if valid != nil && valid.Height < int64(e.source.LastBlock())-e.blockValidationHeightDelta {
 				e.logger.Info("Flushing BlockChainStore")
 				_, err = e.validateBlocksSimple(valid.Height)
 				if err != nil {
@@ -326,33 +445,50 @@ func Extract(blockChainStore blocc.BlockChainStore, txBus blocc.TxBus) (*Extract
 				}
 			}
 		}
-		conf.Stop.Done()
-	}()
+	})
 
 	return e, nil
 
 }
 
-// Establish the connection to the peer address and mark it connected.
+// Establish the connection to the source, it's an error if no peers could be connected
 func (e *Extractor) Connect() error {
 
-	// When we get a verack message we are ready to process
-	var err error
-	ready := make(chan struct{})
+	// Reset extractor state
+	e.Lock()
+	e.lastBlockHeightUnknown = false
+	e.Unlock()
+
+	return e.source.Connect()
+
+}
+
+// services returns the services advertised to peers, there is no witness service without segwit
+func (e *Extractor) services() wire.ServiceFlag {
+	if e.chain.NoSegwit {
+		return 0
+	}
+	return wire.SFNodeWitness
+}
+
+// peerConfig returns the config of a peer connection with the extractor listeners, ready is closed on verack
+func (e *Extractor) peerConfig(ready chan struct{}) *peer.Config {
 
 	peerConfig := &peer.Config{
 		UserAgentName:    conf.Executable, // User agent name to advertise.
 		UserAgentVersion: conf.GitVersion, // User agent version to advertise.
 		ChainParams:      e.chainParams,
-		Services:         wire.SFNodeWitness,
+		Services:         e.services(),
 		TrickleInterval:  time.Second * 10,
 		Listeners: peer.MessageListeners{
-			OnBlock:   e.OnBlock,
-			OnTx:      e.OnTx,
-			OnInv:     e.OnInv,
-			OnHeaders: e.OnHeaders,
+			OnBlock:    e.OnBlock,
+			OnTx:       e.OnTx,
+			OnInv:      e.OnInv,
+			OnHeaders:  e.OnHeaders,
+			OnGetData:  e.OnGetData,
+			OnNotFound: e.OnNotFound,
 			OnVerAck: func(p *peer.Peer, msg *wire.MsgVerAck) {
-				e.logger.Debug("Got VerAck")
+				e.logger.Debugw("Got VerAck", "peer", p.Addr())
 				close(ready)
 			},
 		},
@@ -364,50 +500,22 @@ func (e *Extractor) Connect() error {
 		peerConfig.Listeners.OnWrite = e.OnWrite
 	}
 
-	// Reset extractor state
-	e.Lock()
-	e.lastBlockHeightUnknown = false
-	e.Unlock()
-
-	// Create peer connection
-	e.peer, err = peer.NewOutboundPeer(peerConfig, net.JoinHostPort(config.GetString("extractor.btc.host"), config.GetString("extractor.btc.port")))
-	if err != nil {
-		return fmt.Errorf("Could not create outbound peer: %v", err)
-	}
-
-	conn, err := net.Dial("tcp", e.peer.Addr())
-	if err != nil {
-		return fmt.Errorf("Could not Dial peer: %v", err)
-	}
-
-	e.peer.AssociateConnection(conn)
-
-	// Wait until ready or timeout
-	select {
-	case <-ready:
-	case <-time.After(10 * time.Second):
-		return fmt.Errorf("Never got verack ready message")
-	}
-
-	e.logger.Infow("Connected to peer", "peer", e.peer.Addr(), "height", e.peer.StartingHeight(), "last_block_height", e.peer.LastBlock())
-
-	return nil
+	return peerConfig
 
 }
 
-// Disconnect will disconnect a peer
+// Disconnect will disconnect from the source
 func (e *Extractor) Disconnect() {
-	e.peer.Disconnect()
+	e.source.Disconnect()
 }
 
-// RequestHeaders will make a GetHeaders request of the peer
+// RequestHeaders will request the headers after start from the source
 func (e *Extractor) RequestHeaders(start string, stop string) (<-chan struct{}, error) {
 
 	startHash, err := chainhash.NewHashFromStr(start)
 	if err != nil {
 		return nil, fmt.Errorf("NewHashFromStr: error %v\n", err)
 	}
-	var locator blockchain.BlockLocator = []*chainhash.Hash{startHash}
 
 	// Stophash - All zero means fetch as many as we can
 	stopHash, err := chainhash.NewHashFromStr(stop)
@@ -422,9 +530,9 @@ func (e *Extractor) RequestHeaders(start string, stop string) (<-chan struct{},
 	}
 	defer e.Unlock()
 
-	err = e.peer.PushGetHeadersMsg(locator, stopHash)
+	err = e.source.RequestHeaders(startHash, stopHash)
 	if err != nil {
-		return nil, fmt.Errorf("PushGetHeadersMsg: error %v\n", err)
+		return nil, err
 	}
 
 	return e.waitHeaders, nil
@@ -437,13 +545,17 @@ func (e *Extractor) OnHeaders(p *peer.Peer, msg *wire.MsgHeaders) {
 
 	// Put all the headers in the cache
 	go func() {
-		for _, h := range msg.Headers {
-			prevBlockHeader, err := e.blockHeaderCache.GetBlockHeaderByBlockId(Symbol, h.PrevBlock.String())
+		for x, h := range msg.Headers {
+			prevBlockHeader, err := e.blockHeaderCache.GetBlockHeaderByBlockId(e.symbol, h.PrevBlock.String())
 			if err != nil || prevBlockHeader == nil {
 				e.logger.Warnw("Could not find prevBlock when parsing headers", "error", err, "prevBlockNil", prevBlockHeader == nil)
+				// Headers we requested should connect to what we have
+				if x == 0 && p != nil {
+					e.peers.Misbehaving(p, 1, "Headers do not connect")
+				}
 				continue
 			}
-			e.blockHeaderCache.InsertBlockHeader(Symbol, &blocc.BlockHeader{
+			e.blockHeaderCache.InsertBlockHeader(e.symbol, &blocc.BlockHeader{
 				BlockId:     h.BlockHash().String(),
 				Height:      prevBlockHeader.Height + 1,
 				PrevBlockId: prevBlockHeader.BlockId,
@@ -459,14 +571,13 @@ func (e *Extractor) OnHeaders(p *peer.Peer, msg *wire.MsgHeaders) {
 	}()
 }
 
-// RequestBlocks will send a GetBlocks Message to the peer
+// RequestBlocks will request the blocks after start from the source
 func (e *Extractor) RequestBlocks(start string, stop string) error {
 
 	startHash, err := chainhash.NewHashFromStr(start)
 	if err != nil {
 		return fmt.Errorf("NewHashFromStr: error %v", err)
 	}
-	var locator blockchain.BlockLocator = []*chainhash.Hash{startHash}
 
 	// Stophash - All zero means fetch 500
 	stopHash, err := chainhash.NewHashFromStr(stop)
@@ -474,44 +585,47 @@ func (e *Extractor) RequestBlocks(start string, stop string) error {
 		return fmt.Errorf("NewHashFromStr: error %v", err)
 	}
 
-	err = e.peer.PushGetBlocksMsg(locator, stopHash)
-	if err != nil {
-		return fmt.Errorf("PushGetBlocksMsg: error %v", err)
-	}
-
-	return nil
+	return e.source.RequestBlocks(startHash, stopHash)
 
 }
 
 // OnBlock is called when we receive a block message
 func (e *Extractor) OnBlock(p *peer.Peer, msg *wire.MsgBlock, buf []byte) {
 
-	// If we're only handling transaction, all we really need is the block height
+	// If we're only handling transaction, all we really need is the block height and which transactions it confirms
 	if e.txFetch {
+
 		// Fetch the previous block, use it's height to update the current block height being saved for mempool transactions
-		prevBlk, err := e.blockChainStore.GetBlockByBlockId(Symbol, msg.Header.PrevBlock.String(), blocc.BlockIncludeHeader)
+		prevBlk, err := e.blockChainStore.GetBlockByBlockId(e.symbol, msg.Header.PrevBlock.String(), blocc.BlockIncludeHeader)
 		if err == blocc.ErrNotFound {
 			e.Lock()
 			e.lastBlockHeightUnknown = true
 			e.Unlock()
+			go e.confirmMemPoolTxs(msg, blocc.HeightUnknown)
 			return
 		} else if err != nil {
 			e.logger.Errorf("Error OnBlock e.GetBlockByBlockId: %v", err)
+			go e.confirmMemPoolTxs(msg, blocc.HeightUnknown)
 			return
 		}
 		e.Lock()
 		e.lastBlockHeightUnknown = false
 		e.Unlock()
-		e.peer.UpdateLastBlockHeight(int32(prevBlk.Height + 1))
+		e.source.UpdateLastBlockHeight(int32(prevBlk.Height + 1))
+		go e.confirmMemPoolTxs(msg, prevBlk.Height+1)
 		return
 	}
 	// Otherwise handle the block
+	e.blockReceived(msg.BlockHash())
 	go e.handleBlock(msg)
 }
 
-// RequestMemPool will send a request for the peers mempool
+// RequestMemPool will request the mempool from the source
 func (e *Extractor) RequestMemPool() {
-	e.peer.QueueMessage(wire.NewMsgMemPool(), nil)
+	err := e.source.RequestMemPool()
+	if err != nil {
+		e.logger.Errorw("Could not request mempool", "error", err)
+	}
 }
 
 // OnTx is called when we receive a transaction
@@ -551,6 +665,11 @@ func (e *Extractor) OnInv(p *peer.Peer, msg *wire.MsgInv) {
 
 	// OnInv is invoked when a peer receives an inv bitcoin message. This is essentially the peer saying I have this piece of information
 	// We immediately request that piece of information if it's a transaction or a block
+	// Only the best peer's inventory is requested so the same data isn't requested from every peer
+	if !e.peers.IsBest(p) {
+		return
+	}
+
 	for _, iv := range msg.InvList {
 		switch iv.Type {
 		case wire.InvTypeTx:
@@ -558,8 +677,8 @@ func (e *Extractor) OnInv(p *peer.Peer, msg *wire.MsgInv) {
 			if e.txFetch {
 				e.logger.Debugw("Got Inv", "type", iv.Type, "txid", iv.Hash.String())
 				msg := wire.NewMsgGetData()
-				// Request the witness version
-				iv.Type |= wire.InvWitnessFlag
+				// Request the witness version if the chain has segwit
+				iv.Type = e.chain.invType(iv.Type)
 				err := msg.AddInvVect(iv)
 				if err != nil {
 					e.logger.Errorw("AddInvVect", "error", err)
@@ -574,8 +693,8 @@ func (e *Extractor) OnInv(p *peer.Peer, msg *wire.MsgInv) {
 			if bh == nil {
 				e.logger.Debugw("Got Inv", "type", iv.Type, "block_id", blockHash)
 				msg := wire.NewMsgGetData()
-				// Request the witness version
-				iv.Type |= wire.InvWitnessFlag
+				// Request the witness version if the chain has segwit
+				iv.Type = e.chain.invType(iv.Type)
 				err := msg.AddInvVect(iv)
 				if err != nil {
 					e.logger.Errorw("AddInvVect", "error", err)
@@ -588,6 +707,15 @@ func (e *Extractor) OnInv(p *peer.Peer, msg *wire.MsgInv) {
 	}
 }
 
+// OnNotFound is called when the peer does not have data we requested, a peer without the blocks it announced is misbehaving
+func (e *Extractor) OnNotFound(p *peer.Peer, msg *wire.MsgNotFound) {
+	for _, iv := range msg.InvList {
+		if iv.Type == wire.InvTypeBlock || iv.Type == wire.InvTypeWitnessBlock {
+			e.peers.Misbehaving(p, 10, "Block not found")
+		}
+	}
+}
+
 // OnRead is a low level function to capture raw messages coming in
 func (e *Extractor) OnRead(p *peer.Peer, bytesRead int, msg wire.Message, err error) {
 	e.logger.Debugw("Got Message", "type", reflect.TypeOf(msg), "size", bytesRead, "error", err)
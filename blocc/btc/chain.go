@@ -0,0 +1,137 @@
+package btc
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/btc/bscript"
+)
+
+// Chain is a Bitcoin derived coin the extractor and servers can handle. The extractor works with any chain that shares
+// the bitcoin wire protocol and block format, what differs is described here.
+type Chain struct {
+	// Symbol is the symbol the blocks and transactions are stored under
+	Symbol string
+	// Name is the name of the coin
+	Name string
+	// Networks are the chain parameters of each network by name (mainnet, testnet3...) including the genesis block,
+	// the network magic and the address encodings
+	Networks []*chaincfg.Params
+	// ExtractAddresses returns the script type, addresses and required signatures of an output script, nil uses
+	// bscript.ExtractAddresses which encodes them the same as bitcoin with the prefixes of the network
+	ExtractAddresses func(script []byte, params *chaincfg.Params) (string, []string, int, error)
+	// NormalizeAddress returns an address the way ExtractAddresses encodes it so other encodings of the same address
+	// can be searched for, nil leaves addresses as they are
+	NormalizeAddress func(address string, params *chaincfg.Params) string
+	// MaxBlockVSize is the virtual size of transactions that fit in a block, the size in bytes for chains without
+	// segwit. The fee estimates and projected blocks fill blocks of this size.
+	MaxBlockVSize int64
+
+	// NoVerifyScripts is set for chains with signatures txscript can not check, ie the replay protected signature hash
+	// of Bitcoin Cash. Their transactions are only checked by the node they are relayed to.
+	NoVerifyScripts bool
+
+	// NoSegwit is set for chains without segregated witness. Blocks and transactions are requested without the witness
+	// flag, the weight and vsize are not recorded and fee rates are per byte.
+	NoSegwit bool
+}
+
+var (
+	chains     = make(map[string]*Chain)
+	chainsLock sync.RWMutex
+)
+
+func init() {
+	RegisterChain(&Chain{
+		Symbol: Symbol,
+		Name:   "Bitcoin",
+		Networks: []*chaincfg.Params{
+			&chaincfg.MainNetParams,
+			&chaincfg.RegressionNetParams,
+			&chaincfg.SimNetParams,
+			&chaincfg.TestNet3Params,
+		},
+		MaxBlockVSize: 1000000, // 4M weight units
+	})
+}
+
+// RegisterChain makes a chain available by it's symbol, it panics if the symbol is already registered. Chains register
+// themselves when their package is imported.
+func RegisterChain(c *Chain) {
+	chainsLock.Lock()
+	defer chainsLock.Unlock()
+	if _, ok := chains[c.Symbol]; ok {
+		panic(fmt.Sprintf("Chain %s is already registered", c.Symbol))
+	}
+	chains[c.Symbol] = c
+}
+
+// GetChain returns the chain registered with the symbol or blocc.ErrUnknownSymbol
+func GetChain(symbol string) (*Chain, error) {
+	chainsLock.RLock()
+	defer chainsLock.RUnlock()
+	c, ok := chains[symbol]
+	if !ok {
+		return nil, blocc.ErrUnknownSymbol
+	}
+	return c, nil
+}
+
+// Chains returns the registered chains sorted by symbol
+func Chains() []*Chain {
+	chainsLock.RLock()
+	defer chainsLock.RUnlock()
+	ret := make([]*Chain, 0, len(chains))
+	for _, c := range chains {
+		ret = append(ret, c)
+	}
+	sort.Slice(ret, func(x, y int) bool { return ret[x].Symbol < ret[y].Symbol })
+	return ret
+}
+
+// Params returns the chain parameters of a network by name
+func (c *Chain) Params(network string) (*chaincfg.Params, error) {
+
+	// Find the selected network
+	for _, cp := range c.Networks {
+		if network == cp.Name {
+			return cp, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Could not find chain %s for %s", network, c.Symbol)
+
+}
+
+// extractAddresses returns the script type, addresses and required signatures of an output script for the chain
+func (c *Chain) extractAddresses(script []byte, params *chaincfg.Params) (string, []string, int, error) {
+	if c.ExtractAddresses != nil {
+		return c.ExtractAddresses(script, params)
+	}
+	return bscript.ExtractAddresses(script, params)
+}
+
+// NormalizeAddresses returns the addresses the way the chain stores them
+func (c *Chain) NormalizeAddresses(addresses []string, params *chaincfg.Params) []string {
+	if c.NormalizeAddress == nil || len(addresses) == 0 {
+		return addresses
+	}
+	ret := make([]string, len(addresses))
+	for x, address := range addresses {
+		ret[x] = c.NormalizeAddress(address, params)
+	}
+	return ret
+}
+
+// invType adds the witness flag to block and transaction requests for chains with segwit
+func (c *Chain) invType(t wire.InvType) wire.InvType {
+	if c.NoSegwit {
+		return t
+	}
+	return t | wire.InvWitnessFlag
+}
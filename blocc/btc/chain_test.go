@@ -0,0 +1,30 @@
+package btc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+func TestChains(t *testing.T) {
+
+	chain, err := GetChain(Symbol)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	params, err := chain.Params("testnet3")
+	assert.Nil(t, err)
+	assert.Equal(t, "testnet3", params.Name)
+	_, err = chain.Params("testnet4")
+	assert.NotNil(t, err)
+
+	_, err = GetChain("test")
+	assert.Equal(t, blocc.ErrUnknownSymbol, err)
+
+	// Symbols are unique
+	assert.Panics(t, func() { RegisterChain(&Chain{Symbol: Symbol}) })
+	assert.Equal(t, chain, Chains()[0])
+
+}
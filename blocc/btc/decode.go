@@ -0,0 +1,148 @@
+package btc
+
+import (
+	"crypto/sha256"
+	"strings"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/spf13/cast"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// DecodeTransaction decodes a serialized transaction into the same blocc.Tx as a stored transaction with the scripts
+// disassembled, the witnesses and the signature operation cost. The previous transactions are looked up with getPrevTxs
+// to resolve the inputs and fee, the transaction is incomplete if any are missing.
+func DecodeTransaction(raw []byte, chain *Chain, chainParams *chaincfg.Params, getPrevTxs func(txIds []string) ([]*blocc.Tx, error)) (*blocc.Tx, error) {
+
+	wTx, err := DecodeTx(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, txs, _ := newTx(wTx, blocc.HeightUnknown, chain, chainParams)
+	tx.Raw = raw
+
+	// Resolve the previous outputs
+	prevTxs := make(map[string]*blocc.Tx)
+	if !txs.Coinbase {
+		var txIds []string
+		for _, txIn := range tx.In {
+			if _, ok := prevTxs[txIn.TxId]; !ok {
+				prevTxs[txIn.TxId] = nil
+				txIds = append(txIds, txIn.TxId)
+			}
+		}
+		found, err := getPrevTxs(txIds)
+		if err != nil {
+			return nil, err
+		}
+		for _, prevTx := range found {
+			prevTxs[prevTx.TxId] = prevTx
+		}
+	}
+
+	// Signature operations are counted the same as blockchain.GetSigOpCost, without segwit they are not scaled
+	scale := blockchain.WitnessScaleFactor
+	if chain.NoSegwit {
+		scale = 1
+	}
+	var sigOpCost int
+	for height, vin := range wTx.TxIn {
+		txIn := tx.In[height]
+		txIn.Raw = vin.SignatureScript
+		txIn.Data["script_sig_asm"] = disasm(vin.SignatureScript)
+		if len(vin.Witness) > 0 {
+			txIn.Data["witness"] = strings.Join(parseWitness(vin.Witness), " ")
+		}
+
+		cost := txscript.GetSigOpCount(vin.SignatureScript) * scale
+
+		if !txs.Coinbase {
+			prevTx := prevTxs[txIn.TxId]
+			if prevTx == nil || int64(len(prevTx.Out)) <= txIn.Height || prevTx.Out[txIn.Height] == nil {
+				txs.Incomplete = true
+			} else {
+				txIn.Out = prevTx.Out[txIn.Height]
+				txs.InputValue += txIn.Out.Value
+				pkScript := []byte(txIn.Out.Raw)
+				if txscript.IsPayToScriptHash(pkScript) {
+					cost += txscript.GetPreciseSigOpCount(vin.SignatureScript, pkScript, true) * scale
+				}
+				if !chain.NoSegwit {
+					cost += txscript.GetWitnessSigOpCount(vin.SignatureScript, pkScript, vin.Witness)
+				}
+			}
+		}
+
+		txIn.Data["sigop_cost"] = cast.ToString(cost)
+		sigOpCost += cost
+	}
+
+	for height, vout := range wTx.TxOut {
+		txOut := tx.Out[height]
+		if txOut.Data == nil {
+			txOut.Data = make(map[string]string)
+		}
+		txOut.Data["asm"] = disasm(vout.PkScript)
+		cost := txscript.GetSigOpCount(vout.PkScript) * scale
+		txOut.Data["sigop_cost"] = cast.ToString(cost)
+		sigOpCost += cost
+	}
+	tx.Data["sigop_cost"] = cast.ToString(sigOpCost)
+	if !chain.NoSegwit {
+		tx.Data["stripped_size"] = cast.ToString(wTx.SerializeSizeStripped())
+	}
+
+	txs.setFee(tx)
+
+	return tx, nil
+
+}
+
+// DecodeScript decodes an output script with it's type, addresses and the P2SH and P2WSH addresses paying to it
+func DecodeScript(script []byte, chain *Chain, chainParams *chaincfg.Params) (*blocc.Script, error) {
+
+	s := &blocc.Script{
+		Asm:    disasm(script),
+		Sigops: int64(txscript.GetSigOpCount(script)),
+	}
+
+	scriptType, addresses, reqSigs, err := chain.extractAddresses(script, chainParams)
+	if err != nil {
+		s.Type = txscript.NonStandardTy.String()
+	} else {
+		s.Type = scriptType
+		s.Addresses = addresses
+		s.ReqSigs = int64(reqSigs)
+	}
+
+	// A script that already pays to a script hash or witness program can't be wrapped again
+	if !txscript.IsPayToScriptHash(script) {
+		address, err := btcutil.NewAddressScriptHash(script, chainParams)
+		if err != nil {
+			return nil, err
+		}
+		s.P2SH = address.EncodeAddress()
+	}
+	if !txscript.IsWitnessProgram(script) && !txscript.IsPayToScriptHash(script) {
+		hash := sha256.Sum256(script)
+		address, err := btcutil.NewAddressWitnessScriptHash(hash[:], chainParams)
+		if err != nil {
+			return nil, err
+		}
+		s.P2WSH = address.EncodeAddress()
+	}
+
+	return s, nil
+
+}
+
+// disasm disassembles a script, what could be parsed is returned with [error] for an invalid script
+func disasm(script []byte) string {
+	asm, _ := txscript.DisasmString(script)
+	return asm
+}
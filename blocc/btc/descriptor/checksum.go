@@ -0,0 +1,88 @@
+package descriptor
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The BIP380 descriptor checksum is a BCH code over the characters of the descriptor
+const (
+	inputCharset    = "0123456789()[],'/*abcdefgh@:$%{}IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+	checksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	checksumLength  = 8
+)
+
+var checksumGenerator = []uint64{0xf5dee51989, 0xa9fdca3312, 0x1bab10e32d, 0x3706b1677a, 0x644d626ffd}
+
+// Checksum returns the 8 character checksum of a descriptor (without the #checksum suffix)
+func Checksum(desc string) (string, error) {
+
+	symbols := make([]uint64, 0, len(desc)+len(desc)/3+checksumLength)
+	groups := make([]uint64, 0, 3)
+	for x, c := range desc {
+		v := strings.IndexRune(inputCharset, c)
+		if v < 0 {
+			return "", fmt.Errorf("Invalid character %q at position %d", c, x)
+		}
+		symbols = append(symbols, uint64(v&31))
+		groups = append(groups, uint64(v>>5))
+		if len(groups) == 3 {
+			symbols = append(symbols, groups[0]*9+groups[1]*3+groups[2])
+			groups = groups[:0]
+		}
+	}
+	switch len(groups) {
+	case 1:
+		symbols = append(symbols, groups[0])
+	case 2:
+		symbols = append(symbols, groups[0]*3+groups[1])
+	}
+	symbols = append(symbols, make([]uint64, checksumLength)...)
+
+	polymod := checksumPolymod(symbols) ^ 1
+	var sb strings.Builder
+	for x := 0; x < checksumLength; x++ {
+		sb.WriteByte(checksumCharset[(polymod>>uint(5*(checksumLength-1-x)))&31])
+	}
+
+	return sb.String(), nil
+
+}
+
+// splitChecksum splits desc#checksum and verifies the checksum if there is one
+func splitChecksum(s string) (string, error) {
+
+	pos := strings.LastIndexByte(s, '#')
+	if pos < 0 {
+		return s, nil
+	}
+
+	desc, checksum := s[:pos], s[pos+1:]
+	if len(checksum) != checksumLength {
+		return "", fmt.Errorf("Checksum must be %d characters", checksumLength)
+	}
+	expected, err := Checksum(desc)
+	if err != nil {
+		return "", err
+	}
+	if checksum != expected {
+		return "", fmt.Errorf("Invalid checksum %s, expected %s", checksum, expected)
+	}
+
+	return desc, nil
+
+}
+
+func checksumPolymod(symbols []uint64) uint64 {
+	chk := uint64(1)
+	for _, v := range symbols {
+		top := chk >> 35
+		chk = (chk&0x7ffffffff)<<5 ^ v
+		for x := uint(0); x < 5; x++ {
+			if (top>>x)&1 == 1 {
+				chk ^= checksumGenerator[x]
+			}
+		}
+	}
+	return chk
+}
@@ -0,0 +1,384 @@
+// Package descriptor parses BIP380 output descriptors and derives their output scripts and addresses
+package descriptor
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+
+	"git.coinninja.net/backend/blocc/blocc/btc/bscript"
+)
+
+var (
+	ErrPrivateKey          = errors.New("Private keys are not accepted")
+	ErrWrongNetwork        = errors.New("Key is for another network")
+	ErrNoAddress           = errors.New("Descriptor has no address")
+	errHardenedDerivations = errors.New("Hardened derivations are not possible from an extended public key")
+)
+
+// The contexts an expression can appear in
+const (
+	ctxTop     = iota
+	ctxSh      // Inside sh()
+	ctxWitness // Inside wpkh() or wsh()
+	ctxTr      // Inside tr()
+)
+
+const (
+	maxMultisigKeys     = 20
+	maxScriptHashScript = 520 // The maximum size of a P2SH redeem script
+)
+
+// Descriptor is a parsed output descriptor
+type Descriptor struct {
+	desc   string
+	root   *node
+	params *chaincfg.Params
+}
+
+// node is a SCRIPT expression
+type node struct {
+	fn        string
+	keys      []*key
+	threshold int
+	sub       *node
+	script    []byte // The fixed script of addr() and raw()
+}
+
+// Parse parses an output descriptor with an optional #checksum which is verified if present.
+// Supported are pk, pkh, wpkh, sh, wsh, multi, sortedmulti, tr (key path only), addr and raw.
+func Parse(s string, params *chaincfg.Params) (*Descriptor, error) {
+
+	desc, err := splitChecksum(strings.TrimSpace(s))
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := parseNode(desc, ctxTop, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Descriptor{
+		desc:   desc,
+		root:   root,
+		params: params,
+	}, nil
+
+}
+
+// String returns the descriptor with it's checksum
+func (d *Descriptor) String() string {
+	checksum, _ := Checksum(d.desc) // Already validated by Parse
+	return d.desc + "#" + checksum
+}
+
+// IsRange checks if the descriptor has a wildcard and derives a script for each index
+func (d *Descriptor) IsRange() bool {
+	return d.root.isRange()
+}
+
+// Script derives the output script at index. The index is ignored if the descriptor is not a range.
+func (d *Descriptor) Script(index uint32) ([]byte, error) {
+	return d.root.derive(index)
+}
+
+// Addresses derives the addresses at index the same way the extractor indexes outputs
+func (d *Descriptor) Addresses(index uint32) ([]string, error) {
+	script, err := d.Script(index)
+	if err != nil {
+		return nil, err
+	}
+	_, addresses, _, err := bscript.ExtractAddresses(script, d.params)
+	if err != nil {
+		return nil, err
+	}
+	if len(addresses) == 0 {
+		return nil, ErrNoAddress
+	}
+	return addresses, nil
+}
+
+// parseNode parses a SCRIPT expression in context ctx
+func parseNode(s string, ctx int, params *chaincfg.Params) (*node, error) {
+
+	open := strings.IndexByte(s, '(')
+	if open < 0 || !strings.HasSuffix(s, ")") {
+		return nil, fmt.Errorf("Invalid expression %s", s)
+	}
+	n := &node{fn: s[:open]}
+	args := splitArgs(s[open+1 : len(s)-1])
+
+	switch n.fn {
+	case "pk", "pkh", "wpkh":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s() takes one key", n.fn)
+		}
+		keyCtx := ctx
+		if n.fn == "wpkh" {
+			if ctx != ctxTop && ctx != ctxSh {
+				return nil, fmt.Errorf("wpkh() can only be used at the top level or inside sh()")
+			}
+			keyCtx = ctxWitness
+		}
+		k, err := parseKey(args[0], keyCtx, params)
+		if err != nil {
+			return nil, err
+		}
+		n.keys = []*key{k}
+
+	case "sh", "wsh":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s() takes one script", n.fn)
+		}
+		subCtx := ctxSh
+		if n.fn == "sh" && ctx != ctxTop {
+			return nil, fmt.Errorf("sh() can only be used at the top level")
+		} else if n.fn == "wsh" {
+			if ctx != ctxTop && ctx != ctxSh {
+				return nil, fmt.Errorf("wsh() can only be used at the top level or inside sh()")
+			}
+			subCtx = ctxWitness
+		}
+		sub, err := parseNode(args[0], subCtx, params)
+		if err != nil {
+			return nil, err
+		}
+		if sub.fn == "addr" || sub.fn == "raw" || sub.fn == "tr" {
+			return nil, fmt.Errorf("%s() can only be used at the top level", sub.fn)
+		}
+		n.sub = sub
+
+	case "multi", "sortedmulti":
+		if ctx == ctxTr {
+			return nil, fmt.Errorf("%s() can not be used inside tr()", n.fn)
+		}
+		if len(args) < 2 {
+			return nil, fmt.Errorf("%s() takes a threshold and at least one key", n.fn)
+		}
+		threshold, err := strconv.Atoi(args[0])
+		if err != nil || threshold < 1 || threshold > len(args)-1 {
+			return nil, fmt.Errorf("Invalid threshold %s for %d keys", args[0], len(args)-1)
+		}
+		if len(args)-1 > maxMultisigKeys {
+			return nil, fmt.Errorf("%s() takes at most %d keys", n.fn, maxMultisigKeys)
+		}
+		n.threshold = threshold
+		for _, arg := range args[1:] {
+			k, err := parseKey(arg, ctx, params)
+			if err != nil {
+				return nil, err
+			}
+			n.keys = append(n.keys, k)
+		}
+
+	case "tr":
+		if ctx != ctxTop {
+			return nil, fmt.Errorf("tr() can only be used at the top level")
+		}
+		if len(args) != 1 {
+			return nil, fmt.Errorf("tr() script trees are not supported")
+		}
+		k, err := parseKey(args[0], ctxTr, params)
+		if err != nil {
+			return nil, err
+		}
+		n.keys = []*key{k}
+
+	case "addr":
+		if ctx != ctxTop || len(args) != 1 {
+			return nil, fmt.Errorf("addr() takes one address at the top level")
+		}
+		address, err := btcutil.DecodeAddress(args[0], params)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid address %s: %v", args[0], err)
+		}
+		if !address.IsForNet(params) {
+			return nil, ErrWrongNetwork
+		}
+		if n.script, err = txscript.PayToAddrScript(address); err != nil {
+			return nil, err
+		}
+
+	case "raw":
+		if ctx != ctxTop || len(args) != 1 {
+			return nil, fmt.Errorf("raw() takes one script at the top level")
+		}
+		script, err := hex.DecodeString(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("Invalid script hex: %v", err)
+		}
+		n.script = script
+
+	default:
+		return nil, fmt.Errorf("Unsupported expression %s()", n.fn)
+	}
+
+	return n, nil
+
+}
+
+// splitArgs splits the arguments of an expression on the commas that are not nested
+func splitArgs(s string) []string {
+	var args []string
+	var depth, start int
+	for x, c := range s {
+		switch c {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, s[start:x])
+				start = x + 1
+			}
+		}
+	}
+	return append(args, s[start:])
+}
+
+func (n *node) isRange() bool {
+	if n.sub != nil {
+		return n.sub.isRange()
+	}
+	for _, k := range n.keys {
+		if k.wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// derive builds the script of the expression at index
+func (n *node) derive(index uint32) ([]byte, error) {
+
+	switch n.fn {
+	case "addr", "raw":
+		return n.script, nil
+
+	case "pk":
+		pubKey, err := n.keys[0].serialize(index)
+		if err != nil {
+			return nil, err
+		}
+		return txscript.NewScriptBuilder().AddData(pubKey).AddOp(txscript.OP_CHECKSIG).Script()
+
+	case "pkh":
+		pubKey, err := n.keys[0].serialize(index)
+		if err != nil {
+			return nil, err
+		}
+		return txscript.NewScriptBuilder().AddOp(txscript.OP_DUP).AddOp(txscript.OP_HASH160).
+			AddData(btcutil.Hash160(pubKey)).AddOp(txscript.OP_EQUALVERIFY).AddOp(txscript.OP_CHECKSIG).Script()
+
+	case "wpkh":
+		pubKey, err := n.keys[0].serialize(index)
+		if err != nil {
+			return nil, err
+		}
+		return txscript.NewScriptBuilder().AddOp(txscript.OP_0).AddData(btcutil.Hash160(pubKey)).Script()
+
+	case "sh":
+		script, err := n.sub.derive(index)
+		if err != nil {
+			return nil, err
+		}
+		if len(script) > maxScriptHashScript {
+			return nil, fmt.Errorf("sh() script is larger than %d bytes", maxScriptHashScript)
+		}
+		return txscript.NewScriptBuilder().AddOp(txscript.OP_HASH160).AddData(btcutil.Hash160(script)).
+			AddOp(txscript.OP_EQUAL).Script()
+
+	case "wsh":
+		script, err := n.sub.derive(index)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.Sum256(script)
+		return txscript.NewScriptBuilder().AddOp(txscript.OP_0).AddData(hash[:]).Script()
+
+	case "multi", "sortedmulti":
+		pubKeys := make([][]byte, len(n.keys))
+		for x, k := range n.keys {
+			pubKey, err := k.serialize(index)
+			if err != nil {
+				return nil, err
+			}
+			pubKeys[x] = pubKey
+		}
+		if n.fn == "sortedmulti" {
+			sort.Slice(pubKeys, func(i, j int) bool { return bytes.Compare(pubKeys[i], pubKeys[j]) < 0 })
+		}
+		builder := txscript.NewScriptBuilder().AddInt64(int64(n.threshold))
+		for _, pubKey := range pubKeys {
+			builder.AddData(pubKey)
+		}
+		return builder.AddInt64(int64(len(pubKeys))).AddOp(txscript.OP_CHECKMULTISIG).Script()
+
+	case "tr":
+		pubKey, err := n.keys[0].publicKey(index)
+		if err != nil {
+			return nil, err
+		}
+		outputKey, err := taprootOutputKey(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		return bscript.TaprootScript(outputKey)
+	}
+
+	return nil, fmt.Errorf("Unsupported expression %s()", n.fn)
+
+}
+
+// taprootOutputKey tweaks the internal key with no script tree as described in BIP341 and BIP86
+func taprootOutputKey(internalKey *btcec.PublicKey) ([]byte, error) {
+
+	curve := btcec.S256()
+
+	// Use the point with the even y coordinate
+	xOnly := internalKey.SerializeCompressed()[1:]
+	p, err := btcec.ParsePubKey(append([]byte{0x02}, xOnly...), curve)
+	if err != nil {
+		return nil, err
+	}
+
+	tweak := taggedHash("TapTweak", xOnly)
+	if new(big.Int).SetBytes(tweak).Cmp(curve.N) >= 0 {
+		return nil, fmt.Errorf("Invalid taproot tweak")
+	}
+	tx, ty := curve.ScalarBaseMult(tweak)
+	qx, qy := curve.Add(p.X, p.Y, tx, ty)
+	if qx.Sign() == 0 && qy.Sign() == 0 {
+		return nil, fmt.Errorf("Invalid taproot output key")
+	}
+
+	outputKey := make([]byte, 32)
+	qxBytes := qx.Bytes()
+	copy(outputKey[32-len(qxBytes):], qxBytes)
+
+	return outputKey, nil
+
+}
+
+// taggedHash is the BIP340 hash sha256(sha256(tag) || sha256(tag) || msg)
+func taggedHash(tag string, msg []byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	h.Write(msg)
+	return h.Sum(nil)
+}
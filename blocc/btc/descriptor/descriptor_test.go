@@ -0,0 +1,134 @@
+package descriptor
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/assert"
+)
+
+// The account keys of the test mnemonic "abandon abandon ... about" (BIP44, BIP84 and BIP86 accounts)
+const (
+	testXPub44 = "xpub6BosfCnifzxcFwrSzQiqu2DBVTshkCXacvNsWGYJVVhhawA7d4R5WSWGFNbi8Aw6ZRc1brxMyWMzG3DSSSSoekkudhUd9yLb6qx39T9nMdj"
+	testXPub84 = "xpub6CatWdiZiodmUeTDp8LT5or8nmbKNcuyvz7WyksVFkKB4RHwCD3XyuvPEbvqAQY3rAPshWcMLoP2fMFMKHPJ4ZeZXYVUhLv1VMrjPC7PW6V"
+	testXPub86 = "xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ"
+
+	testPubKey1 = "022f8bde4d1a07209355b4a7250a5c5128e88b84bddc619ab7cba8d569b240efe4"
+	testPubKey2 = "025cbdf0646e5db4eaa398f365f2ea7a0e3d419b7e0330e39ce92bddedcac4f9bc"
+)
+
+func TestChecksum(t *testing.T) {
+
+	checksum, err := Checksum("raw(deadbeef)")
+	assert.Nil(t, err)
+	assert.Equal(t, "89f8spxm", checksum)
+
+	checksum, err = Checksum("tr(" + testXPub86 + "/0/*)")
+	assert.Nil(t, err)
+	assert.Equal(t, "8e7pq23w", checksum)
+
+	_, err = Checksum("raw(deadbeef)\n")
+	assert.NotNil(t, err)
+
+}
+
+func TestAddresses(t *testing.T) {
+
+	for _, test := range []struct {
+		desc    string
+		index   uint32
+		address string
+	}{
+		{"pkh(" + testXPub44 + "/0/*)", 0, "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA"},
+		{"pkh([73c5da0a/44'/0'/0']" + testXPub44 + "/1/*)", 0, "1J3J6EvPrv8q6AC3VCjWV45Uf3nssNMRtH"},
+		{"wpkh(" + testXPub84 + "/0/*)", 0, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu"},
+		{"wpkh(" + testXPub84 + "/0/*)", 1, "bc1qnjg0jd8228aq7egyzacy8cys3knf9xvrerkf9g"},
+		{"wpkh(" + testXPub84 + "/1/0)", 5, "bc1q8c6fshw2dlwun7ekn9qwf37cu2rn755upcp6el"},
+		{"tr(" + testXPub86 + "/0/*)#8e7pq23w", 0, "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr"},
+		{"addr(bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu)", 0, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu"},
+	} {
+		d, err := Parse(test.desc, &chaincfg.MainNetParams)
+		if !assert.Nil(t, err, test.desc) {
+			continue
+		}
+		addresses, err := d.Addresses(test.index)
+		assert.Nil(t, err, test.desc)
+		assert.Equal(t, []string{test.address}, addresses, test.desc)
+	}
+
+	d, err := Parse("raw(deadbeef)", &chaincfg.MainNetParams)
+	assert.Nil(t, err)
+	assert.False(t, d.IsRange())
+	assert.Equal(t, "raw(deadbeef)#89f8spxm", d.String())
+	_, err = d.Addresses(0)
+	assert.Equal(t, ErrNoAddress, err)
+
+}
+
+func TestMulti(t *testing.T) {
+
+	expected := "5121" + testPubKey1 + "21" + testPubKey2 + "52ae"
+
+	// multi keeps the order, sortedmulti sorts the keys
+	for _, desc := range []string{
+		"multi(1," + testPubKey1 + "," + testPubKey2 + ")",
+		"sortedmulti(1," + testPubKey2 + "," + testPubKey1 + ")",
+	} {
+		d, err := Parse(desc, &chaincfg.MainNetParams)
+		if !assert.Nil(t, err, desc) {
+			continue
+		}
+		script, err := d.Script(0)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, hex.EncodeToString(script), desc)
+	}
+
+	d, err := Parse("sh(wsh(sortedmulti(2,"+testXPub84+"/0/*,"+testXPub44+"/0/*)))", &chaincfg.MainNetParams)
+	assert.Nil(t, err)
+	assert.True(t, d.IsRange())
+	a0, err := d.Addresses(0)
+	assert.Nil(t, err)
+	a1, err := d.Addresses(1)
+	assert.Nil(t, err)
+	assert.NotEqual(t, a0, a1)
+	assert.Equal(t, "3", a0[0][:1])
+
+}
+
+func TestParse(t *testing.T) {
+
+	for _, test := range []struct {
+		desc string
+		err  error
+	}{
+		{"pk(L4rK1yDtCWekvXuE6oXD9jCYfFNV2cWRpVuPLBcCU2z8TrisoyY1)", ErrPrivateKey},
+		{"wpkh(xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi/0/*)", ErrPrivateKey},
+		{"wpkh(" + testXPub84 + "/0h/*)", errHardenedDerivations},
+		{"wpkh(" + testXPub84 + "/0/*')", errHardenedDerivations},
+	} {
+		_, err := Parse(test.desc, &chaincfg.MainNetParams)
+		assert.Equal(t, test.err, err, test.desc)
+	}
+
+	_, err := Parse("wpkh("+testXPub84+"/0/*)", &chaincfg.TestNet3Params)
+	assert.Equal(t, ErrWrongNetwork, err)
+
+	for _, desc := range []string{
+		"raw(deadbeef)#89f8spxx",                            // Bad checksum
+		"raw(deadbeef)#89f8sp",                              // Short checksum
+		"sh(sh(pkh(" + testPubKey1 + ")))",                  // sh only at the top
+		"wsh(wpkh(" + testPubKey1 + "))",                    // wpkh not in wsh
+		"sh(raw(deadbeef))",                                 // raw only at the top
+		"wpkh(04" + testPubKey1[2:] + testPubKey1[2:] + ")", // Not a valid key
+		"tr(" + testPubKey1 + ",pk(" + testPubKey2 + "))",   // Script trees
+		"multi(3," + testPubKey1 + "," + testPubKey2 + ")",  // Threshold above the keys
+		"combo(" + testPubKey1 + ")",
+		"wpkh(" + testXPub84 + "/*/0)",
+		"pkh(" + testPubKey1,
+	} {
+		_, err := Parse(desc, &chaincfg.MainNetParams)
+		assert.NotNil(t, err, desc)
+	}
+
+}
@@ -0,0 +1,145 @@
+package descriptor
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+)
+
+// key is a KEY expression, either a fixed public key or an extended public key with a derivation path
+type key struct {
+	pubKey     *btcec.PublicKey
+	compressed bool
+
+	extKey   *hdkeychain.ExtendedKey // The extended key with the fixed part of the path already derived
+	wildcard bool                    // If the path ends in /* and the index is derived
+}
+
+// parseKey parses a KEY expression in context ctx
+func parseKey(s string, ctx int, params *chaincfg.Params) (*key, error) {
+
+	// The key origin is informational only, check it and drop it
+	if strings.HasPrefix(s, "[") {
+		end := strings.IndexByte(s, ']')
+		if end < 0 {
+			return nil, fmt.Errorf("Key origin %s is missing ]", s)
+		}
+		if err := checkOrigin(s[1:end]); err != nil {
+			return nil, err
+		}
+		s = s[end+1:]
+	}
+
+	if s == "" {
+		return nil, fmt.Errorf("Missing key")
+	}
+
+	// Hex public keys
+	if b, err := hex.DecodeString(s); err == nil {
+		if ctx == ctxTr && len(b) == 32 {
+			// x-only keys have an implicit even y coordinate
+			b = append([]byte{0x02}, b...)
+		}
+		pubKey, err := btcec.ParsePubKey(b, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("Invalid public key %s: %v", s, err)
+		}
+		compressed := btcec.IsCompressedPubKey(b)
+		if !compressed && (ctx == ctxWitness || ctx == ctxTr) {
+			return nil, fmt.Errorf("Uncompressed keys are not allowed in segwit descriptors")
+		}
+		return &key{pubKey: pubKey, compressed: compressed}, nil
+	}
+
+	if _, err := btcutil.DecodeWIF(s); err == nil {
+		return nil, ErrPrivateKey
+	}
+
+	// Extended public keys with an optional path
+	parts := strings.Split(s, "/")
+	extKey, err := hdkeychain.NewKeyFromString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse key %s: %v", parts[0], err)
+	}
+	if extKey.IsPrivate() {
+		return nil, ErrPrivateKey
+	}
+	if !extKey.IsForNet(params) {
+		return nil, ErrWrongNetwork
+	}
+
+	k := &key{compressed: true}
+	for x, part := range parts[1:] {
+		if part == "*" && x == len(parts)-2 {
+			k.wildcard = true
+			break
+		}
+		if strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") {
+			return nil, errHardenedDerivations
+		}
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || index >= hdkeychain.HardenedKeyStart {
+			return nil, fmt.Errorf("Invalid derivation step %s", part)
+		}
+		if extKey, err = extKey.Child(uint32(index)); err != nil {
+			return nil, fmt.Errorf("Could not derive %s: %v", part, err)
+		}
+	}
+
+	if k.wildcard {
+		k.extKey = extKey
+	} else if k.pubKey, err = extKey.ECPubKey(); err != nil {
+		return nil, err
+	}
+
+	return k, nil
+
+}
+
+// checkOrigin checks a key origin of a fingerprint followed by a (possibly hardened) path
+func checkOrigin(origin string) error {
+	parts := strings.Split(origin, "/")
+	if b, err := hex.DecodeString(parts[0]); err != nil || len(b) != 4 {
+		return fmt.Errorf("Invalid key origin fingerprint %s", parts[0])
+	}
+	for _, part := range parts[1:] {
+		part = strings.TrimRight(part, "'h")
+		if index, err := strconv.ParseUint(part, 10, 32); err != nil || index >= hdkeychain.HardenedKeyStart {
+			return fmt.Errorf("Invalid key origin step %s", part)
+		}
+	}
+	return nil
+}
+
+// publicKey returns the public key at index
+func (k *key) publicKey(index uint32) (*btcec.PublicKey, error) {
+	if !k.wildcard {
+		return k.pubKey, nil
+	}
+	if index >= hdkeychain.HardenedKeyStart {
+		return nil, errHardenedDerivations
+	}
+	child, err := k.extKey.Child(index)
+	if err != nil {
+		return nil, fmt.Errorf("Could not derive index %d: %v", index, err)
+	}
+	return child.ECPubKey()
+}
+
+// serialize returns the serialized public key at index
+func (k *key) serialize(index uint32) ([]byte, error) {
+	pubKey, err := k.publicKey(index)
+	if err != nil {
+		return nil, err
+	}
+	if k.compressed {
+		return pubKey.SerializeCompressed(), nil
+	}
+	return pubKey.SerializeUncompressed(), nil
+}
@@ -0,0 +1,319 @@
+package btc
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/peer"
+	"github.com/btcsuite/btcd/wire"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/conf"
+)
+
+// blockDownload is a set of blocks being requested from the connected peers in parallel. The blocks are split into
+// ranges of extractor.btc.block_download_range and each peer has up to extractor.btc.block_download_window blocks in
+// flight. A range a peer doesn't finish within extractor.btc.block_download_timeout is given to another peer.
+type blockDownload struct {
+	pending  []*blockRange
+	inFlight map[*peer.Peer][]*blockRange
+	ranges   map[chainhash.Hash]*blockRange
+	received chan chainhash.Hash
+	quit     chan struct{}
+}
+
+// blockRange is a range of blocks from a height and the peer it's requested from
+type blockRange struct {
+	height   int64
+	hashes   []chainhash.Hash
+	missing  map[chainhash.Hash]struct{}
+	peer     *peer.Peer
+	stalled  *peer.Peer
+	deadline time.Time
+}
+
+// DownloadBlocks requests the blocks after valid through height from the connected peers using the headers in the
+// blockHeaderCache. It returns once they are requested, the download continues until they are received or another
+// download is started.
+func (e *Extractor) DownloadBlocks(valid *blocc.BlockHeader, height int64) error {
+
+	// Follow the headers from the valid block
+	var hashes []chainhash.Hash
+	blockId := valid.BlockId
+	for h := valid.Height + 1; h <= height; h++ {
+		bh, err := e.blockHeaderCache.GetBlockHeaderByPrevBlockId(e.symbol, blockId)
+		if err != nil || bh == nil {
+			return fmt.Errorf("Could not find header at height %d: %v", h, err)
+		}
+		hash, err := chainhash.NewHashFromStr(bh.BlockId)
+		if err != nil {
+			return fmt.Errorf("NewHashFromStr: error %v", err)
+		}
+		hashes = append(hashes, *hash)
+		blockId = bh.BlockId
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	dl := newBlockDownload(hashes, valid.Height+1, e.blockDownloadRange)
+
+	// Replace any running download
+	e.downloadLock.Lock()
+	if e.download != nil {
+		close(e.download.quit)
+	}
+	e.download = dl
+	e.downloadLock.Unlock()
+
+	e.logger.Infow("Downloading blocks", "from", valid.Height+1, "to", height, "ranges", len(dl.pending))
+
+	go e.runBlockDownload(dl)
+
+	return nil
+
+}
+
+// newBlockDownload splits the blocks from height into ranges of rangeSize
+func newBlockDownload(hashes []chainhash.Hash, height int64, rangeSize int) *blockDownload {
+
+	if rangeSize < 1 {
+		rangeSize = 1
+	}
+
+	dl := &blockDownload{
+		inFlight: make(map[*peer.Peer][]*blockRange),
+		ranges:   make(map[chainhash.Hash]*blockRange),
+		received: make(chan chainhash.Hash, len(hashes)),
+		quit:     make(chan struct{}),
+	}
+	for x := 0; x < len(hashes); x += rangeSize {
+		end := x + rangeSize
+		if end > len(hashes) {
+			end = len(hashes)
+		}
+		br := &blockRange{
+			height:  height + int64(x),
+			hashes:  hashes[x:end],
+			missing: make(map[chainhash.Hash]struct{}),
+		}
+		for _, hash := range br.hashes {
+			br.missing[hash] = struct{}{}
+			dl.ranges[hash] = br
+		}
+		dl.pending = append(dl.pending, br)
+	}
+
+	return dl
+
+}
+
+// blockReceived marks a block of the running download as received
+func (e *Extractor) blockReceived(hash chainhash.Hash) {
+	e.downloadLock.Lock()
+	dl := e.download
+	e.downloadLock.Unlock()
+	if dl == nil {
+		return
+	}
+	// The channel holds every block of the download
+	select {
+	case dl.received <- hash:
+	default:
+	}
+}
+
+// runBlockDownload assigns the ranges to peers and reassigns them when they stall until the blocks are received
+func (e *Extractor) runBlockDownload(dl *blockDownload) {
+
+	defer func() {
+		e.downloadLock.Lock()
+		if e.download == dl {
+			e.download = nil
+		}
+		e.downloadLock.Unlock()
+	}()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		e.assignBlockRanges(dl)
+
+		if len(dl.pending) == 0 && len(dl.inFlight) == 0 {
+			return
+		}
+
+		select {
+		case hash := <-dl.received:
+			e.receiveBlock(dl, hash)
+
+		case now := <-ticker.C:
+			e.requeueBlockRanges(dl, now)
+
+		case <-dl.quit:
+			return
+
+		case <-conf.Stop.Chan():
+			return
+		}
+	}
+
+}
+
+// receiveBlock marks a block as received and removes it's range from the peer once it's complete
+func (e *Extractor) receiveBlock(dl *blockDownload, hash chainhash.Hash) {
+	br, ok := dl.ranges[hash]
+	if !ok {
+		return
+	}
+	delete(dl.ranges, hash)
+	delete(br.missing, hash)
+	// The peer is making progress
+	br.deadline = time.Now().Add(e.blockDownloadTimeout)
+	if len(br.missing) == 0 {
+		dl.remove(br)
+	}
+}
+
+// requeueBlockRanges takes back the ranges of stalled and disconnected peers and puts them first in the queue
+func (e *Extractor) requeueBlockRanges(dl *blockDownload, now time.Time) {
+
+	var requeue []*blockRange
+	for p, brs := range dl.inFlight {
+		for _, br := range brs {
+			if !p.Connected() || now.After(br.deadline) {
+				requeue = append(requeue, br)
+			}
+		}
+	}
+	for _, br := range requeue {
+		if br.peer.Connected() {
+			e.logger.Warnw("Block download stalled", "peer", br.peer.Addr(), "height", br.height, "missing", len(br.missing))
+			e.peers.StalledPeer(br.peer)
+			br.stalled = br.peer
+		}
+		dl.remove(br)
+		br.peer = nil
+	}
+	if len(requeue) > 0 {
+		// The lowest blocks are needed first
+		sort.Slice(requeue, func(i, j int) bool { return requeue[i].height < requeue[j].height })
+		dl.pending = append(requeue, dl.pending...)
+	}
+
+}
+
+// assignBlockRanges requests the pending ranges from the peers with room in their window, best peers first. Blocks more
+// than extractor.btc.block_concurrent above the valid block are not requested yet as handleBlock would hold them, a
+// range crossing that height is split and only the blocks below it are requested.
+func (e *Extractor) assignBlockRanges(dl *blockDownload) {
+
+	if len(dl.pending) == 0 {
+		return
+	}
+
+	valid := e.validBlockStore.GetValidBlock()
+	if valid == nil {
+		return
+	}
+
+	limit := valid.Height + e.blockConcurrent
+
+	peers := e.peers.Ranked()
+	for len(dl.pending) > 0 {
+		br := dl.pending[0]
+		if br.height > limit {
+			return
+		}
+		if last := br.height + int64(len(br.hashes)) - 1; last > limit {
+			dl.split(br, int(limit-br.height)+1)
+		}
+		// The stalled peer may have sent the rest after all
+		if len(br.missing) == 0 {
+			dl.pending = dl.pending[1:]
+			continue
+		}
+
+		// The best peer with room, a peer that stalled on this range only gets it back if there's nobody else
+		var assignTo *peer.Peer
+		for _, p := range peers {
+			if dl.inFlightCount(p)+len(br.missing) > e.blockDownloadWindow && len(dl.inFlight[p]) > 0 {
+				continue
+			}
+			if p == br.stalled && len(peers) > 1 {
+				continue
+			}
+			assignTo = p
+			break
+		}
+		if assignTo == nil {
+			return
+		}
+
+		msg := wire.NewMsgGetData()
+		for _, hash := range br.hashes {
+			if _, ok := br.missing[hash]; !ok {
+				continue
+			}
+			h := hash
+			err := msg.AddInvVect(wire.NewInvVect(e.chain.invType(wire.InvTypeBlock), &h))
+			if err != nil {
+				e.logger.Errorw("AddInvVect", "error", err)
+			}
+		}
+		assignTo.QueueMessage(msg, nil)
+
+		br.peer = assignTo
+		br.deadline = time.Now().Add(e.blockDownloadTimeout)
+		dl.inFlight[assignTo] = append(dl.inFlight[assignTo], br)
+		dl.pending = dl.pending[1:]
+	}
+
+}
+
+// inFlightCount returns the number of blocks requested from a peer and not yet received
+func (dl *blockDownload) inFlightCount(p *peer.Peer) int {
+	var count int
+	for _, br := range dl.inFlight[p] {
+		count += len(br.missing)
+	}
+	return count
+}
+
+// remove removes a range from it's peer
+func (dl *blockDownload) remove(br *blockRange) {
+	brs := dl.inFlight[br.peer]
+	for x := range brs {
+		if brs[x] == br {
+			brs = append(brs[:x], brs[x+1:]...)
+			break
+		}
+	}
+	if len(brs) == 0 {
+		delete(dl.inFlight, br.peer)
+	} else {
+		dl.inFlight[br.peer] = brs
+	}
+}
+
+// split moves the blocks of the first pending range after count into a new range queued right after it
+func (dl *blockDownload) split(br *blockRange, count int) {
+	rest := &blockRange{
+		height:  br.height + int64(count),
+		hashes:  br.hashes[count:],
+		missing: make(map[chainhash.Hash]struct{}),
+		stalled: br.stalled,
+	}
+	for _, hash := range rest.hashes {
+		if _, ok := br.missing[hash]; ok {
+			delete(br.missing, hash)
+			rest.missing[hash] = struct{}{}
+			dl.ranges[hash] = rest
+		}
+	}
+	br.hashes = br.hashes[:count]
+	dl.pending = append(dl.pending[:1], append([]*blockRange{rest}, dl.pending[1:]...)...)
+}
@@ -0,0 +1,260 @@
+package btc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/peer"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/btools"
+)
+
+func TestNewBlockDownload(t *testing.T) {
+
+	hashes := make([]chainhash.Hash, 10)
+	for x := range hashes {
+		hashes[x][0] = byte(x)
+	}
+
+	dl := newBlockDownload(hashes, 100, 4)
+	assert.Len(t, dl.pending, 3)
+	assert.Len(t, dl.ranges, 10)
+
+	assert.Equal(t, int64(100), dl.pending[0].height)
+	assert.Equal(t, int64(104), dl.pending[1].height)
+	assert.Equal(t, int64(108), dl.pending[2].height)
+	assert.Len(t, dl.pending[2].missing, 2)
+	assert.Equal(t, dl.pending[1], dl.ranges[hashes[5]])
+
+	// Every block is received without blocking
+	for _, hash := range hashes {
+		dl.received <- hash
+	}
+	assert.Len(t, dl.received, 10)
+
+	// The range can't be less than a block
+	assert.Len(t, newBlockDownload(hashes, 0, 0).pending, 10)
+
+}
+
+// newTestDownloadExtractor returns an extractor downloading from the peers with the blocks through height 99 valid
+func newTestDownloadExtractor(peers ...*peer.Peer) *Extractor {
+
+	pm := &peerManager{
+		logger:       zap.S(),
+		stallPenalty: time.Minute,
+		peers:        make(map[string]*peerState),
+	}
+	for _, p := range peers {
+		pm.peers[p.Addr()] = &peerState{addr: p.Addr(), peer: p}
+	}
+
+	validBlockStore := btools.NewValidBlockStoreMem()
+	validBlockStore.SetValidBlock(&blocc.BlockHeader{Height: 99})
+
+	chain, _ := GetChain(Symbol)
+	return &Extractor{
+		logger:               zap.S().With("package", "blocc.btc"),
+		chain:                chain,
+		symbol:               Symbol,
+		peers:                pm,
+		validBlockStore:      validBlockStore,
+		blockConcurrent:      30,
+		blockDownloadWindow:  8,
+		blockDownloadTimeout: time.Minute,
+	}
+
+}
+
+// testBlockHashes returns count distinct block hashes
+func testBlockHashes(count int) []chainhash.Hash {
+	hashes := make([]chainhash.Hash, count)
+	for x := range hashes {
+		hashes[x][0] = byte(x)
+	}
+	return hashes
+}
+
+// requestedBlocks waits for a getdata request and returns the blocks it requests
+func requestedBlocks(t *testing.T, getData chan *wire.MsgGetData) []chainhash.Hash {
+	select {
+	case msg := <-getData:
+		var hashes []chainhash.Hash
+		for _, iv := range msg.InvList {
+			assert.Equal(t, wire.InvTypeWitnessBlock, iv.Type)
+			hashes = append(hashes, iv.Hash)
+		}
+		return hashes
+	case <-time.After(5 * time.Second):
+		t.Fatal("No getdata request")
+	}
+	return nil
+}
+
+func TestBlockDownloadWindow(t *testing.T) {
+
+	p1, getData1 := newTestPeer(t, "127.0.0.1:1")
+	defer p1.Disconnect()
+	p2, getData2 := newTestPeer(t, "127.0.0.1:2")
+	defer p2.Disconnect()
+	e := newTestDownloadExtractor(p1, p2)
+
+	hashes := testBlockHashes(20)
+	dl := newBlockDownload(hashes, 100, 4)
+	e.assignBlockRanges(dl)
+
+	// Each peer gets two ranges filling it's window and the last range waits
+	assert.Equal(t, 8, dl.inFlightCount(p1))
+	assert.Equal(t, 8, dl.inFlightCount(p2))
+	assert.Len(t, dl.pending, 1)
+	assert.Equal(t, int64(116), dl.pending[0].height)
+	assert.Equal(t, hashes[0:4], requestedBlocks(t, getData1))
+	assert.Equal(t, hashes[4:8], requestedBlocks(t, getData1))
+	assert.Equal(t, hashes[8:12], requestedBlocks(t, getData2))
+	assert.Equal(t, hashes[12:16], requestedBlocks(t, getData2))
+
+	// A partly received range still takes room
+	e.receiveBlock(dl, hashes[0])
+	e.assignBlockRanges(dl)
+	assert.Equal(t, 7, dl.inFlightCount(p1))
+	assert.Len(t, dl.pending, 1)
+
+	// Once a range is done the window has room for the next one
+	for _, hash := range hashes[1:4] {
+		e.receiveBlock(dl, hash)
+	}
+	e.assignBlockRanges(dl)
+	assert.Empty(t, dl.pending)
+	assert.Equal(t, 8, dl.inFlightCount(p1))
+	assert.Equal(t, hashes[16:20], requestedBlocks(t, getData1))
+
+}
+
+func TestBlockDownloadOutOfOrder(t *testing.T) {
+
+	p, getData := newTestPeer(t, "127.0.0.1:1")
+	defer p.Disconnect()
+	e := newTestDownloadExtractor(p)
+	e.blockDownloadWindow = 100
+
+	hashes := testBlockHashes(8)
+	dl := newBlockDownload(hashes, 100, 4)
+	e.assignBlockRanges(dl)
+	assert.Equal(t, hashes[0:4], requestedBlocks(t, getData))
+	assert.Equal(t, hashes[4:8], requestedBlocks(t, getData))
+
+	// The second range completes first, in reverse
+	for x := 7; x >= 4; x-- {
+		e.receiveBlock(dl, hashes[x])
+	}
+	if assert.Len(t, dl.inFlight[p], 1) {
+		assert.True(t, dl.inFlight[p][0] == dl.ranges[hashes[0]])
+	}
+
+	// Blocks that are not part of the download or already received are ignored
+	e.receiveBlock(dl, chainhash.Hash{0xff})
+	e.receiveBlock(dl, hashes[7])
+	assert.Equal(t, 4, dl.inFlightCount(p))
+
+	for _, x := range []int{2, 0, 3, 1} {
+		e.receiveBlock(dl, hashes[x])
+	}
+	assert.Empty(t, dl.inFlight)
+	assert.Empty(t, dl.pending)
+	assert.Empty(t, dl.ranges)
+
+}
+
+func TestBlockDownloadStalled(t *testing.T) {
+
+	p1, getData1 := newTestPeer(t, "127.0.0.1:1")
+	defer p1.Disconnect()
+	p2, getData2 := newTestPeer(t, "127.0.0.1:2")
+	e := newTestDownloadExtractor(p1, p2)
+	e.blockDownloadWindow = 4
+
+	hashes := testBlockHashes(8)
+	dl := newBlockDownload(hashes, 100, 4)
+	e.assignBlockRanges(dl)
+	assert.Equal(t, hashes[0:4], requestedBlocks(t, getData1))
+	assert.Equal(t, hashes[4:8], requestedBlocks(t, getData2))
+	e.blockDownloadWindow = 8
+
+	// Nothing is taken back before the deadline
+	e.receiveBlock(dl, hashes[1])
+	e.requeueBlockRanges(dl, time.Now())
+	assert.Empty(t, dl.pending)
+
+	// The first peer stalls while the second makes progress
+	dl.ranges[hashes[4]].deadline = time.Now().Add(time.Hour)
+	e.requeueBlockRanges(dl, time.Now().Add(2*e.blockDownloadTimeout))
+	if assert.Len(t, dl.pending, 1) {
+		assert.Equal(t, int64(100), dl.pending[0].height)
+		// Peers are compared by pointer as they are in use
+		assert.True(t, dl.pending[0].stalled == p1)
+		assert.True(t, dl.pending[0].peer == nil)
+	}
+	_, ok := dl.inFlight[p1]
+	assert.False(t, ok)
+	assert.Equal(t, 1, e.peers.peers[p1.Addr()].stalls)
+	assert.Equal(t, 0, e.peers.peers[p2.Addr()].stalls)
+
+	// The missing blocks go to the other peer
+	e.assignBlockRanges(dl)
+	assert.Empty(t, dl.pending)
+	assert.Equal(t, []chainhash.Hash{hashes[0], hashes[2], hashes[3]}, requestedBlocks(t, getData2))
+	assert.Equal(t, 7, dl.inFlightCount(p2))
+
+	// When that peer disconnects everything goes back to the first one, it's not counted as a stall
+	p2.Disconnect()
+	p2.WaitForDisconnect()
+	e.requeueBlockRanges(dl, time.Now())
+	if assert.Len(t, dl.pending, 2) {
+		assert.Equal(t, int64(100), dl.pending[0].height)
+		assert.Equal(t, int64(104), dl.pending[1].height)
+	}
+	assert.Equal(t, 0, e.peers.peers[p2.Addr()].stalls)
+	e.assignBlockRanges(dl)
+	assert.Empty(t, dl.pending)
+	assert.Equal(t, []chainhash.Hash{hashes[0], hashes[2], hashes[3]}, requestedBlocks(t, getData1))
+	assert.Equal(t, hashes[4:8], requestedBlocks(t, getData1))
+
+}
+
+func TestBlockDownloadConcurrent(t *testing.T) {
+
+	p, getData := newTestPeer(t, "127.0.0.1:1")
+	defer p.Disconnect()
+	e := newTestDownloadExtractor(p)
+	e.blockConcurrent = 6
+	e.blockDownloadWindow = 100
+
+	// Blocks through 105 can be requested, the second range is split at it
+	hashes := testBlockHashes(12)
+	dl := newBlockDownload(hashes, 100, 4)
+	e.assignBlockRanges(dl)
+	assert.Equal(t, hashes[0:4], requestedBlocks(t, getData))
+	assert.Equal(t, hashes[4:6], requestedBlocks(t, getData))
+	if assert.Len(t, dl.pending, 2) {
+		assert.Equal(t, int64(106), dl.pending[0].height)
+		assert.Equal(t, hashes[6:8], dl.pending[0].hashes)
+		assert.True(t, dl.pending[0] == dl.ranges[hashes[7]])
+		assert.Equal(t, int64(108), dl.pending[1].height)
+	}
+	assert.Equal(t, 6, dl.inFlightCount(p))
+
+	// The rest follows as blocks are validated
+	e.validBlockStore.SetValidBlock(&blocc.BlockHeader{Height: 103})
+	e.assignBlockRanges(dl)
+	assert.Equal(t, hashes[6:8], requestedBlocks(t, getData))
+	assert.Equal(t, hashes[8:10], requestedBlocks(t, getData))
+	if assert.Len(t, dl.pending, 1) {
+		assert.Equal(t, int64(110), dl.pending[0].height)
+	}
+
+}
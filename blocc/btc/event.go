@@ -0,0 +1,18 @@
+package btc
+
+import (
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// publishEvent sends an event on the EventBus and to the webhooks if there are any
+func (e *Extractor) publishEvent(event *blocc.Event) {
+	if e.eventBus != nil {
+		err := e.eventBus.PublishEvent(event.Symbol, event)
+		if err != nil {
+			e.logger.Errorw("Could not EventBus PublishEvent", "error", err, "type", event.Type)
+		}
+	}
+	if e.webhooks != nil {
+		e.webhooks.HandleEvent(event)
+	}
+}
@@ -28,9 +28,9 @@ func (e *Extractor) fetchBlockChain() {
 		loopStartTime := time.Now()
 
 		// If we're disconnected, attempt to reconnect - this resets the state
-		if !e.peer.Connected() {
+		if !e.source.Connected() {
 			// Ensure we're disconnected
-			e.peer.Disconnect()
+			e.source.Disconnect()
 			// Reconnect
 			e.logger.Warn("Attempting peer reconnect")
 			err := e.Connect()
@@ -51,7 +51,7 @@ func (e *Extractor) fetchBlockChain() {
 		e.blockHeaderTxMon.AddBlockHeader(valid, e.blockHeaderTxMonBHLifetime)
 
 		// How tall is our peer block height
-		peerBlockHeight := int64(e.peer.LastBlock())
+		peerBlockHeight := int64(e.source.LastBlock())
 
 		// Validate the block chain up to this point
 		if loopStartTime.Sub(lastValidateBlockChain) > e.blockValidationInterval {
@@ -65,7 +65,7 @@ func (e *Extractor) fetchBlockChain() {
 			if valid.Height >= peerBlockHeight-1 && e.lastBlockHeightUnknown {
 				e.logger.Info("Unknown height blocks detected on caught up BlockChain. Resetting Connection.")
 				e.RUnlock()
-				e.peer.Disconnect()
+				e.source.Disconnect()
 				time.Sleep(time.Second)
 				continue
 			}
@@ -82,7 +82,7 @@ func (e *Extractor) fetchBlockChain() {
 				newValid, err = e.validateBlocksSimple(valid.Height)
 			} else {
 				// Validate the more recent block chain completely up to peerBlockheight - blockValidationHeightHoldOff
-				newValid, err = e.ValidateBlockChain(Symbol, peerBlockHeight-e.blockValidationHeightHoldOff)
+				newValid, err = e.ValidateBlockChain(e.symbol, peerBlockHeight-e.blockValidationHeightHoldOff)
 			}
 
 			if err == blocc.ErrInvalidBlock || err == blocc.ErrMissingBlock || err == blocc.ErrMissingInput {
@@ -109,7 +109,7 @@ func (e *Extractor) fetchBlockChain() {
 		}
 
 		// Get the current height of our header cache
-		topHeader, err := e.blockHeaderCache.GetTopBlockHeader(Symbol)
+		topHeader, err := e.blockHeaderCache.GetTopBlockHeader(e.symbol)
 		if err != nil || topHeader == nil {
 			e.logger.Fatalw("Could not blockHeaderCache.GetTopBlockHeader", "error", err, "validNil", valid == nil)
 		}
@@ -132,9 +132,11 @@ func (e *Extractor) fetchBlockChain() {
 				case <-gotHeaders:
 				case <-time.After(5 * time.Minute):
 					e.logger.Infow("Timeout waiting for headers", "block", valid, "header", topHeader)
-					// This usually seems to be the fault of a dropped message. Disconnecting resets the state.
-					e.Disconnect()
-					time.Sleep(time.Minute)
+					// This usually seems to be the fault of a dropped message. Fail over to another peer or reconnect to reset the state.
+					e.source.Stalled()
+					if !e.source.Connected() {
+						time.Sleep(time.Minute)
+					}
 					// Continue and reconnect
 				}
 				continue
@@ -144,10 +146,10 @@ func (e *Extractor) fetchBlockChain() {
 		// Expire other blocks and headers below current peer height - blockValidationHeightDelta or the valid height whichever is less
 		// as this is the range the valid hight could be rolled back to if there is a problem and we want to ensure we have all the necessary headers
 		if peerBlockHeight-e.blockValidationHeightDelta <= valid.Height {
-			e.blockHeaderCache.ExpireBlockHeaderBelowHeight(Symbol, peerBlockHeight-e.blockValidationHeightDelta-1)
+			e.blockHeaderCache.ExpireBlockHeaderBelowHeight(e.symbol, peerBlockHeight-e.blockValidationHeightDelta-1)
 			e.blockHeaderTxMon.ExpireBlockHeadersBelowBlockHeight(peerBlockHeight - e.blockValidationHeightDelta - 1)
 		} else {
-			e.blockHeaderCache.ExpireBlockHeaderBelowHeight(Symbol, valid.Height)
+			e.blockHeaderCache.ExpireBlockHeaderBelowHeight(e.symbol, valid.Height)
 			e.blockHeaderTxMon.ExpireBlockHeadersBelowBlockHeight(valid.Height)
 		}
 
@@ -171,9 +173,9 @@ func (e *Extractor) fetchBlockChain() {
 			// If we have retried the current block more than one, it likely means there is some issue with the blockChainStore
 			if lastValidBlockRequestedRetries > 1 {
 				// Reset the state, load the last valid block from disk
-				e.blockChainStore.FlushBlocks(Symbol)
-				e.blockChainStore.FlushTransactions(Symbol)
-				valid, err = e.blockChainStore.GetBlockHeaderTopByStatuses(Symbol, []string{blocc.StatusValid})
+				e.blockChainStore.FlushBlocks(e.symbol)
+				e.blockChainStore.FlushTransactions(e.symbol)
+				valid, err = e.blockChainStore.GetBlockHeaderTopByStatuses(e.symbol, []string{blocc.StatusValid})
 				if err != nil {
 					e.logger.Fatalw("blockChainStore.GetBlockHeaderTopByStatuses", "error", err)
 				}
@@ -185,17 +187,27 @@ func (e *Extractor) fetchBlockChain() {
 			// This might be because something didn't make it to redis/the valid block store
 			// Clear and reset the valid block store to the last valid block
 			e.validBlockStore.SetValidBlock(valid)
-			// Disconnect the peer, wait and reconnect above
-			e.Disconnect()
-			time.Sleep(time.Minute)
+			// Fail over to another peer or disconnect, wait and reconnect above
+			e.source.Stalled()
+			if !e.source.Connected() {
+				time.Sleep(time.Minute)
+			}
 			continue
 		} else {
 			// Reset the retries counter
 			lastValidBlockRequestedRetries = 0
 		}
 
-		// Make the block request
-		e.RequestBlocks(valid.BlockId, "0")
+		// Make the block request, if we have the headers spread it across the peers otherwise ask the source
+		if e.blockDownloadParallel && e.peers != nil && topHeader.Height >= expectedBlockHeight {
+			err = e.DownloadBlocks(valid, expectedBlockHeight)
+			if err != nil {
+				e.logger.Warnw("Could not download blocks from peers", "from", valid, "error", err)
+				e.RequestBlocks(valid.BlockId, "0")
+			}
+		} else {
+			e.RequestBlocks(valid.BlockId, "0")
+		}
 		lastValidBlockRequested = valid.BlockId
 
 		// If we have no block for extractor.btc.block_timeout, assume it stalled
@@ -254,22 +266,22 @@ func (e *Extractor) fetchBlockChain() {
 func (e *Extractor) validateBlocksSimple(height int64) (*blocc.BlockHeader, error) {
 
 	// Ensure the blockChainStore is operating properly and flush everything without error
-	if err := e.blockChainStore.FlushBlocks(Symbol); err != nil {
+	if err := e.blockChainStore.FlushBlocks(e.symbol); err != nil {
 		// Make sure we flush as much as we can before we die
-		e.blockChainStore.FlushTransactions(Symbol)
+		e.blockChainStore.FlushTransactions(e.symbol)
 		return nil, fmt.Errorf("blockChainStore.FlushBlocks error:%v", err)
 	}
-	if err := e.blockChainStore.FlushTransactions(Symbol); err != nil {
+	if err := e.blockChainStore.FlushTransactions(e.symbol); err != nil {
 		return nil, fmt.Errorf("blockChainStore.FlushTransactions error:%v", err)
 	}
 
 	// If there are invalid blocks up until height, there is nothing to do, this needs to be fixed, exit out returning the highest valid block height we have
-	blks, err := e.blockChainStore.FindBlocksByStatusAndHeight(Symbol, []string{blocc.StatusInvalid}, blocc.HeightUnknown, height, blocc.BlockIncludeHeader, 0, 1)
+	blks, err := e.blockChainStore.FindBlocksByStatusAndHeight(e.symbol, []string{blocc.StatusInvalid}, blocc.HeightUnknown, height, blocc.BlockIncludeHeader, 0, 1)
 	if err != nil && err != blocc.ErrNotFound {
 		return nil, fmt.Errorf("Could not blockChainStore.FindBlocksByStatusAndHeight: %v", err)
 	} else if err == nil {
 		// We found an invalid block, the last non-valid block should be one before this one
-		lastValidBlockHeader, err := e.blockChainStore.GetBlockHeaderTopByStatuses(Symbol, []string{blocc.StatusValid})
+		lastValidBlockHeader, err := e.blockChainStore.GetBlockHeaderTopByStatuses(e.symbol, []string{blocc.StatusValid})
 		if err != nil {
 			return nil, fmt.Errorf("Could not fetch last valid block from invalid %v blockChainStore.GetBlockHeaderTopByStatuses: %v", blks[0], err)
 		}
@@ -277,7 +289,7 @@ func (e *Extractor) validateBlocksSimple(height int64) (*blocc.BlockHeader, erro
 	}
 
 	// Update the status through the given height to valid as long as it's marked as new
-	err = e.blockChainStore.UpdateBlockStatusByStatusesAndHeight(Symbol, []string{blocc.StatusNew}, blocc.HeightUnknown, height, blocc.StatusValid)
+	err = e.blockChainStore.UpdateBlockStatusByStatusesAndHeight(e.symbol, []string{blocc.StatusNew}, blocc.HeightUnknown, height, blocc.StatusValid)
 	if err != nil {
 		return nil, fmt.Errorf("Could not blockChainStore.UpdateBlockStatusByHeight: %v", err)
 	}
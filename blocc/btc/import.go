@@ -0,0 +1,322 @@
+package btc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/conf"
+)
+
+// blockFileEntry is where a block is stored in the block files
+type blockFileEntry struct {
+	file   string
+	offset int64
+	size   uint32
+	header wire.BlockHeader
+}
+
+// blockFiles is an index of the blocks in the blk*.dat files of a Bitcoin Core data directory
+type blockFiles struct {
+	dir    string
+	key    []byte
+	blocks map[chainhash.Hash]*blockFileEntry
+}
+
+// blockFilesDir finds the blocks directory for the chain in a Bitcoin Core data directory, the blocks directory itself
+// can also be given
+func blockFilesDir(datadir string, chainParams *chaincfg.Params) (string, error) {
+
+	dirs := []string{filepath.Join(datadir, "blocks"), datadir}
+	if chainParams.Name != chaincfg.MainNetParams.Name {
+		dirs = append([]string{filepath.Join(datadir, chainParams.Name, "blocks")}, dirs...)
+	}
+
+	for _, dir := range dirs {
+		files, err := filepath.Glob(filepath.Join(dir, "blk*.dat"))
+		if err == nil && len(files) > 0 {
+			return dir, nil
+		}
+	}
+
+	return "", fmt.Errorf("Could not find blk*.dat files in %s", datadir)
+
+}
+
+// indexBlockFiles reads the headers of every block in the block files for the network
+func indexBlockFiles(dir string, net wire.BitcoinNet) (*blockFiles, error) {
+
+	bf := &blockFiles{
+		dir:    dir,
+		blocks: make(map[chainhash.Hash]*blockFileEntry),
+	}
+
+	// Newer versions of Bitcoin Core obfuscate the block files with the key in xor.dat
+	key, err := ioutil.ReadFile(filepath.Join(dir, "xor.dat"))
+	if err == nil && len(key) > 0 {
+		bf.key = key
+	} else if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("Could not read xor.dat: %v", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "blk*.dat"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		err = bf.indexBlockFile(file, net)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return bf, nil
+
+}
+
+// indexBlockFile reads the headers of the blocks in a block file, only the headers are read and the rest of each block
+// is skipped
+func (bf *blockFiles) indexBlockFile(file string, net wire.BitcoinNet) error {
+
+	f, err := os.Open(file)
+	if err != nil {
+		return fmt.Errorf("Could not open %s: %v", file, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat %s: %v", file, err)
+	}
+	fileSize := info.Size()
+
+	r := bufio.NewReader(f)
+	prefix := make([]byte, 8)
+	header := make([]byte, wire.MaxBlockHeaderPayload)
+
+	// Each block is the network magic, the size and the block, the rest of the file is zeros once the blocks end
+	var offset int64
+	for offset+8 <= fileSize {
+		if _, err = io.ReadFull(r, prefix); err != nil {
+			return fmt.Errorf("Could not read %s at %d: %v", file, offset, err)
+		}
+		bf.xor(prefix, offset)
+		magic := wire.BitcoinNet(binary.LittleEndian.Uint32(prefix))
+		if magic == 0 {
+			break
+		} else if magic != net {
+			return fmt.Errorf("Unexpected network magic %08x in %s at %d", uint32(magic), file, offset)
+		}
+		size := binary.LittleEndian.Uint32(prefix[4:])
+		offset += 8
+		if offset+int64(size) > fileSize || size < wire.MaxBlockHeaderPayload {
+			return fmt.Errorf("Truncated block in %s at %d", file, offset)
+		}
+
+		if _, err = io.ReadFull(r, header); err != nil {
+			return fmt.Errorf("Could not read block header in %s at %d: %v", file, offset, err)
+		}
+		bf.xor(header, offset)
+		entry := &blockFileEntry{
+			file:   file,
+			offset: offset,
+			size:   size,
+		}
+		err = entry.header.Deserialize(bytes.NewReader(header))
+		if err != nil {
+			return fmt.Errorf("Could not decode block header in %s at %d: %v", file, offset, err)
+		}
+		bf.blocks[entry.header.BlockHash()] = entry
+
+		// Skip the transactions, seeking when they are not already buffered
+		offset += int64(size)
+		if skip := int(size - wire.MaxBlockHeaderPayload); skip <= r.Buffered() {
+			_, err = r.Discard(skip)
+		} else {
+			_, err = f.Seek(offset, io.SeekStart)
+			r.Reset(f)
+		}
+		if err != nil {
+			return fmt.Errorf("Could not skip block in %s at %d: %v", file, offset, err)
+		}
+	}
+
+	return nil
+
+}
+
+// xor removes the obfuscation from data read at offset of a block file
+func (bf *blockFiles) xor(data []byte, offset int64) {
+	if len(bf.key) == 0 {
+		return
+	}
+	keyLen := int64(len(bf.key))
+	for x := range data {
+		data[x] ^= bf.key[(offset+int64(x))%keyLen]
+	}
+}
+
+// bestChain returns the blocks after the block start on the chain with the most work
+func (bf *blockFiles) bestChain(start chainhash.Hash) []*blockFileEntry {
+
+	children := make(map[chainhash.Hash][]chainhash.Hash)
+	for hash, entry := range bf.blocks {
+		children[entry.header.PrevBlock] = append(children[entry.header.PrevBlock], hash)
+	}
+
+	// Walk every branch from the start, parents before children
+	work := map[chainhash.Hash]*big.Int{start: big.NewInt(0)}
+	best := start
+	queue := []chainhash.Hash{start}
+	for len(queue) > 0 {
+		hash := queue[0]
+		queue = queue[1:]
+		for _, child := range children[hash] {
+			work[child] = new(big.Int).Add(work[hash], blockchain.CalcWork(bf.blocks[child].header.Bits))
+			if work[child].Cmp(work[best]) > 0 {
+				best = child
+			}
+			queue = append(queue, child)
+		}
+	}
+
+	// Follow the best tip back to the start
+	var chain []*blockFileEntry
+	for hash := best; hash != start; hash = bf.blocks[hash].header.PrevBlock {
+		chain = append(chain, bf.blocks[hash])
+	}
+	for x, y := 0, len(chain)-1; x < y; x, y = x+1, y-1 {
+		chain[x], chain[y] = chain[y], chain[x]
+	}
+
+	return chain
+
+}
+
+// readBlock reads a block from the block files
+func (bf *blockFiles) readBlock(entry *blockFileEntry) (*wire.MsgBlock, error) {
+
+	f, err := os.Open(entry.file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	data := make([]byte, entry.size)
+	_, err = f.ReadAt(data, entry.offset)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	bf.xor(data, entry.offset)
+
+	var msg wire.MsgBlock
+	err = msg.Deserialize(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	return &msg, nil
+
+}
+
+// ImportBlocks reads the blocks after the valid block from the blk*.dat files of a Bitcoin Core data directory and
+// handles them in order the same as blocks from the peers. It returns once the last block in the files is handled.
+func (e *Extractor) ImportBlocks(datadir string) error {
+
+	dir, err := blockFilesDir(datadir, e.chainParams)
+	if err != nil {
+		return err
+	}
+
+	e.logger.Infow("Indexing block files", "dir", dir)
+	bf, err := indexBlockFiles(dir, e.chainParams.Net)
+	if err != nil {
+		return err
+	}
+
+	valid := e.validBlockStore.GetValidBlock()
+	if valid == nil {
+		return fmt.Errorf("No valid block to import from")
+	}
+	start, err := chainhash.NewHashFromStr(valid.BlockId)
+	if err != nil {
+		return fmt.Errorf("NewHashFromStr: error %v", err)
+	}
+
+	chain := bf.bestChain(*start)
+	if len(chain) == 0 {
+		e.logger.Infow("No blocks to import", "blocks", len(bf.blocks), "valid", valid)
+		return nil
+	}
+	tipHeight := valid.Height + int64(len(chain))
+	e.logger.Infow("Importing blocks", "blocks", len(bf.blocks), "from", valid.Height+1, "to", tipHeight)
+
+	// Only flush the stores on every block once we reach the end of the files
+	e.source.UpdateLastBlockHeight(int32(tipHeight))
+
+	for x, entry := range chain {
+		height := valid.Height + 1 + int64(x)
+
+		// The height of the block comes from the header cache
+		err = e.blockHeaderCache.InsertBlockHeader(e.symbol, &blocc.BlockHeader{
+			BlockId:     entry.header.BlockHash().String(),
+			PrevBlockId: entry.header.PrevBlock.String(),
+			Height:      height,
+			Time:        entry.header.Timestamp.UTC().Unix(),
+		}, e.blockHeaderCacheLifetime)
+		if err != nil {
+			return fmt.Errorf("Could not blockHeaderCache.InsertBlockHeader: %v", err)
+		}
+
+		// Handle extractor.btc.block_concurrent blocks at a time
+		if height-e.blockConcurrent > valid.Height {
+			select {
+			case bh := <-e.blockHeaderTxMon.WaitForBlockHeight(height-e.blockConcurrent, e.blockHeaderTxMonBlockWaitTimeout):
+				if bh == nil {
+					return fmt.Errorf("Timeout waiting for block height %d", height-e.blockConcurrent)
+				}
+			case <-conf.Stop.Chan():
+				return nil
+			}
+		}
+
+		blk, err := bf.readBlock(entry)
+		if err != nil {
+			return fmt.Errorf("Could not read block %s: %v", entry.header.BlockHash(), err)
+		}
+		go e.handleBlock(blk)
+	}
+
+	// Wait for the last block
+	select {
+	case bh := <-e.blockHeaderTxMon.WaitForBlockHeight(tipHeight, e.blockHeaderTxMonBlockWaitTimeout):
+		if bh == nil {
+			return fmt.Errorf("Timeout waiting for block height %d", tipHeight)
+		}
+	case <-conf.Stop.Chan():
+		return nil
+	}
+
+	e.blockChainStore.FlushBlocks(e.symbol)
+	e.blockChainStore.FlushTransactions(e.symbol)
+
+	e.logger.Infow("Imported blocks", "height", tipHeight, "valid", e.validBlockStore.GetValidBlock())
+
+	return nil
+
+}
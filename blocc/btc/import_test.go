@@ -0,0 +1,228 @@
+package btc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/btools"
+	"git.coinninja.net/backend/blocc/store/memory"
+)
+
+// The regtest block files have the genesis block and five blocks out of order over two files with a shorter fork at
+// height 2
+var importTestChain = []string{
+	"6e0bfa885c46731737d106e06e9c74f76774153a73960d96f63c32cbc2e1a456",
+	"4fd144e980be22af094ab1cac7692b640340542791d34e638930d8460e0682cd",
+	"63c8d56fa9478d4dedc4ad50399943caa26857f1647fd6961fbc4c7c81b0bad1",
+	"5a2281276a01dc453f7d89707b9b93dc13d5e6f7f146b67e5ac5b4eb3eb35dde",
+	"47be79cb61dd885ec1a9a7437115e19995bef7327aa0890ed3c6cc7150511aa3",
+}
+
+func TestIndexBlockFiles(t *testing.T) {
+
+	params := &chaincfg.RegressionNetParams
+
+	dir, err := blockFilesDir("testdata", params)
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join("testdata", "regtest", "blocks"), dir)
+
+	_, err = blockFilesDir("testdata", &chaincfg.MainNetParams)
+	assert.NotNil(t, err)
+
+	// The wrong network
+	_, err = indexBlockFiles(dir, chaincfg.MainNetParams.Net)
+	assert.NotNil(t, err)
+
+	bf, err := indexBlockFiles(dir, params.Net)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	assert.Len(t, bf.blocks, 7)
+
+	// The fork is left out
+	chain := bf.bestChain(*params.GenesisHash)
+	if assert.Len(t, chain, len(importTestChain)) {
+		for x, entry := range chain {
+			assert.Equal(t, importTestChain[x], entry.header.BlockHash().String())
+		}
+	}
+
+	// From the middle of the chain
+	assert.Len(t, bf.bestChain(chain[2].header.BlockHash()), 2)
+
+	blk, err := bf.readBlock(chain[4])
+	assert.Nil(t, err)
+	assert.Equal(t, importTestChain[4], blk.BlockHash().String())
+	assert.Len(t, blk.Transactions, 1)
+
+}
+
+func TestIndexBlockFilesXor(t *testing.T) {
+
+	params := &chaincfg.RegressionNetParams
+
+	dir, err := ioutil.TempDir("", "blocc-import")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	// Obfuscate the block files the same as Bitcoin Core
+	bf := &blockFiles{key: []byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}}
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "xor.dat"), bf.key, 0644))
+	files, _ := filepath.Glob(filepath.Join("testdata", "regtest", "blocks", "blk*.dat"))
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		assert.Nil(t, err)
+		bf.xor(data, 0)
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, filepath.Base(file)), data, 0644))
+	}
+
+	bf, err = indexBlockFiles(dir, params.Net)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	chain := bf.bestChain(*params.GenesisHash)
+	assert.Len(t, chain, len(importTestChain))
+
+	blk, err := bf.readBlock(chain[2])
+	assert.Nil(t, err)
+	assert.Equal(t, importTestChain[2], blk.BlockHash().String())
+
+}
+
+func TestIndexBlockFilesSkip(t *testing.T) {
+
+	params := &chaincfg.RegressionNetParams
+
+	dir, err := ioutil.TempDir("", "blocc-import")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	bf, err := indexBlockFiles(filepath.Join("testdata", "regtest", "blocks"), params.Net)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	// Pad the blocks past the read buffer so the index has to seek over them
+	var data bytes.Buffer
+	for _, entry := range bf.blocks {
+		blk, err := bf.readBlock(entry)
+		assert.Nil(t, err)
+		var raw bytes.Buffer
+		assert.Nil(t, blk.Serialize(&raw))
+		raw.Write(make([]byte, 10000))
+		assert.Nil(t, binary.Write(&data, binary.LittleEndian, uint32(params.Net)))
+		assert.Nil(t, binary.Write(&data, binary.LittleEndian, uint32(raw.Len())))
+		data.Write(raw.Bytes())
+	}
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "blk00000.dat"), data.Bytes(), 0644))
+
+	bf, err = indexBlockFiles(dir, params.Net)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	assert.Len(t, bf.blocks, 7)
+	chain := bf.bestChain(*params.GenesisHash)
+	if assert.Len(t, chain, len(importTestChain)) {
+		blk, err := bf.readBlock(chain[4])
+		assert.Nil(t, err)
+		assert.Equal(t, importTestChain[4], blk.BlockHash().String())
+	}
+
+	// A block that runs past the end of the file
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "blk00000.dat"), data.Bytes()[:data.Len()-1], 0644))
+	_, err = indexBlockFiles(dir, params.Net)
+	assert.NotNil(t, err)
+
+}
+
+func TestImportBlocks(t *testing.T) {
+
+	params := &chaincfg.RegressionNetParams
+
+	bcs, err := memory.New()
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	assert.Nil(t, bcs.Init(Symbol))
+
+	// The peers only hear the height until the import hands off to them
+	addrs, closeListeners := newTestPeerListeners(t, 1)
+	defer closeListeners()
+	pm := newTestPeerManager(addrs, 1)
+	defer pm.Disconnect()
+	assert.Nil(t, pm.Connect())
+
+	bhc := btools.NewBlockHeaderCacheMem()
+	assert.Nil(t, bhc.Init(Symbol))
+
+	chain, _ := GetChain(Symbol)
+	e := &Extractor{
+		logger:                           zap.S().With("package", "blocc.btc"),
+		source:                           pm,
+		peers:                            pm,
+		chain:                            chain,
+		chainParams:                      params,
+		symbol:                           Symbol,
+		blockChainStore:                  bcs,
+		validBlockStore:                  btools.NewValidBlockStoreMem(),
+		blockConcurrent:                  2,
+		blockHeaderCache:                 bhc,
+		blockHeaderCacheLifetime:         time.Hour,
+		blockHeaderTxMon:                 btools.NewBlockHeaderTxMonitorMem(),
+		blockHeaderTxMonBlockWaitTimeout: 10 * time.Second,
+		blockHeaderTxMonBHLifetime:       time.Hour,
+		blockHeaderTxMonTxLifetime:       time.Hour,
+		txTrackOutputs:                   true,
+		memPoolSpends:                    btools.NewMemPoolSpendsMem(),
+		memPoolPackages:                  btools.NewMemPoolPackagesMem(),
+		memPoolReceived:                  make(map[string]*memPoolReceived),
+	}
+
+	// Start from the genesis block the same as Extract
+	assert.Nil(t, e.blockHeaderCache.InsertBlockHeader(Symbol, &blocc.BlockHeader{
+		BlockId: params.GenesisHash.String(),
+		Time:    params.GenesisBlock.Header.Timestamp.Unix(),
+		Height:  0,
+	}, e.blockHeaderCacheLifetime))
+	e.validBlockStore.SetValidBlock(&blocc.BlockHeader{Height: blocc.HeightUnknown})
+	e.handleBlock(params.GenesisBlock)
+
+	assert.Nil(t, e.ImportBlocks("testdata"))
+
+	// Every block of the best chain was handled at it's height and the fork was left out
+	for x, blockId := range importTestChain {
+		blks, err := bcs.FindBlocksByHeight(Symbol, int64(x+1), blocc.BlockIncludeHeader)
+		assert.Nil(t, err)
+		if assert.Len(t, blks, 1) {
+			assert.Equal(t, blockId, blks[0].BlockId)
+		}
+	}
+	txs, err := bcs.GetTxsByBlockId(Symbol, importTestChain[4], blocc.TxIncludeAllButRaw)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 1)
+
+	// The p2p sync takes over from the last imported block
+	valid := e.validBlockStore.GetValidBlock()
+	assert.Equal(t, int64(len(importTestChain)), valid.Height)
+	assert.Equal(t, importTestChain[4], valid.BlockId)
+	top, err := e.blockHeaderCache.GetTopBlockHeader(Symbol)
+	assert.Nil(t, err)
+	assert.Equal(t, importTestChain[4], top.BlockId)
+	assert.Equal(t, int32(len(importTestChain)), pm.LastBlock())
+	assert.Equal(t, int32(len(importTestChain)), pm.Best().LastBlock())
+
+	// There's nothing left to import on a restart
+	assert.Nil(t, e.ImportBlocks("testdata"))
+	assert.Equal(t, valid, e.validBlockStore.GetValidBlock())
+
+}
@@ -0,0 +1,190 @@
+// Package ltc registers Litecoin with the btc extractor. Litecoin uses the bitcoin wire protocol and block format with
+// it's own network magic, genesis block and address prefixes. Import it for it's side effect.
+package ltc
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	"git.coinninja.net/backend/blocc/blocc/btc"
+)
+
+const Symbol = "ltc"
+
+var (
+	// The highest proof of work value a block can have, 2^236 - 1
+	powLimit = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 236), big.NewInt(1))
+
+	// The coinbase of the genesis blocks
+	genesisCoinbaseTx = wire.MsgTx{
+		Version: 1,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Index: 0xffffffff},
+			// NY Times 05/Oct/2011 Steve Jobs, Apple’s Visionary, Dies at 56
+			SignatureScript: []byte{
+				0x04, 0xff, 0xff, 0x00, 0x1d, 0x01, 0x04, 0x40, 0x4e, 0x59, 0x20, 0x54, 0x69, 0x6d, 0x65, 0x73,
+				0x20, 0x30, 0x35, 0x2f, 0x4f, 0x63, 0x74, 0x2f, 0x32, 0x30, 0x31, 0x31, 0x20, 0x53, 0x74, 0x65,
+				0x76, 0x65, 0x20, 0x4a, 0x6f, 0x62, 0x73, 0x2c, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x65, 0xe2, 0x80,
+				0x99, 0x73, 0x20, 0x56, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x2c, 0x20, 0x44, 0x69,
+				0x65, 0x73, 0x20, 0x61, 0x74, 0x20, 0x35, 0x36,
+			},
+			Sequence: 0xffffffff,
+		}},
+		TxOut: []*wire.TxOut{{
+			Value: 0x12a05f200,
+			PkScript: []byte{
+				0x41, 0x04, 0x01, 0x84, 0x71, 0x0f, 0xa6, 0x89, 0xad, 0x50, 0x23, 0x69, 0x0c, 0x80, 0xf3, 0xa4,
+				0x9c, 0x8f, 0x13, 0xf8, 0xd4, 0x5b, 0x8c, 0x85, 0x7f, 0xbc, 0xbc, 0x8b, 0xc4, 0xa8, 0xe4, 0xd3,
+				0xeb, 0x4b, 0x10, 0xf4, 0xd4, 0x60, 0x4f, 0xa0, 0x8d, 0xce, 0x60, 0x1a, 0xaf, 0x0f, 0x47, 0x02,
+				0x16, 0xfe, 0x1b, 0x51, 0x85, 0x0b, 0x4a, 0xcf, 0x21, 0xb1, 0x79, 0xc4, 0x50, 0x70, 0xac, 0x7b,
+				0x03, 0xa9, 0xac,
+			},
+		}},
+	}
+
+	// 97ddfbbae6be97fd6cdf3e7ca13232a3afff2353e29badfab7f73011edd4ced9
+	genesisMerkleRoot = chainhash.Hash{
+		0xd9, 0xce, 0xd4, 0xed, 0x11, 0x30, 0xf7, 0xb7, 0xfa, 0xad, 0x9b, 0xe2, 0x53, 0x23, 0xff, 0xaf,
+		0xa3, 0x32, 0x32, 0xa1, 0x7c, 0x3e, 0xdf, 0x6c, 0xfd, 0x97, 0xbe, 0xe6, 0xba, 0xfb, 0xdd, 0x97,
+	}
+
+	mainNetGenesisBlock = wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:    1,
+			MerkleRoot: genesisMerkleRoot,
+			Timestamp:  time.Unix(1317972665, 0), // 2011-10-07 07:31:05 +0000 UTC
+			Bits:       0x1e0ffff0,
+			Nonce:      2084524493,
+		},
+		Transactions: []*wire.MsgTx{&genesisCoinbaseTx},
+	}
+
+	// 12a765e31ffd4059bada1e25190f6e98c99d9714d334efa41a195a7e7e04bfe2
+	mainNetGenesisHash = chainhash.Hash{
+		0xe2, 0xbf, 0x04, 0x7e, 0x7e, 0x5a, 0x19, 0x1a, 0xa4, 0xef, 0x34, 0xd3, 0x14, 0x97, 0x9d, 0xc9,
+		0x98, 0x6e, 0x0f, 0x19, 0x25, 0x1e, 0xda, 0xba, 0x59, 0x40, 0xfd, 0x1f, 0xe3, 0x65, 0xa7, 0x12,
+	}
+
+	testNet4GenesisBlock = wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:    1,
+			MerkleRoot: genesisMerkleRoot,
+			Timestamp:  time.Unix(1486949366, 0), // 2017-02-13 01:29:26 +0000 UTC
+			Bits:       0x1e0ffff0,
+			Nonce:      293345,
+		},
+		Transactions: []*wire.MsgTx{&genesisCoinbaseTx},
+	}
+
+	// 4966625a4b2851d9fdee139e56211a0d88575f59ed816ff5e6a63deb4e3e29a0
+	testNet4GenesisHash = chainhash.Hash{
+		0xa0, 0x29, 0x3e, 0x4e, 0xeb, 0x3d, 0xa6, 0xe6, 0xf5, 0x6f, 0x81, 0xed, 0x59, 0x5f, 0x57, 0x88,
+		0x0d, 0x1a, 0x21, 0x56, 0x9e, 0x13, 0xee, 0xfd, 0xd9, 0x51, 0x28, 0x4b, 0x5a, 0x62, 0x66, 0x49,
+	}
+)
+
+// MainNetParams are the parameters of the Litecoin main network
+var MainNetParams = chaincfg.Params{
+	Name:        "mainnet",
+	Net:         0xdbb6c0fb,
+	DefaultPort: "9333",
+	DNSSeeds: []chaincfg.DNSSeed{
+		{Host: "seed-a.litecoin.loshan.co.uk", HasFiltering: true},
+		{Host: "dnsseed.thrasher.io", HasFiltering: true},
+		{Host: "dnsseed.litecointools.com", HasFiltering: false},
+		{Host: "dnsseed.litecoinpool.org", HasFiltering: false},
+		{Host: "dnsseed.koin-project.com", HasFiltering: false},
+	},
+
+	GenesisBlock:             &mainNetGenesisBlock,
+	GenesisHash:              &mainNetGenesisHash,
+	PowLimit:                 powLimit,
+	PowLimitBits:             0x1e0fffff,
+	BIP0034Height:            710000,
+	BIP0065Height:            918684,
+	BIP0066Height:            811879,
+	CoinbaseMaturity:         100,
+	SubsidyReductionInterval: 840000,
+	TargetTimespan:           (time.Hour * 24 * 7) / 2, // 3.5 days
+	TargetTimePerBlock:       time.Minute * 5 / 2,      // 2.5 minutes
+	RetargetAdjustmentFactor: 4,
+
+	RuleChangeActivationThreshold: 6048,
+	MinerConfirmationWindow:       8064,
+
+	Bech32HRPSegwit: "ltc",
+
+	PubKeyHashAddrID:        0x30, // starts with L
+	ScriptHashAddrID:        0x32, // starts with M
+	PrivateKeyID:            0xb0, // starts with 6 (uncompressed) or T (compressed)
+	WitnessPubKeyHashAddrID: 0x06,
+	WitnessScriptHashAddrID: 0x0a,
+
+	HDPrivateKeyID: [4]byte{0x04, 0x88, 0xad, 0xe4}, // starts with xprv
+	HDPublicKeyID:  [4]byte{0x04, 0x88, 0xb2, 0x1e}, // starts with xpub
+	HDCoinType:     2,
+}
+
+// TestNet4Params are the parameters of the Litecoin test network
+var TestNet4Params = chaincfg.Params{
+	Name:        "testnet4",
+	Net:         0xf1c8d2fd,
+	DefaultPort: "19335",
+	DNSSeeds: []chaincfg.DNSSeed{
+		{Host: "testnet-seed.litecointools.com", HasFiltering: false},
+		{Host: "seed-b.litecoin.loshan.co.uk", HasFiltering: true},
+		{Host: "dnsseed-testnet.thrasher.io", HasFiltering: true},
+	},
+
+	GenesisBlock:             &testNet4GenesisBlock,
+	GenesisHash:              &testNet4GenesisHash,
+	PowLimit:                 powLimit,
+	PowLimitBits:             0x1e0fffff,
+	BIP0034Height:            76,
+	BIP0065Height:            76,
+	BIP0066Height:            76,
+	CoinbaseMaturity:         100,
+	SubsidyReductionInterval: 840000,
+	TargetTimespan:           (time.Hour * 24 * 7) / 2, // 3.5 days
+	TargetTimePerBlock:       time.Minute * 5 / 2,      // 2.5 minutes
+	RetargetAdjustmentFactor: 4,
+	ReduceMinDifficulty:      true,
+	MinDiffReductionTime:     time.Minute * 5, // TargetTimePerBlock * 2
+
+	RuleChangeActivationThreshold: 1512,
+	MinerConfirmationWindow:       2016,
+
+	Bech32HRPSegwit: "tltc",
+
+	PubKeyHashAddrID:        0x6f, // starts with m or n
+	ScriptHashAddrID:        0x3a, // starts with Q
+	PrivateKeyID:            0xef, // starts with 9 (uncompressed) or c (compressed)
+	WitnessPubKeyHashAddrID: 0x03,
+	WitnessScriptHashAddrID: 0x28,
+
+	HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94}, // starts with tprv
+	HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf}, // starts with tpub
+	HDCoinType:     1,
+}
+
+func init() {
+
+	// Addresses are only decoded for registered networks
+	for _, params := range []*chaincfg.Params{&MainNetParams, &TestNet4Params} {
+		if err := chaincfg.Register(params); err != nil {
+			panic(err)
+		}
+	}
+
+	btc.RegisterChain(&btc.Chain{
+		Symbol:        Symbol,
+		Name:          "Litecoin",
+		Networks:      []*chaincfg.Params{&MainNetParams, &TestNet4Params},
+		MaxBlockVSize: 1000000, // 4M weight units
+	})
+
+}
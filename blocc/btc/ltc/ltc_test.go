@@ -0,0 +1,61 @@
+package ltc
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+
+	"git.coinninja.net/backend/blocc/blocc/btc"
+)
+
+func TestGenesisBlocks(t *testing.T) {
+
+	for _, params := range []*chaincfg.Params{&MainNetParams, &TestNet4Params} {
+		assert.Equal(t, genesisCoinbaseTx.TxHash(), params.GenesisBlock.Header.MerkleRoot, params.Name)
+		assert.Equal(t, params.GenesisBlock.BlockHash(), *params.GenesisHash, params.Name)
+	}
+
+	assert.Equal(t, "12a765e31ffd4059bada1e25190f6e98c99d9714d334efa41a195a7e7e04bfe2", MainNetParams.GenesisHash.String())
+	assert.Equal(t, "4966625a4b2851d9fdee139e56211a0d88575f59ed816ff5e6a63deb4e3e29a0", TestNet4Params.GenesisHash.String())
+
+}
+
+func TestChain(t *testing.T) {
+
+	chain, err := btc.GetChain(Symbol)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, "Litecoin", chain.Name)
+
+	params, err := chain.Params("mainnet")
+	assert.Nil(t, err)
+	assert.Equal(t, &MainNetParams, params)
+
+	// Bitcoin's test network is not a Litecoin network
+	_, err = chain.Params("testnet3")
+	assert.NotNil(t, err)
+
+	// Addresses use the Litecoin prefixes
+	for _, test := range []struct {
+		script  string
+		address string
+	}{
+		{"76a914751e76e8199196d454941c45d1b3a323f1433bd688ac", "LVuDpNCSSj6pQ7t9Pv6d6sUkLKoqDEVUnJ"},
+		{"0014751e76e8199196d454941c45d1b3a323f1433bd6", "ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9"},
+	} {
+		script, _ := hex.DecodeString(test.script)
+		ds, err := btc.DecodeScript(script, chain, params)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{test.address}, ds.Addresses)
+
+		// The network is registered to decode them
+		address, err := btcutil.DecodeAddress(test.address, params)
+		assert.Nil(t, err)
+		assert.Equal(t, test.address, address.EncodeAddress())
+	}
+
+}
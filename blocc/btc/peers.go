@@ -0,0 +1,532 @@
+package btc
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/peer"
+	"github.com/btcsuite/btcd/wire"
+	config "github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/conf"
+)
+
+// peerManager keeps connections to several peers and picks the best one to request data from. The best peer is kept
+// until it disconnects, stalls or misbehaves and then the next best connected peer takes over.
+type peerManager struct {
+	logger *zap.SugaredLogger
+
+	// Creates the config of a new peer with the extractor listeners, ready is closed on verack
+	peerConfig func(ready chan struct{}) *peer.Config
+
+	addrs          []string
+	seeds          []chaincfg.DNSSeed
+	defaultPort    string
+	count          int
+	connectTimeout time.Duration
+	stallPenalty   time.Duration
+	banScore       int
+	banDuration    time.Duration
+
+	// The state of every peer we have connected to by address, it's kept when disconnected to remember the score
+	peers     map[string]*peerState
+	best      *peerState
+	lastBlock int32
+	sync.RWMutex
+
+	// Serializes connecting to peers
+	connectLock sync.Mutex
+}
+
+// peerState is a peer and it's score
+type peerState struct {
+	addr        string
+	peer        *peer.Peer
+	stalls      int
+	misbehavior int
+	bannedUntil time.Time
+}
+
+// peerScore is a snapshot of a connected peer's score for selecting the best peer, lower is better
+type peerScore struct {
+	addr        string
+	latency     time.Duration
+	stalls      int
+	misbehavior int
+}
+
+func newPeerManager(chainParams *chaincfg.Params, peerConfig func(ready chan struct{}) *peer.Config) *peerManager {
+
+	// The configured peers or the single host
+	addrs := config.GetStringSlice("extractor.btc.peers")
+	if len(addrs) == 0 {
+		addrs = []string{net.JoinHostPort(config.GetString("extractor.btc.host"), config.GetString("extractor.btc.port"))}
+	}
+
+	pm := &peerManager{
+		logger:     zap.S().With("package", "blocc.btc.peers"),
+		peerConfig: peerConfig,
+
+		addrs:          addrs,
+		defaultPort:    chainParams.DefaultPort,
+		count:          config.GetInt("extractor.btc.peer_count"),
+		connectTimeout: config.GetDuration("extractor.btc.peer_connect_timeout"),
+		stallPenalty:   config.GetDuration("extractor.btc.peer_stall_penalty"),
+		banScore:       config.GetInt("extractor.btc.peer_ban_score"),
+		banDuration:    config.GetDuration("extractor.btc.peer_ban_duration"),
+
+		peers: make(map[string]*peerState),
+	}
+
+	if config.GetBool("extractor.btc.dns_seeds") {
+		pm.seeds = chainParams.DNSSeeds
+	}
+
+	if pm.count < 1 {
+		pm.count = 1
+	}
+
+	return pm
+
+}
+
+// Connect connects to peers until there are extractor.btc.peer_count connections, it's an error if none are connected
+func (pm *peerManager) Connect() error {
+
+	pm.connectLock.Lock()
+	defer pm.connectLock.Unlock()
+
+	var lastErr error
+	for _, addr := range pm.candidates() {
+		if pm.connectedCount() >= pm.count {
+			break
+		}
+		err := pm.connect(addr)
+		if err != nil {
+			pm.logger.Warnw("Could not connect to peer", "peer", addr, "error", err)
+			lastErr = err
+		}
+	}
+
+	if !pm.Connected() {
+		if lastErr == nil {
+			lastErr = fmt.Errorf("No peers available")
+		}
+		return lastErr
+	}
+
+	pm.selectBest()
+
+	return nil
+
+}
+
+// connect connects to a peer and waits for it to be ready
+func (pm *peerManager) connect(addr string) error {
+
+	ready := make(chan struct{})
+	p, err := peer.NewOutboundPeer(pm.peerConfig(ready), addr)
+	if err != nil {
+		return fmt.Errorf("Could not create outbound peer: %v", err)
+	}
+
+	conn, err := net.DialTimeout("tcp", p.Addr(), pm.connectTimeout)
+	if err != nil {
+		return fmt.Errorf("Could not Dial peer: %v", err)
+	}
+
+	p.AssociateConnection(conn)
+
+	// Wait until ready or timeout
+	select {
+	case <-ready:
+	case <-time.After(pm.connectTimeout):
+		p.Disconnect()
+		return fmt.Errorf("Never got verack ready message")
+	}
+
+	pm.Lock()
+	ps, ok := pm.peers[addr]
+	if !ok {
+		ps = &peerState{addr: addr}
+		pm.peers[addr] = ps
+	}
+	ps.peer = p
+	// Keep the heights of the peers consistent
+	if p.LastBlock() > pm.lastBlock {
+		pm.lastBlock = p.LastBlock()
+	}
+	p.UpdateLastBlockHeight(pm.lastBlock)
+	pm.Unlock()
+
+	pm.logger.Infow("Connected to peer", "peer", addr, "height", p.StartingHeight(), "last_block_height", p.LastBlock())
+
+	return nil
+
+}
+
+// candidates returns the addresses that are not connected or banned, configured ones first followed by the DNS seeds.
+// Peers that stalled or misbehaved go last so a failover reconnects to the next candidate rather than the same peer.
+func (pm *peerManager) candidates() []string {
+
+	addrs := append([]string{}, pm.addrs...)
+	for _, seed := range pm.seeds {
+		hosts, err := net.LookupHost(seed.Host)
+		if err != nil {
+			pm.logger.Warnw("Could not lookup DNS seed", "seed", seed.Host, "error", err)
+			continue
+		}
+		for _, host := range hosts {
+			addrs = append(addrs, net.JoinHostPort(host, pm.defaultPort))
+		}
+	}
+
+	pm.RLock()
+	defer pm.RUnlock()
+
+	var ret []string
+	for _, addr := range addrs {
+		if ps, ok := pm.peers[addr]; ok {
+			if (ps.peer != nil && ps.peer.Connected()) || time.Now().Before(ps.bannedUntil) {
+				continue
+			}
+		}
+		ret = append(ret, addr)
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return pm.penalty(ret[i]) < pm.penalty(ret[j])
+	})
+
+	return ret
+
+}
+
+// penalty returns the stalls and misbehavior of a peer by address, the lock must be held
+func (pm *peerManager) penalty(addr string) int {
+	ps, ok := pm.peers[addr]
+	if !ok {
+		return 0
+	}
+	return ps.stalls + ps.misbehavior
+}
+
+// Run reconnects and replaces the best peer every extractor.btc.peer_check_interval until stopped
+func (pm *peerManager) Run() {
+
+	for {
+		select {
+		case <-time.After(config.GetDuration("extractor.btc.peer_check_interval")):
+		case <-conf.Stop.Chan():
+			return
+		}
+
+		if pm.connectedCount() < pm.count {
+			err := pm.Connect()
+			if err != nil {
+				pm.logger.Errorw("Could not connect to peers", "error", err)
+				continue
+			}
+		}
+
+		pm.RLock()
+		lost := pm.best == nil || !pm.best.peer.Connected()
+		pm.RUnlock()
+		if lost {
+			pm.selectBest()
+		}
+	}
+
+}
+
+// selectBest picks the connected peer with the lowest score as the best peer
+func (pm *peerManager) selectBest() {
+
+	pm.Lock()
+	defer pm.Unlock()
+
+	addrs := rankPeers(pm.scores(), pm.stallPenalty)
+	if len(addrs) == 0 {
+		pm.best = nil
+		return
+	}
+	if pm.best == nil || pm.best.addr != addrs[0] {
+		pm.logger.Infow("Selected best peer", "peer", addrs[0])
+	}
+	pm.best = pm.peers[addrs[0]]
+
+}
+
+// scores returns the scores of the connected peers, the lock must be held
+func (pm *peerManager) scores() []peerScore {
+	var scores []peerScore
+	for _, ps := range pm.peers {
+		if ps.peer == nil || !ps.peer.Connected() {
+			continue
+		}
+		scores = append(scores, peerScore{
+			addr:        ps.addr,
+			latency:     time.Duration(ps.peer.LastPingMicros()) * time.Microsecond,
+			stalls:      ps.stalls,
+			misbehavior: ps.misbehavior,
+		})
+	}
+	return scores
+}
+
+// rankPeers returns the addresses of the peers from the lowest score, a stall costs stallPenalty of latency and each
+// misbehavior point one second
+func rankPeers(scores []peerScore, stallPenalty time.Duration) []string {
+
+	score := func(s peerScore) time.Duration {
+		return s.latency + time.Duration(s.stalls)*stallPenalty + time.Duration(s.misbehavior)*time.Second
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		if score(scores[i]) == score(scores[j]) {
+			return scores[i].addr < scores[j].addr
+		}
+		return score(scores[i]) < score(scores[j])
+	})
+
+	addrs := make([]string, len(scores))
+	for x, s := range scores {
+		addrs[x] = s.addr
+	}
+	return addrs
+
+}
+
+// Ranked returns the connected peers from the best score
+func (pm *peerManager) Ranked() []*peer.Peer {
+	pm.RLock()
+	defer pm.RUnlock()
+	var ret []*peer.Peer
+	for _, addr := range rankPeers(pm.scores(), pm.stallPenalty) {
+		ret = append(ret, pm.peers[addr].peer)
+	}
+	return ret
+}
+
+// Best returns the peer data is requested from or nil if there are none connected
+func (pm *peerManager) Best() *peer.Peer {
+	pm.RLock()
+	best := pm.best
+	pm.RUnlock()
+	if best == nil || !best.peer.Connected() {
+		pm.selectBest()
+		pm.RLock()
+		best = pm.best
+		pm.RUnlock()
+		if best == nil {
+			return nil
+		}
+	}
+	return best.peer
+}
+
+// IsBest returns if p is the best peer
+func (pm *peerManager) IsBest(p *peer.Peer) bool {
+	pm.RLock()
+	defer pm.RUnlock()
+	return pm.best != nil && pm.best.peer == p
+}
+
+// All returns the connected peers
+func (pm *peerManager) All() []*peer.Peer {
+	pm.RLock()
+	defer pm.RUnlock()
+	var ret []*peer.Peer
+	for _, ps := range pm.peers {
+		if ps.peer != nil && ps.peer.Connected() {
+			ret = append(ret, ps.peer)
+		}
+	}
+	return ret
+}
+
+// Connected returns if any peer is connected
+func (pm *peerManager) Connected() bool {
+	return pm.connectedCount() > 0
+}
+
+func (pm *peerManager) connectedCount() int {
+	return len(pm.All())
+}
+
+// Disconnect disconnects all of the peers
+func (pm *peerManager) Disconnect() {
+	for _, p := range pm.All() {
+		p.Disconnect()
+	}
+	pm.Lock()
+	pm.best = nil
+	pm.Unlock()
+}
+
+// Stalled penalizes the best peer for not sending what was requested and fails over to the next best peer
+func (pm *peerManager) Stalled() {
+	pm.Lock()
+	best := pm.best
+	pm.best = nil
+	pm.Unlock()
+	if best != nil {
+		pm.StalledPeer(best.peer)
+		best.peer.Disconnect()
+	}
+	pm.selectBest()
+}
+
+// StalledPeer penalizes a peer for not sending what was requested
+func (pm *peerManager) StalledPeer(p *peer.Peer) {
+	pm.Lock()
+	defer pm.Unlock()
+	for _, ps := range pm.peers {
+		if ps.peer == p {
+			ps.stalls++
+			pm.logger.Warnw("Peer stalled", "peer", ps.addr, "stalls", ps.stalls)
+			return
+		}
+	}
+}
+
+// Misbehaving adds to the misbehavior score of a peer, it's banned for extractor.btc.peer_ban_duration once it reaches
+// extractor.btc.peer_ban_score
+func (pm *peerManager) Misbehaving(p *peer.Peer, score int, reason string) {
+
+	pm.Lock()
+	var ps *peerState
+	for _, s := range pm.peers {
+		if s.peer == p {
+			ps = s
+			break
+		}
+	}
+	if ps == nil {
+		pm.Unlock()
+		return
+	}
+	ps.misbehavior += score
+	pm.logger.Warnw("Peer misbehaving", "peer", ps.addr, "reason", reason, "misbehavior", ps.misbehavior)
+	banned := ps.misbehavior >= pm.banScore
+	if banned {
+		ps.bannedUntil = time.Now().Add(pm.banDuration)
+		ps.misbehavior = 0
+		if pm.best == ps {
+			pm.best = nil
+		}
+	}
+	pm.Unlock()
+
+	if banned {
+		pm.logger.Warnw("Banning peer", "peer", ps.addr, "until", ps.bannedUntil)
+		p.Disconnect()
+		pm.selectBest()
+	}
+
+}
+
+// LastBlock returns the block height of the peers
+func (pm *peerManager) LastBlock() int32 {
+	pm.RLock()
+	defer pm.RUnlock()
+	height := pm.lastBlock
+	for _, ps := range pm.peers {
+		if ps.peer != nil && ps.peer.Connected() && ps.peer.LastBlock() > height {
+			height = ps.peer.LastBlock()
+		}
+	}
+	return height
+}
+
+// UpdateLastBlockHeight updates the block height of all of the peers
+func (pm *peerManager) UpdateLastBlockHeight(height int32) {
+	pm.Lock()
+	defer pm.Unlock()
+	if height > pm.lastBlock {
+		pm.lastBlock = height
+	}
+	for _, ps := range pm.peers {
+		if ps.peer != nil {
+			ps.peer.UpdateLastBlockHeight(height)
+		}
+	}
+}
+
+// RequestHeaders sends a getheaders message to the best peer
+func (pm *peerManager) RequestHeaders(start *chainhash.Hash, stop *chainhash.Hash) error {
+
+	best := pm.Best()
+	if best == nil {
+		return fmt.Errorf("Not connected to peer")
+	}
+	err := best.PushGetHeadersMsg(blockchain.BlockLocator{start}, stop)
+	if err != nil {
+		return fmt.Errorf("PushGetHeadersMsg: error %v", err)
+	}
+
+	return nil
+
+}
+
+// RequestBlocks sends a getblocks message to the best peer, it will announce the blocks to request
+func (pm *peerManager) RequestBlocks(start *chainhash.Hash, stop *chainhash.Hash) error {
+
+	best := pm.Best()
+	if best == nil {
+		return fmt.Errorf("Not connected to peer")
+	}
+	err := best.PushGetBlocksMsg(blockchain.BlockLocator{start}, stop)
+	if err != nil {
+		return fmt.Errorf("PushGetBlocksMsg: error %v", err)
+	}
+
+	return nil
+
+}
+
+// RequestMemPool sends a mempool message to the best peer, it will announce it's transactions
+func (pm *peerManager) RequestMemPool() error {
+
+	best := pm.Best()
+	if best == nil {
+		return fmt.Errorf("Not connected to peer")
+	}
+	best.QueueMessage(wire.NewMsgMemPool(), nil)
+
+	return nil
+
+}
+
+// RelayTx announces a transaction to all of the connected peers, they request it with getdata
+func (pm *peerManager) RelayTx(msgTx *wire.MsgTx) error {
+
+	peers := pm.All()
+	if len(peers) == 0 {
+		return fmt.Errorf("Not connected to peer")
+	}
+
+	// Announce it right away rather than waiting for the inventory trickle
+	hash := msgTx.TxHash()
+	msg := wire.NewMsgInv()
+	err := msg.AddInvVect(wire.NewInvVect(wire.InvTypeTx, &hash))
+	if err != nil {
+		return err
+	}
+	for _, p := range peers {
+		p.QueueMessage(msg, nil)
+	}
+
+	return nil
+
+}
+
+// MemPoolEvents is false, peers don't announce transactions leaving their mempool
+func (pm *peerManager) MemPoolEvents() bool {
+	return false
+}
@@ -0,0 +1,258 @@
+package btc
+
+import (
+	"net"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/peer"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestRankPeers(t *testing.T) {
+
+	assert.Empty(t, rankPeers(nil, time.Minute))
+
+	// Lowest latency
+	assert.Equal(t, "b", rankPeers([]peerScore{
+		{addr: "a", latency: 200 * time.Millisecond},
+		{addr: "b", latency: 100 * time.Millisecond},
+	}, time.Minute)[0])
+
+	// A stall costs more than the latency difference
+	assert.Equal(t, "a", rankPeers([]peerScore{
+		{addr: "a", latency: 200 * time.Millisecond},
+		{addr: "b", latency: 100 * time.Millisecond, stalls: 1},
+	}, time.Minute)[0])
+
+	// Misbehavior is a second per point
+	assert.Equal(t, "b", rankPeers([]peerScore{
+		{addr: "a", latency: 200 * time.Millisecond, misbehavior: 2},
+		{addr: "b", latency: time.Second},
+	}, time.Minute)[0])
+
+	// Ties go to the address
+	assert.Equal(t, "a", rankPeers([]peerScore{
+		{addr: "b"},
+		{addr: "a"},
+	}, time.Minute)[0])
+
+}
+
+func TestPeerManagerMisbehaving(t *testing.T) {
+
+	pm := &peerManager{
+		logger:      zap.S(),
+		addrs:       []string{"127.0.0.1:1", "127.0.0.1:2"},
+		count:       2,
+		banScore:    10,
+		banDuration: time.Hour,
+		peers:       make(map[string]*peerState),
+	}
+
+	p, err := peer.NewOutboundPeer(&peer.Config{ChainParams: &chaincfg.RegressionNetParams}, "127.0.0.1:1")
+	assert.Nil(t, err)
+	pm.peers["127.0.0.1:1"] = &peerState{addr: "127.0.0.1:1", peer: p}
+
+	// Not banned yet but it goes last
+	pm.Misbehaving(p, 5, "test")
+	assert.Equal(t, 5, pm.peers["127.0.0.1:1"].misbehavior)
+	assert.Equal(t, []string{"127.0.0.1:2", "127.0.0.1:1"}, pm.candidates())
+
+	// Banned peers are not candidates
+	pm.Misbehaving(p, 5, "test")
+	assert.True(t, pm.peers["127.0.0.1:1"].bannedUntil.After(time.Now()))
+	assert.Equal(t, []string{"127.0.0.1:2"}, pm.candidates())
+
+	// The height is kept across peers
+	pm.UpdateLastBlockHeight(100)
+	assert.Equal(t, int32(100), p.LastBlock())
+	assert.Equal(t, int32(100), pm.LastBlock())
+
+}
+
+func TestPeerManagerStalled(t *testing.T) {
+
+	addrs, closeListeners := newTestPeerListeners(t, 3)
+	defer closeListeners()
+	pm := newTestPeerManager(addrs, 2)
+	defer pm.Disconnect()
+
+	// The first two are connected and the first is the best
+	assert.Nil(t, pm.Connect())
+	assert.Len(t, pm.All(), 2)
+	assert.Equal(t, addrs[0], pm.Best().Addr())
+	assert.Equal(t, []string{addrs[2]}, pm.candidates())
+
+	// The best peer is penalized, dropped and the next connected peer takes over
+	stalled := pm.Best()
+	pm.Stalled()
+	assert.False(t, stalled.Connected())
+	assert.Equal(t, 1, pm.peers[addrs[0]].stalls)
+	assert.Equal(t, addrs[1], pm.Best().Addr())
+
+	// Reconnecting picks the next candidate rather than the peer that stalled
+	assert.Equal(t, []string{addrs[2], addrs[0]}, pm.candidates())
+	assert.Nil(t, pm.Connect())
+	assert.Len(t, pm.All(), 2)
+	assert.True(t, pm.peers[addrs[2]].peer.Connected())
+	assert.Equal(t, addrs[1], pm.Best().Addr())
+
+	// A stall on another peer doesn't change the best peer
+	pm.StalledPeer(pm.peers[addrs[2]].peer)
+	assert.Equal(t, 1, pm.peers[addrs[2]].stalls)
+	assert.True(t, pm.IsBest(pm.peers[addrs[1]].peer))
+
+	// Even with all of them connected the stalled peers rank last
+	pm.count = 3
+	assert.Nil(t, pm.Connect())
+	assert.Len(t, pm.All(), 3)
+	var ranked []string
+	for _, p := range pm.Ranked() {
+		ranked = append(ranked, p.Addr())
+	}
+	assert.Equal(t, []string{addrs[1], addrs[0], addrs[2]}, ranked)
+
+}
+
+func TestPeerManagerBestDisconnected(t *testing.T) {
+
+	addrs, closeListeners := newTestPeerListeners(t, 2)
+	defer closeListeners()
+	pm := newTestPeerManager(addrs, 2)
+	defer pm.Disconnect()
+
+	assert.Nil(t, pm.Connect())
+	best := pm.Best()
+	assert.Equal(t, addrs[0], best.Addr())
+
+	// The next best connected peer is selected when the best disconnects
+	best.Disconnect()
+	best.WaitForDisconnect()
+	assert.Equal(t, addrs[1], pm.Best().Addr())
+	assert.True(t, pm.IsBest(pm.peers[addrs[1]].peer))
+
+	// There's no best peer once none are connected
+	pm.Best().Disconnect()
+	assert.Nil(t, pm.Best())
+	assert.False(t, pm.Connected())
+
+	// The disconnected peers are candidates again
+	assert.Equal(t, addrs, pm.candidates())
+
+}
+
+// newTestPeerManager returns a peer manager that connects count of the addresses
+func newTestPeerManager(addrs []string, count int) *peerManager {
+	return &peerManager{
+		logger:         zap.S(),
+		peerConfig:     testPeerConfig,
+		addrs:          addrs,
+		count:          count,
+		connectTimeout: 5 * time.Second,
+		stallPenalty:   time.Minute,
+		banScore:       10,
+		banDuration:    time.Hour,
+		peers:          make(map[string]*peerState),
+	}
+}
+
+// newTestPeerListeners listens for peers on count local addresses that are returned sorted so ties in the ranking go
+// to the first address, the returned func stops listening
+func newTestPeerListeners(t *testing.T, count int) ([]string, func()) {
+
+	var addrs []string
+	var listeners []net.Listener
+	for x := 0; x < count; x++ {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+		go func() {
+			for {
+				conn, err := l.Accept()
+				if err != nil {
+					return
+				}
+				go serveTestPeer(conn, make(chan *wire.MsgGetData, 100))
+			}
+		}()
+		addrs = append(addrs, l.Addr().String())
+		listeners = append(listeners, l)
+	}
+	sort.Strings(addrs)
+
+	return addrs, func() {
+		for _, l := range listeners {
+			l.Close()
+		}
+	}
+
+}
+
+// testPeerConfig returns the config of a test peer, ready is closed on verack
+func testPeerConfig(ready chan struct{}) *peer.Config {
+	return &peer.Config{
+		ChainParams: &chaincfg.RegressionNetParams,
+		Listeners: peer.MessageListeners{
+			OnVerAck: func(p *peer.Peer, msg *wire.MsgVerAck) { close(ready) },
+		},
+	}
+}
+
+// newTestPeer returns an outbound peer connected over a pipe to a remote test peer, the getdata requests the remote
+// peer receives are sent on the returned channel
+func newTestPeer(t *testing.T, addr string) (*peer.Peer, chan *wire.MsgGetData) {
+
+	ready := make(chan struct{})
+	p, err := peer.NewOutboundPeer(testPeerConfig(ready), addr)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	getData := make(chan *wire.MsgGetData, 100)
+	conn, remoteConn := net.Pipe()
+	go serveTestPeer(remoteConn, getData)
+	p.AssociateConnection(conn)
+
+	select {
+	case <-ready:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Peer %s never got verack", addr)
+	}
+
+	return p, getData
+
+}
+
+// serveTestPeer answers the version handshake of a peer on conn and sends the getdata requests it receives on getData
+// until the connection is closed. A btcd peer can't be used as it refuses connections from the same process.
+func serveTestPeer(conn net.Conn, getData chan *wire.MsgGetData) {
+
+	defer conn.Close()
+
+	write := func(msg wire.Message) error {
+		return wire.WriteMessage(conn, msg, wire.ProtocolVersion, chaincfg.RegressionNetParams.Net)
+	}
+
+	for {
+		msg, _, err := wire.ReadMessage(conn, wire.ProtocolVersion, chaincfg.RegressionNetParams.Net)
+		if err != nil {
+			return
+		}
+		switch m := msg.(type) {
+		case *wire.MsgVersion:
+			version := wire.NewMsgVersion(&m.AddrMe, &m.AddrYou, m.Nonce+1, 0)
+			if write(version) != nil || write(wire.NewMsgVerAck()) != nil {
+				return
+			}
+		case *wire.MsgGetData:
+			getData <- m
+		}
+	}
+
+}
@@ -0,0 +1,216 @@
+package btc
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/peer"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+const (
+	// The same standardness policy as bitcoind/btcd
+	maxStandardTxVersion     = 2
+	maxStandardTxWeight      = 400000
+	maxStandardSigScriptSize = 1650
+	minRelayTxFeeKB          = 1000
+)
+
+// relayTx is a transaction announced to the peer waiting for it to be requested
+type relayTx struct {
+	msgTx *wire.MsgTx
+	added time.Time
+}
+
+// DecodeTx decodes a serialized transaction with or without witness data
+func DecodeTx(raw []byte) (*wire.MsgTx, error) {
+	msgTx := new(wire.MsgTx)
+	err := msgTx.Deserialize(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	return msgTx, nil
+}
+
+// TxVSize returns the virtual size of a transaction
+func TxVSize(msgTx *wire.MsgTx) int64 {
+	weight := int64((msgTx.SerializeSizeStripped() * (4 - 1)) + msgTx.SerializeSize()) // WitnessScaleFactor = 4
+	return (weight + 3) / 4
+}
+
+// CheckStandard checks a transaction is sane and standard so the peer will accept and relay it
+func CheckStandard(msgTx *wire.MsgTx) error {
+
+	tx := btcutil.NewTx(msgTx)
+	err := blockchain.CheckTransactionSanity(tx)
+	if err != nil {
+		return err
+	}
+
+	if blockchain.IsCoinBaseTx(msgTx) {
+		return fmt.Errorf("Coinbase transactions can not be relayed")
+	}
+
+	if msgTx.Version > maxStandardTxVersion || msgTx.Version < 1 {
+		return fmt.Errorf("Transaction version %d is not standard", msgTx.Version)
+	}
+
+	if weight := blockchain.GetTransactionWeight(tx); weight > maxStandardTxWeight {
+		return fmt.Errorf("Transaction weight %d is larger than %d", weight, maxStandardTxWeight)
+	}
+
+	for height, txIn := range msgTx.TxIn {
+		if len(txIn.SignatureScript) > maxStandardSigScriptSize {
+			return fmt.Errorf("Input %d signature script is larger than %d", height, maxStandardSigScriptSize)
+		}
+		if !txscript.IsPushOnlyScript(txIn.SignatureScript) {
+			return fmt.Errorf("Input %d signature script is not push only", height)
+		}
+	}
+
+	var nullData int
+	for height, txOut := range msgTx.TxOut {
+		switch txscript.GetScriptClass(txOut.PkScript) {
+		case txscript.NonStandardTy:
+			return fmt.Errorf("Output %d script is not standard", height)
+		case txscript.NullDataTy:
+			nullData++
+			if nullData > 1 {
+				return fmt.Errorf("Transaction has more than one null data output")
+			}
+			continue
+		}
+		if isDust(txOut) {
+			return fmt.Errorf("Output %d value %d is dust", height, txOut.Value)
+		}
+	}
+
+	return nil
+
+}
+
+// VerifyScripts runs the signature script of each input against the output script it spends, prevOuts are the spent
+// outputs in the order of the inputs
+func VerifyScripts(msgTx *wire.MsgTx, prevOuts []*wire.TxOut) error {
+
+	if len(prevOuts) != len(msgTx.TxIn) {
+		return fmt.Errorf("Transaction has %d inputs and %d spent outputs", len(msgTx.TxIn), len(prevOuts))
+	}
+
+	sigHashes := txscript.NewTxSigHashes(msgTx)
+	for height, prevOut := range prevOuts {
+		engine, err := txscript.NewEngine(prevOut.PkScript, msgTx, height, txscript.StandardVerifyFlags, nil, sigHashes, prevOut.Value)
+		if err != nil {
+			return fmt.Errorf("Input %d script could not be run: %v", height, err)
+		}
+		err = engine.Execute()
+		if err != nil {
+			return fmt.Errorf("Input %d script failed: %v", height, err)
+		}
+	}
+
+	return nil
+
+}
+
+// isDust returns if an output costs more to spend than 1/3 of it's value at the minimum relay fee rate
+func isDust(txOut *wire.TxOut) bool {
+	// The output plus an input spending it
+	size := txOut.SerializeSize() + 41
+	if txscript.IsWitnessProgram(txOut.PkScript) {
+		size += 107 / 4
+	} else {
+		size += 107
+	}
+	return txOut.Value*1000/(3*int64(size)) < minRelayTxFeeKB
+}
+
+// RelayTx sends a transaction to the source, the peers are sent it when they request it
+func (e *Extractor) RelayTx(raw []byte) error {
+
+	msgTx, err := DecodeTx(raw)
+	if err != nil {
+		return fmt.Errorf("Could not decode transaction: %v", err)
+	}
+
+	if !e.source.Connected() {
+		return fmt.Errorf("Not connected to peer")
+	}
+
+	// Check for conflicts with the mempool we know about
+	hash := msgTx.TxHash()
+	for _, txIn := range msgTx.TxIn {
+		spender := e.memPoolSpends.GetSpender(txIn.PreviousOutPoint.Hash.String(), int64(txIn.PreviousOutPoint.Index))
+		if spender != "" && spender != hash.String() {
+			return fmt.Errorf("Output %s:%d is already spent by %s", txIn.PreviousOutPoint.Hash.String(), txIn.PreviousOutPoint.Index, spender)
+		}
+	}
+
+	// The node accepts it directly, it will come back with the mempool
+	if e.peers == nil {
+		err = e.source.RelayTx(msgTx)
+		if err != nil {
+			return err
+		}
+		e.logger.Infow("Relayed transaction", "tx_id", hash.String())
+		return nil
+	}
+
+	e.relayTxsLock.Lock()
+	// Forget transactions the peer never requested
+	for h, rtx := range e.relayTxs {
+		if time.Since(rtx.added) > e.txRelayLifetime {
+			delete(e.relayTxs, h)
+		}
+	}
+	e.relayTxs[hash] = &relayTx{
+		msgTx: msgTx,
+		added: time.Now(),
+	}
+	e.relayTxsLock.Unlock()
+
+	err = e.peers.RelayTx(msgTx)
+	if err != nil {
+		return err
+	}
+
+	e.logger.Infow("Relayed transaction", "tx_id", hash.String(), "peers", len(e.peers.All()))
+
+	return nil
+
+}
+
+// OnGetData is called when a peer requests data, it's sent the transactions we relayed. They are only added to the
+// mempool once the node accepts them and they come back with it's mempool.
+func (e *Extractor) OnGetData(p *peer.Peer, msg *wire.MsgGetData) {
+
+	notFound := wire.NewMsgNotFound()
+
+	for _, iv := range msg.InvList {
+		if iv.Type != wire.InvTypeTx && iv.Type != wire.InvTypeWitnessTx {
+			notFound.AddInvVect(iv)
+			continue
+		}
+
+		e.relayTxsLock.Lock()
+		rtx, found := e.relayTxs[iv.Hash]
+		e.relayTxsLock.Unlock()
+
+		if !found {
+			notFound.AddInvVect(iv)
+			continue
+		}
+
+		e.logger.Debugw("Sending relayed transaction", "tx_id", iv.Hash.String(), "peer", p.Addr())
+		p.QueueMessage(rtx.msgTx, nil)
+	}
+
+	if len(notFound.InvList) > 0 {
+		p.QueueMessage(notFound, nil)
+	}
+
+}
@@ -0,0 +1,699 @@
+package btc
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	config "github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/conf"
+)
+
+// How many calls are sent in one batch request
+const rpcBatchSize = 100
+
+// rpcClient makes JSON-RPC calls to Bitcoin Core
+type rpcClient struct {
+	url      string
+	user     string
+	password string
+	client   *http.Client
+	id       uint64
+}
+
+type rpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+	ID     uint64          `json:"id"`
+}
+
+// rpcError is an error returned by the node
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("RPC error %d: %s", e.Code, e.Message)
+}
+
+func newRPCClient(url string, user string, password string, timeout time.Duration) *rpcClient {
+	return &rpcClient{
+		url:      url,
+		user:     user,
+		password: password,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// post sends a request body and decodes the response into ret
+func (c *rpcClient) post(body interface{}, ret interface{}) error {
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.user != "" || c.password != "" {
+		req.SetBasicAuth(c.user, c.password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Errors also come back with a JSON body except for authentication
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("RPC authentication failed: %s", resp.Status)
+	}
+	err = json.NewDecoder(resp.Body).Decode(ret)
+	if err != nil {
+		return fmt.Errorf("Could not decode RPC response (%s): %v", resp.Status, err)
+	}
+
+	return nil
+
+}
+
+// call makes a call and decodes it's result into result if not nil
+func (c *rpcClient) call(method string, params []interface{}, result interface{}) error {
+
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	var resp rpcResponse
+	err := c.post(&rpcRequest{
+		JSONRPC: "1.0",
+		ID:      atomic.AddUint64(&c.id, 1),
+		Method:  method,
+		Params:  params,
+	}, &resp)
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return resp.Error
+	}
+	if result != nil {
+		return json.Unmarshal(resp.Result, result)
+	}
+
+	return nil
+
+}
+
+// batch makes a call of method for each of params in batches of rpcBatchSize, result is called in order with each
+// result or it's error and any error it returns stops the batch
+func (c *rpcClient) batch(method string, params [][]interface{}, result func(x int, raw json.RawMessage, err error) error) error {
+
+	for start := 0; start < len(params); start += rpcBatchSize {
+		end := start + rpcBatchSize
+		if end > len(params) {
+			end = len(params)
+		}
+
+		reqs := make([]*rpcRequest, 0, end-start)
+		for _, p := range params[start:end] {
+			reqs = append(reqs, &rpcRequest{
+				JSONRPC: "1.0",
+				ID:      atomic.AddUint64(&c.id, 1),
+				Method:  method,
+				Params:  p,
+			})
+		}
+
+		var resps []*rpcResponse
+		err := c.post(reqs, &resps)
+		if err != nil {
+			return err
+		}
+
+		// The responses can come back in any order
+		byId := make(map[uint64]*rpcResponse, len(resps))
+		for _, resp := range resps {
+			byId[resp.ID] = resp
+		}
+		for x, req := range reqs {
+			resp, ok := byId[req.ID]
+			if !ok {
+				return fmt.Errorf("Missing RPC response to %s", method)
+			}
+			if resp.Error != nil {
+				err = result(start+x, nil, resp.Error)
+			} else {
+				err = result(start+x, resp.Result, nil)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+
+}
+
+// rpcHeader is the verbose result of getblockheader
+type rpcHeader struct {
+	Hash              string `json:"hash"`
+	Height            int64  `json:"height"`
+	Confirmations     int64  `json:"confirmations"`
+	PreviousBlockHash string `json:"previousblockhash"`
+}
+
+// rpcSource gets blocks and transactions from the JSON-RPC interface of Bitcoin Core. New blocks and mempool changes
+// are found by polling every extractor.btc.rpc_poll_interval.
+type rpcSource struct {
+	logger       *zap.SugaredLogger
+	client       *rpcClient
+	listeners    SourceListeners
+	chain        string
+	pollInterval time.Duration
+	pollMemPool  bool
+	// Returns a transaction the source already has instead of getting it from the node
+	cachedTx func(txId string) *wire.MsgTx
+
+	connected bool
+	lastBlock int32
+	// The best block seen by the poller
+	tipHash   string
+	tipHeight int64
+	// The mempool when last polled, nil until requested
+	memPool map[string]struct{}
+	sync.RWMutex
+}
+
+func newRPCSource(chainParams *chaincfg.Params, listeners SourceListeners) *rpcSource {
+	return &rpcSource{
+		logger: zap.S().With("package", "blocc.btc.rpc"),
+		client: newRPCClient(
+			config.GetString("extractor.btc.rpc_url"),
+			config.GetString("extractor.btc.rpc_user"),
+			config.GetString("extractor.btc.rpc_password"),
+			config.GetDuration("extractor.btc.rpc_timeout"),
+		),
+		listeners:    listeners,
+		chain:        coreChainName(chainParams),
+		pollInterval: config.GetDuration("extractor.btc.rpc_poll_interval"),
+		pollMemPool:  true,
+	}
+}
+
+// coreChainName returns the name Bitcoin Core uses for a chain
+func coreChainName(chainParams *chaincfg.Params) string {
+	switch chainParams.Name {
+	case chaincfg.MainNetParams.Name:
+		return "main"
+	case chaincfg.TestNet3Params.Name, "testnet4": // Litecoin's test network
+		return "test"
+	}
+	return chainParams.Name
+}
+
+// Connect checks the node is on the same chain and gets it's best block
+func (s *rpcSource) Connect() error {
+
+	var info struct {
+		Chain         string `json:"chain"`
+		Blocks        int32  `json:"blocks"`
+		BestBlockHash string `json:"bestblockhash"`
+	}
+	err := s.client.call("getblockchaininfo", nil, &info)
+	if err != nil {
+		return fmt.Errorf("Could not getblockchaininfo: %v", err)
+	}
+	if info.Chain != s.chain {
+		return fmt.Errorf("Node is on chain %s not %s", info.Chain, s.chain)
+	}
+
+	s.Lock()
+	s.connected = true
+	if info.Blocks > s.lastBlock {
+		s.lastBlock = info.Blocks
+	}
+	s.tipHash = info.BestBlockHash
+	s.tipHeight = int64(info.Blocks)
+	s.Unlock()
+
+	s.logger.Infow("Connected to node", "url", s.client.url, "chain", info.Chain, "height", info.Blocks)
+
+	return nil
+
+}
+
+// Connected returns if the last call to the node worked
+func (s *rpcSource) Connected() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.connected
+}
+
+// Disconnect marks the node disconnected so it's checked again with Connect
+func (s *rpcSource) Disconnect() {
+	s.Lock()
+	s.connected = false
+	s.memPool = nil
+	s.Unlock()
+}
+
+// Stalled reconnects to the node
+func (s *rpcSource) Stalled() {
+	s.logger.Warnw("Node stalled", "url", s.client.url)
+	s.Disconnect()
+}
+
+// LastBlock returns the block height of the node
+func (s *rpcSource) LastBlock() int32 {
+	s.RLock()
+	defer s.RUnlock()
+	return s.lastBlock
+}
+
+// UpdateLastBlockHeight updates the block height of the node
+func (s *rpcSource) UpdateLastBlockHeight(height int32) {
+	s.Lock()
+	defer s.Unlock()
+	if height > s.lastBlock {
+		s.lastBlock = height
+	}
+}
+
+// Run polls for new blocks and mempool changes until stopped
+func (s *rpcSource) Run() {
+	for {
+		select {
+		case <-time.After(s.pollInterval):
+		case <-conf.Stop.Chan():
+			return
+		}
+		if !s.Connected() {
+			continue
+		}
+		err := s.poll()
+		if err != nil {
+			s.logger.Errorw("Could not poll node", "error", err)
+			s.Disconnect()
+		}
+	}
+}
+
+// poll sends the blocks above the last best block to OnBlock and the mempool changes to OnTx and OnTxRemoved
+func (s *rpcSource) poll() error {
+
+	var tipHash string
+	err := s.client.call("getbestblockhash", nil, &tipHash)
+	if err != nil {
+		return err
+	}
+
+	// Transactions confirmed by the new blocks did not leave the mempool
+	confirmed := make(map[string]struct{})
+
+	s.RLock()
+	lastHash, lastHeight := s.tipHash, s.tipHeight
+	s.RUnlock()
+
+	if tipHash != lastHash {
+		var tip rpcHeader
+		err = s.client.call("getblockheader", []interface{}{tipHash, true}, &tip)
+		if err != nil {
+			return err
+		}
+
+		// Send the blocks since the last poll, following the chain further back is left to the extractor
+		start := lastHeight + 1
+		if start > tip.Height {
+			start = tip.Height
+		} else if start < tip.Height-rpcBatchSize+1 {
+			start = tip.Height - rpcBatchSize + 1
+		}
+		blks, err := s.getBlocks(start, tip.Height)
+		if err != nil {
+			return err
+		}
+
+		s.Lock()
+		s.tipHash = tipHash
+		s.tipHeight = tip.Height
+		if int32(tip.Height) > s.lastBlock {
+			s.lastBlock = int32(tip.Height)
+		}
+		s.Unlock()
+
+		for _, blk := range blks {
+			for _, tx := range blk.Transactions {
+				confirmed[tx.TxHash().String()] = struct{}{}
+			}
+			if s.listeners.OnBlock != nil {
+				s.listeners.OnBlock(blk)
+			}
+		}
+	}
+
+	if !s.pollMemPool || s.listeners.OnTx == nil {
+		return nil
+	}
+
+	s.RLock()
+	loaded := s.memPool != nil
+	s.RUnlock()
+	if !loaded {
+		return nil
+	}
+
+	var txIds []string
+	err = s.client.call("getrawmempool", []interface{}{false}, &txIds)
+	if err != nil {
+		return err
+	}
+
+	memPool := make(map[string]struct{}, len(txIds))
+	var added []string
+	s.Lock()
+	for _, txId := range txIds {
+		memPool[txId] = struct{}{}
+		if _, ok := s.memPool[txId]; !ok {
+			added = append(added, txId)
+		}
+	}
+	var removed []string
+	for txId := range s.memPool {
+		if _, ok := memPool[txId]; !ok {
+			if _, ok := confirmed[txId]; !ok {
+				removed = append(removed, txId)
+			}
+		}
+	}
+	s.memPool = memPool
+	s.Unlock()
+
+	for _, txId := range removed {
+		if s.listeners.OnTxRemoved != nil {
+			s.listeners.OnTxRemoved(txId)
+		}
+	}
+
+	return s.sendTxs(added)
+
+}
+
+// getBlocks returns the blocks of the best chain from height start through end
+func (s *rpcSource) getBlocks(start int64, end int64) ([]*wire.MsgBlock, error) {
+
+	hashes, err := s.getBlockHashes(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make([][]interface{}, len(hashes))
+	for x, hash := range hashes {
+		params[x] = []interface{}{hash, 0}
+	}
+	blks := make([]*wire.MsgBlock, len(hashes))
+	err = s.client.batch("getblock", params, func(x int, raw json.RawMessage, err error) error {
+		if err != nil {
+			return err
+		}
+		blks[x] = new(wire.MsgBlock)
+		return decodeRPCHex(raw, blks[x].Deserialize)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return blks, nil
+
+}
+
+// getBlockHashes returns the block hashes of the best chain from height start through end
+func (s *rpcSource) getBlockHashes(start int64, end int64) ([]string, error) {
+
+	if end < start {
+		return nil, nil
+	}
+
+	params := make([][]interface{}, 0, end-start+1)
+	for height := start; height <= end; height++ {
+		params = append(params, []interface{}{height})
+	}
+	hashes := make([]string, len(params))
+	err := s.client.batch("getblockhash", params, func(x int, raw json.RawMessage, err error) error {
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(raw, &hashes[x])
+	})
+
+	return hashes, err
+
+}
+
+// requestRange finds the height of the best chain block start connects to and the height to request through
+func (s *rpcSource) requestRange(start *chainhash.Hash, stop *chainhash.Hash, count int64) (int64, int64, error) {
+
+	// Follow a block no longer on the best chain back to where it forked
+	var h rpcHeader
+	hash := start.String()
+	for {
+		err := s.client.call("getblockheader", []interface{}{hash, true}, &h)
+		if err != nil {
+			return 0, 0, err
+		}
+		if h.Confirmations >= 0 || h.PreviousBlockHash == "" {
+			break
+		}
+		hash = h.PreviousBlockHash
+	}
+
+	var height int64
+	err := s.client.call("getblockcount", nil, &height)
+	if err != nil {
+		return 0, 0, err
+	}
+	s.UpdateLastBlockHeight(int32(height))
+
+	end := h.Height + count
+	if end > height {
+		end = height
+	}
+	if stop != nil && *stop != (chainhash.Hash{}) {
+		var sh rpcHeader
+		err = s.client.call("getblockheader", []interface{}{stop.String(), true}, &sh)
+		if err != nil {
+			return 0, 0, err
+		}
+		if sh.Height < end {
+			end = sh.Height
+		}
+	}
+
+	return h.Height, end, nil
+
+}
+
+// RequestHeaders sends the headers after start to OnHeaders
+func (s *rpcSource) RequestHeaders(start *chainhash.Hash, stop *chainhash.Hash) error {
+
+	if !s.Connected() {
+		return fmt.Errorf("Not connected to node")
+	}
+
+	go func() {
+		from, end, err := s.requestRange(start, stop, config.GetInt64("extractor.btc.block_headers_request_count"))
+		if err != nil {
+			s.logger.Errorw("Could not request headers", "start", start, "error", err)
+			return
+		}
+
+		hashes, err := s.getBlockHashes(from+1, end)
+		if err != nil {
+			s.logger.Errorw("Could not getblockhash", "error", err)
+			return
+		}
+		params := make([][]interface{}, len(hashes))
+		for x, hash := range hashes {
+			params[x] = []interface{}{hash, false}
+		}
+		headers := make([]*wire.BlockHeader, len(hashes))
+		err = s.client.batch("getblockheader", params, func(x int, raw json.RawMessage, err error) error {
+			if err != nil {
+				return err
+			}
+			headers[x] = new(wire.BlockHeader)
+			return decodeRPCHex(raw, headers[x].Deserialize)
+		})
+		if err != nil {
+			s.logger.Errorw("Could not getblockheader", "error", err)
+			return
+		}
+
+		if len(headers) > 0 && s.listeners.OnHeaders != nil {
+			s.listeners.OnHeaders(headers)
+		}
+	}()
+
+	return nil
+
+}
+
+// RequestBlocks sends the blocks after start to OnBlock
+func (s *rpcSource) RequestBlocks(start *chainhash.Hash, stop *chainhash.Hash) error {
+
+	if !s.Connected() {
+		return fmt.Errorf("Not connected to node")
+	}
+
+	go func() {
+		from, end, err := s.requestRange(start, stop, config.GetInt64("extractor.btc.block_request_count"))
+		if err != nil {
+			s.logger.Errorw("Could not request blocks", "start", start, "error", err)
+			return
+		}
+
+		// A few at a time so they are not all held in memory
+		for height := from + 1; height <= end; height += 10 {
+			last := height + 9
+			if last > end {
+				last = end
+			}
+			blks, err := s.getBlocks(height, last)
+			if err != nil {
+				s.logger.Errorw("Could not getblock", "height", height, "error", err)
+				return
+			}
+			for _, blk := range blks {
+				if s.listeners.OnBlock != nil {
+					s.listeners.OnBlock(blk)
+				}
+			}
+		}
+	}()
+
+	return nil
+
+}
+
+// RequestMemPool sends every transaction in the mempool to OnTx, the poller reports changes from then on
+func (s *rpcSource) RequestMemPool() error {
+
+	if !s.Connected() {
+		return fmt.Errorf("Not connected to node")
+	}
+
+	var txIds []string
+	err := s.client.call("getrawmempool", []interface{}{false}, &txIds)
+	if err != nil {
+		return fmt.Errorf("Could not getrawmempool: %v", err)
+	}
+
+	memPool := make(map[string]struct{}, len(txIds))
+	for _, txId := range txIds {
+		memPool[txId] = struct{}{}
+	}
+	s.Lock()
+	s.memPool = memPool
+	s.Unlock()
+
+	go func() {
+		err := s.sendTxs(txIds)
+		if err != nil {
+			s.logger.Errorw("Could not get mempool transactions", "error", err)
+		}
+	}()
+
+	return nil
+
+}
+
+// sendTxs gets transactions from the node and sends them to OnTx, those no longer in the mempool are skipped
+func (s *rpcSource) sendTxs(txIds []string) error {
+
+	if s.listeners.OnTx == nil || len(txIds) == 0 {
+		return nil
+	}
+
+	params := make([][]interface{}, 0, len(txIds))
+	fetch := make([]string, 0, len(txIds))
+	for _, txId := range txIds {
+		if s.cachedTx != nil {
+			if msgTx := s.cachedTx(txId); msgTx != nil {
+				s.listeners.OnTx(msgTx)
+				continue
+			}
+		}
+		params = append(params, []interface{}{txId, false})
+		fetch = append(fetch, txId)
+	}
+	return s.client.batch("getrawtransaction", params, func(x int, raw json.RawMessage, err error) error {
+		if err != nil {
+			s.logger.Debugw("Could not getrawtransaction", "tx_id", fetch[x], "error", err)
+			return nil
+		}
+		msgTx := new(wire.MsgTx)
+		err = decodeRPCHex(raw, msgTx.Deserialize)
+		if err != nil {
+			return err
+		}
+		s.listeners.OnTx(msgTx)
+		return nil
+	})
+
+}
+
+// RelayTx sends the transaction to the node with sendrawtransaction
+func (s *rpcSource) RelayTx(msgTx *wire.MsgTx) error {
+
+	var buf bytes.Buffer
+	err := msgTx.Serialize(&buf)
+	if err != nil {
+		return err
+	}
+
+	return s.client.call("sendrawtransaction", []interface{}{hex.EncodeToString(buf.Bytes())}, nil)
+
+}
+
+// MemPoolEvents is true, the poller reports transactions leaving the mempool
+func (s *rpcSource) MemPoolEvents() bool {
+	return true
+}
+
+// decodeRPCHex decodes a hex string result with deserialize
+func decodeRPCHex(raw json.RawMessage, deserialize func(r io.Reader) error) error {
+	var h string
+	err := json.Unmarshal(raw, &h)
+	if err != nil {
+		return err
+	}
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return err
+	}
+	return deserialize(bytes.NewReader(b))
+}
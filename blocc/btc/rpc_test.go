@@ -0,0 +1,266 @@
+package btc
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	config "github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+// testNode is a stand-in for the Bitcoin Core JSON-RPC interface serving the regtest block files
+type testNode struct {
+	blocks  []*wire.MsgBlock
+	memPool map[string]*wire.MsgTx
+	sent    []string
+	sync.Mutex
+}
+
+func newTestNode(t *testing.T) *testNode {
+
+	params := &chaincfg.RegressionNetParams
+	bf, err := indexBlockFiles("testdata/regtest/blocks", params.Net)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	n := &testNode{
+		blocks:  []*wire.MsgBlock{params.GenesisBlock},
+		memPool: make(map[string]*wire.MsgTx),
+	}
+	for _, entry := range bf.bestChain(*params.GenesisHash) {
+		blk, err := bf.readBlock(entry)
+		assert.Nil(t, err)
+		n.blocks = append(n.blocks, blk)
+	}
+
+	return n
+
+}
+
+func (n *testNode) handle(method string, params []interface{}) (interface{}, *rpcError) {
+
+	n.Lock()
+	defer n.Unlock()
+
+	serialize := func(s interface{ Serialize(w io.Writer) error }) string {
+		var buf bytes.Buffer
+		s.Serialize(&buf)
+		return hex.EncodeToString(buf.Bytes())
+	}
+	notFound := &rpcError{Code: -5, Message: "Not found"}
+
+	switch method {
+	case "getblockchaininfo":
+		tip := n.blocks[len(n.blocks)-1]
+		return map[string]interface{}{"chain": "regtest", "blocks": len(n.blocks) - 1, "bestblockhash": tip.BlockHash().String()}, nil
+	case "getblockcount":
+		return len(n.blocks) - 1, nil
+	case "getbestblockhash":
+		return n.blocks[len(n.blocks)-1].BlockHash().String(), nil
+	case "getblockhash":
+		height := int(params[0].(float64))
+		if height >= len(n.blocks) {
+			return nil, &rpcError{Code: -8, Message: "Block height out of range"}
+		}
+		return n.blocks[height].BlockHash().String(), nil
+	case "getblockheader", "getblock":
+		for height, blk := range n.blocks {
+			if blk.BlockHash().String() != params[0].(string) {
+				continue
+			}
+			if method == "getblock" {
+				return serialize(blk), nil
+			}
+			if verbose, _ := params[1].(bool); !verbose {
+				return serialize(&blk.Header), nil
+			}
+			return &rpcHeader{
+				Hash:              blk.BlockHash().String(),
+				Height:            int64(height),
+				Confirmations:     int64(len(n.blocks) - height),
+				PreviousBlockHash: blk.Header.PrevBlock.String(),
+			}, nil
+		}
+		return nil, notFound
+	case "getrawmempool":
+		txIds := []string{}
+		for txId := range n.memPool {
+			txIds = append(txIds, txId)
+		}
+		return txIds, nil
+	case "getrawtransaction":
+		if msgTx, ok := n.memPool[params[0].(string)]; ok {
+			return serialize(msgTx), nil
+		}
+		return nil, notFound
+	case "sendrawtransaction":
+		n.sent = append(n.sent, params[0].(string))
+		return "", nil
+	}
+
+	return nil, &rpcError{Code: -32601, Message: "Method not found"}
+
+}
+
+func (n *testNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if user, password, _ := r.BasicAuth(); user != "user" || password != "password" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	respond := func(req *rpcRequest) map[string]interface{} {
+		result, err := n.handle(req.Method, req.Params)
+		return map[string]interface{}{"result": result, "error": err, "id": req.ID}
+	}
+
+	// A batch is an array of requests
+	if len(body) > 0 && body[0] == '[' {
+		var reqs []*rpcRequest
+		json.Unmarshal(body, &reqs)
+		resps := make([]interface{}, 0, len(reqs))
+		// Answer out of order
+		for x := len(reqs) - 1; x >= 0; x-- {
+			resps = append(resps, respond(reqs[x]))
+		}
+		json.NewEncoder(w).Encode(resps)
+		return
+	}
+
+	var req rpcRequest
+	json.Unmarshal(body, &req)
+	json.NewEncoder(w).Encode(respond(&req))
+
+}
+
+func newTestRPCSource(t *testing.T, url string, listeners SourceListeners) *rpcSource {
+	config.Set("extractor.btc.rpc_url", url)
+	config.Set("extractor.btc.rpc_user", "user")
+	config.Set("extractor.btc.rpc_password", "password")
+	config.Set("extractor.btc.rpc_timeout", "10s")
+	config.Set("extractor.btc.block_headers_request_count", 2000)
+	config.Set("extractor.btc.block_request_count", 500)
+	return newRPCSource(&chaincfg.RegressionNetParams, listeners)
+}
+
+func TestRPCSource(t *testing.T) {
+
+	node := newTestNode(t)
+	server := httptest.NewServer(node)
+	defer server.Close()
+
+	headers := make(chan []*wire.BlockHeader, 1)
+	blocks := make(chan *wire.MsgBlock, 10)
+	txs := make(chan *wire.MsgTx, 10)
+	removed := make(chan string, 10)
+	s := newTestRPCSource(t, server.URL, SourceListeners{
+		OnHeaders:   func(h []*wire.BlockHeader) { headers <- h },
+		OnBlock:     func(msg *wire.MsgBlock) { blocks <- msg },
+		OnTx:        func(msg *wire.MsgTx) { txs <- msg },
+		OnTxRemoved: func(txId string) { removed <- txId },
+	})
+
+	// Wrong chain
+	s.chain = "main"
+	assert.NotNil(t, s.Connect())
+	s.chain = "regtest"
+
+	assert.Nil(t, s.Connect())
+	assert.True(t, s.Connected())
+	assert.Equal(t, int32(5), s.LastBlock())
+
+	// Headers from the genesis block
+	assert.Nil(t, s.RequestHeaders(&node.blocks[0].Header.PrevBlock, &chainhash.Hash{}))
+	select {
+	case <-headers:
+		t.Fatal("The genesis block has no previous block")
+	case <-time.After(100 * time.Millisecond):
+	}
+	genesis := node.blocks[0].BlockHash()
+	assert.Nil(t, s.RequestHeaders(&genesis, &chainhash.Hash{}))
+	select {
+	case h := <-headers:
+		if assert.Len(t, h, 5) {
+			for x := range h {
+				assert.Equal(t, node.blocks[x+1].BlockHash(), h[x].BlockHash())
+			}
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("No headers")
+	}
+
+	// Blocks from block 2 through 4
+	start, stop := node.blocks[2].BlockHash(), node.blocks[4].BlockHash()
+	assert.Nil(t, s.RequestBlocks(&start, &stop))
+	for x := 3; x <= 4; x++ {
+		select {
+		case blk := <-blocks:
+			assert.Equal(t, node.blocks[x].BlockHash(), blk.BlockHash())
+		case <-time.After(5 * time.Second):
+			t.Fatal("No block")
+		}
+	}
+
+	// The mempool
+	tx1, tx2 := wire.NewMsgTx(1), wire.NewMsgTx(2)
+	for _, msgTx := range []*wire.MsgTx{tx1, tx2} {
+		msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+		msgTx.AddTxOut(wire.NewTxOut(int64(msgTx.Version), []byte{0x51}))
+	}
+	node.Lock()
+	node.memPool[tx1.TxHash().String()] = tx1
+	node.Unlock()
+	assert.Nil(t, s.RequestMemPool())
+	select {
+	case msgTx := <-txs:
+		assert.Equal(t, tx1.TxHash(), msgTx.TxHash())
+	case <-time.After(5 * time.Second):
+		t.Fatal("No transaction")
+	}
+
+	// A new block confirming tx1 and tx2 arriving in the mempool
+	blk := wire.NewMsgBlock(&wire.BlockHeader{PrevBlock: node.blocks[5].BlockHash()})
+	blk.AddTransaction(tx1)
+	node.Lock()
+	node.blocks = append(node.blocks, blk)
+	delete(node.memPool, tx1.TxHash().String())
+	node.memPool[tx2.TxHash().String()] = tx2
+	node.Unlock()
+	assert.Nil(t, s.poll())
+	assert.Equal(t, blk.BlockHash(), (<-blocks).BlockHash())
+	assert.Equal(t, int32(6), s.LastBlock())
+	assert.Equal(t, tx2.TxHash(), (<-txs).TxHash())
+	assert.Len(t, removed, 0)
+
+	// tx2 leaves the mempool without a block
+	node.Lock()
+	delete(node.memPool, tx2.TxHash().String())
+	node.Unlock()
+	assert.Nil(t, s.poll())
+	assert.Equal(t, tx2.TxHash().String(), <-removed)
+	assert.Len(t, blocks, 0)
+
+	// Sending a transaction
+	assert.Nil(t, s.RelayTx(tx2))
+	node.Lock()
+	assert.Len(t, node.sent, 1)
+	node.Unlock()
+
+	// Authentication
+	s.client.password = "wrong"
+	assert.NotNil(t, s.Connect())
+
+}
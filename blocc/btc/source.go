@@ -0,0 +1,81 @@
+package btc
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// Source is the node the extractor gets the block chain and mempool from, set with extractor.btc.source
+//   - p2p: the bitcoin wire protocol to extractor.btc.peers
+//   - rpc: the JSON-RPC interface of Bitcoin Core polled every extractor.btc.rpc_poll_interval
+//   - zmq: the JSON-RPC interface with blocks and mempool changes pushed from the ZMQ notifications
+type Source interface {
+	// Connect connects to the node, it's an error if it can't be reached
+	Connect() error
+	Connected() bool
+	Disconnect()
+	// Run keeps watching the node until stopped
+	Run()
+	// Stalled is called when the data requested never arrived, the source should fail over or reconnect
+	Stalled()
+
+	// The block height of the node
+	LastBlock() int32
+	UpdateLastBlockHeight(height int32)
+
+	// RequestHeaders requests the headers after start through stop or as many as possible if stop is zero
+	RequestHeaders(start *chainhash.Hash, stop *chainhash.Hash) error
+	// RequestBlocks requests the blocks after start through stop or extractor.btc.block_request_count if stop is zero
+	RequestBlocks(start *chainhash.Hash, stop *chainhash.Hash) error
+	// RequestMemPool requests every transaction in the mempool
+	RequestMemPool() error
+	// RelayTx sends a transaction to the node
+	RelayTx(msgTx *wire.MsgTx) error
+
+	// MemPoolEvents returns if transactions leaving the mempool are reported to OnTxRemoved, otherwise the mempool must
+	// be refreshed periodically to find them
+	MemPoolEvents() bool
+}
+
+// SourceListeners are called with the data from sources other than p2p, the peers call the extractor directly
+type SourceListeners struct {
+	OnHeaders   func(headers []*wire.BlockHeader)
+	OnBlock     func(msg *wire.MsgBlock)
+	OnTx        func(msg *wire.MsgTx)
+	OnTxRemoved func(txId string)
+}
+
+// newSource creates the configured source
+func (e *Extractor) newSource(source string) (Source, error) {
+
+	listeners := SourceListeners{
+		OnHeaders: func(headers []*wire.BlockHeader) {
+			e.OnHeaders(nil, &wire.MsgHeaders{Headers: headers})
+		},
+		OnBlock: func(msg *wire.MsgBlock) {
+			e.OnBlock(nil, msg, nil)
+		},
+	}
+	// Only the transaction extractor follows the mempool
+	if e.txFetch {
+		listeners.OnTx = func(msg *wire.MsgTx) {
+			e.OnTx(nil, msg)
+		}
+		listeners.OnTxRemoved = e.removeMemPoolTx
+	}
+
+	switch source {
+	case "p2p":
+		e.peers = newPeerManager(e.chainParams, e.peerConfig)
+		return e.peers, nil
+	case "rpc":
+		return newRPCSource(e.chainParams, listeners), nil
+	case "zmq":
+		return newZMQSource(e.chainParams, listeners)
+	}
+
+	return nil, fmt.Errorf("Unknown source %s", source)
+
+}
@@ -6,12 +6,13 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
-	"github.com/btcsuite/btcutil"
 	"github.com/spf13/cast"
 
 	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
 )
 
 type txStat struct {
@@ -21,22 +22,16 @@ type txStat struct {
 	OutputValue int64
 	Fee         int64
 	FeeVSize    float64
+	SignalsRBF  bool
+	// FeeSize is the size the fee rate is calculated with, the virtual size or the size without segwit
+	FeeSize float64
 }
 
 // handleTx is called to handle transaction both when sent from the peer as part of the mempool or when parsing block
 func (e *Extractor) handleTx(blk *blocc.Block, txHeight int64, wTx *wire.MsgTx, prevOutPoints map[string]*blocc.Tx) *txStat {
 
 	// Build the blocc.Tx
-	tx := &blocc.Tx{
-		Symbol: Symbol,
-		TxId:   wTx.TxHash().String(),
-		Height: txHeight,
-		TxSize: int64(wTx.SerializeSize()),
-		Data:   make(map[string]string),
-		Metric: make(map[string]float64),
-		In:     make([]*blocc.TxIn, len(wTx.TxIn)),
-		Out:    make([]*blocc.TxOut, len(wTx.TxOut)),
-	}
+	tx, txs, vsize := newTx(wTx, txHeight, e.chain, e.chainParams)
 
 	// Write the raw transaction
 	if e.txStoreRaw {
@@ -45,66 +40,13 @@ func (e *Extractor) handleTx(blk *blocc.Block, txHeight int64, wTx *wire.MsgTx,
 		tx.Raw = r.Bytes()
 	}
 
-	// Metrics
-	weight := int64((wTx.SerializeSizeStripped() * (4 - 1)) + wTx.SerializeSize()) // WitnessScaleFactor = 4
-	tx.Data["vin_count"] = cast.ToString(len(wTx.TxIn))
-	tx.Data["vout_count"] = cast.ToString(len(wTx.TxOut))
-	tx.Data["weight"] = cast.ToString(weight)
-	tx.Data["vsize"] = cast.ToString((weight + 3) / 4)
-	tx.Data["version"] = cast.ToString(wTx.Version)
-	tx.Data["lock_time"] = cast.ToString(wTx.LockTime)
-
-	// This will be returned
-	txs := &txStat{
-		Coinbase: blockchain.IsCoinBaseTx(wTx),
-	}
-	tx.Data["coinbase"] = cast.ToString(txs.Coinbase)
-
-	// Parse all of the outputs
-	for height, vout := range wTx.TxOut {
-
-		txOut := &blocc.TxOut{
-			Value: vout.Value,
-			Raw:   vout.PkScript,
-		}
-		txs.OutputValue += vout.Value
-
-		// Attempt to parse simple addresses out of the script
-		scriptType, addresses, reqSigs, err := txscript.ExtractPkScriptAddrs(vout.PkScript, e.chainParams)
-		// Could not decode
-		if err != nil {
-			txOut.Type = txscript.NonStandardTy.String()
-		} else {
-			txOut.Type = scriptType.String()
-			txOut.Addresses = parseBTCAddresses(addresses)
-			txOut.Data = map[string]string{
-				"req_sigs": cast.ToString(reqSigs),
-			}
-			txOut.Metric = make(map[string]float64)
-		}
-
-		tx.Out[height] = txOut
-	}
-	tx.Data["out_value"] = cast.ToString(txs.OutputValue)
-
 	// At this point all transaction outputs are final and safe to read.
 	// The only things accessed from the blockHeaderTxMon are the outputs so it's safe to put the transaction in the blockHeaderTxMon
 	e.blockHeaderTxMon.AddTx(tx, e.blockHeaderTxMonTxLifetime)
 
-	// Parse all of the inputs
-	for height, vin := range wTx.TxIn {
-		txIn := &blocc.TxIn{
-			TxId:   vin.PreviousOutPoint.Hash.String(),
-			Height: int64(vin.PreviousOutPoint.Index),
-			Data: map[string]string{
-				"sequence": cast.ToString(vin.Sequence),
-			},
-			Metric: make(map[string]float64),
-		}
-		tx.In[height] = txIn
-
-		// Resolve the previous transaction
-		if e.txResolvePrevious && !txs.Coinbase {
+	// Resolve the previous transactions
+	if e.txResolvePrevious && !txs.Coinbase {
+		for _, txIn := range tx.In {
 
 			// If the transaction id is not included in prevOutPoints it means it's included in this block
 			prevTx, found := prevOutPoints[txIn.TxId]
@@ -131,75 +73,85 @@ func (e *Extractor) handleTx(blk *blocc.Block, txHeight int64, wTx *wire.MsgTx,
 					"found", found,
 				)
 				txs.Incomplete = true
-			} else {
-				if int64(len(prevTx.Out)) > txIn.Height {
-					txIn.Out = prevTx.Out[txIn.Height]
-					txs.InputValue += txIn.Out.Value
-				} else {
-					e.logger.Warnw("PreviousOutPoint missing transaction",
-						"tx_id", txIn.TxId,
-						"height", txIn.Height,
-						"blkheight", blk.GetHeightSafe(),
-						"found", found,
-					)
-					txs.Incomplete = true
-				}
+			} else if !txs.resolveInput(txIn, prevTx) {
+				e.logger.Warnw("PreviousOutPoint missing transaction",
+					"tx_id", txIn.TxId,
+					"height", txIn.Height,
+					"blkheight", blk.GetHeightSafe(),
+					"found", found,
+				)
 			}
 		}
 	}
 
 	// Final TX stats
-	tx.Data["in_value"] = cast.ToString(txs.InputValue)
-	tx.Incomplete = txs.Incomplete
-	// If it's a coinbase or we couldn't find an input, mark the fee as zero otherwise it's some negative number messing everything up
-	if txs.Coinbase || txs.Incomplete {
-		txs.Fee = 0
-		txs.FeeVSize = 0
-	} else {
-		txs.Fee = txs.InputValue - txs.OutputValue
-		txs.FeeVSize = float64(txs.Fee) / ((float64(weight) + 3) / 4) // = fee / vsize
-	}
-	tx.Data["fee"] = cast.ToString(txs.Fee)
-	tx.Data["fee_vsize"] = cast.ToString(txs.FeeVSize)
+	txs.setFee(tx)
 
 	// If this transaction came as part of a block, add block metadata
-	if blk != nil {
+	setTxBlock(tx, blk)
+	if blk == nil {
 
-		tx.BlockId = blk.BlockId
-		tx.BlockHeight = blk.Height
-		tx.Time = blk.Time
-		tx.BlockTime = blk.Time
-
-	} else {
-
-		tx.BlockId = blocc.BlockIdMempool
-		tx.BlockHeight = blocc.HeightUnknown
 		tx.Time = time.Now().UTC().Unix()
 		tx.Data["received_time"] = cast.ToString(time.Now().UTC().Unix())
 
 		// Start accumulating metrics as numbers for calculations/fees
 		e.Lock()
 		if !e.lastBlockHeightUnknown {
-			tx.Data["received_block_height"] = cast.ToString(e.peer.LastBlock())
+			tx.Data["received_block_height"] = cast.ToString(e.source.LastBlock())
 		}
 		e.Unlock()
 
+		// Hold the mempool until this transaction is stored so the conflicts and packages are stored in order
+		e.memPoolLock.Lock()
+		defer e.memPoolLock.Unlock()
+
+		// The mempool is reloaded periodically, keep when it was first received
+		e.keepReceived(tx)
+
+		// Evict any mempool transactions spending the same outputs, the peer has accepted this one in their place
+		for _, conflictTxId := range e.memPoolSpends.AddTx(tx) {
+			e.logger.Infow("Transaction replaced", "tx_id", conflictTxId, "replaced_by", tx.TxId)
+			e.evictMemPoolTx(conflictTxId, tx.TxId)
+		}
+
+		// Link it to it's mempool parents and children, the packages of those are updated once this one is stored
+		related := e.memPoolPackages.AddTx(tx.TxId, txs.Fee, vsize, parentTxIds(tx))
+		e.memPoolPackages.GetPackage(tx.TxId).SetData(tx.Data)
+		defer e.updateMemPoolPackages(related)
+
 		// Send it on the TxBus
 		if e.txBus != nil {
-			err := e.txBus.Publish(Symbol, "stream", tx)
+			err := e.txBus.Publish(e.symbol, "stream", tx)
 			if err != nil {
 				e.logger.Errorw("Could not TxMsgBus Public", "error", err)
 			}
 		}
+		e.publishEvent(blocc.NewTxEvent(blocc.EventTxAdded, e.symbol, tx))
 
 	}
 
 	// Add it to the blockChainStore
-	err := e.blockChainStore.UpsertTransaction(Symbol, tx)
+	err := e.blockChainStore.UpsertTransaction(e.symbol, tx)
 	if err != nil {
 		e.logger.Errorw("Could not BlockStore UpsertTransaction", "error", err)
 	}
 
+	// Maintain the unspent outputs
+	if e.txTrackOutputs {
+		err = e.blockChainStore.UpsertOutputs(e.symbol, TrackOutputs(tx, txs.Coinbase))
+		if err != nil {
+			e.logger.Errorw("Could not BlockStore UpsertOutputs", "error", err)
+		}
+	}
+
+	// Maintain the address summaries
+	if e.txTrackAddresses {
+		err = e.blockChainStore.UpsertAddressTxs(e.symbol, store.AddressTxs(tx))
+		if err != nil {
+			e.logger.Errorw("Could not BlockStore UpsertAddressTxs", "error", err)
+		}
+	}
+
 	// At this point in time, the only references to the transaction will be for using the outputs
 	// We can clean the TX of unnessesary stuff to free up memory
 	tx.Data = nil
@@ -211,6 +163,202 @@ func (e *Extractor) handleTx(blk *blocc.Block, txHeight int64, wTx *wire.MsgTx,
 
 }
 
+// newTx builds the blocc.Tx of a transaction with it's outputs and inputs, the previous outputs are not resolved. It
+// also returns the virtual size, which is the size for chains without segwit.
+func newTx(wTx *wire.MsgTx, txHeight int64, chain *Chain, chainParams *chaincfg.Params) (*blocc.Tx, *txStat, int64) {
+
+	tx := &blocc.Tx{
+		Symbol: chain.Symbol,
+		TxId:   wTx.TxHash().String(),
+		Height: txHeight,
+		TxSize: int64(wTx.SerializeSize()),
+		Data:   make(map[string]string),
+		Metric: make(map[string]float64),
+		In:     make([]*blocc.TxIn, len(wTx.TxIn)),
+		Out:    make([]*blocc.TxOut, len(wTx.TxOut)),
+	}
+
+	// This will be returned
+	txs := &txStat{
+		Coinbase: blockchain.IsCoinBaseTx(wTx),
+	}
+
+	// Metrics
+	vsize := tx.TxSize
+	tx.Data["vin_count"] = cast.ToString(len(wTx.TxIn))
+	tx.Data["vout_count"] = cast.ToString(len(wTx.TxOut))
+	if chain.NoSegwit {
+		// The fee rate is per byte
+		txs.FeeSize = float64(tx.TxSize)
+	} else {
+		weight := int64((wTx.SerializeSizeStripped() * (4 - 1)) + wTx.SerializeSize()) // WitnessScaleFactor = 4
+		vsize = (weight + 3) / 4
+		tx.Data["weight"] = cast.ToString(weight)
+		tx.Data["vsize"] = cast.ToString(vsize)
+		txs.FeeSize = (float64(weight) + 3) / 4
+	}
+	tx.Data["version"] = cast.ToString(wTx.Version)
+	tx.Data["lock_time"] = cast.ToString(wTx.LockTime)
+	tx.Data["coinbase"] = cast.ToString(txs.Coinbase)
+
+	// Parse all of the outputs
+	for height, vout := range wTx.TxOut {
+
+		txOut := &blocc.TxOut{
+			Value: vout.Value,
+			Raw:   vout.PkScript,
+		}
+		txs.OutputValue += vout.Value
+
+		// Attempt to parse simple addresses out of the script
+		scriptType, addresses, reqSigs, err := chain.extractAddresses(vout.PkScript, chainParams)
+		// Could not decode
+		if err != nil {
+			txOut.Type = txscript.NonStandardTy.String()
+		} else {
+			txOut.Type = scriptType
+			txOut.Addresses = addresses
+			txOut.Data = map[string]string{
+				"req_sigs": cast.ToString(reqSigs),
+			}
+			txOut.Metric = make(map[string]float64)
+		}
+
+		tx.Out[height] = txOut
+	}
+	tx.Data["out_value"] = cast.ToString(txs.OutputValue)
+
+	// Parse all of the inputs
+	for height, vin := range wTx.TxIn {
+		tx.In[height] = &blocc.TxIn{
+			TxId:   vin.PreviousOutPoint.Hash.String(),
+			Height: int64(vin.PreviousOutPoint.Index),
+			Data: map[string]string{
+				"sequence": cast.ToString(vin.Sequence),
+			},
+			Metric: make(map[string]float64),
+		}
+
+		// BIP125 - Any input with a sequence below 0xfffffffe signals the transaction can be replaced
+		if !txs.Coinbase && vin.Sequence < wire.MaxTxInSequenceNum-1 {
+			txs.SignalsRBF = true
+		}
+	}
+
+	return tx, txs, vsize
+
+}
+
+// ConvertTx builds the blocc.Tx of a transaction the way the extractor stores it with the raw transaction, blk is nil
+// for mempool transactions. The inputs are resolved from prevTxs by transaction id.
+func ConvertTx(wTx *wire.MsgTx, txHeight int64, blk *blocc.Block, chain *Chain, chainParams *chaincfg.Params, prevTxs map[string]*blocc.Tx) *blocc.Tx {
+	tx, _ := convertTx(wTx, txHeight, blk, chain, chainParams, prevTxs)
+	return tx
+}
+
+// convertTx builds the blocc.Tx and it's stats without waiting on or logging missing previous transactions
+func convertTx(wTx *wire.MsgTx, txHeight int64, blk *blocc.Block, chain *Chain, chainParams *chaincfg.Params, prevTxs map[string]*blocc.Tx) (*blocc.Tx, *txStat) {
+
+	tx, txs, _ := newTx(wTx, txHeight, chain, chainParams)
+
+	var r = new(bytes.Buffer)
+	wTx.Serialize(r)
+	tx.Raw = r.Bytes()
+
+	if !txs.Coinbase {
+		for _, txIn := range tx.In {
+			txs.resolveInput(txIn, prevTxs[txIn.TxId])
+		}
+	}
+	txs.setFee(tx)
+	setTxBlock(tx, blk)
+
+	return tx, txs
+
+}
+
+// resolveInput sets the previous output of an input from the previous transaction, if it is missing the transaction
+// is marked incomplete and it returns false
+func (txs *txStat) resolveInput(txIn *blocc.TxIn, prevTx *blocc.Tx) bool {
+	if prevTx == nil || int64(len(prevTx.Out)) <= txIn.Height {
+		txs.Incomplete = true
+		return false
+	}
+	txIn.Out = prevTx.Out[txIn.Height]
+	txs.InputValue += txIn.Out.Value
+	return true
+}
+
+// setTxBlock sets the block of a transaction, blk is nil for mempool transactions
+func setTxBlock(tx *blocc.Tx, blk *blocc.Block) {
+	if blk != nil {
+		tx.BlockId = blk.BlockId
+		tx.BlockHeight = blk.Height
+		tx.Time = blk.Time
+		tx.BlockTime = blk.Time
+	} else {
+		tx.BlockId = blocc.BlockIdMempool
+		tx.BlockHeight = blocc.HeightUnknown
+	}
+}
+
+// setFee sets the final transaction stats once the previous outputs are resolved
+func (txs *txStat) setFee(tx *blocc.Tx) {
+
+	tx.Data["in_value"] = cast.ToString(txs.InputValue)
+	tx.Data["signals_rbf"] = cast.ToString(txs.SignalsRBF)
+	tx.Incomplete = txs.Incomplete
+	// If it's a coinbase or we couldn't find an input, mark the fee as zero otherwise it's some negative number messing everything up
+	if txs.Coinbase || txs.Incomplete {
+		txs.Fee = 0
+		txs.FeeVSize = 0
+	} else {
+		txs.Fee = txs.InputValue - txs.OutputValue
+		txs.FeeVSize = float64(txs.Fee) / txs.FeeSize // = fee / vsize
+	}
+	tx.Data["fee"] = cast.ToString(txs.Fee)
+	tx.Data["fee_vsize"] = cast.ToString(txs.FeeVSize)
+
+}
+
+// TrackOutputs returns the outputs created by the transaction and the previous outputs spent by it
+func TrackOutputs(tx *blocc.Tx, coinbase bool) []*blocc.Output {
+
+	outputs := make([]*blocc.Output, 0, len(tx.Out)+len(tx.In))
+
+	for height, out := range tx.Out {
+		outputs = append(outputs, &blocc.Output{
+			Symbol:      tx.Symbol,
+			TxId:        tx.TxId,
+			Height:      int64(height),
+			BlockId:     tx.BlockId,
+			BlockHeight: tx.BlockHeight,
+			Time:        tx.Time,
+			Out:         out,
+		})
+	}
+
+	// The coinbase input does not spend anything
+	if coinbase {
+		return outputs
+	}
+
+	for height, in := range tx.In {
+		outputs = append(outputs, &blocc.Output{
+			Symbol:           tx.Symbol,
+			TxId:             in.TxId,
+			Height:           in.Height,
+			SpentTxId:        tx.TxId,
+			SpentHeight:      int64(height),
+			SpentBlockId:     tx.BlockId,
+			SpentBlockHeight: tx.BlockHeight,
+		})
+	}
+
+	return outputs
+
+}
+
 // This populates the map of prevOutPoints with any transactions that are still missing
 func (e *Extractor) getPrevOutPoints(prevOutPoints map[string]*blocc.Tx, chainCompleteToThisBlock <-chan struct{}, txIdsInThisBlock map[string]struct{}) error {
 
@@ -236,7 +384,7 @@ func (e *Extractor) getPrevOutPoints(prevOutPoints map[string]*blocc.Tx, chainCo
 	}
 
 	// Fetch all the previous outpoints in one go to the block-store
-	txs, err := e.blockChainStore.GetTxsByTxIds(Symbol, txIds, blocc.TxIncludeOut)
+	txs, err := e.blockChainStore.GetTxsByTxIds(e.symbol, txIds, blocc.TxIncludeOut)
 	if err != nil {
 		return fmt.Errorf("Could not blockChainStore.GetTxsByTxIds: %v", err)
 	}
@@ -280,7 +428,7 @@ func (e *Extractor) getPrevOutPoints(prevOutPoints map[string]*blocc.Tx, chainCo
 	}
 
 	// We may have missed the cache, give the block chain store one more try
-	txs, err = e.blockChainStore.GetTxsByTxIds(Symbol, txIds, blocc.TxIncludeOut)
+	txs, err = e.blockChainStore.GetTxsByTxIds(e.symbol, txIds, blocc.TxIncludeOut)
 	if err != nil {
 		return fmt.Errorf("Could not blockChainStore.GetTxsByTxIds: %v", err)
 	}
@@ -299,11 +447,279 @@ func (e *Extractor) getPrevOutPoints(prevOutPoints map[string]*blocc.Tx, chainCo
 
 }
 
-// This coverts []btcutil.Address to []string
-func parseBTCAddresses(in []btcutil.Address) []string {
-	ret := make([]string, len(in), len(in))
-	for x, y := range in {
-		ret[x] = y.EncodeAddress()
+// evictMemPoolTx removes a mempool transaction replaced by a conflicting transaction along with any mempool transactions
+// spending it's outputs. Each is marked with replaced_by (unless replacedBy is empty) and published on the TxBus conflict
+// key and as a tx_evicted event before being removed.
+// The memPoolLock must be held.
+func (e *Extractor) evictMemPoolTx(txId string, replacedBy string) {
+
+	e.memPoolSpends.RemoveTx(txId)
+	delete(e.memPoolReceived, txId)
+	defer e.updateMemPoolPackages(e.memPoolPackages.RemoveTx(txId))
+
+	tx, err := e.blockChainStore.GetTxByTxId(e.symbol, txId, blocc.TxIncludeAll)
+	if err == blocc.ErrNotFound {
+		e.logger.Warnw("Could not find replaced transaction", "tx_id", txId, "replaced_by", replacedBy)
+		return
+	} else if err != nil {
+		e.logger.Errorw("Could not BlockStore GetTxByTxId", "error", err, "tx_id", txId)
+		return
+	}
+
+	// It was confirmed in the meantime
+	if !blocc.IsMemPool(tx.BlockId) {
+		return
+	}
+
+	// The descendants spending this transaction's outputs are no longer valid either
+	for height := range tx.Out {
+		if spender := e.memPoolSpends.GetSpender(txId, int64(height)); spender != "" {
+			e.evictMemPoolTx(spender, replacedBy)
+		}
+	}
+
+	// Move it to the replaced flag and remove it the same way the mempool-update flag is scrubbed
+	tx.BlockId = blocc.BlockIdMempoolReplaced
+	if replacedBy != "" {
+		if tx.Data == nil {
+			tx.Data = make(map[string]string)
+		}
+		tx.Data["replaced_by"] = replacedBy
+	}
+
+	err = e.blockChainStore.UpsertTransaction(e.symbol, tx)
+	if err != nil {
+		e.logger.Errorw("Could not BlockStore UpsertTransaction", "error", err)
+		return
+	}
+	if e.txTrackOutputs {
+		err = e.blockChainStore.UpsertOutputs(e.symbol, TrackOutputs(tx, false))
+		if err != nil {
+			e.logger.Errorw("Could not BlockStore UpsertOutputs", "error", err)
+			return
+		}
+	}
+	if e.txTrackAddresses {
+		err = e.blockChainStore.UpsertAddressTxs(e.symbol, store.AddressTxs(tx))
+		if err != nil {
+			e.logger.Errorw("Could not BlockStore UpsertAddressTxs", "error", err)
+			return
+		}
+	}
+
+	// Ensure everything is written before removing it
+	err = e.blockChainStore.FlushTransactions(e.symbol)
+	if err != nil {
+		e.logger.Errorw("Could not BlockStore FlushTransactions", "error", err)
+		return
+	}
+	err = e.blockChainStore.DeleteTransactionsByBlockIdAndTime(e.symbol, blocc.BlockIdMempoolReplaced, nil, nil)
+	if err != nil {
+		e.logger.Errorw("Could not BlockStore DeleteTransactionsByBlockIdAndTime", "error", err)
+		return
+	}
+	if e.txTrackOutputs {
+		err = e.blockChainStore.RollbackOutputsByBlockId(e.symbol, blocc.BlockIdMempoolReplaced)
+		if err != nil {
+			e.logger.Errorw("Could not BlockStore RollbackOutputsByBlockId", "error", err)
+			return
+		}
+	}
+	if e.txTrackAddresses {
+		err = e.blockChainStore.RollbackAddressTxsByBlockId(e.symbol, blocc.BlockIdMempoolReplaced)
+		if err != nil {
+			e.logger.Errorw("Could not BlockStore RollbackAddressTxsByBlockId", "error", err)
+			return
+		}
+	}
+
+	// Send the conflict on the TxBus
+	if e.txBus != nil {
+		err = e.txBus.Publish(e.symbol, "conflict", tx)
+		if err != nil {
+			e.logger.Errorw("Could not TxMsgBus Publish", "error", err)
+		}
+	}
+	e.publishEvent(blocc.NewTxEvent(blocc.EventTxEvicted, e.symbol, tx))
+
+}
+
+// removeMemPoolTx removes a transaction the source reported leaving the mempool other than by confirmation, it's
+// evicted the same as a replaced transaction without replaced_by
+func (e *Extractor) removeMemPoolTx(txId string) {
+	e.memPoolLock.Lock()
+	defer e.memPoolLock.Unlock()
+	e.evictMemPoolTx(txId, "")
+}
+
+// confirmMemPoolTxs forgets the mempool transactions confirmed by a block at height and updates the packages of their
+// descendants. A tx_confirmed event is published for each one that was in the mempool. Mempool transactions spending
+// the same outputs as a confirmed transaction (a double spend mined instead) are evicted as replaced by it.
+func (e *Extractor) confirmMemPoolTxs(wBlk *wire.MsgBlock, height int64) {
+
+	e.memPoolLock.Lock()
+	defer e.memPoolLock.Unlock()
+
+	blockId := wBlk.BlockHash().String()
+	txIds := make(map[string]struct{}, len(wBlk.Transactions))
+	for _, wTx := range wBlk.Transactions {
+		txIds[wTx.TxHash().String()] = struct{}{}
+	}
+
+	var related []string
+	for _, wTx := range wBlk.Transactions {
+		txId := wTx.TxHash().String()
+		if e.memPoolPackages.GetPackage(txId) != nil {
+			e.publishEvent(blocc.NewTxEvent(blocc.EventTxConfirmed, e.symbol, &blocc.Tx{
+				Symbol:      e.symbol,
+				TxId:        txId,
+				BlockId:     blockId,
+				BlockHeight: height,
+				BlockTime:   wBlk.Header.Timestamp.UTC().Unix(),
+			}))
+		}
+		e.memPoolSpends.RemoveTx(txId)
+		delete(e.memPoolReceived, txId)
+		related = append(related, e.memPoolPackages.RemoveTx(txId)...)
+	}
+
+	// Evict the mempool transactions that conflict with the block
+	for _, wTx := range wBlk.Transactions {
+		if blockchain.IsCoinBaseTx(wTx) {
+			continue
+		}
+		txId := wTx.TxHash().String()
+		for _, in := range wTx.TxIn {
+			spender := e.memPoolSpends.GetSpender(in.PreviousOutPoint.Hash.String(), int64(in.PreviousOutPoint.Index))
+			if _, confirmed := txIds[spender]; spender == "" || confirmed {
+				continue
+			}
+			e.logger.Infow("Transaction double spent in block", "tx_id", spender, "replaced_by", txId, "block_id", blockId)
+			e.evictMemPoolTx(spender, txId)
+		}
+	}
+
+	e.updateMemPoolPackages(related)
+
+}
+
+// updateMemPoolPackages stores the current package of mempool transactions after a parent or child arrived or left.
+// Transactions no longer tracked (confirmed or evicted) are skipped. The memPoolLock must be held.
+func (e *Extractor) updateMemPoolPackages(txIds []string) {
+
+	seen := make(map[string]struct{})
+	for _, txId := range txIds {
+
+		if _, ok := seen[txId]; ok {
+			continue
+		}
+		seen[txId] = struct{}{}
+
+		p := e.memPoolPackages.GetPackage(txId)
+		if p == nil {
+			continue
+		}
+
+		tx, err := e.blockChainStore.GetTxByTxId(e.symbol, txId, blocc.TxIncludeAll)
+		if err == blocc.ErrNotFound {
+			// It may not be written yet
+			err = e.blockChainStore.FlushTransactions(e.symbol)
+			if err != nil {
+				e.logger.Errorw("Could not BlockStore FlushTransactions", "error", err)
+				continue
+			}
+			tx, err = e.blockChainStore.GetTxByTxId(e.symbol, txId, blocc.TxIncludeAll)
+		}
+		if err == blocc.ErrNotFound {
+			e.logger.Warnw("Could not find mempool transaction to update package", "tx_id", txId)
+			continue
+		} else if err != nil {
+			e.logger.Errorw("Could not BlockStore GetTxByTxId", "error", err, "tx_id", txId)
+			continue
+		}
+
+		// It was confirmed in the meantime
+		if !blocc.IsMemPool(tx.BlockId) {
+			continue
+		}
+
+		if tx.Data == nil {
+			tx.Data = make(map[string]string)
+		}
+		p.SetData(tx.Data)
+
+		err = e.blockChainStore.UpsertTransaction(e.symbol, tx)
+		if err != nil {
+			e.logger.Errorw("Could not BlockStore UpsertTransaction", "error", err)
+		}
+
+	}
+
+}
+
+// memPoolReceived is when a mempool transaction was first received
+type memPoolReceived struct {
+	time                int64
+	receivedTime        string
+	receivedBlockHeight string
+}
+
+// keepReceived keeps the time and block height a mempool transaction was first received if it was received before,
+// otherwise it's remembered until it's confirmed or evicted. These are kept through confirmation to measure how long it
+// took. The memPoolLock must be held.
+func (e *Extractor) keepReceived(tx *blocc.Tx) {
+
+	r, ok := e.memPoolReceived[tx.TxId]
+	if !ok {
+		e.memPoolReceived[tx.TxId] = &memPoolReceived{
+			time:                tx.Time,
+			receivedTime:        tx.DataValue("received_time"),
+			receivedBlockHeight: tx.DataValue("received_block_height"),
+		}
+		return
+	}
+
+	tx.Time = r.time
+	tx.Data["received_time"] = r.receivedTime
+	if r.receivedBlockHeight != "" {
+		tx.Data["received_block_height"] = r.receivedBlockHeight
+	} else {
+		delete(tx.Data, "received_block_height")
+	}
+
+}
+
+// loadMemPoolReceived replaces the received times with those of the stored mempool transactions being reloaded, which
+// are looked up at once rather than as each transaction arrives again
+func (e *Extractor) loadMemPoolReceived() {
+
+	received := make(map[string]*memPoolReceived)
+	txs, err := e.blockChainStore.GetTxsByBlockId(e.symbol, blocc.BlockIdMempoolUpdate, blocc.TxIncludeHeader|blocc.TxIncludeData)
+	if err != nil && err != blocc.ErrNotFound {
+		e.logger.Errorw("Could not BlockStore GetTxsByBlockId", "error", err, "block_id", blocc.BlockIdMempoolUpdate)
+	}
+	for _, tx := range txs {
+		if tx.DataValue("received_time") == "" {
+			continue
+		}
+		received[tx.TxId] = &memPoolReceived{
+			time:                tx.Time,
+			receivedTime:        tx.DataValue("received_time"),
+			receivedBlockHeight: tx.DataValue("received_block_height"),
+		}
+	}
+
+	e.memPoolLock.Lock()
+	e.memPoolReceived = received
+	e.memPoolLock.Unlock()
+
+}
+
+// parentTxIds returns the ids of the transactions spent by a transaction
+func parentTxIds(tx *blocc.Tx) []string {
+	ret := make([]string, 0, len(tx.In))
+	for _, in := range tx.In {
+		ret = append(ret, in.TxId)
 	}
 	return ret
 }
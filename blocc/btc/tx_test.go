@@ -0,0 +1,268 @@
+package btc
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/btools"
+	"git.coinninja.net/backend/blocc/mocks"
+	"git.coinninja.net/backend/blocc/store"
+	"git.coinninja.net/backend/blocc/store/memory"
+)
+
+// testMsgTx returns a transaction spending the outPoints with sequence with one output per value
+func testMsgTx(sequence uint32, outPoints []wire.OutPoint, values ...int64) *wire.MsgTx {
+	msgTx := wire.NewMsgTx(2)
+	for x := range outPoints {
+		txIn := wire.NewTxIn(&outPoints[x], nil, nil)
+		txIn.Sequence = sequence
+		msgTx.AddTxIn(txIn)
+	}
+	for _, value := range values {
+		msgTx.AddTxOut(wire.NewTxOut(value, []byte{0x51}))
+	}
+	return msgTx
+}
+
+// outPoint returns the outPoint of an output of a transaction
+func outPoint(msgTx *wire.MsgTx, index uint32) wire.OutPoint {
+	return wire.OutPoint{Hash: msgTx.TxHash(), Index: index}
+}
+
+func TestNewTxSignalsRBF(t *testing.T) {
+
+	chain, _ := GetChain(Symbol)
+	prev := []wire.OutPoint{{Hash: chainhash.Hash{1}}}
+
+	for _, test := range []struct {
+		name       string
+		msgTx      *wire.MsgTx
+		signalsRBF bool
+	}{
+		{"Final", testMsgTx(wire.MaxTxInSequenceNum, prev, 1), false},
+		{"Locktime only", testMsgTx(wire.MaxTxInSequenceNum-1, prev, 1), false},
+		{"Replaceable", testMsgTx(wire.MaxTxInSequenceNum-2, prev, 1), true},
+		{"Relative locktime", testMsgTx(10, prev, 1), true},
+		{"Any input", func() *wire.MsgTx {
+			msgTx := testMsgTx(wire.MaxTxInSequenceNum, []wire.OutPoint{{Hash: chainhash.Hash{1}}, {Hash: chainhash.Hash{2}}}, 1)
+			msgTx.TxIn[1].Sequence = 0
+			return msgTx
+		}(), true},
+		{"Coinbase", testMsgTx(0, []wire.OutPoint{{Index: wire.MaxPrevOutIndex}}, 1), false},
+	} {
+		tx, txs, _ := newTx(test.msgTx, blocc.HeightUnknown, chain, &chaincfg.RegressionNetParams)
+		assert.Equal(t, test.signalsRBF, txs.SignalsRBF, test.name)
+		txs.setFee(tx)
+		assert.Equal(t, map[bool]string{true: "true", false: "false"}[test.signalsRBF], tx.Data["signals_rbf"], test.name)
+	}
+
+}
+
+// newTestMemPoolExtractor returns an extractor tracking the mempool in a memory store with the events it publishes
+func newTestMemPoolExtractor(t *testing.T) (*Extractor, *[]*blocc.Event) {
+
+	bcs, err := memory.New()
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	assert.Nil(t, bcs.Init(Symbol))
+
+	events := new([]*blocc.Event)
+	eb := new(mocks.EventBus)
+	eb.On("PublishEvent", Symbol, mock.AnythingOfType("*blocc.Event")).Run(func(args mock.Arguments) {
+		*events = append(*events, args.Get(1).(*blocc.Event))
+	}).Return(nil)
+
+	chain, _ := GetChain(Symbol)
+	return &Extractor{
+		logger:           zap.S().With("package", "blocc.btc"),
+		chain:            chain,
+		chainParams:      &chaincfg.RegressionNetParams,
+		symbol:           Symbol,
+		blockChainStore:  bcs,
+		eventBus:         eb,
+		txTrackOutputs:   true,
+		txTrackAddresses: true,
+		memPoolSpends:    btools.NewMemPoolSpendsMem(),
+		memPoolPackages:  btools.NewMemPoolPackagesMem(),
+		memPoolReceived:  make(map[string]*memPoolReceived),
+	}, events
+
+}
+
+// storeTx stores a transaction in blockId with it's outputs and tracks it's spends and package in the mempool
+func storeTx(t *testing.T, e *Extractor, msgTx *wire.MsgTx, blockId string) *blocc.Tx {
+
+	tx, txs, vsize := newTx(msgTx, blocc.HeightUnknown, e.chain, e.chainParams)
+	tx.BlockId = blockId
+	txs.setFee(tx)
+
+	assert.Nil(t, e.blockChainStore.UpsertTransaction(Symbol, tx))
+	assert.Nil(t, e.blockChainStore.UpsertOutputs(Symbol, TrackOutputs(tx, txs.Coinbase)))
+	assert.Nil(t, e.blockChainStore.UpsertAddressTxs(Symbol, store.AddressTxs(tx)))
+
+	if blocc.IsMemPool(blockId) {
+		assert.Empty(t, e.memPoolSpends.AddTx(tx))
+		e.memPoolPackages.AddTx(tx.TxId, txs.Fee, vsize, parentTxIds(tx))
+	}
+
+	return tx
+
+}
+
+func TestEvictMemPoolTx(t *testing.T) {
+
+	e, events := newTestMemPoolExtractor(t)
+
+	// A confirmed transaction, a mempool transaction spending it and a mempool child
+	prev := testMsgTx(wire.MaxTxInSequenceNum, []wire.OutPoint{{Hash: chainhash.Hash{1}}}, 1000, 1000)
+	storeTx(t, e, prev, "block1")
+	tx1 := testMsgTx(wire.MaxTxInSequenceNum-2, []wire.OutPoint{outPoint(prev, 0)}, 900)
+	storeTx(t, e, tx1, blocc.BlockIdMempool)
+	tx2 := testMsgTx(wire.MaxTxInSequenceNum, []wire.OutPoint{outPoint(tx1, 0)}, 800)
+	storeTx(t, e, tx2, blocc.BlockIdMempool)
+
+	o, err := e.blockChainStore.GetOutputByOutPoint(Symbol, prev.TxHash().String(), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, tx1.TxHash().String(), o.SpentTxId)
+
+	// tx3 double spends tx1
+	tx3, txs3, _ := newTx(testMsgTx(wire.MaxTxInSequenceNum, []wire.OutPoint{outPoint(prev, 0)}, 500), blocc.HeightUnknown, e.chain, e.chainParams)
+	txs3.setFee(tx3)
+	conflicts := e.memPoolSpends.AddTx(tx3)
+	assert.Equal(t, []string{tx1.TxHash().String()}, conflicts)
+
+	e.memPoolLock.Lock()
+	e.evictMemPoolTx(conflicts[0], tx3.TxId)
+	e.memPoolLock.Unlock()
+
+	// Both the replaced transaction and it's child are gone with the outputs rolled back
+	for _, msgTx := range []*wire.MsgTx{tx1, tx2} {
+		_, err = e.blockChainStore.GetTxByTxId(Symbol, msgTx.TxHash().String(), blocc.TxIncludeAll)
+		assert.Equal(t, blocc.ErrNotFound, err)
+		assert.Nil(t, e.memPoolPackages.GetPackage(msgTx.TxHash().String()))
+	}
+	o, err = e.blockChainStore.GetOutputByOutPoint(Symbol, prev.TxHash().String(), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "", o.SpentTxId)
+	_, err = e.blockChainStore.GetOutputByOutPoint(Symbol, tx1.TxHash().String(), 0)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+	// The replacement still spends the output
+	assert.Equal(t, tx3.TxId, e.memPoolSpends.GetSpender(prev.TxHash().String(), 0))
+
+	// Each is published as evicted and replaced by tx3, the child first
+	if assert.Len(t, *events, 2) {
+		for x, msgTx := range []*wire.MsgTx{tx2, tx1} {
+			event := (*events)[x]
+			assert.Equal(t, blocc.EventTxEvicted, event.Type)
+			assert.Equal(t, msgTx.TxHash().String(), event.Tx.TxId)
+			assert.Equal(t, blocc.BlockIdMempoolReplaced, event.Tx.BlockId)
+			assert.Equal(t, tx3.TxId, event.Tx.Data["replaced_by"])
+		}
+	}
+
+}
+
+func TestConfirmMemPoolTxsConflicts(t *testing.T) {
+
+	e, events := newTestMemPoolExtractor(t)
+
+	prev := testMsgTx(wire.MaxTxInSequenceNum, []wire.OutPoint{{Hash: chainhash.Hash{1}}}, 1000, 1000)
+	storeTx(t, e, prev, "block1")
+
+	// tx1 is in the mempool and confirmed as is, tx2 is double spent by tx3 in the block
+	tx1 := testMsgTx(wire.MaxTxInSequenceNum, []wire.OutPoint{outPoint(prev, 0)}, 900)
+	storeTx(t, e, tx1, blocc.BlockIdMempool)
+	tx2 := testMsgTx(wire.MaxTxInSequenceNum, []wire.OutPoint{outPoint(prev, 1)}, 900)
+	storeTx(t, e, tx2, blocc.BlockIdMempool)
+	tx3 := testMsgTx(wire.MaxTxInSequenceNum, []wire.OutPoint{outPoint(prev, 1)}, 800)
+
+	coinbase := testMsgTx(wire.MaxTxInSequenceNum, []wire.OutPoint{{Index: wire.MaxPrevOutIndex}}, 5000)
+	blk := wire.NewMsgBlock(&wire.BlockHeader{})
+	for _, msgTx := range []*wire.MsgTx{coinbase, tx1, tx3} {
+		assert.Nil(t, blk.AddTransaction(msgTx))
+	}
+
+	e.confirmMemPoolTxs(blk, 2)
+
+	// tx1 is confirmed and tx2 is evicted as replaced by tx3
+	assert.Nil(t, e.memPoolPackages.GetPackage(tx1.TxHash().String()))
+	assert.Nil(t, e.memPoolPackages.GetPackage(tx2.TxHash().String()))
+	assert.Equal(t, "", e.memPoolSpends.GetSpender(prev.TxHash().String(), 0))
+	assert.Equal(t, "", e.memPoolSpends.GetSpender(prev.TxHash().String(), 1))
+	_, err := e.blockChainStore.GetTxByTxId(Symbol, tx2.TxHash().String(), blocc.TxIncludeAll)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+	if assert.Len(t, *events, 2) {
+		assert.Equal(t, blocc.EventTxConfirmed, (*events)[0].Type)
+		assert.Equal(t, tx1.TxHash().String(), (*events)[0].Tx.TxId)
+		assert.Equal(t, int64(2), (*events)[0].Tx.BlockHeight)
+		assert.Equal(t, blocc.EventTxEvicted, (*events)[1].Type)
+		assert.Equal(t, tx2.TxHash().String(), (*events)[1].Tx.TxId)
+		assert.Equal(t, tx3.TxHash().String(), (*events)[1].Tx.Data["replaced_by"])
+	}
+
+}
+
+func TestKeepReceived(t *testing.T) {
+
+	e, _ := newTestMemPoolExtractor(t)
+
+	// receivedTx returns a mempool transaction as handleTx builds it when received at a time and block height
+	receivedTx := func(msgTx *wire.MsgTx, received int64, height string) *blocc.Tx {
+		tx, txs, _ := newTx(msgTx, blocc.HeightUnknown, e.chain, e.chainParams)
+		txs.setFee(tx)
+		tx.BlockId = blocc.BlockIdMempool
+		tx.Time = received
+		tx.Data["received_time"] = cast.ToString(received)
+		if height != "" {
+			tx.Data["received_block_height"] = height
+		}
+		return tx
+	}
+
+	// tx1 was stored before the mempool was reloaded
+	prev := testMsgTx(wire.MaxTxInSequenceNum, []wire.OutPoint{{Hash: chainhash.Hash{1}}}, 1000, 1000)
+	msgTx1 := testMsgTx(wire.MaxTxInSequenceNum, []wire.OutPoint{outPoint(prev, 0)}, 900)
+	stored := receivedTx(msgTx1, 100, "5")
+	stored.BlockId = blocc.BlockIdMempoolUpdate
+	assert.Nil(t, e.blockChainStore.UpsertTransaction(Symbol, stored))
+	e.loadMemPoolReceived()
+
+	// It keeps when it was first received
+	tx1 := receivedTx(msgTx1, 200, "7")
+	e.keepReceived(tx1)
+	assert.Equal(t, int64(100), tx1.Time)
+	assert.Equal(t, "100", tx1.Data["received_time"])
+	assert.Equal(t, "5", tx1.Data["received_block_height"])
+
+	// tx2 is new and is remembered when it's received again
+	msgTx2 := testMsgTx(wire.MaxTxInSequenceNum, []wire.OutPoint{outPoint(prev, 1)}, 900)
+	tx2 := receivedTx(msgTx2, 300, "")
+	e.keepReceived(tx2)
+	assert.Equal(t, int64(300), tx2.Time)
+	tx2 = receivedTx(msgTx2, 400, "8")
+	e.keepReceived(tx2)
+	assert.Equal(t, int64(300), tx2.Time)
+	assert.Equal(t, "300", tx2.Data["received_time"])
+	assert.Equal(t, "", tx2.Data["received_block_height"])
+
+	// They are forgotten once confirmed
+	coinbase := testMsgTx(wire.MaxTxInSequenceNum, []wire.OutPoint{{Index: wire.MaxPrevOutIndex}}, 5000)
+	blk := wire.NewMsgBlock(&wire.BlockHeader{})
+	for _, msgTx := range []*wire.MsgTx{coinbase, msgTx1, msgTx2} {
+		assert.Nil(t, blk.AddTransaction(msgTx))
+	}
+	e.confirmMemPoolTxs(blk, 2)
+	assert.Empty(t, e.memPoolReceived)
+
+}
@@ -46,6 +46,18 @@ func (e *Extractor) ValidateBlockChain(symbol string, stopAfter int64) (*blocc.B
 						if err != nil {
 							return lastValidBlockHeader, fmt.Errorf("Could not blockChainStore.DeleteTransactionsByBlockIdAndTime:%v", err)
 						}
+						if e.txTrackOutputs {
+							err = e.blockChainStore.RollbackOutputsByBlockId(symbol, blk.BlockId)
+							if err != nil {
+								return lastValidBlockHeader, fmt.Errorf("Could not blockChainStore.RollbackOutputsByBlockId:%v", err)
+							}
+						}
+						if e.txTrackAddresses {
+							err = e.blockChainStore.RollbackAddressTxsByBlockId(symbol, blk.BlockId)
+							if err != nil {
+								return lastValidBlockHeader, fmt.Errorf("Could not blockChainStore.RollbackAddressTxsByBlockId:%v", err)
+							}
+						}
 					}
 				}
 			}
@@ -146,6 +158,7 @@ func (e *Extractor) ValidateBlockChain(symbol string, stopAfter int64) (*blocc.B
 			if err != nil {
 				return nil, fmt.Errorf("Could not blockChainStore.UpdateBlock:%v", err)
 			}
+			e.publishEvent(blocc.NewBlockEvent(blocc.EventBlockConnected, symbol, blk.BlockHeader()))
 		}
 	}
 
@@ -170,6 +183,18 @@ func (e *Extractor) ValidateBlockChain(symbol string, stopAfter int64) (*blocc.B
 				if err != nil {
 					return lastValidBlockHeader, fmt.Errorf("Could not blockChainStore.DeleteTransactionsByBlockIdAndTime:%v", err)
 				}
+				if e.txTrackOutputs {
+					err = e.blockChainStore.RollbackOutputsByBlockId(symbol, blk.BlockId)
+					if err != nil {
+						return lastValidBlockHeader, fmt.Errorf("Could not blockChainStore.RollbackOutputsByBlockId:%v", err)
+					}
+				}
+				if e.txTrackAddresses {
+					err = e.blockChainStore.RollbackAddressTxsByBlockId(symbol, blk.BlockId)
+					if err != nil {
+						return lastValidBlockHeader, fmt.Errorf("Could not blockChainStore.RollbackAddressTxsByBlockId:%v", err)
+					}
+				}
 			}
 		}
 
@@ -253,6 +278,20 @@ func (e *Extractor) handleFork(symbol string, height int64, stopAfter int64) err
 				if err != nil {
 					return fmt.Errorf("Could not blockChainStore.UpdateBlock:%v", err)
 				}
+				e.publishEvent(blocc.NewBlockEvent(blocc.EventBlockDisconnected, symbol, blk.BlockHeader()))
+				// The orphaned block no longer creates or spends outputs or affects addresses
+				if e.txTrackOutputs {
+					err = e.blockChainStore.RollbackOutputsByBlockId(symbol, blk.BlockId)
+					if err != nil {
+						return fmt.Errorf("Could not blockChainStore.RollbackOutputsByBlockId:%v", err)
+					}
+				}
+				if e.txTrackAddresses {
+					err = e.blockChainStore.RollbackAddressTxsByBlockId(symbol, blk.BlockId)
+					if err != nil {
+						return fmt.Errorf("Could not blockChainStore.RollbackAddressTxsByBlockId:%v", err)
+					}
+				}
 				// Get the next block in the chain or break when done
 				blk, found = blksByPrevBlockId[blk.BlockId]
 			}
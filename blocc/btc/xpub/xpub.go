@@ -0,0 +1,178 @@
+// Package xpub derives the addresses of extended public keys (xpub/ypub/zpub and their testnet equivalents)
+package xpub
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/btcsuite/btcutil/hdkeychain"
+)
+
+// Script types of the derived addresses
+const (
+	ScriptTypeP2PKH      = "p2pkh"       // BIP44 legacy addresses
+	ScriptTypeP2SHP2WPKH = "p2sh-p2wpkh" // BIP49 nested segwit addresses
+	ScriptTypeP2WPKH     = "p2wpkh"      // BIP84 native segwit addresses
+)
+
+// Chains of a BIP44 account
+const (
+	ChainReceive uint32 = 0
+	ChainChange  uint32 = 1
+)
+
+var (
+	ErrPrivateKey          = errors.New("Extended private keys are not accepted")
+	ErrUnknownVersion      = errors.New("Unknown extended public key version")
+	ErrWrongNetwork        = errors.New("Extended public key is for another network")
+	ErrUnknownScriptType   = errors.New("Unknown script type")
+	ErrScriptTypeMismatch  = errors.New("Script type does not match the extended public key version")
+	errHardenedDerivations = errors.New("Hardened derivations are not possible from an extended public key")
+)
+
+// version describes the SLIP-132 version bytes of an extended public key
+type version struct {
+	id         [4]byte
+	public     [4]byte // The BIP32 public key version of the network (xpub or tpub)
+	scriptType string
+}
+
+// versions are the SLIP-132 extended public key versions
+var versions = []version{
+	{[4]byte{0x04, 0x88, 0xb2, 0x1e}, [4]byte{0x04, 0x88, 0xb2, 0x1e}, ScriptTypeP2PKH},      // xpub
+	{[4]byte{0x04, 0x9d, 0x7c, 0xb2}, [4]byte{0x04, 0x88, 0xb2, 0x1e}, ScriptTypeP2SHP2WPKH}, // ypub
+	{[4]byte{0x04, 0xb2, 0x47, 0x46}, [4]byte{0x04, 0x88, 0xb2, 0x1e}, ScriptTypeP2WPKH},     // zpub
+	{[4]byte{0x04, 0x35, 0x87, 0xcf}, [4]byte{0x04, 0x35, 0x87, 0xcf}, ScriptTypeP2PKH},      // tpub
+	{[4]byte{0x04, 0x4a, 0x52, 0x62}, [4]byte{0x04, 0x35, 0x87, 0xcf}, ScriptTypeP2SHP2WPKH}, // upub
+	{[4]byte{0x04, 0x5f, 0x1c, 0xf6}, [4]byte{0x04, 0x35, 0x87, 0xcf}, ScriptTypeP2WPKH},     // vpub
+}
+
+// Key is an extended public key that derives addresses of a script type
+type Key struct {
+	key        *hdkeychain.ExtendedKey
+	scriptType string
+	params     *chaincfg.Params
+
+	chains map[uint32]*hdkeychain.ExtendedKey
+}
+
+// Parse parses an extended public key. The script type defaults to the one implied by the version
+// (xpub=p2pkh, ypub=p2sh-p2wpkh, zpub=p2wpkh) and may only be overridden for xpub/tpub keys.
+func Parse(s string, scriptType string, params *chaincfg.Params) (*Key, error) {
+
+	key, err := hdkeychain.NewKeyFromString(s)
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse extended public key: %v", err)
+	}
+	if key.IsPrivate() {
+		return nil, ErrPrivateKey
+	}
+
+	// Find the version, the key has already been checked so the first 4 bytes are the version
+	var v *version
+	for x := range versions {
+		if bytes.HasPrefix(base58.Decode(s), versions[x].id[:]) {
+			v = &versions[x]
+			break
+		}
+	}
+	if v == nil {
+		return nil, ErrUnknownVersion
+	}
+	if !bytes.Equal(v.public[:], params.HDPublicKeyID[:]) {
+		return nil, ErrWrongNetwork
+	}
+
+	switch scriptType {
+	case "":
+		scriptType = v.scriptType
+	case ScriptTypeP2PKH, ScriptTypeP2SHP2WPKH, ScriptTypeP2WPKH:
+		if v.scriptType != ScriptTypeP2PKH && scriptType != v.scriptType {
+			return nil, ErrScriptTypeMismatch
+		}
+	default:
+		return nil, ErrUnknownScriptType
+	}
+
+	// Derivation only depends on the key and chain code, use the standard version for the network
+	key.SetNet(params)
+
+	return &Key{
+		key:        key,
+		scriptType: scriptType,
+		params:     params,
+		chains:     make(map[uint32]*hdkeychain.ExtendedKey),
+	}, nil
+
+}
+
+// ScriptType returns the script type of the derived addresses
+func (k *Key) ScriptType() string {
+	return k.scriptType
+}
+
+// Address derives the address at index of chain (0=receive, 1=change)
+func (k *Key) Address(chain uint32, index uint32) (string, error) {
+
+	if chain >= hdkeychain.HardenedKeyStart || index >= hdkeychain.HardenedKeyStart {
+		return "", errHardenedDerivations
+	}
+
+	chainKey, ok := k.chains[chain]
+	if !ok {
+		var err error
+		chainKey, err = k.key.Child(chain)
+		if err != nil {
+			return "", fmt.Errorf("Could not derive chain %d: %v", chain, err)
+		}
+		k.chains[chain] = chainKey
+	}
+
+	child, err := chainKey.Child(index)
+	if err != nil {
+		return "", fmt.Errorf("Could not derive index %d: %v", index, err)
+	}
+	pubKey, err := child.ECPubKey()
+	if err != nil {
+		return "", err
+	}
+	pubKeyHash := btcutil.Hash160(pubKey.SerializeCompressed())
+
+	var address btcutil.Address
+	switch k.scriptType {
+	case ScriptTypeP2PKH:
+		address, err = btcutil.NewAddressPubKeyHash(pubKeyHash, k.params)
+	case ScriptTypeP2WPKH:
+		address, err = btcutil.NewAddressWitnessPubKeyHash(pubKeyHash, k.params)
+	case ScriptTypeP2SHP2WPKH:
+		var script []byte
+		script, err = txscript.NewScriptBuilder().AddOp(txscript.OP_0).AddData(pubKeyHash).Script()
+		if err == nil {
+			address, err = btcutil.NewAddressScriptHash(script, k.params)
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("Could not create address: %v", err)
+	}
+
+	return address.EncodeAddress(), nil
+
+}
+
+// Addresses derives count addresses of chain starting at index
+func (k *Key) Addresses(chain uint32, index uint32, count int) ([]string, error) {
+	addresses := make([]string, 0, count)
+	for x := 0; x < count; x++ {
+		address, err := k.Address(chain, index+uint32(x))
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
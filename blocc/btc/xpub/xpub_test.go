@@ -0,0 +1,68 @@
+package xpub
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/assert"
+)
+
+// The account keys of the BIP84 test mnemonic "abandon abandon ... about"
+const (
+	testXPub = "xpub6BosfCnifzxcFwrSzQiqu2DBVTshkCXacvNsWGYJVVhhawA7d4R5WSWGFNbi8Aw6ZRc1brxMyWMzG3DSSSSoekkudhUd9yLb6qx39T9nMdj"
+	testYPub = "ypub6Ww3ibxVfGzLrAH1PNcjyAWenMTbbAosGNB6VvmSEgytSER9azLDWCxoJwW7Ke7icmizBMXrzBx9979FfaHxHcrArf3zbeJJJUZPf663zsP"
+	testZPub = "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs"
+)
+
+func TestAddress(t *testing.T) {
+
+	for _, test := range []struct {
+		key     string
+		chain   uint32
+		index   uint32
+		address string
+	}{
+		{testXPub, ChainReceive, 0, "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA"},
+		{testXPub, ChainChange, 0, "1J3J6EvPrv8q6AC3VCjWV45Uf3nssNMRtH"},
+		{testYPub, ChainReceive, 0, "37VucYSaXLCAsxYyAPfbSi9eh4iEcbShgf"},
+		{testYPub, ChainChange, 0, "34K56kSjgUCUSD8GTtuF7c9Zzwokbs6uZ7"},
+		{testZPub, ChainReceive, 0, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu"},
+		{testZPub, ChainReceive, 1, "bc1qnjg0jd8228aq7egyzacy8cys3knf9xvrerkf9g"},
+		{testZPub, ChainChange, 0, "bc1q8c6fshw2dlwun7ekn9qwf37cu2rn755upcp6el"},
+	} {
+		k, err := Parse(test.key, "", &chaincfg.MainNetParams)
+		if !assert.Nil(t, err) {
+			continue
+		}
+		address, err := k.Address(test.chain, test.index)
+		assert.Nil(t, err)
+		assert.Equal(t, test.address, address)
+	}
+
+	// An xpub can derive any script type
+	k, err := Parse(testXPub, ScriptTypeP2WPKH, &chaincfg.MainNetParams)
+	assert.Nil(t, err)
+	addresses, err := k.Addresses(ChainReceive, 0, 3)
+	assert.Nil(t, err)
+	assert.Len(t, addresses, 3)
+
+}
+
+func TestParse(t *testing.T) {
+
+	_, err := Parse(testZPub, ScriptTypeP2PKH, &chaincfg.MainNetParams)
+	assert.Equal(t, ErrScriptTypeMismatch, err)
+
+	_, err = Parse(testZPub, "p2tr", &chaincfg.MainNetParams)
+	assert.Equal(t, ErrUnknownScriptType, err)
+
+	_, err = Parse(testZPub, "", &chaincfg.TestNet3Params)
+	assert.Equal(t, ErrWrongNetwork, err)
+
+	_, err = Parse("xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi", "", &chaincfg.MainNetParams)
+	assert.Equal(t, ErrPrivateKey, err)
+
+	_, err = Parse("notakey", "", &chaincfg.MainNetParams)
+	assert.NotNil(t, err)
+
+}
@@ -0,0 +1,404 @@
+package btc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	config "github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/conf"
+)
+
+// The ZMTP 3.0 frame flags
+const (
+	zmtpFlagMore    = 0x01
+	zmtpFlagLong    = 0x02
+	zmtpFlagCommand = 0x04
+)
+
+// The most transactions from rawtx held until the sequence topic adds them to the mempool
+const zmqTxCacheSize = 10000
+
+// zmqSubscriber is a ZMQ SUB socket connected to a Bitcoin Core PUB socket. It speaks enough ZMTP 3.0 with the NULL
+// mechanism to subscribe to topics and receive their messages, reconnecting until closed.
+type zmqSubscriber struct {
+	logger  *zap.SugaredLogger
+	addr    string
+	topics  []string
+	handler func(topic string, body []byte)
+
+	conn   net.Conn
+	closed bool
+	sync.Mutex
+}
+
+func newZMQSubscriber(addr string, topics []string, handler func(topic string, body []byte)) *zmqSubscriber {
+	return &zmqSubscriber{
+		logger:  zap.S().With("package", "blocc.btc.zmq", "addr", addr),
+		addr:    addr,
+		topics:  topics,
+		handler: handler,
+	}
+}
+
+// Run receives messages and reconnects after errors until closed
+func (z *zmqSubscriber) Run() {
+	for {
+		err := z.receive()
+		z.Lock()
+		closed := z.closed
+		z.Unlock()
+		if closed {
+			return
+		}
+		z.logger.Warnw("ZMQ subscription failed, reconnecting", "error", err)
+		select {
+		case <-time.After(5 * time.Second):
+		case <-conf.Stop.Chan():
+			return
+		}
+	}
+}
+
+// Close stops receiving
+func (z *zmqSubscriber) Close() {
+	z.Lock()
+	defer z.Unlock()
+	z.closed = true
+	if z.conn != nil {
+		z.conn.Close()
+	}
+}
+
+// receive connects, subscribes and passes messages to the handler until the connection fails
+func (z *zmqSubscriber) receive() error {
+
+	conn, err := net.DialTimeout("tcp", strings.TrimPrefix(z.addr, "tcp://"), 10*time.Second)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	z.Lock()
+	if z.closed {
+		z.Unlock()
+		return nil
+	}
+	z.conn = conn
+	z.Unlock()
+
+	err = zmtpHandshake(conn, "SUB")
+	if err != nil {
+		return err
+	}
+
+	// A subscription is a message of 1 followed by the topic
+	for _, topic := range z.topics {
+		err = zmtpWriteFrame(conn, 0, append([]byte{1}, topic...))
+		if err != nil {
+			return err
+		}
+	}
+
+	z.logger.Infow("Subscribed to ZMQ", "topics", z.topics)
+
+	for {
+		msg, err := zmtpReadMessage(conn)
+		if err != nil {
+			return err
+		}
+		// The topic, body and sequence number
+		if len(msg) < 2 {
+			continue
+		}
+		z.handler(string(msg[0]), msg[1])
+	}
+
+}
+
+// zmtpHandshake exchanges greetings with the NULL mechanism and the READY command with the socket type
+func zmtpHandshake(conn io.ReadWriter, socketType string) error {
+
+	greeting := make([]byte, 64)
+	greeting[0] = 0xff
+	greeting[9] = 0x7f
+	greeting[10] = 3 // Version 3.0
+	copy(greeting[12:32], "NULL")
+	_, err := conn.Write(greeting)
+	if err != nil {
+		return err
+	}
+
+	peer := make([]byte, 64)
+	_, err = io.ReadFull(conn, peer)
+	if err != nil {
+		return err
+	}
+	if peer[0] != 0xff || peer[9] != 0x7f || peer[10] < 3 {
+		return fmt.Errorf("Not a ZMTP 3 peer")
+	}
+	if mechanism := string(bytes.TrimRight(peer[12:32], "\x00")); mechanism != "NULL" {
+		return fmt.Errorf("Unsupported ZMTP mechanism %s", mechanism)
+	}
+
+	// READY with the Socket-Type property
+	var ready bytes.Buffer
+	ready.WriteByte(5)
+	ready.WriteString("READY")
+	ready.WriteByte(byte(len("Socket-Type")))
+	ready.WriteString("Socket-Type")
+	binary.Write(&ready, binary.BigEndian, uint32(len(socketType)))
+	ready.WriteString(socketType)
+	err = zmtpWriteFrame(conn, zmtpFlagCommand, ready.Bytes())
+	if err != nil {
+		return err
+	}
+
+	flags, body, err := zmtpReadFrame(conn)
+	if err != nil {
+		return err
+	}
+	if flags&zmtpFlagCommand == 0 || len(body) < 6 || string(body[1:6]) != "READY" {
+		return fmt.Errorf("Expected ZMTP READY")
+	}
+
+	return nil
+
+}
+
+// zmtpWriteFrame writes a frame with the flags and the size
+func zmtpWriteFrame(w io.Writer, flags byte, body []byte) error {
+	var header []byte
+	if len(body) > 255 {
+		header = make([]byte, 9)
+		header[0] = flags | zmtpFlagLong
+		binary.BigEndian.PutUint64(header[1:], uint64(len(body)))
+	} else {
+		header = []byte{flags, byte(len(body))}
+	}
+	_, err := w.Write(append(header, body...))
+	return err
+}
+
+// zmtpReadFrame reads a frame returning it's flags and body
+func zmtpReadFrame(r io.Reader) (byte, []byte, error) {
+
+	header := make([]byte, 1, 9)
+	_, err := io.ReadFull(r, header)
+	if err != nil {
+		return 0, nil, err
+	}
+	flags := header[0]
+
+	var size uint64
+	if flags&zmtpFlagLong != 0 {
+		b := make([]byte, 8)
+		_, err = io.ReadFull(r, b)
+		if err != nil {
+			return 0, nil, err
+		}
+		size = binary.BigEndian.Uint64(b)
+	} else {
+		b := make([]byte, 1)
+		_, err = io.ReadFull(r, b)
+		if err != nil {
+			return 0, nil, err
+		}
+		size = uint64(b[0])
+	}
+	// Larger than any block
+	if size > wire.MaxMessagePayload {
+		return 0, nil, fmt.Errorf("ZMTP frame too large: %d", size)
+	}
+
+	body := make([]byte, size)
+	_, err = io.ReadFull(r, body)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return flags, body, nil
+
+}
+
+// zmtpReadMessage reads the frames of the next message, commands are skipped
+func zmtpReadMessage(r io.Reader) ([][]byte, error) {
+	var msg [][]byte
+	for {
+		flags, body, err := zmtpReadFrame(r)
+		if err != nil {
+			return nil, err
+		}
+		if flags&zmtpFlagCommand != 0 {
+			continue
+		}
+		msg = append(msg, body)
+		if flags&zmtpFlagMore == 0 {
+			return msg, nil
+		}
+	}
+}
+
+// zmqSource is the rpcSource with blocks from the rawblock topic and exact mempool changes from the sequence topic.
+// Transactions from rawtx are used when the sequence topic adds them to the mempool, otherwise they are fetched with
+// the JSON-RPC interface. The node is still polled for blocks in case a notification is missed.
+type zmqSource struct {
+	*rpcSource
+	subscribers []*zmqSubscriber
+
+	// Recent transactions from rawtx by txid
+	txCache      map[string]*wire.MsgTx
+	txCacheOrder []string
+	txCacheLock  sync.Mutex
+}
+
+func newZMQSource(chainParams *chaincfg.Params, listeners SourceListeners) (*zmqSource, error) {
+
+	s := &zmqSource{
+		rpcSource: newRPCSource(chainParams, listeners),
+		txCache:   make(map[string]*wire.MsgTx),
+	}
+	s.cachedTx = s.takeTx
+
+	// Topics published on the same address share a connection
+	var addrs []string
+	topics := make(map[string][]string)
+	for _, topic := range []string{"rawblock", "rawtx", "sequence"} {
+		addr := config.GetString("extractor.btc.zmq_" + topic)
+		if addr == "" {
+			continue
+		}
+		if _, ok := topics[addr]; !ok {
+			addrs = append(addrs, addr)
+		}
+		topics[addr] = append(topics[addr], topic)
+		// The sequence topic replaces polling the mempool
+		if topic == "sequence" {
+			s.pollMemPool = false
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("No ZMQ topics configured")
+	}
+
+	for _, addr := range addrs {
+		s.subscribers = append(s.subscribers, newZMQSubscriber(addr, topics[addr], s.handleMessage))
+	}
+
+	return s, nil
+
+}
+
+// Run receives the notifications and polls the node
+func (s *zmqSource) Run() {
+	for _, sub := range s.subscribers {
+		go sub.Run()
+	}
+	s.rpcSource.Run()
+	for _, sub := range s.subscribers {
+		sub.Close()
+	}
+}
+
+// handleMessage handles a notification from a topic
+func (s *zmqSource) handleMessage(topic string, body []byte) {
+
+	switch topic {
+	case "rawblock":
+		blk := new(wire.MsgBlock)
+		err := blk.Deserialize(bytes.NewReader(body))
+		if err != nil {
+			s.logger.Errorw("Could not decode rawblock", "error", err)
+			return
+		}
+		// The poller won't send it again
+		s.Lock()
+		s.tipHash = blk.BlockHash().String()
+		s.tipHeight++
+		if int32(s.tipHeight) > s.lastBlock {
+			s.lastBlock = int32(s.tipHeight)
+		}
+		s.Unlock()
+		if s.listeners.OnBlock != nil {
+			s.listeners.OnBlock(blk)
+		}
+
+	case "rawtx":
+		if s.listeners.OnTx == nil {
+			return
+		}
+		msgTx := new(wire.MsgTx)
+		err := msgTx.Deserialize(bytes.NewReader(body))
+		if err != nil {
+			s.logger.Errorw("Could not decode rawtx", "error", err)
+			return
+		}
+		s.cacheTx(msgTx)
+
+	case "sequence":
+		// The hash, a label and for mempool changes the mempool sequence number
+		if len(body) < 33 || s.listeners.OnTx == nil {
+			return
+		}
+		txId := hex.EncodeToString(body[:32])
+		switch body[32] {
+		case 'A':
+			s.Lock()
+			loaded := s.memPool != nil
+			if loaded {
+				s.memPool[txId] = struct{}{}
+			}
+			s.Unlock()
+			if !loaded {
+				return
+			}
+			err := s.sendTxs([]string{txId})
+			if err != nil {
+				s.logger.Errorw("Could not get transaction", "tx_id", txId, "error", err)
+			}
+		case 'R':
+			s.Lock()
+			delete(s.memPool, txId)
+			s.Unlock()
+			if s.listeners.OnTxRemoved != nil {
+				s.listeners.OnTxRemoved(txId)
+			}
+		}
+	}
+
+}
+
+// cacheTx holds a transaction from rawtx for the sequence topic, the oldest are dropped past zmqTxCacheSize
+func (s *zmqSource) cacheTx(msgTx *wire.MsgTx) {
+	txId := msgTx.TxHash().String()
+	s.txCacheLock.Lock()
+	defer s.txCacheLock.Unlock()
+	if _, ok := s.txCache[txId]; ok {
+		return
+	}
+	s.txCache[txId] = msgTx
+	s.txCacheOrder = append(s.txCacheOrder, txId)
+	for len(s.txCacheOrder) > zmqTxCacheSize {
+		delete(s.txCache, s.txCacheOrder[0])
+		s.txCacheOrder = s.txCacheOrder[1:]
+	}
+}
+
+// takeTx returns and removes a transaction from the cache or nil
+func (s *zmqSource) takeTx(txId string) *wire.MsgTx {
+	s.txCacheLock.Lock()
+	defer s.txCacheLock.Unlock()
+	msgTx := s.txCache[txId]
+	delete(s.txCache, txId)
+	return msgTx
+}
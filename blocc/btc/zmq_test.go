@@ -0,0 +1,200 @@
+package btc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	config "github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+// testPublisher is a stand-in for the Bitcoin Core ZMQ PUB socket
+type testPublisher struct {
+	listener net.Listener
+	subs     chan []string
+	conns    chan net.Conn
+}
+
+func newTestPublisher(t *testing.T) *testPublisher {
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	p := &testPublisher{
+		listener: listener,
+		subs:     make(chan []string, 1),
+		conns:    make(chan net.Conn, 1),
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			err = zmtpHandshake(conn, "PUB")
+			if err != nil {
+				conn.Close()
+				continue
+			}
+			// Wait for the subscriptions to the three topics
+			var topics []string
+			for len(topics) < 3 {
+				msg, err := zmtpReadMessage(conn)
+				if err != nil || len(msg[0]) == 0 || msg[0][0] != 1 {
+					break
+				}
+				topics = append(topics, string(msg[0][1:]))
+			}
+			p.subs <- topics
+			p.conns <- conn
+		}
+	}()
+
+	return p
+
+}
+
+// publish sends a message the way Bitcoin Core does with the topic, body and sequence number
+func publish(t *testing.T, conn net.Conn, topic string, body []byte) {
+	seq := make([]byte, 4)
+	binary.LittleEndian.PutUint32(seq, 1)
+	assert.Nil(t, zmtpWriteFrame(conn, zmtpFlagMore, []byte(topic)))
+	assert.Nil(t, zmtpWriteFrame(conn, zmtpFlagMore, body))
+	assert.Nil(t, zmtpWriteFrame(conn, 0, seq))
+}
+
+func TestZMTPFrame(t *testing.T) {
+
+	for _, size := range []int{0, 255, 256, 100000} {
+		var buf bytes.Buffer
+		body := bytes.Repeat([]byte{0xab}, size)
+		assert.Nil(t, zmtpWriteFrame(&buf, zmtpFlagMore, body))
+		flags, b, err := zmtpReadFrame(&buf)
+		assert.Nil(t, err)
+		assert.Equal(t, byte(zmtpFlagMore), flags&zmtpFlagMore)
+		assert.Equal(t, body, b)
+	}
+
+	// Larger than a message
+	_, _, err := zmtpReadFrame(bytes.NewReader([]byte{zmtpFlagLong, 0xff, 0, 0, 0, 0, 0, 0, 0}))
+	assert.NotNil(t, err)
+
+}
+
+func TestZMQSource(t *testing.T) {
+
+	node := newTestNode(t)
+	server := httptest.NewServer(node)
+	defer server.Close()
+
+	pub := newTestPublisher(t)
+	defer pub.listener.Close()
+
+	blocks := make(chan *wire.MsgBlock, 10)
+	txs := make(chan *wire.MsgTx, 10)
+	removed := make(chan string, 10)
+	listeners := SourceListeners{
+		OnBlock:     func(msg *wire.MsgBlock) { blocks <- msg },
+		OnTx:        func(msg *wire.MsgTx) { txs <- msg },
+		OnTxRemoved: func(txId string) { removed <- txId },
+	}
+
+	// No topics
+	newTestRPCSource(t, server.URL, listeners)
+	config.Set("extractor.btc.zmq_rawblock", "")
+	_, err := newZMQSource(&chaincfg.RegressionNetParams, listeners)
+	assert.NotNil(t, err)
+
+	addr := "tcp://" + pub.listener.Addr().String()
+	config.Set("extractor.btc.zmq_rawblock", addr)
+	config.Set("extractor.btc.zmq_rawtx", addr)
+	config.Set("extractor.btc.zmq_sequence", addr)
+	defer func() {
+		config.Set("extractor.btc.zmq_rawblock", "")
+		config.Set("extractor.btc.zmq_rawtx", "")
+		config.Set("extractor.btc.zmq_sequence", "")
+	}()
+
+	s, err := newZMQSource(&chaincfg.RegressionNetParams, listeners)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	assert.Len(t, s.subscribers, 1)
+	assert.False(t, s.pollMemPool)
+	assert.Nil(t, s.Connect())
+	assert.Nil(t, s.RequestMemPool())
+
+	for _, sub := range s.subscribers {
+		go sub.Run()
+		defer sub.Close()
+	}
+
+	var conn net.Conn
+	select {
+	case topics := <-pub.subs:
+		assert.Equal(t, []string{"rawblock", "rawtx", "sequence"}, topics)
+		conn = <-pub.conns
+	case <-time.After(5 * time.Second):
+		t.Fatal("No subscription")
+	}
+	defer conn.Close()
+
+	// A block
+	blk := wire.NewMsgBlock(&wire.BlockHeader{PrevBlock: node.blocks[5].BlockHash()})
+	var buf bytes.Buffer
+	assert.Nil(t, blk.Serialize(&buf))
+	publish(t, conn, "rawblock", buf.Bytes())
+	select {
+	case msg := <-blocks:
+		assert.Equal(t, blk.BlockHash(), msg.BlockHash())
+	case <-time.After(5 * time.Second):
+		t.Fatal("No block")
+	}
+	assert.Equal(t, int32(6), s.LastBlock())
+
+	// A transaction from rawtx is sent once the sequence topic adds it to the mempool
+	msgTx := wire.NewMsgTx(1)
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+	msgTx.AddTxOut(wire.NewTxOut(1, []byte{0x51}))
+	buf.Reset()
+	assert.Nil(t, msgTx.Serialize(&buf))
+	publish(t, conn, "rawtx", buf.Bytes())
+	select {
+	case <-txs:
+		t.Fatal("rawtx alone is not in the mempool")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// The sequence topic uses the hash in the byte order it's displayed
+	txHash := msgTx.TxHash()
+	hash := make([]byte, chainhash.HashSize)
+	for x := range hash {
+		hash[x] = txHash[chainhash.HashSize-1-x]
+	}
+	publish(t, conn, "sequence", append(append(hash, 'A'), 1, 0, 0, 0, 0, 0, 0, 0))
+	select {
+	case tx := <-txs:
+		assert.Equal(t, txHash, tx.TxHash())
+	case <-time.After(5 * time.Second):
+		t.Fatal("No transaction")
+	}
+
+	publish(t, conn, "sequence", append(append(hash, 'R'), 2, 0, 0, 0, 0, 0, 0, 0))
+	select {
+	case txId := <-removed:
+		assert.Equal(t, txHash.String(), txId)
+	case <-time.After(5 * time.Second):
+		t.Fatal("No removal")
+	}
+
+}
@@ -0,0 +1,192 @@
+package btools
+
+import (
+	"sync"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+type memPoolPackagesMem struct {
+	txs     map[string]*memPoolPackageTx
+	waiting map[string][]string // The txIds of children by the txId of a parent that has not arrived (or is confirmed)
+	sync.Mutex
+}
+
+type memPoolPackageTx struct {
+	fee      int64
+	vSize    int64
+	parents  []string
+	children []string
+	pending  []string // Parents that are not tracked
+}
+
+func NewMemPoolPackagesMem() *memPoolPackagesMem {
+	return &memPoolPackagesMem{
+		txs:     make(map[string]*memPoolPackageTx),
+		waiting: make(map[string][]string),
+	}
+}
+
+// AddTx adds a transaction spending parentTxIds and returns the ids of the other transactions whose package changed
+func (mpp *memPoolPackagesMem) AddTx(txId string, fee int64, vSize int64, parentTxIds []string) []string {
+
+	mpp.Lock()
+	defer mpp.Unlock()
+
+	// Already tracked
+	if _, ok := mpp.txs[txId]; ok {
+		return nil
+	}
+
+	ptx := &memPoolPackageTx{
+		fee:   fee,
+		vSize: vSize,
+	}
+	mpp.txs[txId] = ptx
+
+	// Link to the parents or wait for them
+	for _, parentTxId := range parentTxIds {
+		if parentTxId == txId || contains(ptx.parents, parentTxId) || contains(ptx.pending, parentTxId) {
+			continue
+		}
+		if parent, ok := mpp.txs[parentTxId]; ok {
+			parent.children = append(parent.children, txId)
+			ptx.parents = append(ptx.parents, parentTxId)
+		} else {
+			mpp.waiting[parentTxId] = append(mpp.waiting[parentTxId], txId)
+			ptx.pending = append(ptx.pending, parentTxId)
+		}
+	}
+
+	// Adopt any children that arrived first
+	for _, childTxId := range mpp.waiting[txId] {
+		if child, ok := mpp.txs[childTxId]; ok {
+			child.pending = remove(child.pending, txId)
+			child.parents = append(child.parents, txId)
+			ptx.children = append(ptx.children, childTxId)
+		}
+	}
+	delete(mpp.waiting, txId)
+
+	return mpp.related(txId)
+
+}
+
+// RemoveTx removes a confirmed or evicted transaction and returns the ids of the other transactions whose package changed
+func (mpp *memPoolPackagesMem) RemoveTx(txId string) []string {
+
+	mpp.Lock()
+	defer mpp.Unlock()
+
+	ptx, ok := mpp.txs[txId]
+	if !ok {
+		return nil
+	}
+
+	related := mpp.related(txId)
+
+	for _, parentTxId := range ptx.parents {
+		if parent, ok := mpp.txs[parentTxId]; ok {
+			parent.children = remove(parent.children, txId)
+		}
+	}
+	for _, childTxId := range ptx.children {
+		if child, ok := mpp.txs[childTxId]; ok {
+			child.parents = remove(child.parents, txId)
+		}
+	}
+	for _, parentTxId := range ptx.pending {
+		if children := remove(mpp.waiting[parentTxId], txId); len(children) > 0 {
+			mpp.waiting[parentTxId] = children
+		} else {
+			delete(mpp.waiting, parentTxId)
+		}
+	}
+	delete(mpp.txs, txId)
+
+	return related
+
+}
+
+// GetPackage returns the package of a transaction or nil if it's not tracked
+func (mpp *memPoolPackagesMem) GetPackage(txId string) *blocc.TxPackage {
+
+	mpp.Lock()
+	defer mpp.Unlock()
+
+	ptx, ok := mpp.txs[txId]
+	if !ok {
+		return nil
+	}
+
+	p := &blocc.TxPackage{
+		AncestorCount:   1,
+		AncestorVSize:   ptx.vSize,
+		AncestorFee:     ptx.fee,
+		DescendantCount: 1,
+		DescendantVSize: ptx.vSize,
+		DescendantFee:   ptx.fee,
+	}
+	for _, ancestorTxId := range mpp.walk(txId, func(ptx *memPoolPackageTx) []string { return ptx.parents }) {
+		ancestor := mpp.txs[ancestorTxId]
+		p.AncestorCount++
+		p.AncestorVSize += ancestor.vSize
+		p.AncestorFee += ancestor.fee
+	}
+	for _, descendantTxId := range mpp.walk(txId, func(ptx *memPoolPackageTx) []string { return ptx.children }) {
+		descendant := mpp.txs[descendantTxId]
+		p.DescendantCount++
+		p.DescendantVSize += descendant.vSize
+		p.DescendantFee += descendant.fee
+	}
+
+	return p
+
+}
+
+// Clear forgets all transactions
+func (mpp *memPoolPackagesMem) Clear() {
+	mpp.Lock()
+	mpp.txs = make(map[string]*memPoolPackageTx)
+	mpp.waiting = make(map[string][]string)
+	mpp.Unlock()
+}
+
+// related returns the ancestors and descendants of a transaction
+func (mpp *memPoolPackagesMem) related(txId string) []string {
+	return append(
+		mpp.walk(txId, func(ptx *memPoolPackageTx) []string { return ptx.parents }),
+		mpp.walk(txId, func(ptx *memPoolPackageTx) []string { return ptx.children })...,
+	)
+}
+
+// walk returns every transaction reachable from txId following next, excluding txId
+func (mpp *memPoolPackagesMem) walk(txId string, next func(ptx *memPoolPackageTx) []string) []string {
+	var ret []string
+	seen := map[string]struct{}{txId: {}}
+	queue := []string{txId}
+	for len(queue) > 0 {
+		ptx := mpp.txs[queue[0]]
+		queue = queue[1:]
+		for _, nextTxId := range next(ptx) {
+			if _, ok := seen[nextTxId]; ok {
+				continue
+			}
+			seen[nextTxId] = struct{}{}
+			ret = append(ret, nextTxId)
+			queue = append(queue, nextTxId)
+		}
+	}
+	return ret
+}
+
+// remove returns values without value
+func remove(values []string, value string) []string {
+	ret := values[:0]
+	for _, v := range values {
+		if v != value {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
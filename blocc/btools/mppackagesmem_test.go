@@ -0,0 +1,97 @@
+package btools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+func TestMemPoolPackagesChain(t *testing.T) {
+
+	// A low fee parent with a child and a high fee grandchild paying for both
+	mpp := NewMemPoolPackagesMem()
+	assert.Empty(t, mpp.AddTx("a", 100, 100, nil))
+	assert.Equal(t, []string{"a"}, mpp.AddTx("b", 100, 100, []string{"a"}))
+	assert.Equal(t, []string{"b", "a"}, mpp.AddTx("c", 1000, 100, []string{"b"}))
+
+	// Already tracked
+	assert.Nil(t, mpp.AddTx("c", 1000, 100, []string{"b"}))
+
+	for _, test := range []struct {
+		txId string
+		p    *blocc.TxPackage
+	}{
+		{"a", &blocc.TxPackage{AncestorCount: 1, AncestorVSize: 100, AncestorFee: 100, DescendantCount: 3, DescendantVSize: 300, DescendantFee: 1200}},
+		{"b", &blocc.TxPackage{AncestorCount: 2, AncestorVSize: 200, AncestorFee: 200, DescendantCount: 2, DescendantVSize: 200, DescendantFee: 1100}},
+		{"c", &blocc.TxPackage{AncestorCount: 3, AncestorVSize: 300, AncestorFee: 1200, DescendantCount: 1, DescendantVSize: 100, DescendantFee: 1000}},
+	} {
+		assert.Equal(t, test.p, mpp.GetPackage(test.txId), test.txId)
+	}
+	assert.Equal(t, 4.0, mpp.GetPackage("a").DescendantFeeVSize())
+	assert.Equal(t, 4.0, mpp.GetPackage("c").AncestorFeeVSize())
+
+	// Once the parent confirms the rest of the package pays for itself
+	assert.Equal(t, []string{"b", "c"}, mpp.RemoveTx("a"))
+	assert.Nil(t, mpp.GetPackage("a"))
+	assert.Equal(t, &blocc.TxPackage{AncestorCount: 2, AncestorVSize: 200, AncestorFee: 1100, DescendantCount: 1, DescendantVSize: 100, DescendantFee: 1000}, mpp.GetPackage("c"))
+	assert.Equal(t, 5.5, mpp.GetPackage("c").AncestorFeeVSize())
+
+	// Unknown transactions are ignored
+	assert.Nil(t, mpp.RemoveTx("a"))
+
+}
+
+func TestMemPoolPackagesRemoveMiddle(t *testing.T) {
+
+	mpp := NewMemPoolPackagesMem()
+	mpp.AddTx("a", 100, 100, nil)
+	mpp.AddTx("b", 200, 100, []string{"a"})
+	mpp.AddTx("c", 300, 100, []string{"b"})
+
+	// Removing the middle splits the chain
+	assert.Equal(t, []string{"a", "c"}, mpp.RemoveTx("b"))
+	assert.Equal(t, &blocc.TxPackage{AncestorCount: 1, AncestorVSize: 100, AncestorFee: 100, DescendantCount: 1, DescendantVSize: 100, DescendantFee: 100}, mpp.GetPackage("a"))
+	assert.Equal(t, &blocc.TxPackage{AncestorCount: 1, AncestorVSize: 100, AncestorFee: 300, DescendantCount: 1, DescendantVSize: 100, DescendantFee: 300}, mpp.GetPackage("c"))
+
+}
+
+func TestMemPoolPackagesOrder(t *testing.T) {
+
+	for _, test := range []struct {
+		name  string
+		order []string
+	}{
+		{"Parents first", []string{"a", "b", "c", "d"}},
+		{"Children first", []string{"d", "c", "b", "a"}},
+		{"Mixed", []string{"b", "d", "a", "c"}},
+	} {
+
+		// A diamond, b and c spend a and d spends both b and c
+		parents := map[string][]string{"a": nil, "b": {"a"}, "c": {"a"}, "d": {"b", "c", "b"}}
+		mpp := NewMemPoolPackagesMem()
+		for _, txId := range test.order {
+			mpp.AddTx(txId, 100, 100, parents[txId])
+		}
+
+		// Ancestors are only counted once
+		assert.Equal(t, &blocc.TxPackage{AncestorCount: 4, AncestorVSize: 400, AncestorFee: 400, DescendantCount: 1, DescendantVSize: 100, DescendantFee: 100}, mpp.GetPackage("d"), test.name)
+		assert.Equal(t, &blocc.TxPackage{AncestorCount: 1, AncestorVSize: 100, AncestorFee: 100, DescendantCount: 4, DescendantVSize: 400, DescendantFee: 400}, mpp.GetPackage("a"), test.name)
+		assert.Equal(t, int64(2), mpp.GetPackage("b").DescendantCount, test.name)
+
+		// Once the root confirms it is no longer an ancestor
+		mpp.RemoveTx("a")
+		assert.Equal(t, int64(3), mpp.GetPackage("d").AncestorCount, test.name)
+		mpp.Clear()
+		assert.Nil(t, mpp.GetPackage("d"), test.name)
+	}
+
+	// A child whose parent never arrives only counts itself
+	mpp := NewMemPoolPackagesMem()
+	mpp.AddTx("b", 100, 100, []string{"a"})
+	assert.Equal(t, int64(1), mpp.GetPackage("b").AncestorCount)
+	mpp.RemoveTx("b")
+	assert.Empty(t, mpp.waiting)
+
+}
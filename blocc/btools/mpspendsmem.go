@@ -0,0 +1,88 @@
+package btools
+
+import (
+	"strconv"
+	"sync"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+type memPoolSpendsMem struct {
+	spenders map[string]string   // The spending txId by outPoint
+	spends   map[string][]string // The outPoints spent by txId
+	sync.Mutex
+}
+
+func NewMemPoolSpendsMem() *memPoolSpendsMem {
+	return &memPoolSpendsMem{
+		spenders: make(map[string]string),
+		spends:   make(map[string][]string),
+	}
+}
+
+// AddTx records the previous outputs spent by a transaction and returns the ids of any other transactions spending them
+func (mps *memPoolSpendsMem) AddTx(tx *blocc.Tx) []string {
+
+	mps.Lock()
+	defer mps.Unlock()
+
+	var conflicts []string
+	outPoints := make([]string, 0, len(tx.In))
+	for _, in := range tx.In {
+		outPoint := outPointKey(in.TxId, in.Height)
+		if spender, ok := mps.spenders[outPoint]; ok && spender != tx.TxId && !contains(conflicts, spender) {
+			conflicts = append(conflicts, spender)
+		}
+		// The new transaction is now the spender
+		mps.spenders[outPoint] = tx.TxId
+		outPoints = append(outPoints, outPoint)
+	}
+	mps.spends[tx.TxId] = outPoints
+
+	return conflicts
+
+}
+
+// RemoveTx forgets the previous outputs spent by a transaction
+func (mps *memPoolSpendsMem) RemoveTx(txId string) {
+
+	mps.Lock()
+	defer mps.Unlock()
+
+	for _, outPoint := range mps.spends[txId] {
+		// Only if it has not already been replaced
+		if mps.spenders[outPoint] == txId {
+			delete(mps.spenders, outPoint)
+		}
+	}
+	delete(mps.spends, txId)
+
+}
+
+// GetSpender returns the id of the transaction spending an output or a blank string if it's not spent
+func (mps *memPoolSpendsMem) GetSpender(txId string, height int64) string {
+	mps.Lock()
+	defer mps.Unlock()
+	return mps.spenders[outPointKey(txId, height)]
+}
+
+// Clear forgets all transactions
+func (mps *memPoolSpendsMem) Clear() {
+	mps.Lock()
+	mps.spenders = make(map[string]string)
+	mps.spends = make(map[string][]string)
+	mps.Unlock()
+}
+
+func outPointKey(txId string, height int64) string {
+	return txId + ":" + strconv.FormatInt(height, 10)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
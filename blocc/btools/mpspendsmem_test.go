@@ -0,0 +1,98 @@
+package btools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// spendTx returns a transaction spending the outPoints, each is a txId and height
+func spendTx(txId string, outPoints ...interface{}) *blocc.Tx {
+	tx := &blocc.Tx{TxId: txId}
+	for x := 0; x < len(outPoints); x += 2 {
+		tx.In = append(tx.In, &blocc.TxIn{TxId: outPoints[x].(string), Height: int64(outPoints[x+1].(int))})
+	}
+	return tx
+}
+
+func TestMemPoolSpendsAddTx(t *testing.T) {
+
+	for _, test := range []struct {
+		name      string
+		existing  []*blocc.Tx
+		tx        *blocc.Tx
+		conflicts []string
+	}{
+		{
+			name: "No conflicts",
+			existing: []*blocc.Tx{
+				spendTx("tx1", "prev", 0),
+			},
+			tx:        spendTx("tx2", "prev", 1),
+			conflicts: nil,
+		},
+		{
+			name: "Double spend",
+			existing: []*blocc.Tx{
+				spendTx("tx1", "prev", 0),
+			},
+			tx:        spendTx("tx2", "prev", 0),
+			conflicts: []string{"tx1"},
+		},
+		{
+			name: "Conflicts with each transaction once",
+			existing: []*blocc.Tx{
+				spendTx("tx1", "prev", 0, "prev", 1),
+				spendTx("tx2", "prev", 2),
+				spendTx("tx3", "other", 0),
+			},
+			tx:        spendTx("tx4", "prev", 0, "prev", 1, "prev", 2),
+			conflicts: []string{"tx1", "tx2"},
+		},
+		{
+			name: "The same transaction again is not a conflict",
+			existing: []*blocc.Tx{
+				spendTx("tx1", "prev", 0),
+			},
+			tx:        spendTx("tx1", "prev", 0),
+			conflicts: nil,
+		},
+	} {
+		mps := NewMemPoolSpendsMem()
+		for _, tx := range test.existing {
+			assert.Empty(t, mps.AddTx(tx), test.name)
+		}
+		assert.Equal(t, test.conflicts, mps.AddTx(test.tx), test.name)
+
+		// The new transaction is now the spender of all of it's inputs
+		for _, in := range test.tx.In {
+			assert.Equal(t, test.tx.TxId, mps.GetSpender(in.TxId, in.Height), test.name)
+		}
+	}
+
+}
+
+func TestMemPoolSpendsRemoveTx(t *testing.T) {
+
+	mps := NewMemPoolSpendsMem()
+	mps.AddTx(spendTx("tx1", "prev", 0, "prev", 1))
+	mps.AddTx(spendTx("tx2", "prev", 1))
+
+	// Removing the replaced transaction leaves the outputs of the replacement
+	mps.RemoveTx("tx1")
+	assert.Equal(t, "", mps.GetSpender("prev", 0))
+	assert.Equal(t, "tx2", mps.GetSpender("prev", 1))
+
+	mps.RemoveTx("tx2")
+	assert.Equal(t, "", mps.GetSpender("prev", 1))
+
+	// Unknown transactions are ignored
+	mps.RemoveTx("tx3")
+
+	mps.AddTx(spendTx("tx4", "prev", 2))
+	mps.Clear()
+	assert.Equal(t, "", mps.GetSpender("prev", 2))
+
+}
@@ -0,0 +1,53 @@
+package blocc
+
+import (
+	"time"
+)
+
+// These are the types of events published on the EventBus
+const (
+	EventBlockConnected    = "block_connected"
+	EventBlockDisconnected = "block_disconnected"
+	EventTxAdded           = "tx_added"
+	EventTxConfirmed       = "tx_confirmed"
+	EventTxEvicted         = "tx_evicted"
+)
+
+// EventTxConfirmations is the type of address events updating the confirmations of a transaction
+const EventTxConfirmations = "tx_confirmations"
+
+// EventTypes are all of the event types
+var EventTypes = []string{EventBlockConnected, EventBlockDisconnected, EventTxAdded, EventTxConfirmed, EventTxEvicted}
+
+// NewBlockEvent returns an event of eventType for a block
+func NewBlockEvent(eventType string, symbol string, bh *BlockHeader) *Event {
+	return &Event{
+		Type:   eventType,
+		Symbol: symbol,
+		Time:   time.Now().UTC().Unix(),
+		Block:  bh,
+	}
+}
+
+// NewTxEvent returns an event of eventType for a transaction
+func NewTxEvent(eventType string, symbol string, tx *Tx) *Event {
+	return &Event{
+		Type:   eventType,
+		Symbol: symbol,
+		Time:   time.Now().UTC().Unix(),
+		Tx:     tx,
+	}
+}
+
+// NewAddressEvent returns an address event of eventType for a transaction matching addresses and scripts
+func NewAddressEvent(eventType string, symbol string, tx *Tx, addresses []string, scripts []string, confirmations int64) *AddressEvent {
+	return &AddressEvent{
+		Type:          eventType,
+		Symbol:        symbol,
+		Time:          time.Now().UTC().Unix(),
+		Addresses:     addresses,
+		Scripts:       scripts,
+		Confirmations: confirmations,
+		Tx:            tx,
+	}
+}
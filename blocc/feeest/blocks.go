@@ -0,0 +1,74 @@
+package feeest
+
+import (
+	"math"
+	"sort"
+
+	"github.com/montanaflynn/stats"
+	"github.com/spf13/cast"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// ProjectBlocks fills up to count blocks of blockVSize with the transactions by fee rate, highest first, the way a
+// miner would. Each transaction stands for scale transactions when txs is a sample of the mempool.
+func ProjectBlocks(txs []*blocc.Tx, scale float64, count int, blockVSize int64) []*blocc.ProjectedBlock {
+
+	type projectedTx struct {
+		feeVSize float64
+		vSize    float64
+		fee      float64
+	}
+
+	ptxs := make([]projectedTx, len(txs))
+	for x, tx := range txs {
+		ptxs[x] = projectedTx{
+			feeVSize: TxFeeVSize(tx),
+			vSize:    txVSize(tx) * scale,
+			fee:      cast.ToFloat64(tx.DataValue("fee")) * scale,
+		}
+	}
+	sort.SliceStable(ptxs, func(i, j int) bool { return ptxs[i].feeVSize > ptxs[j].feeVSize })
+
+	ret := make([]*blocc.ProjectedBlock, 0, count)
+	var feeVSizes []float64
+	var txCount, vSize, fee float64
+
+	// Finish the block being filled
+	closeBlock := func() {
+		if len(feeVSizes) == 0 {
+			return
+		}
+		median, _ := stats.Median(feeVSizes)
+		ret = append(ret, &blocc.ProjectedBlock{
+			MinFeeVSize:    feeVSizes[len(feeVSizes)-1],
+			MedianFeeVSize: median,
+			MaxFeeVSize:    feeVSizes[0],
+			Fee:            int64(math.Round(fee)),
+			TxCount:        int64(math.Round(txCount)),
+			VSize:          int64(math.Round(vSize)),
+		})
+		feeVSizes = nil
+		txCount, vSize, fee = 0, 0, 0
+	}
+
+	for _, ptx := range ptxs {
+		// It does not fit, start the next block
+		if len(feeVSizes) > 0 && vSize+ptx.vSize > float64(blockVSize) {
+			closeBlock()
+			if len(ret) == count {
+				return ret
+			}
+		}
+		feeVSizes = append(feeVSizes, ptx.feeVSize)
+		txCount += scale
+		vSize += ptx.vSize
+		fee += ptx.fee
+	}
+	if len(ret) < count {
+		closeBlock()
+	}
+
+	return ret
+
+}
@@ -0,0 +1,151 @@
+package feeest
+
+import (
+	"sort"
+	"time"
+
+	"github.com/spf13/cast"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// NewConfirmationStats groups confirmed transactions by fee rate into buckets starting at the ascending bounds and
+// measures the blocks and seconds from their received_block_height and received_time until they were confirmed.
+// Transactions that were never received in the mempool are skipped.
+func NewConfirmationStats(txs []*blocc.Tx, bounds []float64) *blocc.ConfirmationStats {
+
+	cs := &blocc.ConfirmationStats{
+		Time:    time.Now().UTC().Unix(),
+		Buckets: make([]*blocc.ConfirmationBucket, len(bounds)),
+	}
+	if len(bounds) == 0 {
+		return cs
+	}
+
+	blocks := make([][]float64, len(bounds))
+	seconds := make([][]float64, len(bounds))
+	for _, tx := range txs {
+
+		receivedTime := cast.ToInt64(tx.DataValue("received_time"))
+		if receivedTime == 0 || blocc.IsMemPool(tx.BlockId) {
+			continue
+		}
+
+		x := sort.Search(len(bounds), func(i int) bool { return bounds[i] > TxFeeVSize(tx) }) - 1
+		if x < 0 {
+			x = 0
+		}
+
+		// Block times can be before the time it was received
+		delay := tx.BlockTime - receivedTime
+		if delay < 0 {
+			delay = 0
+		}
+		seconds[x] = append(seconds[x], float64(delay))
+
+		// Received in the mempool on top of received_block_height, the next block is 1 block
+		if receivedBlockHeight := tx.DataValue("received_block_height"); receivedBlockHeight != "" {
+			delay = tx.BlockHeight - cast.ToInt64(receivedBlockHeight)
+			if delay < 1 {
+				delay = 1
+			}
+			blocks[x] = append(blocks[x], float64(delay))
+		}
+
+	}
+
+	for x, bound := range bounds {
+		cs.Buckets[x] = &blocc.ConfirmationBucket{
+			FeeVSize: bound,
+			Count:    int64(len(seconds[x])),
+			Blocks:   newConfirmationDelay(blocks[x]),
+			Seconds:  newConfirmationDelay(seconds[x]),
+		}
+		cs.Count += cs.Buckets[x].Count
+	}
+
+	return cs
+
+}
+
+// newConfirmationDelay returns the distribution of delays
+func newConfirmationDelay(delays []float64) *blocc.ConfirmationDelay {
+
+	cd := new(blocc.ConfirmationDelay)
+	if len(delays) == 0 {
+		return cd
+	}
+
+	var sum float64
+	for _, delay := range delays {
+		sum += delay
+		if delay > cd.Max {
+			cd.Max = delay
+		}
+	}
+	cd.Avg = sum / float64(len(delays))
+	cd.P10 = store.Percentile(delays, 10.0)
+	cd.Median = store.Percentile(delays, 50.0)
+	cd.P90 = store.Percentile(delays, 90.0)
+
+	return cd
+
+}
+
+// ConfirmationStats returns how long transactions took to confirm in the last server.confirmation_stats_blocks blocks
+// grouped into the mempool histogram buckets
+func (e *Estimator) ConfirmationStats(symbol string) (*blocc.ConfirmationStats, error) {
+
+	cs := new(blocc.ConfirmationStats)
+
+	// Check the cache
+	err := e.distCache.GetScan(symbol+":confirmation", "stats", cs)
+	if err == nil {
+		return cs, nil
+	} else if err != nil && err != blocc.ErrNotFound {
+		e.logger.Errorw("Could not check DistCache for confirmation stats", "error", err)
+	}
+
+	// Missing blocks (ie starting at block_start_height) are a validation error that still returns the top
+	top, err := e.blockChainStore.GetBlockHeaderTopByStatuses(symbol, []string{blocc.StatusValid})
+	if err == blocc.ErrNotFound || (err == nil && top == nil) {
+		// No blocks yet
+		return NewConfirmationStats(nil, e.buckets), nil
+	} else if err != nil && !blocc.IsValidationError(err) {
+		return nil, err
+	}
+	startHeight := top.Height - e.confirmationStatsBlocks + 1
+	if startHeight < 0 {
+		startHeight = 0
+	}
+
+	blks, err := e.blockChainStore.FindBlocksByStatusAndHeight(symbol, []string{blocc.StatusValid}, startHeight, top.Height, blocc.BlockIncludeHeader, 0, store.CountMax)
+	if err != nil && err != blocc.ErrNotFound {
+		return nil, err
+	}
+
+	var txs []*blocc.Tx
+	for _, blk := range blks {
+		blkTxs, err := e.blockChainStore.GetTxsByBlockId(symbol, blk.BlockId, blocc.TxIncludeHeader|blocc.TxIncludeData)
+		if err == blocc.ErrNotFound {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		txs = append(txs, blkTxs...)
+	}
+
+	cs = NewConfirmationStats(txs, e.buckets)
+	cs.StartHeight = startHeight
+	cs.EndHeight = top.Height
+
+	// Set it in the cache
+	err = e.distCache.Set(symbol+":confirmation", "stats", cs, e.confirmationStatsCacheTimeout)
+	if err != nil {
+		e.logger.Errorw("Could not set DistCache confirmation stats", "error", err)
+	}
+
+	return cs, nil
+
+}
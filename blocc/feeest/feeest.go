@@ -0,0 +1,268 @@
+// Package feeest estimates fee rates by simulating blocks being filled from the mempool blended with recent blocks
+package feeest
+
+import (
+	"sort"
+	"time"
+
+	"github.com/spf13/cast"
+	config "github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/btc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+const (
+	// The minimum number of recent blocks the history fee rate is taken from
+	minHistoryBlocks = 3
+)
+
+type Estimator struct {
+	logger *zap.SugaredLogger
+
+	buckets         []float64
+	historyWeight   float64
+	minFeeVSize     float64
+	projectedBlocks int
+
+	confirmationStatsBlocks       int64
+	confirmationStatsCacheTimeout time.Duration
+
+	distCache    store.DistCache
+	cacheTimeout time.Duration
+
+	blockChainStore blocc.BlockChainStore
+}
+
+func New(blockChainStore blocc.BlockChainStore, distCache store.DistCache) *Estimator {
+
+	// The configured buckets or the DefaultBuckets
+	buckets := make([]float64, 0)
+	for _, bucket := range config.GetStringSlice("server.mempool_histogram_buckets") {
+		buckets = append(buckets, cast.ToFloat64(bucket))
+	}
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	} else {
+		sort.Float64s(buckets)
+	}
+
+	return &Estimator{
+		logger: zap.S().With("package", "feeest"),
+
+		buckets:         buckets,
+		historyWeight:   config.GetFloat64("server.fee_estimate_history_weight"),
+		minFeeVSize:     config.GetFloat64("server.fee_estimate_min_fee_vsize"),
+		projectedBlocks: config.GetInt("server.max_projected_blocks"),
+
+		confirmationStatsBlocks:       config.GetInt64("server.confirmation_stats_blocks"),
+		confirmationStatsCacheTimeout: config.GetDuration("server.confirmation_stats_cache_duration"),
+
+		distCache:    distCache,
+		cacheTimeout: config.GetDuration("server.cache_duration"),
+
+		blockChainStore: blockChainStore,
+	}
+
+}
+
+// MemPoolHistogram returns the mempool grouped into the server.mempool_histogram_buckets (or DefaultBuckets)
+func (e *Estimator) MemPoolHistogram(symbol string) (*blocc.MemPoolHistogram, error) {
+
+	h := new(blocc.MemPoolHistogram)
+
+	// Check the cache
+	err := e.distCache.GetScan(symbol+":mempool", "histogram", h)
+	if err == nil {
+		return h, nil
+	} else if err != nil && err != blocc.ErrNotFound {
+		e.logger.Errorw("Could not check DistCache for histogram", "error", err)
+	}
+
+	txs, scale, err := e.memPoolTxs(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	h = NewHistogram(txs, e.buckets, scale)
+
+	// Set it in the cache
+	err = e.distCache.Set(symbol+":mempool", "histogram", h, e.cacheTimeout)
+	if err != nil {
+		e.logger.Errorw("Could not set DistCache histogram", "error", err)
+	}
+
+	return h, nil
+
+}
+
+// MemPoolProjectedBlocks returns the next server.max_projected_blocks blocks projected from the mempool
+func (e *Estimator) MemPoolProjectedBlocks(symbol string) (*blocc.ProjectedBlocks, error) {
+
+	pb := new(blocc.ProjectedBlocks)
+
+	// Check the cache
+	err := e.distCache.GetScan(symbol+":mempool", "blocks", pb)
+	if err == nil {
+		return pb, nil
+	} else if err != nil && err != blocc.ErrNotFound {
+		e.logger.Errorw("Could not check DistCache for blocks", "error", err)
+	}
+
+	chain, err := btc.GetChain(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, scale, err := e.memPoolTxs(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	pb = &blocc.ProjectedBlocks{
+		Time:   time.Now().UTC().Unix(),
+		Blocks: ProjectBlocks(txs, scale, e.projectedBlocks, chain.MaxBlockVSize),
+	}
+
+	// Set it in the cache
+	err = e.distCache.Set(symbol+":mempool", "blocks", pb, e.cacheTimeout)
+	if err != nil {
+		e.logger.Errorw("Could not set DistCache blocks", "error", err)
+	}
+
+	return pb, nil
+
+}
+
+// memPoolTxs returns the mempool transactions and how many transactions each one stands for. Only the most recent
+// store.CountMax mempool transactions are fetched, when there are more they are taken as a sample of the whole mempool.
+func (e *Estimator) memPoolTxs(symbol string) ([]*blocc.Tx, float64, error) {
+
+	txs, err := e.blockChainStore.FindTxs(symbol, nil, blocc.BlockIdMempool, nil, blocc.TxFilterIncompleteAll, nil, nil, blocc.TxIncludeHeader|blocc.TxIncludeData, 0, store.CountMax)
+	if err != nil && err != blocc.ErrNotFound {
+		return nil, 0, err
+	}
+
+	scale := 1.0
+	if len(txs) == store.CountMax {
+		_, count, err := e.blockChainStore.GetMemPoolStats(symbol)
+		if err != nil {
+			return nil, 0, err
+		}
+		if count > int64(len(txs)) {
+			scale = float64(count) / float64(len(txs))
+		}
+	}
+
+	return txs, scale, nil
+
+}
+
+// EstimateFee returns the fee rate for a transaction to confirm within targetBlocks. The rate from filling blocks with
+// the mempool is blended with the 10th percentile fee rate of recent blocks by server.fee_estimate_history_weight.
+func (e *Estimator) EstimateFee(symbol string, targetBlocks int64) (*blocc.FeeEstimate, error) {
+
+	if targetBlocks < 1 {
+		targetBlocks = 1
+	}
+
+	chain, err := btc.GetChain(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := e.MemPoolHistogram(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	fe := &blocc.FeeEstimate{
+		Symbol:       symbol,
+		TargetBlocks: targetBlocks,
+		Time:         h.Time,
+	}
+
+	// The histogram can't tell what is needed inside the top bucket, fill the blocks with it's transactions instead
+	var ok bool
+	fe.MemPoolFeeVSize, ok = Simulate(h, targetBlocks, chain.MaxBlockVSize)
+	if !ok {
+		pb, err := e.MemPoolProjectedBlocks(symbol)
+		if err != nil {
+			return nil, err
+		}
+		fe.MemPoolFeeVSize = SimulateBlocks(pb.Blocks, targetBlocks)
+	}
+	if fe.MemPoolFeeVSize < e.minFeeVSize {
+		fe.MemPoolFeeVSize = e.minFeeVSize
+	}
+
+	// Blend in the blocks covering the target
+	fe.FeeVSize = fe.MemPoolFeeVSize
+	if e.historyWeight > 0 {
+		historyBlocks := targetBlocks
+		if historyBlocks < minHistoryBlocks {
+			historyBlocks = minHistoryBlocks
+		}
+		// Missing blocks (ie starting at block_start_height) are a validation error that still returns the top
+		top, err := e.blockChainStore.GetBlockHeaderTopByStatuses(symbol, []string{blocc.StatusValid})
+		if err != nil && err != blocc.ErrNotFound && !blocc.IsValidationError(err) {
+			return nil, err
+		} else if top != nil {
+			fe.HistoryFeeVSize, err = e.blockChainStore.AverageBlockDataFieldByHeight(symbol, "data.fee_vsize_p10", true, top.Height-historyBlocks+1, blocc.HeightUnknown)
+			if err != nil && err != blocc.ErrNotFound {
+				return nil, err
+			}
+		}
+		if fe.HistoryFeeVSize > 0 {
+			fe.FeeVSize = (fe.MemPoolFeeVSize * (1 - e.historyWeight)) + (fe.HistoryFeeVSize * e.historyWeight)
+		}
+	}
+
+	if fe.FeeVSize < e.minFeeVSize {
+		fe.FeeVSize = e.minFeeVSize
+	}
+
+	return fe, nil
+
+}
+
+// Simulate fills blocks of blockVSize from the highest fee rate bucket of the histogram down and returns the fee rate
+// needed to be included within targetBlocks. This is the top of the first bucket that does not fit or 0 if the whole
+// mempool fits. When the top bucket alone does not fit the histogram has no upper bound to outbid and it returns false.
+func Simulate(h *blocc.MemPoolHistogram, targetBlocks int64, blockVSize int64) (float64, bool) {
+
+	capacity := targetBlocks * blockVSize
+	var filled int64
+	for x := len(h.Buckets) - 1; x >= 0; x-- {
+		filled += h.Buckets[x].VSize
+		if filled <= capacity {
+			continue
+		}
+		// There is nothing above the top bucket to outbid
+		if x == len(h.Buckets)-1 {
+			return 0, false
+		}
+		// Outbid the bucket that does not fit
+		return h.Buckets[x+1].FeeVSize, true
+	}
+
+	return 0, true
+
+}
+
+// SimulateBlocks returns the fee rate needed to be included within targetBlocks of the projected blocks. This is the
+// lowest fee rate of the last target block, or of the last projected block when there are fewer, or 0 if there are none.
+func SimulateBlocks(blocks []*blocc.ProjectedBlock, targetBlocks int64) float64 {
+
+	if len(blocks) == 0 {
+		return 0
+	}
+	if targetBlocks > int64(len(blocks)) {
+		targetBlocks = int64(len(blocks))
+	}
+
+	return blocks[targetBlocks-1].MinFeeVSize
+
+}
@@ -0,0 +1,364 @@
+package feeest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	_ "git.coinninja.net/backend/blocc/blocc/btc/bch"
+	_ "git.coinninja.net/backend/blocc/blocc/btc/ltc"
+	"git.coinninja.net/backend/blocc/mocks"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+func memPoolTx(feeVSize string, vSize string, fee string) *blocc.Tx {
+	return &blocc.Tx{
+		BlockId: blocc.BlockIdMempool,
+		Data:    map[string]string{"fee_vsize": feeVSize, "vsize": vSize, "fee": fee},
+	}
+}
+
+func TestTxFeeVSize(t *testing.T) {
+
+	tx := memPoolTx("50", "100", "5000")
+	assert.Equal(t, 50.0, TxFeeVSize(tx))
+
+	// A child only gets the rate of it's package
+	tx.Data["ancestor_fee_vsize"] = "25.5"
+	assert.Equal(t, 25.5, TxFeeVSize(tx))
+
+	// A parent gets the rate of it's children
+	tx = memPoolTx("1", "100", "100")
+	tx.Data["ancestor_fee_vsize"] = "1"
+	tx.Data["descendant_fee_vsize"] = "25.5"
+	assert.Equal(t, 25.5, TxFeeVSize(tx))
+
+}
+
+func TestNewHistogram(t *testing.T) {
+
+	h := NewHistogram([]*blocc.Tx{
+		memPoolTx("0.5", "200", "100"),
+		memPoolTx("1", "100", "100"),
+		memPoolTx("1.5", "100", "150"),
+		memPoolTx("12", "100", "1200"),
+	}, []float64{1, 2, 10}, 2)
+
+	assert.Equal(t, int64(8), h.Count)
+	assert.Equal(t, int64(1000), h.VSize)
+	assert.Equal(t, []*blocc.MemPoolBucket{
+		{FeeVSize: 1, Count: 6, VSize: 800, Fee: 700},
+		{FeeVSize: 2},
+		{FeeVSize: 10, Count: 2, VSize: 200, Fee: 2400},
+	}, h.Buckets)
+
+}
+
+func TestSimulate(t *testing.T) {
+
+	h := &blocc.MemPoolHistogram{Buckets: []*blocc.MemPoolBucket{
+		{FeeVSize: 1, VSize: 1000},
+		{FeeVSize: 5, VSize: 1000},
+		{FeeVSize: 20, VSize: 500},
+	}}
+
+	// Outbid the bucket that does not fit
+	feeVSize, ok := Simulate(h, 1, 1000)
+	assert.True(t, ok)
+	assert.Equal(t, 20.0, feeVSize)
+	feeVSize, ok = Simulate(h, 2, 1000)
+	assert.True(t, ok)
+	assert.Equal(t, 5.0, feeVSize)
+	// Everything fits
+	feeVSize, ok = Simulate(h, 3, 1000)
+	assert.True(t, ok)
+	assert.Equal(t, 0.0, feeVSize)
+	// Not even the top fits
+	_, ok = Simulate(h, 1, 100)
+	assert.False(t, ok)
+
+}
+
+func TestSimulateBlocks(t *testing.T) {
+
+	blocks := []*blocc.ProjectedBlock{
+		{MinFeeVSize: 40, MaxFeeVSize: 100},
+		{MinFeeVSize: 30, MaxFeeVSize: 35},
+	}
+
+	assert.Equal(t, 40.0, SimulateBlocks(blocks, 1))
+	assert.Equal(t, 30.0, SimulateBlocks(blocks, 2))
+	// Past the projected blocks
+	assert.Equal(t, 30.0, SimulateBlocks(blocks, 5))
+	assert.Equal(t, 0.0, SimulateBlocks(nil, 1))
+
+}
+
+func TestEstimateFee(t *testing.T) {
+
+	bcs := new(mocks.BlockChainStore)
+	dc := new(mocks.DistCache)
+	e := New(bcs, dc)
+	e.historyWeight = 0.5
+
+	// 1.5 blocks of 30 sat/vB and 1 block of 10 sat/vB
+	txs := []*blocc.Tx{
+		memPoolTx("30", "1500000", "45000000"),
+		memPoolTx("10", "1000000", "10000000"),
+	}
+
+	var noTime *time.Time
+	dc.On("GetScan", "btc:mempool", "histogram", mock.AnythingOfType("*blocc.MemPoolHistogram")).Once().Return(blocc.ErrNotFound)
+	dc.On("Set", "btc:mempool", "histogram", mock.AnythingOfType("*blocc.MemPoolHistogram"), mock.AnythingOfType("time.Duration")).Once().Return(nil)
+	bcs.On("FindTxs", "btc", []string(nil), blocc.BlockIdMempool, map[string]string(nil), blocc.TxFilterIncompleteAll, noTime, noTime, blocc.TxIncludeHeader|blocc.TxIncludeData, 0, store.CountMax).Once().Return(txs, nil)
+	bcs.On("GetBlockHeaderTopByStatuses", "btc", []string{blocc.StatusValid}).Once().Return(&blocc.BlockHeader{Height: 100}, nil)
+	bcs.On("AverageBlockDataFieldByHeight", "btc", "data.fee_vsize_p10", true, int64(98), int64(blocc.HeightUnknown)).Once().Return(10.0, nil)
+
+	fe, err := e.EstimateFee("btc", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), fe.TargetBlocks)
+	assert.Equal(t, 40.0, fe.MemPoolFeeVSize)
+	assert.Equal(t, 10.0, fe.HistoryFeeVSize)
+	assert.Equal(t, 25.0, fe.FeeVSize)
+
+	// Missing blocks still return the top with a validation error
+	dc.On("GetScan", "btc:mempool", "histogram", mock.AnythingOfType("*blocc.MemPoolHistogram")).Once().Return(nil)
+	bcs.On("GetBlockHeaderTopByStatuses", "btc", []string{blocc.StatusValid}).Once().Return(&blocc.BlockHeader{Height: 200}, fmt.Errorf("Validation Error: Missing Blocks Detected height:200 blocks:50"))
+	bcs.On("AverageBlockDataFieldByHeight", "btc", "data.fee_vsize_p10", true, int64(198), int64(blocc.HeightUnknown)).Once().Return(20.0, nil)
+
+	fe, err = e.EstimateFee("btc", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 20.0, fe.HistoryFeeVSize)
+
+	// Any other error fails
+	dc.On("GetScan", "btc:mempool", "histogram", mock.AnythingOfType("*blocc.MemPoolHistogram")).Once().Return(nil)
+	bcs.On("GetBlockHeaderTopByStatuses", "btc", []string{blocc.StatusValid}).Once().Return(nil, fmt.Errorf("store down"))
+
+	_, err = e.EstimateFee("btc", 1)
+	assert.NotNil(t, err)
+
+	bcs.AssertExpectations(t)
+	dc.AssertExpectations(t)
+
+}
+
+func TestEstimateFeeTopBucket(t *testing.T) {
+
+	bcs := new(mocks.BlockChainStore)
+	dc := new(mocks.DistCache)
+	e := New(bcs, dc)
+	e.buckets = []float64{1, 10}
+	e.historyWeight = 0
+	e.projectedBlocks = 10
+
+	// 4 blocks of transactions all in the top bucket
+	txs := []*blocc.Tx{
+		memPoolTx("100", "600000", "60000000"),
+		memPoolTx("50", "600000", "30000000"),
+		memPoolTx("20", "600000", "12000000"),
+		memPoolTx("15", "600000", "9000000"),
+	}
+
+	var noTime *time.Time
+	dc.On("GetScan", "btc:mempool", "histogram", mock.AnythingOfType("*blocc.MemPoolHistogram")).Once().Return(blocc.ErrNotFound)
+	dc.On("Set", "btc:mempool", "histogram", mock.AnythingOfType("*blocc.MemPoolHistogram"), mock.AnythingOfType("time.Duration")).Once().Return(nil)
+	dc.On("GetScan", "btc:mempool", "blocks", mock.AnythingOfType("*blocc.ProjectedBlocks")).Once().Return(blocc.ErrNotFound)
+	dc.On("Set", "btc:mempool", "blocks", mock.AnythingOfType("*blocc.ProjectedBlocks"), mock.AnythingOfType("time.Duration")).Once().Return(nil)
+	bcs.On("FindTxs", "btc", []string(nil), blocc.BlockIdMempool, map[string]string(nil), blocc.TxFilterIncompleteAll, noTime, noTime, blocc.TxIncludeHeader|blocc.TxIncludeData, 0, store.CountMax).Twice().Return(txs, nil)
+
+	// Not the bottom of the top bucket
+	fe, err := e.EstimateFee("btc", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 50.0, fe.MemPoolFeeVSize)
+	assert.Equal(t, 50.0, fe.FeeVSize)
+
+	bcs.AssertExpectations(t)
+	dc.AssertExpectations(t)
+
+}
+
+func TestProjectBlocks(t *testing.T) {
+
+	txs := []*blocc.Tx{
+		memPoolTx("5", "400", "2000"),
+		memPoolTx("50", "400", "20000"),
+		memPoolTx("10", "400", "4000"),
+		memPoolTx("20", "400", "8000"),
+		memPoolTx("1", "400", "400"),
+	}
+
+	assert.Equal(t, []*blocc.ProjectedBlock{
+		{MinFeeVSize: 20, MedianFeeVSize: 35, MaxFeeVSize: 50, Fee: 28000, TxCount: 2, VSize: 800},
+		{MinFeeVSize: 5, MedianFeeVSize: 7.5, MaxFeeVSize: 10, Fee: 6000, TxCount: 2, VSize: 800},
+	}, ProjectBlocks(txs, 1, 2, 1000))
+
+	// A sample of the mempool
+	blocks := ProjectBlocks(txs, 2, 10, 1000)
+	assert.Len(t, blocks, 5)
+	assert.Equal(t, &blocc.ProjectedBlock{MinFeeVSize: 1, MedianFeeVSize: 1, MaxFeeVSize: 1, Fee: 800, TxCount: 2, VSize: 800}, blocks[4])
+
+}
+
+func TestMemPoolProjectedBlocks(t *testing.T) {
+
+	bcs := new(mocks.BlockChainStore)
+	dc := new(mocks.DistCache)
+	e := New(bcs, dc)
+	e.projectedBlocks = 10
+
+	// 4MB of transactions is 4 blocks of btc and fits in a single bch block
+	txs := []*blocc.Tx{
+		memPoolTx("5", "1000000", "5000000"),
+		memPoolTx("4", "1000000", "4000000"),
+		memPoolTx("3", "1000000", "3000000"),
+		memPoolTx("2", "1000000", "2000000"),
+	}
+
+	var noTime *time.Time
+	for symbol, count := range map[string]int{"btc": 4, "bch": 1} {
+		dc.On("GetScan", symbol+":mempool", "blocks", mock.AnythingOfType("*blocc.ProjectedBlocks")).Once().Return(blocc.ErrNotFound)
+		dc.On("Set", symbol+":mempool", "blocks", mock.AnythingOfType("*blocc.ProjectedBlocks"), mock.AnythingOfType("time.Duration")).Once().Return(nil)
+		bcs.On("FindTxs", symbol, []string(nil), blocc.BlockIdMempool, map[string]string(nil), blocc.TxFilterIncompleteAll, noTime, noTime, blocc.TxIncludeHeader|blocc.TxIncludeData, 0, store.CountMax).Once().Return(txs, nil)
+
+		pb, err := e.MemPoolProjectedBlocks(symbol)
+		if assert.Nil(t, err) && assert.Len(t, pb.Blocks, count, symbol) && symbol == "bch" {
+			assert.Equal(t, &blocc.ProjectedBlock{MinFeeVSize: 2, MedianFeeVSize: 3.5, MaxFeeVSize: 5, Fee: 14000000, TxCount: 4, VSize: 4000000}, pb.Blocks[0])
+		}
+	}
+
+	// An unknown symbol has no block size
+	dc.On("GetScan", "test:mempool", "blocks", mock.AnythingOfType("*blocc.ProjectedBlocks")).Once().Return(blocc.ErrNotFound)
+	_, err := e.MemPoolProjectedBlocks("test")
+	assert.Equal(t, blocc.ErrUnknownSymbol, err)
+
+	bcs.AssertExpectations(t)
+	dc.AssertExpectations(t)
+
+}
+
+func TestNewConfirmationStats(t *testing.T) {
+
+	confirmedTx := func(feeVSize string, receivedTime string, receivedBlockHeight string, blockTime int64, blockHeight int64) *blocc.Tx {
+		tx := &blocc.Tx{
+			BlockId:     "block",
+			BlockHeight: blockHeight,
+			BlockTime:   blockTime,
+			Data:        map[string]string{"fee_vsize": feeVSize},
+		}
+		if receivedTime != "" {
+			tx.Data["received_time"] = receivedTime
+		}
+		if receivedBlockHeight != "" {
+			tx.Data["received_block_height"] = receivedBlockHeight
+		}
+		return tx
+	}
+
+	cs := NewConfirmationStats([]*blocc.Tx{
+		confirmedTx("1", "1000", "99", 4000, 102),
+		confirmedTx("1.5", "1000", "100", 1600, 101),
+		// Mined before it was received
+		confirmedTx("20", "2000", "100", 1900, 100),
+		// Never seen in the mempool
+		confirmedTx("20", "", "", 1900, 101),
+		// No received block height
+		confirmedTx("25", "1000", "", 1300, 101),
+	}, []float64{1, 2, 10})
+
+	assert.Equal(t, int64(4), cs.Count)
+	assert.Equal(t, []*blocc.ConfirmationBucket{
+		{
+			FeeVSize: 1,
+			Count:    2,
+			Blocks:   &blocc.ConfirmationDelay{Avg: 2, P10: 1.2, Median: 2, P90: 2.8, Max: 3},
+			Seconds:  &blocc.ConfirmationDelay{Avg: 1800, P10: 840, Median: 1800, P90: 2760, Max: 3000},
+		},
+		{
+			FeeVSize: 2,
+			Blocks:   &blocc.ConfirmationDelay{},
+			Seconds:  &blocc.ConfirmationDelay{},
+		},
+		{
+			FeeVSize: 10,
+			Count:    2,
+			Blocks:   &blocc.ConfirmationDelay{Avg: 1, P10: 1, Median: 1, P90: 1, Max: 1},
+			Seconds:  &blocc.ConfirmationDelay{Avg: 150, P10: 30, Median: 150, P90: 270, Max: 300},
+		},
+	}, cs.Buckets)
+
+}
+
+func TestConfirmationStats(t *testing.T) {
+
+	bcs := new(mocks.BlockChainStore)
+	dc := new(mocks.DistCache)
+	e := New(bcs, dc)
+	e.buckets = []float64{1, 10}
+	e.confirmationStatsBlocks = 10
+
+	// No blocks yet is empty and not cached
+	dc.On("GetScan", "btc:confirmation", "stats", mock.AnythingOfType("*blocc.ConfirmationStats")).Return(blocc.ErrNotFound)
+	bcs.On("GetBlockHeaderTopByStatuses", "btc", []string{blocc.StatusValid}).Once().Return(nil, blocc.ErrNotFound)
+
+	cs, err := e.ConfirmationStats("btc")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), cs.Count)
+	assert.Len(t, cs.Buckets, 2)
+
+	// Missing blocks still return the top with a validation error
+	bcs.On("GetBlockHeaderTopByStatuses", "btc", []string{blocc.StatusValid}).Once().Return(&blocc.BlockHeader{Height: 100}, fmt.Errorf("Validation Error: Missing Blocks Detected height:100 blocks:5"))
+	bcs.On("FindBlocksByStatusAndHeight", "btc", []string{blocc.StatusValid}, int64(91), int64(100), blocc.BlockIncludeHeader, 0, store.CountMax).Once().Return([]*blocc.Block{{BlockId: "block1"}}, nil)
+	bcs.On("GetTxsByBlockId", "btc", "block1", blocc.TxIncludeHeader|blocc.TxIncludeData).Once().Return([]*blocc.Tx{{
+		BlockId:     "block1",
+		BlockHeight: 100,
+		BlockTime:   1600,
+		Data:        map[string]string{"fee_vsize": "5", "received_time": "1000", "received_block_height": "99"},
+	}}, nil)
+	dc.On("Set", "btc:confirmation", "stats", mock.AnythingOfType("*blocc.ConfirmationStats"), mock.AnythingOfType("time.Duration")).Once().Return(nil)
+
+	cs, err = e.ConfirmationStats("btc")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), cs.Count)
+	assert.Equal(t, int64(91), cs.StartHeight)
+	assert.Equal(t, int64(100), cs.EndHeight)
+
+	// Any other error fails
+	bcs.On("GetBlockHeaderTopByStatuses", "btc", []string{blocc.StatusValid}).Once().Return(nil, fmt.Errorf("store down"))
+
+	_, err = e.ConfirmationStats("btc")
+	assert.NotNil(t, err)
+
+	bcs.AssertExpectations(t)
+	dc.AssertExpectations(t)
+
+}
+
+func TestCacheSymbols(t *testing.T) {
+
+	bcs := new(mocks.BlockChainStore)
+	dc := new(mocks.DistCache)
+	e := New(bcs, dc)
+
+	// Each symbol is cached on it's own
+	var noTime *time.Time
+	for _, symbol := range []string{"btc", "ltc"} {
+		dc.On("GetScan", symbol+":mempool", "histogram", mock.AnythingOfType("*blocc.MemPoolHistogram")).Once().Return(blocc.ErrNotFound)
+		dc.On("Set", symbol+":mempool", "histogram", mock.AnythingOfType("*blocc.MemPoolHistogram"), mock.AnythingOfType("time.Duration")).Once().Return(nil)
+		dc.On("GetScan", symbol+":mempool", "blocks", mock.AnythingOfType("*blocc.ProjectedBlocks")).Once().Return(blocc.ErrNotFound)
+		dc.On("Set", symbol+":mempool", "blocks", mock.AnythingOfType("*blocc.ProjectedBlocks"), mock.AnythingOfType("time.Duration")).Once().Return(nil)
+		bcs.On("FindTxs", symbol, []string(nil), blocc.BlockIdMempool, map[string]string(nil), blocc.TxFilterIncompleteAll, noTime, noTime, blocc.TxIncludeHeader|blocc.TxIncludeData, 0, store.CountMax).Twice().Return(nil, blocc.ErrNotFound)
+
+		_, err := e.MemPoolHistogram(symbol)
+		assert.Nil(t, err)
+		_, err = e.MemPoolProjectedBlocks(symbol)
+		assert.Nil(t, err)
+	}
+
+	bcs.AssertExpectations(t)
+	dc.AssertExpectations(t)
+
+}
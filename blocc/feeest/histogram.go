@@ -0,0 +1,80 @@
+package feeest
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/spf13/cast"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// DefaultBuckets are the lowest fee rates (satoshis per vbyte) of the mempool histogram buckets
+var DefaultBuckets = []float64{
+	0, 1, 2, 3, 4, 5, 6, 8, 10, 12, 15, 20, 25, 30, 40, 50, 60, 70, 80, 100, 120, 140, 170, 200,
+	250, 300, 400, 500, 600, 700, 800, 1000, 1200, 1400, 1700, 2000,
+}
+
+// TxFeeVSize returns the fee rate a miner effectively gets for a mempool transaction. A child paying less than it's
+// ancestor package is only mined at the package rate and a parent is mined at the rate of it's descendant package
+// when children pay for it.
+func TxFeeVSize(tx *blocc.Tx) float64 {
+	feeVSize := cast.ToFloat64(tx.DataValue("fee_vsize"))
+	if ancestor := cast.ToFloat64(tx.DataValue("ancestor_fee_vsize")); ancestor > 0 && ancestor < feeVSize {
+		feeVSize = ancestor
+	}
+	if descendant := cast.ToFloat64(tx.DataValue("descendant_fee_vsize")); descendant > feeVSize {
+		feeVSize = descendant
+	}
+	return feeVSize
+}
+
+// NewHistogram groups transactions by fee rate into buckets starting at the ascending bounds. Transactions below the
+// first bound are counted in the first bucket. The counts and sizes are multiplied by scale for when txs is a sample.
+func NewHistogram(txs []*blocc.Tx, bounds []float64, scale float64) *blocc.MemPoolHistogram {
+
+	h := &blocc.MemPoolHistogram{
+		Time:    time.Now().UTC().Unix(),
+		Buckets: make([]*blocc.MemPoolBucket, len(bounds)),
+	}
+	for x, bound := range bounds {
+		h.Buckets[x] = &blocc.MemPoolBucket{FeeVSize: bound}
+	}
+	if len(bounds) == 0 {
+		return h
+	}
+
+	count := make([]float64, len(bounds))
+	vSize := make([]float64, len(bounds))
+	fee := make([]float64, len(bounds))
+	for _, tx := range txs {
+		// The last bucket with a bound at or below the fee rate
+		x := sort.Search(len(bounds), func(i int) bool { return bounds[i] > TxFeeVSize(tx) }) - 1
+		if x < 0 {
+			x = 0
+		}
+		count[x]++
+		vSize[x] += txVSize(tx)
+		fee[x] += cast.ToFloat64(tx.DataValue("fee"))
+	}
+
+	for x, b := range h.Buckets {
+		b.Count = int64(math.Round(count[x] * scale))
+		b.VSize = int64(math.Round(vSize[x] * scale))
+		b.Fee = int64(math.Round(fee[x] * scale))
+		h.Count += b.Count
+		h.VSize += b.VSize
+	}
+
+	return h
+
+}
+
+// txVSize is the virtual size of a transaction, the size if it was not recorded
+func txVSize(tx *blocc.Tx) float64 {
+	if vSize := cast.ToFloat64(tx.DataValue("vsize")); vSize > 0 {
+		return vSize
+	}
+	return float64(tx.TxSize)
+}
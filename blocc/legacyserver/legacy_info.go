@@ -125,33 +125,23 @@ func (s *Server) LegacyGetBlockChainInfo(path int) http.HandlerFunc {
 			info.Fees.Max = maxMaxFee
 		}
 
-		// The fast fee will be the average of the 10th percentile of the last 3 blocks
-		info.Fees.Fast, err = s.blockChainStore.AverageBlockDataFieldByHeight(btc.Symbol, "data.fee_vsize_p10", true, info.Blocks-4, blocc.HeightUnknown)
-		if err == blocc.ErrNotFound {
-			info.Fees.Fast = info.Fees.Avg
-		} else if err != nil {
-			render.Render(w, r, s.ErrInternalLog(fmt.Errorf("Error AverageBlockDataFieldByHeight: %v", err)))
-			return
-		}
-
-		// The slow fee will be the 10th percentile of the 10th percentile of the last 144 blocks
-		info.Fees.Slow, err = s.blockChainStore.PercentileBlockDataFieldByHeight(btc.Symbol, "data.fee_vsize_p10", 10.0, true, info.Blocks-145, blocc.HeightUnknown)
-		if err == blocc.ErrNotFound {
-			info.Fees.Slow = info.Fees.Avg
-		} else if err != nil {
-			render.Render(w, r, s.ErrInternalLog(fmt.Errorf("Error PercentileBlockDataFieldByHeight: %v", err)))
-			return
-		} else {
-			// If the fast fee is somehow better than this fee, use it instead, and then increase the fast fee by a couple sats
-			// This should basically never happen
-			if info.Fees.Fast < info.Fees.Slow {
-				info.Fees.Slow = info.Fees.Fast
+		// The fast, med and slow fees are estimated from the mempool and recent blocks for their confirmation targets
+		for _, fee := range []struct {
+			value  *float64
+			target string
+		}{
+			{&info.Fees.Fast, "server.legacy.btc_fee_fast_blocks"},
+			{&info.Fees.Med, "server.legacy.btc_fee_med_blocks"},
+			{&info.Fees.Slow, "server.legacy.btc_fee_slow_blocks"},
+		} {
+			fe, err := s.feeEstimator.EstimateFee(btc.Symbol, config.GetInt64(fee.target))
+			if err != nil {
+				render.Render(w, r, s.ErrInternalLog(fmt.Errorf("Error EstimateFee: %v", err)))
+				return
 			}
+			*fee.value = fe.FeeVSize
 		}
 
-		// Now calculate the medium fee as 2/3 the way to the fast fee from the slow fee
-		info.Fees.Med = (((info.Fees.Fast - info.Fees.Slow) / 3) * 2) + info.Fees.Slow
-
 		// Make extra sure the fees didn't end up the same or in the wrong order if there are rounding errors
 		if info.Fees.Med <= info.Fees.Slow {
 			info.Fees.Med += (info.Fees.Slow - info.Fees.Med) + 1
@@ -165,7 +155,7 @@ func (s *Server) LegacyGetBlockChainInfo(path int) http.HandlerFunc {
 			info.Fees.Min = info.Fees.Avg
 		}
 
-		// This will substitute the p10 fees for the average and min fees
+		// This will substitute the fast fee for the average and min fees
 		if config.GetBool("server.legacy.btc_use_p10_fee") {
 			info.Fees.Min = info.Fees.Fast
 			info.Fees.Avg = info.Fees.Fast
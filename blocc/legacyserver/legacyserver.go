@@ -9,7 +9,9 @@ import (
 	"go.uber.org/zap"
 
 	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/feeest"
 	"git.coinninja.net/backend/blocc/server"
+	"git.coinninja.net/backend/blocc/store"
 )
 
 type Server struct {
@@ -17,16 +19,20 @@ type Server struct {
 
 	defaultCount int
 
+	feeEstimator *feeest.Estimator
+
 	blockChainStore blocc.BlockChainStore
 }
 
-func New(router chi.Router, blockChainStore blocc.BlockChainStore) (*Server, error) {
+func New(router chi.Router, blockChainStore blocc.BlockChainStore, distCache store.DistCache) (*Server, error) {
 
 	s := &Server{
 		logger: zap.S().With("package", "legacyserver"),
 
 		defaultCount: config.GetInt("server.default_count"),
 
+		feeEstimator: feeest.New(blockChainStore, distCache),
+
 		blockChainStore: blockChainStore,
 	}
 
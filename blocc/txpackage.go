@@ -0,0 +1,51 @@
+package blocc
+
+import (
+	"strconv"
+)
+
+// TxPackageDataFields are the transaction data fields holding the package of a mempool transaction
+var TxPackageDataFields = []string{
+	"ancestor_count", "ancestor_vsize", "ancestor_fee", "ancestor_fee_vsize",
+	"descendant_count", "descendant_vsize", "descendant_fee", "descendant_fee_vsize",
+}
+
+// TxPackage is the size and fee of a mempool transaction together with it's mempool ancestors (the transactions it
+// spends that must be mined first) and it's mempool descendants (the transactions spending it). Both include the
+// transaction itself so a transaction without any mempool parents or children has counts of 1.
+type TxPackage struct {
+	AncestorCount   int64
+	AncestorVSize   int64
+	AncestorFee     int64
+	DescendantCount int64
+	DescendantVSize int64
+	DescendantFee   int64
+}
+
+// AncestorFeeVSize is the fee rate a miner gets for including the transaction and it's ancestors
+func (p *TxPackage) AncestorFeeVSize() float64 {
+	if p.AncestorVSize == 0 {
+		return 0
+	}
+	return float64(p.AncestorFee) / float64(p.AncestorVSize)
+}
+
+// DescendantFeeVSize is the fee rate of the transaction and it's descendants, children paying for their parent
+func (p *TxPackage) DescendantFeeVSize() float64 {
+	if p.DescendantVSize == 0 {
+		return 0
+	}
+	return float64(p.DescendantFee) / float64(p.DescendantVSize)
+}
+
+// SetData stores the package in the TxPackageDataFields of the transaction data
+func (p *TxPackage) SetData(data map[string]string) {
+	data["ancestor_count"] = strconv.FormatInt(p.AncestorCount, 10)
+	data["ancestor_vsize"] = strconv.FormatInt(p.AncestorVSize, 10)
+	data["ancestor_fee"] = strconv.FormatInt(p.AncestorFee, 10)
+	data["ancestor_fee_vsize"] = strconv.FormatFloat(p.AncestorFeeVSize(), 'f', -1, 64)
+	data["descendant_count"] = strconv.FormatInt(p.DescendantCount, 10)
+	data["descendant_vsize"] = strconv.FormatInt(p.DescendantVSize, 10)
+	data["descendant_fee"] = strconv.FormatInt(p.DescendantFee, 10)
+	data["descendant_fee_vsize"] = strconv.FormatFloat(p.DescendantFeeVSize(), 'f', -1, 64)
+}
@@ -0,0 +1,574 @@
+// Package webhook posts signed JSON payloads to registered webhooks when their watches fire on block and transaction
+// events. Deliveries are queued in the WebhookStore and retried with exponential backoff before being dead lettered.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	config "github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/conf"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// The headers sent with each payload
+const (
+	SignatureHeader = "X-Blocc-Signature"
+	EventHeader     = "X-Blocc-Event"
+	DeliveryHeader  = "X-Blocc-Delivery"
+)
+
+type Dispatcher struct {
+	logger *zap.SugaredLogger
+	symbol string
+
+	workers         int
+	maxAttempts     int64
+	backoffMin      time.Duration
+	backoffMax      time.Duration
+	pollInterval    time.Duration
+	refreshInterval time.Duration
+	deadLetterMax   int
+
+	client   *http.Client
+	events   chan *blocc.Event
+	webhooks []*blocc.Webhook
+	sync.RWMutex
+
+	blockChainStore blocc.BlockChainStore
+	webhookStore    blocc.WebhookStore
+}
+
+// New creates a Dispatcher for symbol. Events passed to HandleEvent are matched against the webhooks, refreshed from the
+// webhookStore every webhook.refresh_interval, and their deliveries are sent by webhook.workers workers.
+func New(symbol string, blockChainStore blocc.BlockChainStore, webhookStore blocc.WebhookStore) (*Dispatcher, error) {
+
+	d := &Dispatcher{
+		logger: zap.S().With("package", "webhook"),
+		symbol: symbol,
+
+		workers:         config.GetInt("webhook.workers"),
+		maxAttempts:     config.GetInt64("webhook.max_attempts"),
+		backoffMin:      config.GetDuration("webhook.backoff_min"),
+		backoffMax:      config.GetDuration("webhook.backoff_max"),
+		pollInterval:    config.GetDuration("webhook.poll_interval"),
+		refreshInterval: config.GetDuration("webhook.refresh_interval"),
+		deadLetterMax:   config.GetInt("webhook.dead_letter_max"),
+
+		events: make(chan *blocc.Event, config.GetInt("webhook.event_queue_size")),
+
+		blockChainStore: blockChainStore,
+		webhookStore:    webhookStore,
+	}
+
+	if d.workers < 1 {
+		d.workers = 1
+	}
+
+	hf, err := newHostFilter()
+	if err != nil {
+		return nil, err
+	}
+	d.client = newClient(config.GetDuration("webhook.timeout"), hf)
+
+	err = d.refresh()
+	if err != nil {
+		return nil, fmt.Errorf("Could not load webhooks: %v", err)
+	}
+
+	go d.refreshWebhooks()
+	go d.handleEvents()
+	go d.sendDeliveries(conf.Stop.Chan())
+
+	return d, nil
+
+}
+
+// HandleEvent queues an event to be matched against the webhooks. It blocks while the queue is full.
+func (d *Dispatcher) HandleEvent(event *blocc.Event) {
+	d.events <- event
+}
+
+// refreshWebhooks reloads the webhooks until shutdown
+func (d *Dispatcher) refreshWebhooks() {
+	for {
+		select {
+		case <-time.After(d.refreshInterval):
+			if err := d.refresh(); err != nil {
+				d.logger.Errorw("Could not WebhookStore FindWebhooks", "error", err)
+			}
+		case <-conf.Stop.Chan():
+			return
+		}
+	}
+}
+
+// refresh loads the webhooks
+func (d *Dispatcher) refresh() error {
+	whs, err := d.webhookStore.FindWebhooks(d.symbol)
+	if err != nil {
+		return err
+	}
+	d.Lock()
+	d.webhooks = whs
+	d.Unlock()
+	return nil
+}
+
+// handleEvents queues the deliveries for events until shutdown
+func (d *Dispatcher) handleEvents() {
+	for {
+		select {
+		case event := <-d.events:
+			now := time.Now().UTC()
+			for _, we := range d.Match(event) {
+				del := &blocc.WebhookDelivery{
+					Id:        NewId(),
+					WebhookId: we.WebhookId,
+					Event:     we,
+				}
+				we.Id = del.Id
+				err := d.webhookStore.ScheduleWebhookDelivery(d.symbol, del, now)
+				if err != nil {
+					d.logger.Errorw("Could not WebhookStore ScheduleWebhookDelivery", "error", err, "webhook_id", we.WebhookId)
+				}
+			}
+		case <-conf.Stop.Chan():
+			return
+		}
+	}
+}
+
+// Match returns the payloads for each webhook whose watch fires on an event. Transactions fire for watched addresses
+// and transaction ids when added to or evicted from the mempool and when they reach the confirmations of the webhook.
+func (d *Dispatcher) Match(event *blocc.Event) []*blocc.WebhookEvent {
+
+	d.RLock()
+	whs := d.webhooks
+	d.RUnlock()
+
+	var wes []*blocc.WebhookEvent
+	newWebhookEvent := func(wh *blocc.Webhook) *blocc.WebhookEvent {
+		return &blocc.WebhookEvent{
+			WebhookId: wh.Id,
+			Type:      event.Type,
+			Symbol:    event.Symbol,
+			Time:      event.Time,
+			Block:     event.Block,
+			Tx:        event.Tx,
+		}
+	}
+
+	switch event.Type {
+	case blocc.EventTxAdded, blocc.EventTxEvicted:
+		for _, wh := range whs {
+			if addresses, ok := matchTx(wh, event.Tx); ok {
+				we := newWebhookEvent(wh)
+				we.Addresses = addresses
+				wes = append(wes, we)
+			}
+		}
+
+	case blocc.EventBlockConnected, blocc.EventBlockDisconnected:
+		// Transactions are confirmed by the block at the height that gives each webhook it's confirmations
+		byConfirmations := make(map[int64][]*blocc.Webhook)
+		for _, wh := range whs {
+			if wh.Blocks {
+				wes = append(wes, newWebhookEvent(wh))
+			}
+			if event.Type == blocc.EventBlockConnected && (len(wh.Addresses) > 0 || len(wh.TxIds) > 0) {
+				byConfirmations[confirmations(wh)] = append(byConfirmations[confirmations(wh)], wh)
+			}
+		}
+
+		for confs, whs := range byConfirmations {
+			txs, err := d.confirmedTxs(event.Block, confs)
+			if err != nil {
+				d.logger.Errorw("Could not get confirmed transactions", "error", err, "block_id", event.Block.BlockId, "confirmations", confs)
+				continue
+			}
+			for _, tx := range txs {
+				for _, wh := range whs {
+					if addresses, ok := matchTx(wh, tx); ok {
+						wes = append(wes, &blocc.WebhookEvent{
+							WebhookId:     wh.Id,
+							Type:          blocc.EventTxConfirmed,
+							Symbol:        event.Symbol,
+							Time:          event.Time,
+							Confirmations: confs,
+							Addresses:     addresses,
+							Tx:            tx,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return wes
+
+}
+
+// confirmedTxs returns the transactions that reach confirmations with the block
+func (d *Dispatcher) confirmedTxs(bh *blocc.BlockHeader, confirmations int64) ([]*blocc.Tx, error) {
+
+	blockId := bh.BlockId
+	if confirmations > 1 {
+		height := bh.Height - confirmations + 1
+		blks, err := d.blockChainStore.FindBlocksByStatusAndHeight(d.symbol, []string{blocc.StatusValid}, height, height, blocc.BlockIncludeHeader, 0, 1)
+		if err == blocc.ErrNotFound || (err == nil && len(blks) == 0) {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		blockId = blks[0].BlockId
+	}
+
+	txs, err := d.blockChainStore.GetTxsByBlockId(d.symbol, blockId, blocc.TxIncludeAllButRaw)
+	if err == blocc.ErrNotFound {
+		return nil, nil
+	}
+	return txs, err
+
+}
+
+// matchTx returns the watched addresses in a transaction and if the webhook matched the addresses or transaction id
+func matchTx(wh *blocc.Webhook, tx *blocc.Tx) ([]string, bool) {
+
+	if tx == nil {
+		return nil, false
+	}
+
+	var matched bool
+	for _, txId := range wh.TxIds {
+		if txId == tx.TxId {
+			matched = true
+			break
+		}
+	}
+
+	var addresses []string
+	if len(wh.Addresses) > 0 {
+		for _, address := range store.TxAddresses(tx, blocc.TxFilterAddressInputOutput) {
+			for _, watched := range wh.Addresses {
+				if address == watched {
+					addresses = append(addresses, address)
+					matched = true
+					break
+				}
+			}
+		}
+	}
+
+	return addresses, matched
+
+}
+
+// confirmations returns the confirmations a webhook fires at
+func confirmations(wh *blocc.Webhook) int64 {
+	if wh.Confirmations < 1 {
+		return 1
+	}
+	return wh.Confirmations
+}
+
+// sendDeliveries takes due deliveries from the queue and hands them to webhook.workers workers until stop. Deliveries
+// are only taken for idle workers so a slow webhook holds up one worker rather than the others.
+func (d *Dispatcher) sendDeliveries(stop <-chan struct{}) {
+
+	// A delivery not completed within the lease is taken again
+	lease := d.client.Timeout * 2
+
+	// Each idle worker has a token in idle
+	queue := make(chan *blocc.WebhookDelivery, d.workers)
+	idle := make(chan struct{}, d.workers)
+	for x := 0; x < d.workers; x++ {
+		idle <- struct{}{}
+		go func() {
+			for del := range queue {
+				d.Deliver(del)
+				idle <- struct{}{}
+			}
+		}()
+	}
+	defer close(queue)
+
+	for {
+		// Wait for an idle worker and take a delivery for each of them
+		select {
+		case <-idle:
+		case <-stop:
+			return
+		}
+		count := 1
+		for len(idle) > 0 {
+			<-idle
+			count++
+		}
+
+		dels, err := d.webhookStore.TakeWebhookDeliveries(d.symbol, time.Now().UTC(), lease, count)
+		if err != nil {
+			d.logger.Errorw("Could not WebhookStore TakeWebhookDeliveries", "error", err)
+		}
+		for _, del := range dels {
+			queue <- del
+		}
+		for x := len(dels); x < count; x++ {
+			idle <- struct{}{}
+		}
+
+		// Keep going while the queue is full
+		if len(dels) == count {
+			continue
+		}
+
+		select {
+		case <-time.After(d.pollInterval):
+		case <-stop:
+			return
+		}
+	}
+
+}
+
+// Deliver posts a delivery to it's webhook. It's removed from the queue when it succeeds or the webhook no longer exists,
+// otherwise it's scheduled again with backoff until webhook.max_attempts when it's dead lettered.
+func (d *Dispatcher) Deliver(del *blocc.WebhookDelivery) {
+
+	wh, err := d.webhookStore.GetWebhook(d.symbol, del.WebhookId)
+	if err == blocc.ErrNotFound {
+		err = d.webhookStore.DeleteWebhookDelivery(d.symbol, del.Id)
+		if err != nil {
+			d.logger.Errorw("Could not WebhookStore DeleteWebhookDelivery", "error", err, "id", del.Id)
+		}
+		return
+	} else if err != nil {
+		d.logger.Errorw("Could not WebhookStore GetWebhook", "error", err, "webhook_id", del.WebhookId)
+		return
+	}
+
+	now := time.Now().UTC()
+	del.Attempts++
+	del.LastAttempt = now.Unix()
+
+	err = d.post(wh, del)
+	if err == nil {
+		err = d.webhookStore.DeleteWebhookDelivery(d.symbol, del.Id)
+		if err != nil {
+			d.logger.Errorw("Could not WebhookStore DeleteWebhookDelivery", "error", err, "id", del.Id)
+		}
+		return
+	}
+	del.LastError = err.Error()
+
+	if del.Attempts >= d.maxAttempts {
+		d.logger.Warnw("Webhook delivery failed", "error", err, "id", del.Id, "webhook_id", wh.Id, "attempts", del.Attempts)
+		err = d.webhookStore.DeadLetterWebhookDelivery(d.symbol, del, d.deadLetterMax)
+		if err != nil {
+			d.logger.Errorw("Could not WebhookStore DeadLetterWebhookDelivery", "error", err, "id", del.Id)
+		}
+		return
+	}
+
+	err = d.webhookStore.ScheduleWebhookDelivery(d.symbol, del, now.Add(Backoff(del.Attempts, d.backoffMin, d.backoffMax)))
+	if err != nil {
+		d.logger.Errorw("Could not WebhookStore ScheduleWebhookDelivery", "error", err, "id", del.Id)
+	}
+
+}
+
+// post sends the payload of a delivery to the webhook URL
+func (d *Dispatcher) post(wh *blocc.Webhook, del *blocc.WebhookDelivery) error {
+
+	body, err := json.Marshal(del.Event)
+	if err != nil {
+		return fmt.Errorf("Could not marshal payload: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, wh.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, del.Event.GetType())
+	req.Header.Set(DeliveryHeader, del.Id)
+	req.Header.Set(SignatureHeader, Sign(wh.Secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("Webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+
+}
+
+// Sign returns the signature header value of a payload, the hex HMAC-SHA256 of the body using the webhook secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Backoff returns how long to wait after a number of attempts, doubling from min up to max
+func Backoff(attempts int64, min time.Duration, max time.Duration) time.Duration {
+	backoff := min
+	for x := int64(1); x < attempts && backoff < max; x++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		backoff = max
+	}
+	return backoff
+}
+
+// Prepare validates a new webhook and fills in it's id, secret, confirmations and time. The URL can't point at a host
+// that's not public unless it's in webhook.allowed_networks. Host names are checked again once resolved when delivering.
+func Prepare(wh *blocc.Webhook) error {
+
+	u, err := url.Parse(wh.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return fmt.Errorf("Invalid url: %s", wh.URL)
+	}
+	hf, err := newHostFilter()
+	if err != nil {
+		return err
+	}
+	if err = hf.checkHost(u.Hostname()); err != nil {
+		return err
+	}
+	if len(wh.Addresses) == 0 && len(wh.TxIds) == 0 && !wh.Blocks {
+		return fmt.Errorf("You need to watch addresses, tx_ids or blocks")
+	}
+	if wh.Confirmations < 0 {
+		return fmt.Errorf("Invalid confirmations: %d", wh.Confirmations)
+	}
+
+	wh.Id = NewId()
+	if wh.Secret == "" {
+		wh.Secret = NewId()
+	}
+	wh.Confirmations = confirmations(wh)
+	wh.Time = time.Now().UTC().Unix()
+
+	return nil
+
+}
+
+// nonPublicNetworks are the loopback, private, link-local, shared, multicast and unspecified ranges
+var nonPublicNetworks = parseNetworks(
+	"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12", "192.168.0.0/16",
+	"224.0.0.0/4", "240.0.0.0/4", "::/128", "::1/128", "fc00::/7", "fe80::/10", "ff00::/8",
+)
+
+// hostFilter rejects the hosts that are not public so webhooks can't reach internal services, the networks in
+// webhook.allowed_networks are allowed anyways
+type hostFilter struct {
+	allowed []*net.IPNet
+}
+
+func newHostFilter() (*hostFilter, error) {
+	hf := new(hostFilter)
+	for _, cidr := range config.GetStringSlice("webhook.allowed_networks") {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid webhook.allowed_networks %s: %v", cidr, err)
+		}
+		hf.allowed = append(hf.allowed, network)
+	}
+	return hf, nil
+}
+
+// checkHost returns an error if the host is an address that's not allowed or a name for the local host
+func (hf *hostFilter) checkHost(host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		return hf.checkIP(ip)
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return hf.checkIP(net.IPv4(127, 0, 0, 1))
+	}
+	return nil
+}
+
+// checkIP returns an error if the address is not public and not allowed
+func (hf *hostFilter) checkIP(ip net.IP) error {
+	for _, network := range hf.allowed {
+		if network.Contains(ip) {
+			return nil
+		}
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return fmt.Errorf("Host %s is not public", ip)
+		}
+	}
+	return nil
+}
+
+// newClient returns a client that only connects to the addresses the filter allows, they are checked after the host is
+// resolved so a name can't point somewhere it shouldn't
+func newClient(timeout time.Duration, hf *hostFilter) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network string, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return fmt.Errorf("Invalid address %s", address)
+			}
+			return hf.checkIP(ip)
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
+
+// parseNetworks parses CIDRs that are known to be valid
+func parseNetworks(cidrs ...string) []*net.IPNet {
+	var networks []*net.IPNet
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// NewId returns a random id
+func NewId() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,259 @@
+package webhook
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	config "github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/mocks"
+)
+
+func newTestDispatcher(bcs blocc.BlockChainStore, whs blocc.WebhookStore, webhooks ...*blocc.Webhook) *Dispatcher {
+	return &Dispatcher{
+		logger:      zap.S().With("package", "webhook"),
+		symbol:      "btc",
+		maxAttempts: 2,
+		backoffMin:  time.Second,
+		backoffMax:  time.Minute,
+
+		client:   &http.Client{Timeout: time.Second},
+		webhooks: webhooks,
+
+		blockChainStore: bcs,
+		webhookStore:    whs,
+	}
+}
+
+func TestMatch(t *testing.T) {
+
+	bcs := new(mocks.BlockChainStore)
+	whs := new(mocks.WebhookStore)
+	d := newTestDispatcher(bcs, whs,
+		&blocc.Webhook{Id: "addresses", Addresses: []string{"mine"}, Confirmations: 1},
+		&blocc.Webhook{Id: "txids", TxIds: []string{"tx2"}, Confirmations: 3},
+		&blocc.Webhook{Id: "blocks", Blocks: true},
+	)
+
+	tx1 := &blocc.Tx{TxId: "tx1", Out: []*blocc.TxOut{{Addresses: []string{"mine"}}}}
+	tx2 := &blocc.Tx{TxId: "tx2", In: []*blocc.TxIn{{Out: &blocc.TxOut{Addresses: []string{"other"}}}}}
+
+	// Mempool transactions
+	wes := d.Match(blocc.NewTxEvent(blocc.EventTxAdded, "btc", tx1))
+	assert.Len(t, wes, 1)
+	assert.Equal(t, "addresses", wes[0].WebhookId)
+	assert.Equal(t, []string{"mine"}, wes[0].Addresses)
+	assert.Len(t, d.Match(blocc.NewTxEvent(blocc.EventTxEvicted, "btc", tx2)), 1)
+	assert.Len(t, d.Match(blocc.NewTxEvent(blocc.EventTxAdded, "btc", &blocc.Tx{TxId: "tx3"})), 0)
+
+	// A block confirms tx1 and gives tx2 3 confirmations
+	bcs.On("GetTxsByBlockId", "btc", "block100", blocc.TxIncludeAllButRaw).Once().Return([]*blocc.Tx{tx1}, nil)
+	bcs.On("FindBlocksByStatusAndHeight", "btc", []string{blocc.StatusValid}, int64(98), int64(98), blocc.BlockIncludeHeader, 0, 1).Once().Return([]*blocc.Block{{BlockId: "block98"}}, nil)
+	bcs.On("GetTxsByBlockId", "btc", "block98", blocc.TxIncludeAllButRaw).Once().Return([]*blocc.Tx{tx2}, nil)
+
+	wes = d.Match(blocc.NewBlockEvent(blocc.EventBlockConnected, "btc", &blocc.BlockHeader{BlockId: "block100", Height: 100}))
+	fired := make(map[string]*blocc.WebhookEvent)
+	for _, we := range wes {
+		fired[we.WebhookId] = we
+	}
+	assert.Len(t, fired, 3)
+	assert.Equal(t, blocc.EventBlockConnected, fired["blocks"].Type)
+	assert.Equal(t, blocc.EventTxConfirmed, fired["addresses"].Type)
+	assert.Equal(t, int64(1), fired["addresses"].Confirmations)
+	assert.Equal(t, "tx2", fired["txids"].Tx.TxId)
+	assert.Equal(t, int64(3), fired["txids"].Confirmations)
+
+	// Only block webhooks fire on disconnect
+	wes = d.Match(blocc.NewBlockEvent(blocc.EventBlockDisconnected, "btc", &blocc.BlockHeader{BlockId: "block100", Height: 100}))
+	assert.Len(t, wes, 1)
+	assert.Equal(t, "blocks", wes[0].WebhookId)
+
+	bcs.AssertExpectations(t)
+	whs.AssertExpectations(t)
+
+}
+
+func TestDeliver(t *testing.T) {
+
+	var status int
+	var body []byte
+	var header http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer ts.Close()
+
+	bcs := new(mocks.BlockChainStore)
+	whs := new(mocks.WebhookStore)
+	d := newTestDispatcher(bcs, whs)
+
+	wh := &blocc.Webhook{Id: "webhook", URL: ts.URL, Secret: "secret"}
+	whs.On("GetWebhook", "btc", "webhook").Return(wh, nil)
+
+	// Delivered
+	status = http.StatusOK
+	whs.On("DeleteWebhookDelivery", "btc", "delivery1").Once().Return(nil)
+	d.Deliver(&blocc.WebhookDelivery{Id: "delivery1", WebhookId: "webhook", Event: &blocc.WebhookEvent{Id: "delivery1", Type: blocc.EventTxAdded}})
+	assert.Equal(t, Sign("secret", body), header.Get(SignatureHeader))
+	assert.Equal(t, blocc.EventTxAdded, header.Get(EventHeader))
+	assert.Equal(t, "delivery1", header.Get(DeliveryHeader))
+
+	// Retried then dead lettered
+	status = http.StatusInternalServerError
+	del := &blocc.WebhookDelivery{Id: "delivery2", WebhookId: "webhook", Event: &blocc.WebhookEvent{Id: "delivery2"}}
+	whs.On("ScheduleWebhookDelivery", "btc", del, mock.AnythingOfType("time.Time")).Once().Return(nil)
+	d.Deliver(del)
+	assert.Equal(t, int64(1), del.Attempts)
+	assert.Equal(t, "Webhook returned status 500", del.LastError)
+	whs.On("DeadLetterWebhookDelivery", "btc", del, 0).Once().Return(nil)
+	d.Deliver(del)
+	assert.Equal(t, int64(2), del.Attempts)
+
+	// The webhook was removed
+	whs.On("GetWebhook", "btc", "removed").Once().Return(nil, blocc.ErrNotFound)
+	whs.On("DeleteWebhookDelivery", "btc", "delivery3").Once().Return(nil)
+	d.Deliver(&blocc.WebhookDelivery{Id: "delivery3", WebhookId: "removed"})
+
+	bcs.AssertExpectations(t)
+	whs.AssertExpectations(t)
+
+}
+
+func TestBackoff(t *testing.T) {
+
+	assert.Equal(t, 10*time.Second, Backoff(1, 10*time.Second, time.Minute))
+	assert.Equal(t, 40*time.Second, Backoff(3, 10*time.Second, time.Minute))
+	assert.Equal(t, time.Minute, Backoff(10, 10*time.Second, time.Minute))
+
+}
+
+func TestPrepare(t *testing.T) {
+
+	wh := &blocc.Webhook{URL: "https://example.com/hook", Addresses: []string{"mine"}}
+	assert.Nil(t, Prepare(wh))
+	assert.Len(t, wh.Id, 32)
+	assert.Len(t, wh.Secret, 32)
+	assert.Equal(t, int64(1), wh.Confirmations)
+
+	assert.NotNil(t, Prepare(&blocc.Webhook{URL: "ftp://example.com", Blocks: true}))
+	assert.NotNil(t, Prepare(&blocc.Webhook{URL: "https://example.com/hook"}))
+
+	// Hosts that are not public
+	for _, u := range []string{
+		"http://localhost:8080/hook",
+		"http://api.localhost/hook",
+		"http://127.0.0.1/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.1.2.3/hook",
+		"http://172.16.0.1/hook",
+		"http://192.168.1.1/hook",
+		"http://0.0.0.0/hook",
+		"http://[::1]/hook",
+		"http://[fd00::1]/hook",
+		"http://[::ffff:127.0.0.1]/hook",
+	} {
+		assert.NotNil(t, Prepare(&blocc.Webhook{URL: u, Blocks: true}), u)
+	}
+
+	// Unless the network is allowed
+	config.Set("webhook.allowed_networks", []string{"10.0.0.0/8"})
+	defer config.Set("webhook.allowed_networks", []string{})
+	assert.Nil(t, Prepare(&blocc.Webhook{URL: "http://10.1.2.3/hook", Blocks: true}))
+	assert.NotNil(t, Prepare(&blocc.Webhook{URL: "http://192.168.1.1/hook", Blocks: true}))
+
+	config.Set("webhook.allowed_networks", []string{"10.0.0.0"})
+	assert.NotNil(t, Prepare(&blocc.Webhook{URL: "https://example.com/hook", Blocks: true}))
+
+}
+
+func TestClientHostFilter(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+
+	// The address is checked once the name is resolved
+	client := newClient(time.Second, &hostFilter{})
+	_, err := client.Get(ts.URL)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "not public")
+	}
+	_, err = client.Get("http://localhost:" + u.Port())
+	assert.NotNil(t, err)
+
+	client = newClient(time.Second, &hostFilter{allowed: parseNetworks("127.0.0.0/8")})
+	resp, err := client.Get(ts.URL)
+	if assert.Nil(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+}
+
+func TestSendDeliveries(t *testing.T) {
+
+	slow := make(chan struct{})
+	delivered := make(chan string, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			<-slow
+		}
+		delivered <- r.Header.Get(DeliveryHeader)
+	}))
+	defer ts.Close()
+
+	bcs := new(mocks.BlockChainStore)
+	whs := new(mocks.WebhookStore)
+	d := newTestDispatcher(bcs, whs)
+	d.workers = 2
+	d.pollInterval = 10 * time.Millisecond
+	d.client.Timeout = 10 * time.Second
+	lease := 2 * d.client.Timeout
+
+	whs.On("GetWebhook", "btc", "slow").Return(&blocc.Webhook{Id: "slow", URL: ts.URL + "/slow"}, nil)
+	whs.On("GetWebhook", "btc", "fast").Return(&blocc.Webhook{Id: "fast", URL: ts.URL + "/fast"}, nil)
+	whs.On("DeleteWebhookDelivery", "btc", mock.AnythingOfType("string")).Return(nil)
+
+	newDelivery := func(id string, webhookId string) []*blocc.WebhookDelivery {
+		return []*blocc.WebhookDelivery{{Id: id, WebhookId: webhookId, Event: &blocc.WebhookEvent{Id: id}}}
+	}
+
+	// While the slow webhook holds a worker the other keeps delivering, one at a time
+	now := mock.AnythingOfType("time.Time")
+	whs.On("TakeWebhookDeliveries", "btc", now, lease, 2).Once().Return(newDelivery("slow1", "slow"), nil)
+	whs.On("TakeWebhookDeliveries", "btc", now, lease, 1).Once().Return(newDelivery("fast1", "fast"), nil)
+	whs.On("TakeWebhookDeliveries", "btc", now, lease, 1).Once().Return(newDelivery("fast2", "fast"), nil)
+	whs.On("TakeWebhookDeliveries", "btc", now, lease, mock.AnythingOfType("int")).Return(nil, nil)
+
+	stop := make(chan struct{})
+	go d.sendDeliveries(stop)
+
+	for _, id := range []string{"fast1", "fast2"} {
+		select {
+		case got := <-delivered:
+			assert.Equal(t, id, got)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s was not delivered", id)
+		}
+	}
+
+	close(slow)
+	select {
+	case got := <-delivered:
+		assert.Equal(t, "slow1", got)
+	case <-time.After(5 * time.Second):
+		t.Fatal("slow1 was not delivered")
+	}
+	close(stop)
+
+}
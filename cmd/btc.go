@@ -7,23 +7,29 @@ import (
 
 	"git.coinninja.net/backend/blocc/blocc"
 	"git.coinninja.net/backend/blocc/blocc/btc"
+	"git.coinninja.net/backend/blocc/blocc/webhook"
 	"git.coinninja.net/backend/blocc/conf"
 	"git.coinninja.net/backend/blocc/server"
-	"git.coinninja.net/backend/blocc/store/esearch"
 	"git.coinninja.net/backend/blocc/store/redis"
 )
 
 func init() {
 	rootCmd.AddCommand(btcCmd)
+	btcCmd.AddCommand(btcImportCmd)
 
 	btcCmd.PersistentFlags().BoolVarP(&btcCmdBlocks, "block", "b", false, "Start the block extractor")
 	btcCmd.PersistentFlags().BoolVarP(&btcCmdTxns, "transaction", "t", false, "Start the txn extractor")
 	btcCmd.PersistentFlags().BoolVarP(&btcCmdHealth, "health", "", false, "Start the health server")
+	btcCmd.PersistentFlags().StringP("symbol", "s", "btc", "The chain to extract (btc, ltc...)")
 
 	// Bind these to environment variables
 	config.BindPFlag("extractor.btc.block", btcCmd.PersistentFlags().Lookup("block"))
 	config.BindPFlag("extractor.btc.transaction", btcCmd.PersistentFlags().Lookup("transaction"))
 	config.BindPFlag("extractor.btc.health", btcCmd.PersistentFlags().Lookup("health"))
+	config.BindPFlag("extractor.btc.symbol", btcCmd.PersistentFlags().Lookup("symbol"))
+
+	btcImportCmd.Flags().StringP("datadir", "d", "", "Bitcoin Core data directory to import blocks from")
+	config.BindPFlag("extractor.btc.import_datadir", btcImportCmd.Flags().Lookup("datadir"))
 }
 
 var (
@@ -32,9 +38,10 @@ var (
 	btcCmdHealth bool
 
 	btcCmd = &cli.Command{
-		Use:   "btc",
-		Short: "BTC Extractor",
-		Long:  `BTC Extractor`,
+		Use:     "extract",
+		Aliases: []string{"btc"},
+		Short:   "Bitcoin Family Extractor",
+		Long:    `Extractor for Bitcoin and the coins derived from it, the chain is selected with --symbol`,
 		Run: func(cmd *cli.Command, args []string) { // Initialize the databse
 
 			var err error
@@ -42,6 +49,8 @@ var (
 			// Setup the BlockStore
 			var blockChainStore blocc.BlockChainStore
 			var txBus blocc.TxBus
+			var eventBus blocc.EventBus
+			var txRelay blocc.TxRelay
 
 			// Everything uses redis
 			r, err := redis.New()
@@ -49,20 +58,34 @@ var (
 				logger.Fatalw("TxPool/TxBus Error", "error", err)
 			}
 
-			// Elastic will implement BlockStore
-			blockChainStore, err = esearch.NewBlockChainStore()
+			// Setup the configured BlockStore
+			blockChainStore, err = newBlockChainStore()
 			if err != nil {
 				logger.Fatalw("BlockStore Error", "error", err)
 			}
 
+			// Redis will implement the EventBus for both extractors
+			eventBus = r.Prefix("mbus")
+
 			// Redis will implement the TxPool/TxBus
 			if btcCmdTxns {
 				// Redis will also implement the message bus
 				txBus = r.Prefix("mbus")
+				// and route sent transactions to this extractor
+				txRelay = r.Prefix("mbus")
+			}
+
+			// Send webhooks from the events of this extractor
+			var webhooks *webhook.Dispatcher
+			if config.GetBool("webhook.enabled") {
+				webhooks, err = webhook.New(config.GetString("extractor.btc.symbol"), blockChainStore, r.Prefix("webhook"))
+				if err != nil {
+					logger.Fatalw("Could not create webhook dispatcher", "error", err)
+				}
 			}
 
 			// Start the extractor
-			_, err = btc.Extract(blockChainStore, txBus)
+			_, err = btc.Extract(blockChainStore, txBus, eventBus, txRelay, webhooks)
 			if err != nil {
 				logger.Fatalw("Could not create Extractor",
 					"error", err,
@@ -93,4 +116,23 @@ var (
 
 		},
 	}
+
+	btcImportCmd = &cli.Command{
+		Use:   "import",
+		Short: "Import blocks from Bitcoin Core block files",
+		Long:  `Import the blk*.dat files of a Bitcoin Core data directory and then follow the block chain from the peers`,
+		Run: func(cmd *cli.Command, args []string) {
+
+			if config.GetString("extractor.btc.import_datadir") == "" {
+				logger.Fatal("--datadir is required")
+			}
+			if btcCmdTxns {
+				logger.Fatal("Blocks can not be imported by the transaction extractor")
+			}
+
+			// Start the block extractor, it imports before following the peers
+			btcCmd.Run(cmd, args)
+
+		},
+	}
 )
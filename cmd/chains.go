@@ -0,0 +1,7 @@
+package cmd
+
+import (
+	// Register the chains derived from Bitcoin, Bitcoin itself is always available
+	_ "git.coinninja.net/backend/blocc/blocc/btc/bch"
+	_ "git.coinninja.net/backend/blocc/blocc/btc/ltc"
+)
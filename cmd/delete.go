@@ -7,7 +7,6 @@ import (
 
 	"git.coinninja.net/backend/blocc/blocc"
 	"git.coinninja.net/backend/blocc/conf"
-	"git.coinninja.net/backend/blocc/store/esearch"
 )
 
 func init() {
@@ -31,7 +30,7 @@ var (
 			var err error
 
 			// Connect to the store
-			bcs, err = esearch.NewBlockChainStore()
+			bcs, err = newBlockChainStore()
 			if err != nil {
 				logger.Fatalw("BlockStore Error", "error", err)
 			}
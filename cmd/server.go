@@ -9,7 +9,6 @@ import (
 	"git.coinninja.net/backend/blocc/blocc/legacyserver"
 	"git.coinninja.net/backend/blocc/conf"
 	"git.coinninja.net/backend/blocc/server"
-	"git.coinninja.net/backend/blocc/store/esearch"
 	"git.coinninja.net/backend/blocc/store/redis"
 )
 
@@ -27,6 +26,7 @@ var (
 			// Setup the BlockStore
 			var blockChainStore blocc.BlockChainStore
 			var txBus blocc.TxBus
+			var eventBus blocc.EventBus
 
 			// Everything uses redis
 			r, err := redis.New()
@@ -34,17 +34,19 @@ var (
 				logger.Fatalw("TxPool/TxBus Error", "error", err)
 			}
 
-			// Elastic will implement BlockStore
-			blockChainStore, err = esearch.NewBlockChainStore()
+			// Setup the configured BlockStore
+			blockChainStore, err = newBlockChainStore()
 			if err != nil {
 				logger.Fatalw("BlockStore Error", "error", err)
 			}
 
 			// Redis will also implement the message bus
 			txBus = r.Prefix("mbus")
+			eventBus = r.Prefix("mbus")
 
 			// Create the blocc GRPC Server
-			bs, err := bloccserver.New(blockChainStore, txBus, r.Prefix("scache"))
+			distCache := r.Prefix("scache")
+			bs, err := bloccserver.New(blockChainStore, txBus, eventBus, r.Prefix("webhook"), r.Prefix("mbus"), distCache)
 			if err != nil {
 				logger.Fatalw("Could not create bloccserver", "error", err)
 			}
@@ -60,7 +62,11 @@ var (
 			blocc.RegisterBloccRPCServer(s.GRPCServer(), bs)
 			s.GwReg(blocc.RegisterBloccRPCHandlerFromEndpoint)
 
-			_, err = legacyserver.New(s.Router(), blockChainStore)
+			// Events over a WebSocket for browsers
+			s.Router().Get("/ws/events", bs.EventsWebSocket())
+			s.Router().Get("/ws/{symbol}/events", bs.EventsWebSocket())
+
+			_, err = legacyserver.New(s.Router(), blockChainStore, distCache)
 			if err != nil {
 				logger.Fatalw("Could not create legacy server", "error", err)
 			}
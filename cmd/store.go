@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"fmt"
+
+	config "github.com/spf13/viper"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store/esearch"
+	"git.coinninja.net/backend/blocc/store/esearch6"
+	"git.coinninja.net/backend/blocc/store/kv"
+	"git.coinninja.net/backend/blocc/store/memory"
+	"git.coinninja.net/backend/blocc/store/postgres"
+)
+
+// newBlockChainStore returns the BlockChainStore selected by store.backend
+func newBlockChainStore() (blocc.BlockChainStore, error) {
+
+	switch backend := config.GetString("store.backend"); backend {
+	case "esearch":
+		return esearch.NewBlockChainStore()
+	case "esearch6":
+		return esearch6.NewBlockChainStore()
+	case "memory":
+		return memory.New()
+	case "kv":
+		return kv.New()
+	case "postgres":
+		return postgres.New()
+	default:
+		return nil, fmt.Errorf("Unknown store backend: %s", backend)
+	}
+
+}
@@ -44,6 +44,21 @@ func init() {
 	config.SetDefault("server.default_symbol", "btc")
 	config.SetDefault("server.default_count", 20)
 	config.SetDefault("server.cache_duration", "7s")
+	config.SetDefault("server.default_gap_limit", 20)
+	config.SetDefault("server.max_gap_limit", 200)
+	config.SetDefault("server.default_descriptor_range", 1000)
+	config.SetDefault("server.max_descriptor_range", 10000)
+	config.SetDefault("server.fee_estimate_history_weight", 0.3)
+	config.SetDefault("server.fee_estimate_min_fee_vsize", 1.0)
+	config.SetDefault("server.mempool_histogram_buckets", []string{}) // Defaults to feeest.DefaultBuckets if not specified
+	config.SetDefault("server.default_projected_blocks", 8)
+	config.SetDefault("server.max_projected_blocks", 50)
+	config.SetDefault("server.max_subscribe_addresses", 10000)
+	config.SetDefault("server.subscribe_addresses_confirmations", 6)
+	config.SetDefault("server.confirmation_stats_blocks", 36)
+	config.SetDefault("server.confirmation_stats_cache_duration", "1m")
+	config.SetDefault("server.send_tx_min_fee_vsize", 1.0)
+	config.SetDefault("server.send_tx_timeout", "10s")
 	// Legacy API Options
 	config.SetDefault("server.legacy.btc_avg_fee_as_min", true)
 	config.SetDefault("server.legacy.btc_min_fee_max", 100)
@@ -51,6 +66,31 @@ func init() {
 	config.SetDefault("server.legacy.btc_max_fee_max", -1)
 	config.SetDefault("server.legacy.btc_use_p10_fee", false)
 	config.SetDefault("server.legacy.btc_fee_testing", false)
+	config.SetDefault("server.legacy.btc_fee_fast_blocks", 1)
+	config.SetDefault("server.legacy.btc_fee_med_blocks", 6)
+	config.SetDefault("server.legacy.btc_fee_slow_blocks", 144)
+
+	// Block Chain Store
+	config.SetDefault("store.backend", "esearch")
+
+	// Embedded KV Store Settings
+	config.SetDefault("kv.path", "blocc.db")
+	config.SetDefault("kv.open_timeout", "10s")
+	config.SetDefault("kv.batch_size", 10000)
+	config.SetDefault("kv.flush_interval", "5s")
+
+	// PostgreSQL Store Settings
+	config.SetDefault("postgres.host", "postgres")
+	config.SetDefault("postgres.port", "5432")
+	config.SetDefault("postgres.user", "blocc")
+	config.SetDefault("postgres.password", "")
+	config.SetDefault("postgres.dbname", "blocc")
+	config.SetDefault("postgres.sslmode", "disable")
+	config.SetDefault("postgres.max_connections", 10)
+	config.SetDefault("postgres.retries", 5)
+	config.SetDefault("postgres.sleep_between_retries", "5s")
+	config.SetDefault("postgres.batch_size", 10000)
+	config.SetDefault("postgres.flush_interval", "5s")
 
 	// Set Defaults - Elasticsearch
 	config.SetDefault("elasticsearch.request_log", false)
@@ -80,6 +120,14 @@ func init() {
 	config.SetDefault("elasticsearch.tx.index_shards", 24)
 	config.SetDefault("elasticsearch.tx.index_replicas", 0)
 	config.SetDefault("elasticsearch.tx.refresh_interval", "15s")
+	config.SetDefault("elasticsearch.output.template_file", "") // Defaults to loading embedded template-output.json if not specified
+	config.SetDefault("elasticsearch.output.index_shards", 24)
+	config.SetDefault("elasticsearch.output.index_replicas", 0)
+	config.SetDefault("elasticsearch.output.refresh_interval", "15s")
+	config.SetDefault("elasticsearch.address_tx.template_file", "") // Defaults to loading embedded template-address_tx.json if not specified
+	config.SetDefault("elasticsearch.address_tx.index_shards", 24)
+	config.SetDefault("elasticsearch.address_tx.index_replicas", 0)
+	config.SetDefault("elasticsearch.address_tx.refresh_interval", "15s")
 
 	config.SetDefault("elasticsearch.fix_aggregation_size", 5000)
 
@@ -90,12 +138,45 @@ func init() {
 	config.SetDefault("redis.password", "")
 	config.SetDefault("redis.index", 0)
 
+	// Webhook Settings
+	config.SetDefault("webhook.enabled", false)
+	config.SetDefault("webhook.workers", 10)
+	config.SetDefault("webhook.timeout", "10s")
+	config.SetDefault("webhook.max_attempts", 10)
+	config.SetDefault("webhook.backoff_min", "10s")
+	config.SetDefault("webhook.backoff_max", "1h")
+	config.SetDefault("webhook.poll_interval", "1s")
+	config.SetDefault("webhook.refresh_interval", "10s")
+	config.SetDefault("webhook.event_queue_size", 10000)
+	config.SetDefault("webhook.dead_letter_max", 100)
+	config.SetDefault("webhook.allowed_networks", []string{}) // Private networks webhooks may be sent to
+
 	// BTC extractor settings
 	config.SetDefault("extractor.btc.host", "bitcoind")
 	config.SetDefault("extractor.btc.port", 8333)
+	config.SetDefault("extractor.btc.symbol", "btc")
 	config.SetDefault("extractor.btc.chain", "mainnet")
 	config.SetDefault("extractor.btc.debug", false)
 
+	config.SetDefault("extractor.btc.source", "p2p")
+	config.SetDefault("extractor.btc.rpc_url", "http://bitcoind:8332")
+	config.SetDefault("extractor.btc.rpc_user", "")
+	config.SetDefault("extractor.btc.rpc_password", "")
+	config.SetDefault("extractor.btc.rpc_timeout", "1m")
+	config.SetDefault("extractor.btc.rpc_poll_interval", "5s")
+	config.SetDefault("extractor.btc.zmq_rawblock", "")
+	config.SetDefault("extractor.btc.zmq_rawtx", "")
+	config.SetDefault("extractor.btc.zmq_sequence", "")
+
+	config.SetDefault("extractor.btc.peers", []string{}) // Defaults to extractor.btc.host:extractor.btc.port if not specified
+	config.SetDefault("extractor.btc.dns_seeds", false)
+	config.SetDefault("extractor.btc.peer_count", 3)
+	config.SetDefault("extractor.btc.peer_connect_timeout", "10s")
+	config.SetDefault("extractor.btc.peer_check_interval", "30s")
+	config.SetDefault("extractor.btc.peer_stall_penalty", "1m")
+	config.SetDefault("extractor.btc.peer_ban_score", 100)
+	config.SetDefault("extractor.btc.peer_ban_duration", "1h")
+
 	config.SetDefault("extractor.btc.block", false)
 	config.SetDefault("extractor.btc.block_concurrent", 30)
 	config.SetDefault("extractor.btc.block_store_raw", true)
@@ -104,6 +185,11 @@ func init() {
 	config.SetDefault("extractor.btc.block_headers_request_count", 2000)
 	config.SetDefault("extractor.btc.block_request_count", 500)
 	config.SetDefault("extractor.btc.block_request_timeout", "180m")
+	config.SetDefault("extractor.btc.block_download_parallel", false)
+	config.SetDefault("extractor.btc.block_download_range", 16)
+	config.SetDefault("extractor.btc.block_download_window", 128)
+	config.SetDefault("extractor.btc.block_download_timeout", "2m")
+	config.SetDefault("extractor.btc.import_datadir", "")
 	config.SetDefault("extractor.btc.block_timeout", "5m")
 	config.SetDefault("extractor.btc.block_validation_interval", "10m")
 	config.SetDefault("extractor.btc.block_validation_height_delta", 100)
@@ -114,8 +200,11 @@ func init() {
 	config.SetDefault("extractor.btc.transaction_resolve_previous", true)
 	config.SetDefault("extractor.btc.transaction_pool_lifetime", "336h") // 14 days
 	config.SetDefault("extractor.btc.transaction_store_raw", true)
+	config.SetDefault("extractor.btc.transaction_track_outputs", true)
+	config.SetDefault("extractor.btc.transaction_track_addresses", true)
 	config.SetDefault("extractor.btc.transaction_mempool_refresh_interval", "1h")
 	config.SetDefault("extractor.btc.transaction_mempool_load_time", "10m")
+	config.SetDefault("extractor.btc.transaction_relay_lifetime", "10m")
 
 	config.SetDefault("extractor.btc.bhcache_lifetime", "0")
 
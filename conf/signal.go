@@ -63,5 +63,16 @@ func (s *stop) Bool() bool {
 
 // This will force a stop
 func (s *stop) Stop() {
-	close(Stop.c)
+	close(s.c)
+}
+
+// Hold holds shutdown until cleanup has run after the stop. The WaitGroup is added to before returning, if it were
+// added to in the goroutine a stop could Wait and exit before cleanup even started.
+func (s *stop) Hold(cleanup func()) {
+	s.Add(1)
+	go func() {
+		<-s.c
+		cleanup()
+		s.Done()
+	}()
 }
@@ -0,0 +1,41 @@
+package conf
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStopHold(t *testing.T) {
+
+	s := &stop{c: make(chan struct{})}
+
+	var cleaned int32
+	s.Hold(func() {
+		time.Sleep(10 * time.Millisecond)
+		atomic.StoreInt32(&cleaned, 1)
+	})
+
+	waited := make(chan struct{})
+	go func() {
+		s.Wait()
+		close(waited)
+	}()
+
+	// Shutdown is held until the stop
+	select {
+	case <-waited:
+		t.Fatal("Wait returned before the stop")
+	case <-time.After(10 * time.Millisecond):
+	}
+	assert.False(t, s.Bool())
+
+	// And then until cleanup is done
+	s.Stop()
+	<-waited
+	assert.True(t, s.Bool())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&cleaned))
+
+}
@@ -0,0 +1,83 @@
+-- Blocks
+CREATE TABLE block (
+    symbol          TEXT    NOT NULL,
+    block_id        TEXT    NOT NULL,
+    height          BIGINT  NOT NULL DEFAULT 0,
+    prev_block_id   TEXT    NOT NULL DEFAULT '',
+    next_block_id   TEXT    NOT NULL DEFAULT '',
+    time            BIGINT  NOT NULL DEFAULT 0,
+    tx_count        BIGINT  NOT NULL DEFAULT 0,
+    size            BIGINT  NOT NULL DEFAULT 0,
+    status          TEXT    NOT NULL DEFAULT '',
+    incomplete      BOOLEAN NOT NULL DEFAULT false,
+    tx_ids          TEXT[],
+    raw             BYTEA,
+    data            JSONB,
+    metric          JSONB,
+    PRIMARY KEY (symbol, block_id)
+);
+CREATE INDEX block_height_idx ON block (symbol, height);
+CREATE INDEX block_prev_block_id_idx ON block (symbol, prev_block_id);
+CREATE INDEX block_status_height_idx ON block (symbol, status, height);
+CREATE INDEX block_time_idx ON block (symbol, time);
+CREATE INDEX block_tx_ids_idx ON block USING GIN (tx_ids);
+
+-- Transactions
+CREATE TABLE tx (
+    symbol          TEXT    NOT NULL,
+    tx_id           TEXT    NOT NULL,
+    block_id        TEXT    NOT NULL DEFAULT '',
+    block_height    BIGINT  NOT NULL DEFAULT 0,
+    block_time      BIGINT  NOT NULL DEFAULT 0,
+    height          BIGINT  NOT NULL DEFAULT 0,
+    time            BIGINT  NOT NULL DEFAULT 0,
+    size            BIGINT  NOT NULL DEFAULT 0,
+    incomplete      BOOLEAN NOT NULL DEFAULT false,
+    raw             BYTEA,
+    data            JSONB,
+    metric          JSONB,
+    PRIMARY KEY (symbol, tx_id)
+);
+CREATE INDEX tx_block_id_height_idx ON tx (symbol, block_id, height);
+CREATE INDEX tx_block_height_idx ON tx (symbol, block_height);
+CREATE INDEX tx_time_idx ON tx (symbol, time);
+CREATE INDEX tx_data_idx ON tx USING GIN (data jsonb_path_ops);
+
+-- Transaction Inputs, the out_ columns are the previous output being spent
+CREATE TABLE tx_in (
+    symbol          TEXT    NOT NULL,
+    tx_id           TEXT    NOT NULL,
+    n               INTEGER NOT NULL,
+    prev_tx_id      TEXT    NOT NULL DEFAULT '',
+    prev_height     BIGINT  NOT NULL DEFAULT 0,
+    raw             BYTEA,
+    data            JSONB,
+    metric          JSONB,
+    has_out         BOOLEAN NOT NULL DEFAULT false,
+    out_type        TEXT    NOT NULL DEFAULT '',
+    out_addresses   TEXT[],
+    out_value       BIGINT  NOT NULL DEFAULT 0,
+    out_raw         BYTEA,
+    out_data        JSONB,
+    out_metric      JSONB,
+    PRIMARY KEY (symbol, tx_id, n),
+    FOREIGN KEY (symbol, tx_id) REFERENCES tx (symbol, tx_id) ON DELETE CASCADE
+);
+CREATE INDEX tx_in_prev_tx_id_idx ON tx_in (symbol, prev_tx_id, prev_height);
+CREATE INDEX tx_in_out_addresses_idx ON tx_in USING GIN (out_addresses);
+
+-- Transaction Outputs
+CREATE TABLE tx_out (
+    symbol          TEXT    NOT NULL,
+    tx_id           TEXT    NOT NULL,
+    n               INTEGER NOT NULL,
+    type            TEXT    NOT NULL DEFAULT '',
+    addresses       TEXT[],
+    value           BIGINT  NOT NULL DEFAULT 0,
+    raw             BYTEA,
+    data            JSONB,
+    metric          JSONB,
+    PRIMARY KEY (symbol, tx_id, n),
+    FOREIGN KEY (symbol, tx_id) REFERENCES tx (symbol, tx_id) ON DELETE CASCADE
+);
+CREATE INDEX tx_out_addresses_idx ON tx_out USING GIN (addresses);
@@ -0,0 +1,25 @@
+-- Outputs, the UTXO set. An output exists once it's created or spent, the spent_ columns are the spending input
+CREATE TABLE output (
+    symbol              TEXT    NOT NULL,
+    tx_id               TEXT    NOT NULL,
+    height              BIGINT  NOT NULL,
+    block_id            TEXT    NOT NULL DEFAULT '',
+    block_height        BIGINT  NOT NULL DEFAULT 0,
+    time                BIGINT  NOT NULL DEFAULT 0,
+    has_out             BOOLEAN NOT NULL DEFAULT false,
+    type                TEXT    NOT NULL DEFAULT '',
+    addresses           TEXT[],
+    value               BIGINT  NOT NULL DEFAULT 0,
+    raw                 BYTEA,
+    data                JSONB,
+    metric              JSONB,
+    spent_tx_id         TEXT    NOT NULL DEFAULT '',
+    spent_height        BIGINT  NOT NULL DEFAULT 0,
+    spent_block_id      TEXT    NOT NULL DEFAULT '',
+    spent_block_height  BIGINT  NOT NULL DEFAULT 0,
+    PRIMARY KEY (symbol, tx_id, height)
+);
+CREATE INDEX output_block_id_idx ON output (symbol, block_id);
+CREATE INDEX output_spent_block_id_idx ON output (symbol, spent_block_id);
+CREATE INDEX output_block_height_idx ON output (symbol, block_height);
+CREATE INDEX output_addresses_idx ON output USING GIN (addresses);
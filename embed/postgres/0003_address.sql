@@ -0,0 +1,33 @@
+-- Address transactions, the effect of a transaction on an address
+CREATE TABLE address_tx (
+    symbol          TEXT    NOT NULL,
+    address         TEXT    NOT NULL,
+    tx_id           TEXT    NOT NULL,
+    block_id        TEXT    NOT NULL DEFAULT '',
+    block_height    BIGINT  NOT NULL DEFAULT 0,
+    time            BIGINT  NOT NULL DEFAULT 0,
+    received        BIGINT  NOT NULL DEFAULT 0,
+    sent            BIGINT  NOT NULL DEFAULT 0,
+    PRIMARY KEY (symbol, address, tx_id)
+);
+CREATE INDEX address_tx_block_id_idx ON address_tx (symbol, block_id);
+CREATE INDEX address_tx_block_height_idx ON address_tx (symbol, block_height);
+
+-- Address summaries maintained from address_tx
+CREATE TABLE address (
+    symbol                  TEXT    NOT NULL,
+    address                 TEXT    NOT NULL,
+    tx_count                BIGINT  NOT NULL DEFAULT 0,
+    received                BIGINT  NOT NULL DEFAULT 0,
+    sent                    BIGINT  NOT NULL DEFAULT 0,
+    balance                 BIGINT  NOT NULL DEFAULT 0,
+    mempool_tx_count        BIGINT  NOT NULL DEFAULT 0,
+    mempool_received        BIGINT  NOT NULL DEFAULT 0,
+    mempool_sent            BIGINT  NOT NULL DEFAULT 0,
+    mempool_balance         BIGINT  NOT NULL DEFAULT 0,
+    first_seen_block_id     TEXT    NOT NULL DEFAULT '',
+    first_seen_block_height BIGINT  NOT NULL DEFAULT 0,
+    last_seen_block_id      TEXT    NOT NULL DEFAULT '',
+    last_seen_block_height  BIGINT  NOT NULL DEFAULT 0,
+    PRIMARY KEY (symbol, address)
+);
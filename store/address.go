@@ -0,0 +1,92 @@
+package store
+
+import (
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// AddressTxs returns the effect of a transaction on each of it's addresses
+func AddressTxs(tx *blocc.Tx) []*blocc.AddressTx {
+	addresses := TxAddresses(tx, blocc.TxFilterAddressInputOutput)
+	atxs := make([]*blocc.AddressTx, 0, len(addresses))
+	for _, address := range addresses {
+		received, sent := TxAddressValues(tx, address)
+		atxs = append(atxs, &blocc.AddressTx{
+			Symbol:      tx.Symbol,
+			Address:     address,
+			TxId:        tx.TxId,
+			BlockId:     tx.BlockId,
+			BlockHeight: tx.BlockHeight,
+			Time:        tx.Time,
+			Received:    received,
+			Sent:        sent,
+		})
+	}
+	return atxs
+}
+
+// UpdateAddress replaces the address transaction old (if not nil) with new (if not nil) in the address summary.
+// It returns true if the first or last seen block was removed and must be found again with SetAddressSeen.
+func UpdateAddress(a *blocc.Address, old *blocc.AddressTx, new *blocc.AddressTx) bool {
+	var reseen bool
+	if old != nil {
+		applyAddressTx(a, old, -1)
+		if !blocc.IsMemPool(old.BlockId) && (old.BlockId == a.FirstSeenBlockId || old.BlockId == a.LastSeenBlockId) {
+			reseen = true
+		}
+	}
+	if new != nil {
+		applyAddressTx(a, new, 1)
+		if !reseen && !blocc.IsMemPool(new.BlockId) {
+			seeAddressTx(a, new)
+		}
+	}
+	return reseen
+}
+
+// SetAddressSeen sets the first and last seen blocks of the address summary from all of it's address transactions
+func SetAddressSeen(a *blocc.Address, atxs []*blocc.AddressTx) {
+	a.FirstSeenBlockId = ""
+	a.FirstSeenBlockHeight = 0
+	a.LastSeenBlockId = ""
+	a.LastSeenBlockHeight = 0
+	for _, atx := range atxs {
+		if !blocc.IsMemPool(atx.BlockId) {
+			seeAddressTx(a, atx)
+		}
+	}
+}
+
+// IsEmptyAddress checks if the address summary has no transactions
+func IsEmptyAddress(a *blocc.Address) bool {
+	return a.TxCount == 0 && a.MempoolTxCount == 0
+}
+
+// applyAddressTx adds (sign 1) or removes (sign -1) the values of an address transaction
+func applyAddressTx(a *blocc.Address, atx *blocc.AddressTx, sign int64) {
+	if blocc.IsMemPool(atx.BlockId) {
+		a.MempoolTxCount += sign
+		a.MempoolReceived += sign * atx.Received
+		a.MempoolSent += sign * atx.Sent
+		a.MempoolBalance = a.MempoolReceived - a.MempoolSent
+	} else {
+		a.TxCount += sign
+		a.Received += sign * atx.Received
+		a.Sent += sign * atx.Sent
+		a.Balance = a.Received - a.Sent
+	}
+}
+
+// seeAddressTx extends the first and last seen blocks to include a confirmed address transaction. Blocks
+// with the same height use the blockId as a tie breaker so the result does not depend on the order.
+func seeAddressTx(a *blocc.Address, atx *blocc.AddressTx) {
+	if a.FirstSeenBlockId == "" || atx.BlockHeight < a.FirstSeenBlockHeight ||
+		(atx.BlockHeight == a.FirstSeenBlockHeight && atx.BlockId < a.FirstSeenBlockId) {
+		a.FirstSeenBlockId = atx.BlockId
+		a.FirstSeenBlockHeight = atx.BlockHeight
+	}
+	if a.LastSeenBlockId == "" || atx.BlockHeight > a.LastSeenBlockHeight ||
+		(atx.BlockHeight == a.LastSeenBlockHeight && atx.BlockId < a.LastSeenBlockId) {
+		a.LastSeenBlockId = atx.BlockId
+		a.LastSeenBlockHeight = atx.BlockHeight
+	}
+}
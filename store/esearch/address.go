@@ -0,0 +1,168 @@
+package esearch
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/olivere/elastic/v7"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// Elasticsearch has no transactions to keep an address summary document consistent with concurrent updates so
+// the address transactions are stored instead and the summary is aggregated from the handful for the address.
+
+// UpsertAddressTxs replaces address transactions
+func (e *esearch) UpsertAddressTxs(symbol string, atxs []*blocc.AddressTx) error {
+
+	for _, atx := range atxs {
+
+		request := elastic.NewBulkIndexRequest().
+			Index(e.indexName(IndexTypeAddressTx, symbol)).
+			Id(addressTxId(atx.Address, atx.TxId)).
+			Doc(atx)
+
+		// Turn it into JSON such that we can modify the address tx
+		request.Source()
+		// Add it to the bulk handler
+		e.bulk.Add(request)
+
+	}
+
+	return nil
+
+}
+
+// UpdateAddressTxBlockIdByBlockId changes the BlockId of address transactions
+func (e *esearch) UpdateAddressTxBlockIdByBlockId(symbol string, blockId string, newBlockId string) error {
+
+	_, err := e.client.UpdateByQuery().
+		Index(e.indexName(IndexTypeAddressTx, symbol)).
+		Query(elastic.NewTermQuery("block_id", blockId)).
+		Script(elastic.NewScript("ctx._source.block_id = params.block_id").Lang("painless").Param("block_id", newBlockId)).
+		ScrollSize(2500).
+		Refresh("true").
+		Do(e.ctx)
+
+	return err
+
+}
+
+// RollbackAddressTxsByBlockId removes the address transactions in a block
+func (e *esearch) RollbackAddressTxsByBlockId(symbol string, blockId string) error {
+
+	_, err := e.client.DeleteByQuery().
+		Index(e.indexName(IndexTypeAddressTx, symbol)).
+		Query(elastic.NewTermQuery("block_id", blockId)).
+		Refresh("true").
+		Do(e.ctx)
+
+	return err
+
+}
+
+// GetAddress returns the summary of an address
+func (e *esearch) GetAddress(symbol string, address string) (*blocc.Address, error) {
+
+	e.throttleSearches <- struct{}{}
+	defer func() {
+		<-e.throttleSearches
+	}()
+
+	mempool := elastic.NewTermsQuery("block_id", mempoolBlockIds()...)
+
+	res, err := e.client.Search().
+		Index(e.indexName(IndexTypeAddressTx, symbol)).
+		Query(elastic.NewTermQuery("address", address)).
+		Aggregation("confirmed", elastic.NewFilterAggregation().Filter(elastic.NewBoolQuery().MustNot(mempool)).
+			SubAggregation("received", elastic.NewSumAggregation().Field("received")).
+			SubAggregation("sent", elastic.NewSumAggregation().Field("sent")).
+			SubAggregation("first", elastic.NewTopHitsAggregation().Size(1).
+				FetchSourceContext(elastic.NewFetchSourceContext(true).Include("block_id", "block_height")).
+				Sort("block_height", true).Sort("block_id", true)).
+			SubAggregation("last", elastic.NewTopHitsAggregation().Size(1).
+				FetchSourceContext(elastic.NewFetchSourceContext(true).Include("block_id", "block_height")).
+				Sort("block_height", false).Sort("block_id", true))).
+		Aggregation("mempool", elastic.NewFilterAggregation().Filter(mempool).
+			SubAggregation("received", elastic.NewSumAggregation().Field("received")).
+			SubAggregation("sent", elastic.NewSumAggregation().Field("sent"))).
+		Size(0).
+		Do(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Hits.TotalHits.Value == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	a := &blocc.Address{
+		Symbol:  symbol,
+		Address: address,
+	}
+
+	confirmed, ok := res.Aggregations.Filter("confirmed")
+	if !ok {
+		return nil, fmt.Errorf("Could not get confirmed aggregation")
+	}
+	a.TxCount = confirmed.DocCount
+	a.Received = aggregationSum(confirmed.Aggregations, "received")
+	a.Sent = aggregationSum(confirmed.Aggregations, "sent")
+	a.Balance = a.Received - a.Sent
+
+	first, err := aggregationAddressTx(confirmed.Aggregations, "first")
+	if err != nil {
+		return nil, err
+	}
+	if first != nil {
+		a.FirstSeenBlockId = first.BlockId
+		a.FirstSeenBlockHeight = first.BlockHeight
+	}
+	last, err := aggregationAddressTx(confirmed.Aggregations, "last")
+	if err != nil {
+		return nil, err
+	}
+	if last != nil {
+		a.LastSeenBlockId = last.BlockId
+		a.LastSeenBlockHeight = last.BlockHeight
+	}
+
+	mempoolAgg, ok := res.Aggregations.Filter("mempool")
+	if !ok {
+		return nil, fmt.Errorf("Could not get mempool aggregation")
+	}
+	a.MempoolTxCount = mempoolAgg.DocCount
+	a.MempoolReceived = aggregationSum(mempoolAgg.Aggregations, "received")
+	a.MempoolSent = aggregationSum(mempoolAgg.Aggregations, "sent")
+	a.MempoolBalance = a.MempoolReceived - a.MempoolSent
+
+	return a, nil
+
+}
+
+// aggregationSum returns the value of a sum aggregation or 0 if missing
+func aggregationSum(aggs elastic.Aggregations, name string) int64 {
+	sum, ok := aggs.Sum(name)
+	if !ok || sum.Value == nil {
+		return 0
+	}
+	return int64(*sum.Value)
+}
+
+// aggregationAddressTx returns the address transaction of a top hits aggregation or nil if there is none
+func aggregationAddressTx(aggs elastic.Aggregations, name string) (*blocc.AddressTx, error) {
+	topHits, ok := aggs.TopHits(name)
+	if !ok || topHits.Hits == nil || len(topHits.Hits.Hits) == 0 {
+		return nil, nil
+	}
+	atx := new(blocc.AddressTx)
+	if err := json.Unmarshal(topHits.Hits.Hits[0].Source, atx); err != nil {
+		return nil, fmt.Errorf("Could not parse AddressTx: %v", err)
+	}
+	return atx, nil
+}
+
+// addressTxId is the document id of an address transaction
+func addressTxId(address string, txId string) string {
+	return address + ":" + txId
+}
@@ -173,7 +173,7 @@ func New() (*esearch, error) {
 	}
 
 	// Setup the templates
-	for _, t := range []string{IndexTypeBlock, IndexTypeTx} {
+	for _, t := range []string{IndexTypeBlock, IndexTypeTx, IndexTypeOutput, IndexTypeAddressTx} {
 		err = e.ApplyIndexTemplate(t)
 		if err != nil {
 			return nil, fmt.Errorf("Could not ApplyIndexTemplate %s: %v", t, err)
@@ -359,3 +359,12 @@ func (e *esearch) indexName(names ...string) string {
 	}
 	return e.index
 }
+
+// mempoolBlockIds returns the blockIds of the mempool for terms queries
+func mempoolBlockIds() []interface{} {
+	ret := make([]interface{}, len(blocc.MemPoolBlockIds))
+	for i, blockId := range blocc.MemPoolBlockIds {
+		ret[i] = blockId
+	}
+	return ret
+}
@@ -0,0 +1,52 @@
+package esearch
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	config "github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store/storetest"
+)
+
+// newTestStore connects to an Elasticsearch 7 cluster at ESEARCH_TEST_HOST using new indexes that are wiped on cleanup
+func newTestStore(t *testing.T) (*esearch, func()) {
+
+	host := os.Getenv("ESEARCH_TEST_HOST")
+	if host == "" {
+		t.Skip("ESEARCH_TEST_HOST not set")
+	}
+
+	config.Set("elasticsearch.host", host)
+	config.Set("elasticsearch.retries", 1)
+	config.Set("elasticsearch.index", fmt.Sprintf("blocctest%d", time.Now().UnixNano()))
+	config.Set("elasticsearch.block.index_shards", 1)
+	config.Set("elasticsearch.block.refresh_interval", "1s")
+	config.Set("elasticsearch.tx.index_shards", 1)
+	config.Set("elasticsearch.tx.refresh_interval", "1s")
+
+	e, err := New()
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	for _, indexType := range []string{IndexTypeBlock, IndexTypeTx, IndexTypeOutput, IndexTypeAddressTx} {
+		if !assert.Nil(t, e.ApplyIndexTemplate(indexType)) {
+			t.FailNow()
+		}
+	}
+
+	return e, func() {
+		assert.Nil(t, e.wipe())
+	}
+
+}
+
+func TestBlockChainStore(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) (blocc.BlockChainStore, func()) {
+		return newTestStore(t)
+	})
+}
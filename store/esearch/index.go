@@ -9,8 +9,10 @@ import (
 
 // Constants used for elastic
 const (
-	IndexTypeBlock = "block"
-	IndexTypeTx    = "tx"
+	IndexTypeBlock     = "block"
+	IndexTypeTx        = "tx"
+	IndexTypeOutput    = "output"
+	IndexTypeAddressTx = "address_tx"
 )
 
 // Init will initializes the elastic index
@@ -36,6 +38,26 @@ func (e *esearch) Init(symbol string) error {
 		return err
 	}
 
+	err = e.EnsureIndex(IndexTypeOutput, symbol)
+	if err != nil {
+		return err
+	}
+
+	err = e.CheckIndex(IndexTypeOutput, symbol)
+	if err != nil {
+		return err
+	}
+
+	err = e.EnsureIndex(IndexTypeAddressTx, symbol)
+	if err != nil {
+		return err
+	}
+
+	err = e.CheckIndex(IndexTypeAddressTx, symbol)
+	if err != nil {
+		return err
+	}
+
 	return nil
 
 }
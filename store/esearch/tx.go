@@ -371,10 +371,7 @@ func (e *esearch) GetMemPoolStats(symbol string) (int64, int64, error) {
 	res, err := e.client.Search().
 		Index(e.indexName(IndexTypeTx, symbol)).
 		Sort("time", false).
-		Query(elastic.NewBoolQuery().Filter(elastic.NewBoolQuery().Should(
-			elastic.NewTermQuery("block_id", blocc.BlockIdMempool),
-			elastic.NewTermQuery("block_id", blocc.BlockIdMempoolUpdate),
-		))).
+		Query(elastic.NewBoolQuery().Filter(elastic.NewTermsQuery("block_id", mempoolBlockIds()...))).
 		Aggregation("size", elastic.NewSumAggregation().Field("size")).
 		TrackTotalHits(true).
 		Size(0).
@@ -462,14 +459,14 @@ func (e *esearch) GetAddressStats(symbol string, address string) (int64, int64,
 
 }
 
-// FlushTransactions will flush inserts and refreshes the indexes
+// FlushTransactions will flush inserts and refreshes the transaction, output and address transaction indexes
 func (e *esearch) FlushTransactions(symbol string) error {
 
 	err := e.bulk.Flush()
 	if err != nil {
 		return err
 	}
-	_, err = e.client.Refresh(e.indexName(IndexTypeTx, symbol)).Do(e.ctx)
+	_, err = e.client.Refresh(e.indexName(IndexTypeTx, symbol), e.indexName(IndexTypeOutput, symbol), e.indexName(IndexTypeAddressTx, symbol)).Do(e.ctx)
 	if err != nil {
 		return err
 	}
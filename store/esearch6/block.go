@@ -115,10 +115,13 @@ func (e *esearch) DeleteBlockByBlockId(symbol string, blockId string) error {
 		Id(blockId).
 		Refresh("true").
 		Do(e.ctx)
+	if elastic.IsNotFound(err) {
+		return blocc.ErrNotFound
+	}
 	return err
 }
 
-// DeleteAboveBlockHeight removes a block by BlockId
+// DeleteAboveBlockHeight removes blocks, transactions, outputs and address transactions above a block height
 func (e *esearch) DeleteAboveBlockHeight(symbol string, above int64) error {
 
 	_, err := e.client.DeleteByQuery().
@@ -141,6 +144,32 @@ func (e *esearch) DeleteAboveBlockHeight(symbol string, above int64) error {
 		return fmt.Errorf("Could not DeleteByQuery tx: %f", err)
 	}
 
+	_, err = e.client.DeleteByQuery().
+		Index(e.indexName(IndexTypeOutput, symbol)).
+		Type(DocType).
+		Query(elastic.NewRangeQuery("block_height").Gt(above)).
+		Refresh("true").
+		Do(e.ctx)
+	if err != nil {
+		return fmt.Errorf("Could not DeleteByQuery output: %v", err)
+	}
+
+	// Outputs spent above the height are unspent again
+	err = e.unspendOutputs(symbol, elastic.NewRangeQuery("spent_block_height").Gt(above))
+	if err != nil {
+		return fmt.Errorf("Could not unspend outputs: %v", err)
+	}
+
+	_, err = e.client.DeleteByQuery().
+		Index(e.indexName(IndexTypeAddressTx, symbol)).
+		Type(DocType).
+		Query(elastic.NewRangeQuery("block_height").Gt(above)).
+		Refresh("true").
+		Do(e.ctx)
+	if err != nil {
+		return fmt.Errorf("Could not DeleteByQuery address tx: %v", err)
+	}
+
 	return nil
 }
 
@@ -16,6 +16,7 @@ import (
 	config "github.com/spf13/viper"
 	"go.uber.org/zap"
 
+	"git.coinninja.net/backend/blocc/blocc"
 	"git.coinninja.net/backend/blocc/conf"
 	"git.coinninja.net/backend/blocc/embed"
 )
@@ -46,6 +47,11 @@ type esearch struct {
 	sync.Mutex
 }
 
+// NewBlockChainStore returns an Elasticsearch 6 BlockChainStore
+func NewBlockChainStore() (blocc.BlockChainStore, error) {
+	return New()
+}
+
 // NewES creates a connection to Elasticsearch to interact with, it can (and should) use a DistCache to overcome the Refresh interval
 func New() (*esearch, error) {
 
@@ -164,7 +170,7 @@ func New() (*esearch, error) {
 	}
 
 	// Setup the templates
-	for _, t := range []string{IndexTypeBlock, IndexTypeTx} {
+	for _, t := range []string{IndexTypeBlock, IndexTypeTx, IndexTypeOutput, IndexTypeAddressTx} {
 		err = e.ApplyIndexTemplate(t)
 		if err != nil {
 			return nil, fmt.Errorf("Could not ApplyIndexTemplate %s: %v", t, err)
@@ -350,3 +356,12 @@ func (e *esearch) indexName(names ...string) string {
 	}
 	return e.index
 }
+
+// mempoolBlockIds returns the blockIds of the mempool for terms queries
+func mempoolBlockIds() []interface{} {
+	ret := make([]interface{}, len(blocc.MemPoolBlockIds))
+	for i, blockId := range blocc.MemPoolBlockIds {
+		ret[i] = blockId
+	}
+	return ret
+}
@@ -0,0 +1,243 @@
+package esearch6
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/olivere/elastic"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// This merges params.output into the existing document the same as store.MergeOutput
+const outputMergeScript = `
+	def o = params.output;
+	if (o.symbol != null) {
+		ctx._source.symbol = o.symbol;
+	}
+	ctx._source.tx_id = o.tx_id;
+	ctx._source.height = o.height;
+	if (o.block_id != null && o.block_id != '') {
+		ctx._source.block_id = o.block_id;
+		ctx._source.block_height = o.block_height;
+		ctx._source.time = o.time;
+		if (o.out != null) {
+			ctx._source.out = o.out;
+		}
+	}
+	if (o.spent_tx_id != null && o.spent_tx_id != '' && (ctx._source.spent_tx_id == null || ctx._source.spent_tx_id == '' ||
+		params.mempool.contains(ctx._source.spent_block_id) || !params.mempool.contains(o.spent_block_id))) {
+		ctx._source.spent_tx_id = o.spent_tx_id;
+		ctx._source.spent_height = o.spent_height;
+		ctx._source.spent_block_id = o.spent_block_id;
+		ctx._source.spent_block_height = o.spent_block_height;
+	}
+`
+
+// UpsertOutputs merges outputs with the existing outputs
+func (e *esearch) UpsertOutputs(symbol string, outputs []*blocc.Output) error {
+
+	for _, o := range outputs {
+
+		params, err := outputParams(o)
+		if err != nil {
+			return err
+		}
+
+		request := elastic.NewBulkUpdateRequest().
+			Index(e.indexName(IndexTypeOutput, symbol)).
+			Type(DocType).
+			Id(outPointId(o.TxId, o.Height)).
+			Script(elastic.NewScript(outputMergeScript).Lang("painless").Params(params)).
+			ScriptedUpsert(true).
+			Upsert(map[string]interface{}{}).
+			RetryOnConflict(5)
+
+		// Turn it into JSON such that we can modify the output
+		request.Source()
+		// Add it to the bulk handler
+		e.bulk.Add(request)
+
+	}
+
+	return nil
+
+}
+
+// UpdateOutputBlockIdByBlockId changes the BlockId and SpentBlockId of outputs
+func (e *esearch) UpdateOutputBlockIdByBlockId(symbol string, blockId string, newBlockId string) error {
+
+	_, err := e.client.UpdateByQuery().
+		Index(e.indexName(IndexTypeOutput, symbol)).
+		Type(DocType).
+		Query(elastic.NewBoolQuery().Should(
+			elastic.NewTermQuery("block_id", blockId),
+			elastic.NewTermQuery("spent_block_id", blockId),
+		)).
+		Script(elastic.NewScript(`
+			if (ctx._source.block_id == params.block_id) {
+				ctx._source.block_id = params.new_block_id;
+			}
+			if (ctx._source.spent_tx_id != null && ctx._source.spent_block_id == params.block_id) {
+				ctx._source.spent_block_id = params.new_block_id;
+			}
+		`).Lang("painless").Param("block_id", blockId).Param("new_block_id", newBlockId)).
+		ScrollSize(2500).
+		Refresh("true").
+		Do(e.ctx)
+
+	return err
+
+}
+
+// RollbackOutputsByBlockId removes outputs created in a block and unspends the outputs spent in it
+func (e *esearch) RollbackOutputsByBlockId(symbol string, blockId string) error {
+
+	_, err := e.client.DeleteByQuery().
+		Index(e.indexName(IndexTypeOutput, symbol)).
+		Type(DocType).
+		Query(elastic.NewTermQuery("block_id", blockId)).
+		Refresh("true").
+		Do(e.ctx)
+	if err != nil {
+		return fmt.Errorf("Could not DeleteByQuery output: %v", err)
+	}
+
+	return e.unspendOutputs(symbol, elastic.NewTermQuery("spent_block_id", blockId))
+
+}
+
+// GetOutputByOutPoint returns an output by txId and height
+func (e *esearch) GetOutputByOutPoint(symbol string, txId string, height int64) (*blocc.Output, error) {
+
+	e.throttleSearches <- struct{}{}
+	defer func() {
+		<-e.throttleSearches
+	}()
+
+	res, err := e.client.Get().
+		Index(e.indexName(IndexTypeOutput, symbol)).
+		Type(DocType).
+		Id(outPointId(txId, height)).
+		Do(e.ctx)
+
+	if elastic.IsNotFound(err) {
+		return nil, blocc.ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("Could not get output: %v", err)
+	}
+
+	o := new(blocc.Output)
+	if err = json.Unmarshal(*res.Source, o); err != nil {
+		return nil, fmt.Errorf("Could not parse Output: %v", err)
+	}
+
+	return o, nil
+
+}
+
+// FindUnspentOutputsByAddresses returns the unspent outputs for addresses
+func (e *esearch) FindUnspentOutputsByAddresses(symbol string, addresses []string, offset int, count int) ([]*blocc.Output, error) {
+
+	e.throttleSearches <- struct{}{}
+	defer func() {
+		<-e.throttleSearches
+	}()
+
+	// Convert it to an interface
+	addressesInterface := make([]interface{}, len(addresses), len(addresses))
+	for i, address := range addresses {
+		addressesInterface[i] = address
+	}
+
+	query := elastic.NewBoolQuery().
+		Filter(elastic.NewTermsQuery("out.address", addressesInterface...)).
+		Filter(elastic.NewExistsQuery("block_id")).
+		// Outputs spent in the mempool are still unspent
+		MustNot(elastic.NewBoolQuery().
+			Filter(elastic.NewExistsQuery("spent_tx_id")).
+			MustNot(elastic.NewTermsQuery("spent_block_id", mempoolBlockIds()...)))
+
+	// Max results
+	if count == store.CountMax {
+		count = e.countMax
+	}
+
+	res, err := e.client.Search().
+		Index(e.indexName(IndexTypeOutput, symbol)).
+		Type(DocType).
+		Sort("time", false).
+		Sort("tx_id", true).
+		Sort("height", true).
+		Query(query).
+		From(offset).Size(count).
+		Do(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Hits.TotalHits == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	ret := make([]*blocc.Output, len(res.Hits.Hits), len(res.Hits.Hits))
+
+	for i, hit := range res.Hits.Hits {
+		o := new(blocc.Output)
+		err := json.Unmarshal(*hit.Source, o)
+		if err != nil {
+			return nil, fmt.Errorf("Could not parse Output: %s", err)
+		}
+		ret[i] = o
+	}
+
+	return ret, nil
+
+}
+
+// unspendOutputs removes the spending fields from outputs matching the query
+func (e *esearch) unspendOutputs(symbol string, query elastic.Query) error {
+
+	_, err := e.client.UpdateByQuery().
+		Index(e.indexName(IndexTypeOutput, symbol)).
+		Type(DocType).
+		Query(query).
+		Script(elastic.NewScript(`
+			ctx._source.remove('spent_tx_id');
+			ctx._source.remove('spent_height');
+			ctx._source.remove('spent_block_id');
+			ctx._source.remove('spent_block_height');
+		`).Lang("painless")).
+		ScrollSize(2500).
+		Refresh("true").
+		Do(e.ctx)
+
+	return err
+
+}
+
+// outputParams returns the script parameters for outputMergeScript
+func outputParams(o *blocc.Output) (map[string]interface{}, error) {
+
+	b, err := json.Marshal(o)
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode Output: %v", err)
+	}
+	var output map[string]interface{}
+	if err = json.Unmarshal(b, &output); err != nil {
+		return nil, fmt.Errorf("Could not encode Output: %v", err)
+	}
+
+	return map[string]interface{}{
+		"output":  output,
+		"mempool": blocc.MemPoolBlockIds,
+	}, nil
+
+}
+
+// outPointId is the document id of an output
+func outPointId(txId string, height int64) string {
+	return txId + ":" + strconv.FormatInt(height, 10)
+}
@@ -0,0 +1,196 @@
+package store
+
+import (
+	"time"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// TxAddresses returns the unique addresses in a transaction inputs and/or outputs
+func TxAddresses(tx *blocc.Tx, filter blocc.TxFilterAddress) []string {
+	seen := make(map[string]struct{})
+	addresses := make([]string, 0)
+	add := func(addrs []string) {
+		for _, address := range addrs {
+			if _, ok := seen[address]; !ok {
+				seen[address] = struct{}{}
+				addresses = append(addresses, address)
+			}
+		}
+	}
+	if filter&blocc.TxFilterAddressInput != 0 {
+		for _, in := range tx.In {
+			if in != nil && in.Out != nil {
+				add(in.Out.Addresses)
+			}
+		}
+	}
+	if filter&blocc.TxFilterAddressOutput != 0 {
+		for _, out := range tx.Out {
+			if out != nil {
+				add(out.Addresses)
+			}
+		}
+	}
+	return addresses
+}
+
+// TxHasAddress checks if any of the addresses appear in the transaction inputs and/or outputs
+func TxHasAddress(tx *blocc.Tx, addresses []string, filter blocc.TxFilterAddress) bool {
+	for _, txAddress := range TxAddresses(tx, filter) {
+		for _, address := range addresses {
+			if txAddress == address {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// InHeightRange checks a height between start and end height inclusive where HeightUnknown is open ended
+func InHeightRange(height int64, startHeight int64, endHeight int64) bool {
+	if startHeight != blocc.HeightUnknown && height < startHeight {
+		return false
+	}
+	if endHeight != blocc.HeightUnknown && height > endHeight {
+		return false
+	}
+	return true
+}
+
+// InTimeRange checks a unix timestamp against optional start and end times inclusive
+func InTimeRange(t int64, start *time.Time, end *time.Time) bool {
+	if start != nil && t < start.Unix() {
+		return false
+	}
+	if end != nil && t > end.Unix() {
+		return false
+	}
+	return true
+}
+
+// InStatuses checks the status is in the list, an empty list matches everything
+func InStatuses(status string, statuses []string) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchDataFields checks that all fields have the given value
+func MatchDataFields(data map[string]string, fields map[string]string) bool {
+	for k, v := range fields {
+		if value, ok := data[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Paginate returns the start and end index of results for offset and count
+func Paginate(total int, offset int, count int) (int, int) {
+	if count == CountMax || count < 0 {
+		count = total
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > total {
+		offset = total
+	}
+	end := offset + count
+	if end > total {
+		end = total
+	}
+	return offset, end
+}
+
+// IncludeBlock removes the fields from a block that are not included
+func IncludeBlock(b *blocc.Block, include blocc.BlockInclude) *blocc.Block {
+	if include == blocc.BlockIncludeAll {
+		return b
+	}
+	if include&blocc.BlockIncludeData == 0 {
+		b.Data = nil
+	}
+	if include&blocc.BlockIncludeRaw == 0 {
+		b.Raw = nil
+	}
+	if include&blocc.BlockIncludeTxIds == 0 {
+		b.TxIds = nil
+	}
+	return b
+}
+
+// IncludeTx removes the fields from a transaction that are not included
+func IncludeTx(tx *blocc.Tx, include blocc.TxInclude) *blocc.Tx {
+	if include == blocc.TxIncludeAll {
+		return tx
+	}
+	if include&blocc.TxIncludeData == 0 {
+		tx.Data = nil
+	}
+	if include&blocc.TxIncludeRaw == 0 {
+		tx.Raw = nil
+	}
+	if include&blocc.TxIncludeIn == 0 {
+		tx.In = nil
+	}
+	if include&blocc.TxIncludeOut == 0 {
+		tx.Out = nil
+	}
+	return tx
+}
+
+// TxAddressValues returns the value sent to (outputs) and spent from (inputs) an address in a transaction
+func TxAddressValues(tx *blocc.Tx, address string) (int64, int64) {
+	var outputValue, inputValue int64
+	for _, in := range tx.In {
+		if in == nil || in.Out == nil {
+			continue
+		}
+		for _, addr := range in.Out.Addresses {
+			if addr == address {
+				inputValue += in.Out.Value
+				break
+			}
+		}
+	}
+	for _, out := range tx.Out {
+		if out == nil {
+			continue
+		}
+		for _, addr := range out.Addresses {
+			if addr == address {
+				outputValue += out.Value
+				break
+			}
+		}
+	}
+	return outputValue, inputValue
+}
+
+// IsUnspentOutput checks that the output has been created and is not spent in a block
+func IsUnspentOutput(o *blocc.Output) bool {
+	return o.BlockId != "" && o.Out != nil && (o.SpentTxId == "" || blocc.IsMemPool(o.SpentBlockId))
+}
+
+// OutputHasAddress checks if any of the addresses are on the output
+func OutputHasAddress(o *blocc.Output, addresses []string) bool {
+	if o.Out == nil {
+		return false
+	}
+	for _, outAddress := range o.Out.Addresses {
+		for _, address := range addresses {
+			if outAddress == address {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+package kv
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// UpsertAddressTxs replaces address transactions and updates the address summaries
+func (k *kv) UpsertAddressTxs(symbol string, atxs []*blocc.AddressTx) error {
+
+	// Copy the address transactions so the caller can modify them
+	copies := make([]*blocc.AddressTx, len(atxs))
+	for x, atx := range atxs {
+		copies[x] = proto.Clone(atx).(*blocc.AddressTx)
+	}
+
+	return k.queue(symbol, func(bk buckets) error {
+		for _, atx := range copies {
+			old, err := getAddressTx(bk, addressTxKey(atx.Address, atx.TxId))
+			if err != nil {
+				return err
+			}
+			if err = updateAddressTx(bk, old, atx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+}
+
+// UpdateAddressTxBlockIdByBlockId changes the BlockId of address transactions
+func (k *kv) UpdateAddressTxBlockIdByBlockId(symbol string, blockId string, newBlockId string) error {
+
+	return k.write(symbol, func(bk buckets) error {
+		atxs, err := getAddressTxsByBlockId(bk, blockId)
+		if err != nil {
+			return err
+		}
+		for _, atx := range atxs {
+			updated := proto.Clone(atx).(*blocc.AddressTx)
+			updated.BlockId = newBlockId
+			if err = updateAddressTx(bk, atx, updated); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+}
+
+// RollbackAddressTxsByBlockId removes the address transactions in a block from the address summaries
+func (k *kv) RollbackAddressTxsByBlockId(symbol string, blockId string) error {
+
+	return k.write(symbol, func(bk buckets) error {
+		atxs, err := getAddressTxsByBlockId(bk, blockId)
+		if err != nil {
+			return err
+		}
+		for _, atx := range atxs {
+			if err = updateAddressTx(bk, atx, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+}
+
+// GetAddress returns the summary of an address
+func (k *kv) GetAddress(symbol string, address string) (*blocc.Address, error) {
+
+	var a *blocc.Address
+	_, err := k.read(symbol, func(bk buckets) error {
+		var err error
+		a, err = getAddress(bk, address)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if a == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	return a, nil
+
+}
+
+// addressTxKey is the key of an address transaction
+func addressTxKey(address string, txId string) []byte {
+	return indexKey([]byte(address), []byte(txId))
+}
+
+// getAddress returns an address summary or nil if it does not exist
+func getAddress(bk buckets, address string) (*blocc.Address, error) {
+	v := bk.get(bucketAddress).Get([]byte(address))
+	if v == nil {
+		return nil, nil
+	}
+	a := new(blocc.Address)
+	if err := a.Unmarshal(v); err != nil {
+		return nil, fmt.Errorf("Could not parse Address %s: %v", address, err)
+	}
+	return a, nil
+}
+
+// getAddressTx returns an address transaction or nil if it does not exist
+func getAddressTx(bk buckets, key []byte) (*blocc.AddressTx, error) {
+	v := bk.get(bucketAddressTx).Get(key)
+	if v == nil {
+		return nil, nil
+	}
+	atx := new(blocc.AddressTx)
+	if err := atx.Unmarshal(v); err != nil {
+		return nil, fmt.Errorf("Could not parse AddressTx %q: %v", key, err)
+	}
+	return atx, nil
+}
+
+// getAddressTxsByBlockId returns the address transactions in a block
+func getAddressTxsByBlockId(bk buckets, blockId string) ([]*blocc.AddressTx, error) {
+	prefix := prefixKey([]byte(blockId))
+	atxs := make([]*blocc.AddressTx, 0)
+	err := scanPrefix(bk.get(bucketAddressTxBlock), prefix, func(key []byte, _ string) error {
+		// The rest of the key is the address transaction key
+		atx, err := getAddressTx(bk, key[len(prefix):])
+		if err != nil || atx == nil {
+			return err
+		}
+		atxs = append(atxs, atx)
+		return nil
+	})
+	return atxs, err
+}
+
+// updateAddressTx replaces the address transaction old with new where either may be nil and updates the summary
+func updateAddressTx(bk buckets, old *blocc.AddressTx, new *blocc.AddressTx) error {
+
+	address := old.GetAddress()
+	if new != nil {
+		address = new.Address
+	}
+
+	a, err := getAddress(bk, address)
+	if err != nil {
+		return err
+	}
+	if a == nil {
+		a = &blocc.Address{Symbol: new.GetSymbol(), Address: address}
+	}
+	reseen := store.UpdateAddress(a, old, new)
+
+	if old != nil {
+		key := addressTxKey(old.Address, old.TxId)
+		if err = bk.get(bucketAddressTxBlock).Delete(indexKey([]byte(old.BlockId), key)); err != nil {
+			return err
+		}
+		if err = bk.get(bucketAddressTx).Delete(key); err != nil {
+			return err
+		}
+	}
+	if new != nil {
+		key := addressTxKey(new.Address, new.TxId)
+		v, err := new.Marshal()
+		if err != nil {
+			return fmt.Errorf("Could not encode AddressTx %q: %v", key, err)
+		}
+		if err = bk.get(bucketAddressTx).Put(key, v); err != nil {
+			return err
+		}
+		if err = bk.get(bucketAddressTxBlock).Put(indexKey([]byte(new.BlockId), key), []byte{}); err != nil {
+			return err
+		}
+	}
+
+	if reseen {
+		atxs := make([]*blocc.AddressTx, 0)
+		err = scanPrefix(bk.get(bucketAddressTx), prefixKey([]byte(address)), func(key []byte, _ string) error {
+			atx, err := getAddressTx(bk, key)
+			if err != nil || atx == nil {
+				return err
+			}
+			atxs = append(atxs, atx)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		store.SetAddressSeen(a, atxs)
+	}
+
+	if store.IsEmptyAddress(a) {
+		return bk.get(bucketAddress).Delete([]byte(address))
+	}
+
+	v, err := a.Marshal()
+	if err != nil {
+		return fmt.Errorf("Could not encode Address %s: %v", address, err)
+	}
+	return bk.get(bucketAddress).Put([]byte(address), v)
+
+}
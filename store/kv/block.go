@@ -0,0 +1,622 @@
+package kv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// InsertBlock replaces a block
+func (k *kv) InsertBlock(symbol string, b *blocc.Block) error {
+
+	// Copy the block so the caller can modify it
+	b = proto.Clone(b).(*blocc.Block)
+
+	return k.queue(symbol, func(bk buckets) error {
+		return putBlock(bk, b)
+	})
+
+}
+
+// UpdateBlock updates status and data based on blockId, the block will be created if it does not exist
+func (k *kv) UpdateBlock(symbol string, blockId string, status string, nextBlockId string, data map[string]string, metric map[string]float64) error {
+
+	return k.queue(symbol, func(bk buckets) error {
+		b, err := getBlock(bk, blockId)
+		if err != nil {
+			return err
+		} else if b == nil {
+			b = &blocc.Block{BlockId: blockId}
+		}
+		store.UpdateBlockFields(b, status, nextBlockId, data, metric)
+		return putBlock(bk, b)
+	})
+
+}
+
+// UpdateBlockStatusByStatusesAndHeight updates status between heights
+func (k *kv) UpdateBlockStatusByStatusesAndHeight(symbol string, statuses []string, startHeight int64, endHeight int64, status string) error {
+
+	return k.write(symbol, func(bk buckets) error {
+
+		blockIds := blockIdsByStatusesAndHeight(bk, statuses, startHeight, endHeight)
+		for _, blockId := range blockIds {
+			b, err := getBlock(bk, blockId)
+			if err != nil {
+				return err
+			}
+			// If it's already the same status, don't bother
+			if b == nil || b.Status == status {
+				continue
+			}
+			b.Status = status
+			if err := putBlock(bk, b); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	})
+
+}
+
+// DeleteBlockByBlockId removes a block by BlockId
+func (k *kv) DeleteBlockByBlockId(symbol string, blockId string) error {
+
+	var found bool
+	err := k.write(symbol, func(bk buckets) error {
+		b, err := getBlock(bk, blockId)
+		if err != nil || b == nil {
+			return err
+		}
+		found = true
+		return deleteBlock(bk, b)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return blocc.ErrNotFound
+	}
+
+	return nil
+}
+
+// DeleteAboveBlockHeight removes blocks, transactions, outputs and address transactions above a block height
+func (k *kv) DeleteAboveBlockHeight(symbol string, above int64) error {
+
+	return k.write(symbol, func(bk buckets) error {
+
+		// Blocks by the height index
+		blockIds := make([]string, 0)
+		c := bk.get(bucketBlockHeight).Cursor()
+		for key, _ := c.Seek(intKey(above + 1)); key != nil; key, _ = c.Next() {
+			blockIds = append(blockIds, keyId(key))
+		}
+		for _, blockId := range blockIds {
+			b, err := getBlock(bk, blockId)
+			if err != nil {
+				return fmt.Errorf("Could not delete blocks: %v", err)
+			}
+			if b == nil {
+				continue
+			}
+			if err := deleteBlock(bk, b); err != nil {
+				return fmt.Errorf("Could not delete blocks: %v", err)
+			}
+		}
+
+		// There is no index on transaction block height
+		txs := make([]*blocc.Tx, 0)
+		err := bk.get(bucketTx).ForEach(func(_ []byte, v []byte) error {
+			tx := new(blocc.Tx)
+			if err := tx.Unmarshal(v); err != nil {
+				return err
+			}
+			if tx.BlockHeight > above {
+				txs = append(txs, tx)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Could not delete tx: %v", err)
+		}
+		for _, tx := range txs {
+			if err := deleteTx(bk, tx); err != nil {
+				return fmt.Errorf("Could not delete tx: %v", err)
+			}
+		}
+
+		// Nor on output block height
+		outputs := make([]*blocc.Output, 0)
+		err = bk.get(bucketOutput).ForEach(func(_ []byte, v []byte) error {
+			o := new(blocc.Output)
+			if err := o.Unmarshal(v); err != nil {
+				return err
+			}
+			if o.BlockHeight > above || o.SpentBlockHeight > above {
+				outputs = append(outputs, o)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Could not delete outputs: %v", err)
+		}
+		for _, o := range outputs {
+			if o.BlockHeight > above {
+				err = deleteOutput(bk, o)
+			} else {
+				store.UnspendOutput(o)
+				err = putOutput(bk, o)
+			}
+			if err != nil {
+				return fmt.Errorf("Could not delete outputs: %v", err)
+			}
+		}
+
+		// And address transactions
+		atxs := make([]*blocc.AddressTx, 0)
+		err = bk.get(bucketAddressTx).ForEach(func(_ []byte, v []byte) error {
+			atx := new(blocc.AddressTx)
+			if err := atx.Unmarshal(v); err != nil {
+				return err
+			}
+			if atx.BlockHeight > above {
+				atxs = append(atxs, atx)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Could not delete address txs: %v", err)
+		}
+		for _, atx := range atxs {
+			if err = updateAddressTx(bk, atx, nil); err != nil {
+				return fmt.Errorf("Could not delete address txs: %v", err)
+			}
+		}
+
+		return nil
+
+	})
+
+}
+
+// FlushBlocks writes all pending changes
+func (k *kv) FlushBlocks(symbol string) error {
+
+	if err := k.flush(); err != nil {
+		return err
+	}
+
+	// Return the background flush error (if there was one)
+	return k.flushError()
+
+}
+
+// GetBlockHeaderTopByStatuses will return the top block header as it stands
+func (k *kv) GetBlockHeaderTopByStatuses(symbol string, statuses []string) (*blocc.BlockHeader, error) {
+
+	var top *blocc.Block
+	var total int64
+
+	_, err := k.read(symbol, func(bk buckets) error {
+
+		var topKey []byte
+
+		if len(statuses) == 0 {
+			// Count every status
+			err := bk.get(bucketBlockCount).ForEach(func(_ []byte, v []byte) error {
+				total += int64(binary.BigEndian.Uint64(v))
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			topKey, _ = bk.get(bucketBlockHeight).Cursor().Last()
+		} else {
+			var topHeight int64
+			for _, status := range uniqueStrings(statuses) {
+				total += blockCount(bk, status)
+				// The last key for the status has the highest height
+				key := lastPrefixKey(bk.get(bucketBlockStatus), prefixKey([]byte(status)))
+				if key == nil {
+					continue
+				}
+				height := keyInt(key[len(status)+1 : len(status)+9])
+				if topKey == nil || height > topHeight || (height == topHeight && keyId(key) > keyId(topKey)) {
+					topKey = key
+					topHeight = height
+				}
+			}
+		}
+
+		if topKey == nil {
+			return nil
+		}
+
+		var err error
+		top, err = getBlock(bk, keyId(topKey))
+		return err
+
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if top == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	bh := &blocc.BlockHeader{
+		BlockId:     top.BlockId,
+		Height:      top.Height,
+		PrevBlockId: top.PrevBlockId,
+		Time:        top.Time,
+	}
+
+	// Return the height but also an error indicating the height and the number of blocks do not match up (ie missing data)
+	if bh.Height > total+1 {
+		return bh, fmt.Errorf("Validation Error: Missing Blocks Detected height:%d blocks:%d", bh.Height, total)
+	} else if bh.Height+1 < total {
+		return bh, fmt.Errorf("Validation Error: Missing Blocks Detected height:%d blocks:%d", bh.Height, total)
+	}
+
+	return bh, nil
+}
+
+// GetBlockByBlockId gets a block by blockId
+func (k *kv) GetBlockByBlockId(symbol string, blockId string, include blocc.BlockInclude) (*blocc.Block, error) {
+
+	var b *blocc.Block
+	_, err := k.read(symbol, func(bk buckets) error {
+		var err error
+		b, err = getBlock(bk, blockId)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Could not get block: %v", err)
+	}
+
+	if b == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	return store.IncludeBlock(b, include), nil
+
+}
+
+// GetBlockTopByStatuses gets the top block
+func (k *kv) GetBlockTopByStatuses(symbol string, statuses []string, include blocc.BlockInclude) (*blocc.Block, error) {
+
+	// Determine the tip
+	bh, err := k.GetBlockHeaderTopByStatuses(symbol, statuses)
+	// If it's not a validation error, there's still data
+	if err != nil && !blocc.IsValidationError(err) {
+		return nil, err
+	}
+
+	blk, newErr := k.GetBlockByBlockId(symbol, bh.BlockId, include)
+	if newErr != nil {
+		return nil, newErr
+	}
+
+	// Return the block and original error if any
+	return blk, err
+
+}
+
+// FindBlocksByHeight fetches blocks by height
+func (k *kv) FindBlocksByHeight(symbol string, height int64, include blocc.BlockInclude) ([]*blocc.Block, error) {
+	return k.findBlocksByIndex(symbol, bucketBlockHeight, prefixKey(intKey(height)), include)
+}
+
+// FindBlocksByPrevBlockId returns blocks by previous blockId
+func (k *kv) FindBlocksByPrevBlockId(symbol string, prevBlockId string, include blocc.BlockInclude) ([]*blocc.Block, error) {
+	return k.findBlocksByIndex(symbol, bucketBlockPrev, prefixKey([]byte(prevBlockId)), include)
+}
+
+// FindBlocksByTxId returns blocks with TxId
+func (k *kv) FindBlocksByTxId(symbol string, txId string, include blocc.BlockInclude) ([]*blocc.Block, error) {
+	return k.findBlocksByIndex(symbol, bucketBlockTx, prefixKey([]byte(txId)), include)
+}
+
+// FindBlocksByBlockIdsAndTime returns blocks optionally by blockId, time and pagination by descending time
+func (k *kv) FindBlocksByBlockIdsAndTime(symbol string, blockIds []string, start *time.Time, end *time.Time, include blocc.BlockInclude, offset int, count int) ([]*blocc.Block, error) {
+
+	var ret []*blocc.Block
+	var matched int
+
+	_, err := k.read(symbol, func(bk buckets) error {
+
+		// Specific blocks
+		if len(blockIds) > 0 {
+			blks := make([]*blocc.Block, 0)
+			for _, blockId := range uniqueStrings(blockIds) {
+				b, err := getBlock(bk, blockId)
+				if err != nil {
+					return err
+				}
+				if b != nil && store.InTimeRange(b.Time, start, end) {
+					blks = append(blks, b)
+				}
+			}
+			matched = len(blks)
+			store.SortBlocksByTimeDesc(blks)
+			from, to := store.Paginate(len(blks), offset, count)
+			ret = blks[from:to]
+			return nil
+		}
+
+		// Walk the time index
+		p := newPager(offset, count)
+		ret = make([]*blocc.Block, 0)
+		err := scanTimeDesc(bk.get(bucketBlockTime), start, end, func(blockId string) (bool, error) {
+			if p.add() {
+				b, err := getBlock(bk, blockId)
+				if err != nil {
+					return false, err
+				}
+				if b != nil {
+					ret = append(ret, b)
+				}
+			}
+			return !p.full(), nil
+		})
+		matched = p.matched
+		return err
+
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if matched == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	for _, b := range ret {
+		store.IncludeBlock(b, include)
+	}
+
+	return ret, nil
+}
+
+// FindBlocksByStatusAndHeight returns blocks by status and height ascending height
+func (k *kv) FindBlocksByStatusAndHeight(symbol string, statuses []string, startHeight int64, endHeight int64, include blocc.BlockInclude, offset int, count int) ([]*blocc.Block, error) {
+
+	var ret []*blocc.Block
+
+	_, err := k.read(symbol, func(bk buckets) error {
+
+		blockIds := blockIdsByStatusesAndHeight(bk, statuses, startHeight, endHeight)
+		from, to := store.Paginate(len(blockIds), offset, count)
+		ret = make([]*blocc.Block, 0, to-from)
+		for _, blockId := range blockIds[from:to] {
+			b, err := getBlock(bk, blockId)
+			if err != nil {
+				return err
+			}
+			if b != nil {
+				ret = append(ret, store.IncludeBlock(b, include))
+			}
+		}
+		// Signal nothing was found at all
+		if len(blockIds) == 0 {
+			ret = nil
+		}
+		return nil
+
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if ret == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	return ret, nil
+}
+
+// findBlocksByIndex returns all blocks with a prefix in an index by descending time
+func (k *kv) findBlocksByIndex(symbol string, index []byte, prefix []byte, include blocc.BlockInclude) ([]*blocc.Block, error) {
+
+	ret := make([]*blocc.Block, 0)
+	_, err := k.read(symbol, func(bk buckets) error {
+		for _, blockId := range prefixIds(bk.get(index), prefix) {
+			b, err := getBlock(bk, blockId)
+			if err != nil {
+				return err
+			}
+			if b != nil {
+				ret = append(ret, store.IncludeBlock(b, include))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Could not get block: %v", err)
+	}
+
+	if len(ret) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	store.SortBlocksByTimeDesc(ret)
+
+	return ret, nil
+
+}
+
+// blockIdsByStatusesAndHeight returns the blockIds with statuses between heights ordered by ascending height
+func blockIdsByStatusesAndHeight(bk buckets, statuses []string, startHeight int64, endHeight int64) []string {
+
+	type entry struct {
+		height  int64
+		blockId string
+	}
+	entries := make([]entry, 0)
+
+	// scan walks an index of (prefix)height|blockId between the heights
+	scan := func(b []byte, prefix []byte) {
+		c := bk.get(b).Cursor()
+		seek := prefix
+		if startHeight != blocc.HeightUnknown {
+			seek = append(append([]byte{}, prefix...), intKey(startHeight)...)
+		}
+		for key, _ := c.Seek(seek); key != nil && len(key) >= len(prefix)+8 && bytes.HasPrefix(key, prefix); key, _ = c.Next() {
+			height := keyInt(key[len(prefix) : len(prefix)+8])
+			if endHeight != blocc.HeightUnknown && height > endHeight {
+				break
+			}
+			entries = append(entries, entry{height: height, blockId: keyId(key)})
+		}
+	}
+
+	if len(statuses) == 0 {
+		scan(bucketBlockHeight, []byte{})
+		// Already in order
+	} else {
+		for _, status := range uniqueStrings(statuses) {
+			scan(bucketBlockStatus, prefixKey([]byte(status)))
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			if entries[i].height != entries[j].height {
+				return entries[i].height < entries[j].height
+			}
+			return entries[i].blockId < entries[j].blockId
+		})
+	}
+
+	blockIds := make([]string, len(entries))
+	for i, e := range entries {
+		blockIds[i] = e.blockId
+	}
+	return blockIds
+
+}
+
+// getBlock returns a block or nil if it does not exist
+func getBlock(bk buckets, blockId string) (*blocc.Block, error) {
+	v := bk.get(bucketBlock).Get([]byte(blockId))
+	if v == nil {
+		return nil, nil
+	}
+	b := new(blocc.Block)
+	if err := b.Unmarshal(v); err != nil {
+		return nil, fmt.Errorf("Could not parse Block %s: %v", blockId, err)
+	}
+	return b, nil
+}
+
+// putBlock writes a block and maintains the indexes
+func putBlock(bk buckets, b *blocc.Block) error {
+
+	old, err := getBlock(bk, b.BlockId)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		if err := unindexBlock(bk, old); err != nil {
+			return err
+		}
+	}
+
+	v, err := b.Marshal()
+	if err != nil {
+		return fmt.Errorf("Could not encode Block %s: %v", b.BlockId, err)
+	}
+	if err := bk.get(bucketBlock).Put([]byte(b.BlockId), v); err != nil {
+		return err
+	}
+
+	return indexBlock(bk, b)
+
+}
+
+// deleteBlock removes a block and it's index entries
+func deleteBlock(bk buckets, b *blocc.Block) error {
+	if err := unindexBlock(bk, b); err != nil {
+		return err
+	}
+	return bk.get(bucketBlock).Delete([]byte(b.BlockId))
+}
+
+// blockIndexKeys returns the index keys for a block by bucket
+func blockIndexKeys(b *blocc.Block) map[string][][]byte {
+	id := []byte(b.BlockId)
+	keys := map[string][][]byte{
+		string(bucketBlockHeight): {indexKey(intKey(b.Height), id)},
+		string(bucketBlockPrev):   {indexKey([]byte(b.PrevBlockId), id)},
+		string(bucketBlockStatus): {indexKey([]byte(b.Status), intKey(b.Height), id)},
+		string(bucketBlockTime):   {indexKey(intKey(b.Time), id)},
+	}
+	for _, txId := range b.TxIds {
+		keys[string(bucketBlockTx)] = append(keys[string(bucketBlockTx)], indexKey([]byte(txId), id))
+	}
+	return keys
+}
+
+func indexBlock(bk buckets, b *blocc.Block) error {
+	for name, keys := range blockIndexKeys(b) {
+		for _, key := range keys {
+			if err := bk[name].Put(key, []byte{}); err != nil {
+				return err
+			}
+		}
+	}
+	return addBlockCount(bk, b.Status, 1)
+}
+
+func unindexBlock(bk buckets, b *blocc.Block) error {
+	for name, keys := range blockIndexKeys(b) {
+		for _, key := range keys {
+			if err := bk[name].Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+	return addBlockCount(bk, b.Status, -1)
+}
+
+// blockCount returns the number of blocks with status
+func blockCount(bk buckets, status string) int64 {
+	v := bk.get(bucketBlockCount).Get([]byte(status))
+	if v == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(v))
+}
+
+// addBlockCount adjusts the number of blocks with status
+func addBlockCount(bk buckets, status string, delta int64) error {
+	count := blockCount(bk, status) + delta
+	if count <= 0 {
+		return bk.get(bucketBlockCount).Delete([]byte(status))
+	}
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, uint64(count))
+	return bk.get(bucketBlockCount).Put([]byte(status), v)
+}
+
+// uniqueStrings removes duplicates preserving order
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{})
+	ret := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		ret = append(ret, v)
+	}
+	return ret
+}
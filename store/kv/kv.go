@@ -0,0 +1,385 @@
+package kv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	config "github.com/spf13/viper"
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/conf"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// Each symbol has a top level bucket with these sub buckets
+var (
+	bucketBlock       = []byte("block")        // blockId -> block
+	bucketBlockHeight = []byte("block_height") // height|blockId
+	bucketBlockPrev   = []byte("block_prev")   // prevBlockId|blockId
+	bucketBlockStatus = []byte("block_status") // status|height|blockId
+	bucketBlockCount  = []byte("block_count")  // status -> count
+	bucketBlockTime   = []byte("block_time")   // time|blockId
+	bucketBlockTx     = []byte("block_tx")     // txId|blockId
+	bucketTx          = []byte("tx")           // txId -> tx
+	bucketTxBlock     = []byte("tx_block")     // blockId|txId
+	bucketTxAddress   = []byte("tx_address")   // address|txId
+	bucketTxTime      = []byte("tx_time")      // time|txId
+
+	bucketOutput           = []byte("output")             // txId:height -> output
+	bucketOutputBlock      = []byte("output_block")       // blockId|txId:height
+	bucketOutputSpentBlock = []byte("output_spent_block") // spentBlockId|txId:height
+	bucketOutputAddress    = []byte("output_address")     // address|txId:height (unspent only)
+
+	bucketAddress        = []byte("address")          // address -> address summary
+	bucketAddressTx      = []byte("address_tx")       // address|txId -> address transaction
+	bucketAddressTxBlock = []byte("address_tx_block") // blockId|address|txId
+
+	allBuckets = [][]byte{
+		bucketBlock, bucketBlockHeight, bucketBlockPrev, bucketBlockStatus, bucketBlockCount, bucketBlockTime, bucketBlockTx,
+		bucketTx, bucketTxBlock, bucketTxAddress, bucketTxTime,
+		bucketOutput, bucketOutputBlock, bucketOutputSpentBlock, bucketOutputAddress,
+		bucketAddress, bucketAddressTx, bucketAddressTxBlock,
+	}
+)
+
+// Separates the parts of an index key, ids never contain it
+const keySep = 0x00
+
+type kv struct {
+	logger *zap.SugaredLogger
+
+	db        *bolt.DB
+	batchSize int
+
+	// Writes are queued and applied in a single transaction when flushed
+	pending        []func(tx *bolt.Tx) error
+	lastFlushError error
+
+	sync.Mutex
+}
+
+// New opens (or creates) the embedded key value block chain store
+func New() (*kv, error) {
+
+	k := &kv{
+		logger:    zap.S().With("package", "blockstore.kv"),
+		batchSize: config.GetInt("kv.batch_size"),
+	}
+
+	var err error
+	k.db, err = bolt.Open(config.GetString("kv.path"), 0600, &bolt.Options{Timeout: config.GetDuration("kv.open_timeout")})
+	if err != nil {
+		return nil, fmt.Errorf("Could not open kv store %s: %v", config.GetString("kv.path"), err)
+	}
+
+	// Periodically flush pending writes
+	if interval := config.GetDuration("kv.flush_interval"); interval > 0 {
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for range ticker.C {
+				if err := k.flush(); err != nil {
+					k.logger.Errorw("Flush Error", "error", err)
+				}
+				if conf.Stop.Bool() {
+					return
+				}
+			}
+		}()
+	}
+
+	return k, nil
+
+}
+
+// Init creates the buckets for a symbol
+func (k *kv) Init(symbol string) error {
+	return k.db.Update(func(tx *bolt.Tx) error {
+		_, err := symbolBuckets(tx, symbol, true)
+		return err
+	})
+}
+
+// buckets are the sub buckets for a symbol
+type buckets map[string]*bolt.Bucket
+
+func (b buckets) get(name []byte) *bolt.Bucket {
+	return b[string(name)]
+}
+
+// symbolBuckets returns the buckets for a symbol, it will return nil if they do not exist and create is false
+func symbolBuckets(tx *bolt.Tx, symbol string, create bool) (buckets, error) {
+
+	var root *bolt.Bucket
+	var err error
+	if create {
+		root, err = tx.CreateBucketIfNotExists([]byte(symbol))
+		if err != nil {
+			return nil, fmt.Errorf("Could not create bucket %s: %v", symbol, err)
+		}
+	} else {
+		root = tx.Bucket([]byte(symbol))
+		if root == nil {
+			return nil, nil
+		}
+	}
+
+	ret := make(buckets)
+	for _, name := range allBuckets {
+		var b *bolt.Bucket
+		if create {
+			b, err = root.CreateBucketIfNotExists(name)
+			if err != nil {
+				return nil, fmt.Errorf("Could not create bucket %s/%s: %v", symbol, name, err)
+			}
+		} else {
+			b = root.Bucket(name)
+			if b == nil {
+				return nil, nil
+			}
+		}
+		ret[string(name)] = b
+	}
+
+	return ret, nil
+
+}
+
+// queue adds a write to be applied at the next flush, it will flush when the batch size is reached
+func (k *kv) queue(symbol string, fn func(bk buckets) error) error {
+
+	k.Lock()
+	k.pending = append(k.pending, func(tx *bolt.Tx) error {
+		bk, err := symbolBuckets(tx, symbol, true)
+		if err != nil {
+			return err
+		}
+		return fn(bk)
+	})
+	full := k.batchSize > 0 && len(k.pending) >= k.batchSize
+	k.Unlock()
+
+	if full {
+		return k.flush()
+	}
+
+	return nil
+
+}
+
+// flush applies all pending writes in a single transaction
+func (k *kv) flush() error {
+
+	k.Lock()
+	defer k.Unlock()
+
+	return k.apply(nil)
+
+}
+
+// write applies pending writes and then fn in a single transaction. It's used for immediate operations
+func (k *kv) write(symbol string, fn func(bk buckets) error) error {
+
+	k.Lock()
+	defer k.Unlock()
+
+	return k.apply(func(tx *bolt.Tx) error {
+		bk, err := symbolBuckets(tx, symbol, false)
+		if err != nil || bk == nil {
+			return err
+		}
+		return fn(bk)
+	})
+
+}
+
+// apply runs pending writes and an optional extra write, the caller must hold the lock
+func (k *kv) apply(extra func(tx *bolt.Tx) error) error {
+
+	if len(k.pending) == 0 && extra == nil {
+		return nil
+	}
+
+	pending := k.pending
+	k.pending = nil
+
+	err := k.db.Update(func(tx *bolt.Tx) error {
+		for _, fn := range pending {
+			if err := fn(tx); err != nil {
+				return err
+			}
+		}
+		if extra != nil {
+			return extra(tx)
+		}
+		return nil
+	})
+	if err != nil && len(pending) > 0 {
+		k.lastFlushError = fmt.Errorf("Could not flush %d writes: %v", len(pending), err)
+	}
+
+	return err
+
+}
+
+// flushError returns and clears the last flush error
+func (k *kv) flushError() error {
+	k.Lock()
+	defer k.Unlock()
+	err := k.lastFlushError
+	k.lastFlushError = nil
+	return err
+}
+
+// read runs fn in a read only transaction, fn is not called if the symbol does not exist
+func (k *kv) read(symbol string, fn func(bk buckets) error) (bool, error) {
+
+	var found bool
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bk, err := symbolBuckets(tx, symbol, false)
+		if err != nil || bk == nil {
+			return err
+		}
+		found = true
+		return fn(bk)
+	})
+
+	return found, err
+
+}
+
+// intKey encodes an int64 such that the byte order matches the numeric order
+func intKey(v int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v)^(1<<63))
+	return b
+}
+
+// keyInt decodes an int64 encoded with intKey
+func keyInt(b []byte) int64 {
+	return int64(binary.BigEndian.Uint64(b) ^ (1 << 63))
+}
+
+// indexKey joins the parts of an index key
+func indexKey(parts ...[]byte) []byte {
+	return bytes.Join(parts, []byte{keySep})
+}
+
+// prefixKey returns an index key with a trailing separator to scan by prefix
+func prefixKey(parts ...[]byte) []byte {
+	return append(indexKey(parts...), keySep)
+}
+
+// keyId returns the id (the last part) of an index key
+func keyId(key []byte) string {
+	return string(key[bytes.LastIndexByte(key, keySep)+1:])
+}
+
+// scanPrefix calls fn with the id of every key in the index with prefix
+func scanPrefix(b *bolt.Bucket, prefix []byte, fn func(key []byte, id string) error) error {
+	c := b.Cursor()
+	for key, _ := c.Seek(prefix); key != nil && bytes.HasPrefix(key, prefix); key, _ = c.Next() {
+		if err := fn(key, keyId(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lastPrefixKey returns the last key in the index with prefix, the prefix must end with the separator
+func lastPrefixKey(b *bolt.Bucket, prefix []byte) []byte {
+	c := b.Cursor()
+	// Seek to the first key after the prefix and step back
+	after := append(append([]byte{}, prefix[:len(prefix)-1]...), keySep+1)
+	key, _ := c.Seek(after)
+	if key == nil {
+		key, _ = c.Last()
+	} else {
+		key, _ = c.Prev()
+	}
+	if key != nil && bytes.HasPrefix(key, prefix) {
+		return key
+	}
+	return nil
+}
+
+// prefixIds returns the ids of every key in the index with prefix
+func prefixIds(b *bolt.Bucket, prefix []byte) []string {
+	ids := make([]string, 0)
+	scanPrefix(b, prefix, func(key []byte, id string) error {
+		ids = append(ids, id)
+		return nil
+	})
+	return ids
+}
+
+// scanTimeDesc calls fn with ids from a time index (time|id) by descending time between start and end, fn returns false to stop
+func scanTimeDesc(b *bolt.Bucket, start *time.Time, end *time.Time, fn func(id string) (bool, error)) error {
+
+	c := b.Cursor()
+
+	var key []byte
+	if end != nil {
+		// Position after the last key with the end time
+		key, _ = c.Seek(intKey(end.Unix() + 1))
+		if key == nil {
+			key, _ = c.Last()
+		} else {
+			key, _ = c.Prev()
+		}
+	} else {
+		key, _ = c.Last()
+	}
+
+	for ; key != nil; key, _ = c.Prev() {
+		if start != nil && keyInt(key[:8]) < start.Unix() {
+			break
+		}
+		more, err := fn(keyId(key))
+		if err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+	}
+
+	return nil
+
+}
+
+// pager handles offset/count while streaming results
+type pager struct {
+	offset  int
+	count   int
+	matched int
+}
+
+func newPager(offset int, count int) *pager {
+	if offset < 0 {
+		offset = 0
+	}
+	return &pager{offset: offset, count: count}
+}
+
+// add records a match and returns if it should be included in the results
+func (p *pager) add() bool {
+	p.matched++
+	if p.matched <= p.offset {
+		return false
+	}
+	return p.unlimited() || p.matched <= p.offset+p.count
+}
+
+// full indicates all requested results have been found
+func (p *pager) full() bool {
+	return !p.unlimited() && p.matched > 0 && p.matched >= p.offset+p.count
+}
+
+func (p *pager) unlimited() bool {
+	return p.count < 0 || p.count == store.CountMax
+}
@@ -0,0 +1,164 @@
+package kv
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	config "github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+func newTestStore(t *testing.T) (*kv, func()) {
+
+	dir, err := ioutil.TempDir("", "blocc-kv")
+	assert.Nil(t, err)
+
+	config.Set("kv.path", filepath.Join(dir, "blocc.db"))
+	config.Set("kv.batch_size", 0)
+	config.Set("kv.flush_interval", 0)
+
+	k, err := New()
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	return k, func() {
+		k.db.Close()
+		os.RemoveAll(dir)
+	}
+
+}
+
+func TestFlushBatches(t *testing.T) {
+
+	k, done := newTestStore(t)
+	defer done()
+
+	symbol := "sym"
+	assert.Nil(t, k.Init(symbol))
+
+	assert.Nil(t, k.InsertBlock(symbol, &blocc.Block{BlockId: "a", Height: 0, Status: blocc.StatusValid, TxIds: []string{"tx1"}}))
+	assert.Nil(t, k.InsertTransaction(symbol, &blocc.Tx{TxId: "tx1", BlockId: "a", Time: 100}))
+
+	// Nothing visible until flushed
+	_, err := k.GetBlockByBlockId(symbol, "a", blocc.BlockIncludeAll)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+	assert.Nil(t, k.FlushBlocks(symbol))
+	b, err := k.GetBlockByBlockId(symbol, "a", blocc.BlockIncludeAll)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"tx1"}, b.TxIds)
+
+	blks, err := k.FindBlocksByTxId(symbol, "tx1", blocc.BlockIncludeHeader)
+	assert.Nil(t, err)
+	assert.Len(t, blks, 1)
+
+	count, err := k.GetTxCountByBlockId(symbol, "a", false)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), count)
+
+}
+
+func TestUpsertTransactionIndexes(t *testing.T) {
+
+	k, done := newTestStore(t)
+	defer done()
+
+	symbol := "sym"
+	assert.Nil(t, k.InsertTransaction(symbol, &blocc.Tx{
+		TxId:    "tx1",
+		BlockId: blocc.BlockIdMempool,
+		Time:    100,
+		TxSize:  250,
+		Out:     []*blocc.TxOut{{Addresses: []string{"addr1"}, Value: 1000}},
+		Data:    map[string]string{"fee": "10"},
+	}))
+	assert.Nil(t, k.UpsertTransaction(symbol, &blocc.Tx{
+		TxId:        "tx1",
+		BlockId:     "block1",
+		BlockHeight: 5,
+		Data:        map[string]string{"received_time": "90"},
+	}))
+	assert.Nil(t, k.FlushTransactions(symbol))
+
+	tx, err := k.GetTxByTxId(symbol, "tx1", blocc.TxIncludeAll)
+	assert.Nil(t, err)
+	assert.Equal(t, "block1", tx.BlockId)
+	assert.Equal(t, int64(250), tx.TxSize)
+	assert.Equal(t, map[string]string{"fee": "10", "received_time": "90"}, tx.Data)
+
+	// The block index moved with the transaction
+	_, count, err := k.GetMemPoolStats(symbol)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), count)
+	txs, err := k.FindTxs(symbol, nil, "block1", nil, blocc.TxFilterIncompleteAll, nil, nil, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 1)
+
+	txs, err = k.FindTxsByAddressesAndTime(symbol, []string{"addr1"}, nil, nil, blocc.TxFilterAddressOutput, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 1)
+
+	assert.Nil(t, k.DeleteAboveBlockHeight(symbol, 4))
+	_, err = k.GetTxByTxId(symbol, "tx1", blocc.TxIncludeHeader)
+	assert.Equal(t, blocc.ErrNotFound, err)
+	_, err = k.FindTxsByAddressesAndTime(symbol, []string{"addr1"}, nil, nil, blocc.TxFilterAddressOutput, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func TestBlockStatusIndexes(t *testing.T) {
+
+	k, done := newTestStore(t)
+	defer done()
+
+	symbol := "sym"
+	for i, blockId := range []string{"a", "b", "c", "d"} {
+		assert.Nil(t, k.InsertBlock(symbol, &blocc.Block{BlockId: blockId, Height: int64(i), Time: int64(i * 600), Status: blocc.StatusNew}))
+	}
+
+	// Applied in order with the pending writes
+	assert.Nil(t, k.UpdateBlockStatusByStatusesAndHeight(symbol, []string{blocc.StatusNew}, blocc.HeightUnknown, 1, blocc.StatusValid))
+
+	bh, err := k.GetBlockHeaderTopByStatuses(symbol, []string{blocc.StatusValid})
+	assert.Nil(t, err)
+	assert.Equal(t, "b", bh.BlockId)
+
+	bh, err = k.GetBlockHeaderTopByStatuses(symbol, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "d", bh.BlockId)
+
+	blks, err := k.FindBlocksByStatusAndHeight(symbol, []string{blocc.StatusNew}, blocc.HeightUnknown, blocc.HeightUnknown, blocc.BlockIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	if assert.Len(t, blks, 2) {
+		assert.Equal(t, "c", blks[0].BlockId)
+		assert.Equal(t, "d", blks[1].BlockId)
+	}
+
+	// Descending time with pagination
+	blks, err = k.FindBlocksByBlockIdsAndTime(symbol, nil, nil, nil, blocc.BlockIncludeHeader, 1, 2)
+	assert.Nil(t, err)
+	if assert.Len(t, blks, 2) {
+		assert.Equal(t, "c", blks[0].BlockId)
+		assert.Equal(t, "b", blks[1].BlockId)
+	}
+
+	assert.Nil(t, k.UpdateBlock(symbol, "a", blocc.StatusOrphaned, "", map[string]string{"fee_vsize_p10": "5"}, nil))
+	assert.Nil(t, k.FlushBlocks(symbol))
+
+	blks, err = k.FindBlocksByStatusAndHeight(symbol, []string{blocc.StatusOrphaned}, 0, 0, blocc.BlockIncludeData, 0, store.CountMax)
+	assert.Nil(t, err)
+	if assert.Len(t, blks, 1) {
+		assert.Equal(t, "5", blks[0].Data["fee_vsize_p10"])
+	}
+
+	avg, err := k.AverageBlockDataFieldByHeight(symbol, "data.fee_vsize_p10", true, blocc.HeightUnknown, blocc.HeightUnknown)
+	assert.Nil(t, err)
+	assert.Equal(t, 5.0, avg)
+
+}
@@ -0,0 +1,250 @@
+package kv
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// UpsertOutputs merges outputs with the existing outputs
+func (k *kv) UpsertOutputs(symbol string, outputs []*blocc.Output) error {
+
+	// Copy the outputs so the caller can modify them
+	copies := make([]*blocc.Output, len(outputs))
+	for x, o := range outputs {
+		copies[x] = proto.Clone(o).(*blocc.Output)
+	}
+
+	return k.queue(symbol, func(bk buckets) error {
+		for _, o := range copies {
+			existing, err := getOutput(bk, outPointKey(o.TxId, o.Height))
+			if err != nil {
+				return err
+			}
+			if existing == nil {
+				existing = new(blocc.Output)
+			}
+			store.MergeOutput(existing, o)
+			if err := putOutput(bk, existing); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+}
+
+// UpdateOutputBlockIdByBlockId changes the BlockId and SpentBlockId of outputs
+func (k *kv) UpdateOutputBlockIdByBlockId(symbol string, blockId string, newBlockId string) error {
+
+	return k.write(symbol, func(bk buckets) error {
+		return updateOutputsByBlockId(bk, blockId, func(o *blocc.Output) bool {
+			if o.BlockId == blockId {
+				o.BlockId = newBlockId
+			}
+			if o.SpentTxId != "" && o.SpentBlockId == blockId {
+				o.SpentBlockId = newBlockId
+			}
+			return true
+		})
+	})
+
+}
+
+// RollbackOutputsByBlockId removes outputs created in a block and unspends the outputs spent in it
+func (k *kv) RollbackOutputsByBlockId(symbol string, blockId string) error {
+
+	return k.write(symbol, func(bk buckets) error {
+		return updateOutputsByBlockId(bk, blockId, func(o *blocc.Output) bool {
+			if o.BlockId == blockId {
+				return false
+			}
+			store.UnspendOutput(o)
+			return true
+		})
+	})
+
+}
+
+// GetOutputByOutPoint returns an output by txId and height
+func (k *kv) GetOutputByOutPoint(symbol string, txId string, height int64) (*blocc.Output, error) {
+
+	var o *blocc.Output
+	_, err := k.read(symbol, func(bk buckets) error {
+		var err error
+		o, err = getOutput(bk, outPointKey(txId, height))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if o == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	return o, nil
+
+}
+
+// FindUnspentOutputsByAddresses returns the unspent outputs for addresses
+func (k *kv) FindUnspentOutputsByAddresses(symbol string, addresses []string, offset int, count int) ([]*blocc.Output, error) {
+
+	outputs := make([]*blocc.Output, 0)
+	_, err := k.read(symbol, func(bk buckets) error {
+		seen := make(map[string]struct{})
+		for _, address := range uniqueStrings(addresses) {
+			for _, key := range prefixIds(bk.get(bucketOutputAddress), prefixKey([]byte(address))) {
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				o, err := getOutput(bk, key)
+				if err != nil {
+					return err
+				}
+				if o != nil {
+					outputs = append(outputs, o)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(outputs) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	store.SortOutputsByTimeDesc(outputs)
+	from, to := store.Paginate(len(outputs), offset, count)
+
+	return outputs[from:to], nil
+
+}
+
+// updateOutputsByBlockId calls fn for every output created or spent in blockId, fn returns false to delete the output
+func updateOutputsByBlockId(bk buckets, blockId string, fn func(o *blocc.Output) bool) error {
+
+	prefix := prefixKey([]byte(blockId))
+	keys := append(prefixIds(bk.get(bucketOutputBlock), prefix), prefixIds(bk.get(bucketOutputSpentBlock), prefix)...)
+
+	for _, key := range uniqueStrings(keys) {
+		o, err := getOutput(bk, key)
+		if err != nil {
+			return err
+		}
+		if o == nil {
+			continue
+		}
+		if fn(o) {
+			err = putOutput(bk, o)
+		} else {
+			err = deleteOutput(bk, o)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
+// outPointKey is the key of an output
+func outPointKey(txId string, height int64) string {
+	return txId + ":" + strconv.FormatInt(height, 10)
+}
+
+// getOutput returns an output or nil if it does not exist
+func getOutput(bk buckets, key string) (*blocc.Output, error) {
+	v := bk.get(bucketOutput).Get([]byte(key))
+	if v == nil {
+		return nil, nil
+	}
+	o := new(blocc.Output)
+	if err := o.Unmarshal(v); err != nil {
+		return nil, fmt.Errorf("Could not parse Output %s: %v", key, err)
+	}
+	return o, nil
+}
+
+// putOutput writes an output and maintains the indexes
+func putOutput(bk buckets, o *blocc.Output) error {
+
+	key := outPointKey(o.TxId, o.Height)
+	old, err := getOutput(bk, key)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		if err := unindexOutput(bk, old); err != nil {
+			return err
+		}
+	}
+
+	v, err := o.Marshal()
+	if err != nil {
+		return fmt.Errorf("Could not encode Output %s: %v", key, err)
+	}
+	if err := bk.get(bucketOutput).Put([]byte(key), v); err != nil {
+		return err
+	}
+
+	return indexOutput(bk, o)
+
+}
+
+// deleteOutput removes an output and it's index entries
+func deleteOutput(bk buckets, o *blocc.Output) error {
+	if err := unindexOutput(bk, o); err != nil {
+		return err
+	}
+	return bk.get(bucketOutput).Delete([]byte(outPointKey(o.TxId, o.Height)))
+}
+
+// outputIndexKeys returns the index keys for an output by bucket
+func outputIndexKeys(o *blocc.Output) map[string][][]byte {
+	id := []byte(outPointKey(o.TxId, o.Height))
+	keys := make(map[string][][]byte)
+	if o.BlockId != "" {
+		keys[string(bucketOutputBlock)] = [][]byte{indexKey([]byte(o.BlockId), id)}
+	}
+	if o.SpentTxId != "" {
+		keys[string(bucketOutputSpentBlock)] = [][]byte{indexKey([]byte(o.SpentBlockId), id)}
+	}
+	if store.IsUnspentOutput(o) {
+		for _, address := range uniqueStrings(o.Out.Addresses) {
+			keys[string(bucketOutputAddress)] = append(keys[string(bucketOutputAddress)], indexKey([]byte(address), id))
+		}
+	}
+	return keys
+}
+
+func indexOutput(bk buckets, o *blocc.Output) error {
+	for name, keys := range outputIndexKeys(o) {
+		for _, key := range keys {
+			if err := bk[name].Put(key, []byte{}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func unindexOutput(bk buckets, o *blocc.Output) error {
+	for name, keys := range outputIndexKeys(o) {
+		for _, key := range keys {
+			if err := bk[name].Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
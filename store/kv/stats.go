@@ -0,0 +1,77 @@
+package kv
+
+import (
+	"fmt"
+	"strconv"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// AverageBlockDataFieldByHeight returns the average of a block data field between heights
+func (k *kv) AverageBlockDataFieldByHeight(symbol string, field string, omitZero bool, startHeight int64, endHeight int64) (float64, error) {
+
+	values, err := k.blockFieldValues(symbol, field, omitZero, startHeight, endHeight)
+	if err != nil {
+		return 0, err
+	}
+
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values)), nil
+}
+
+// PercentileBlockDataFieldByHeight returns the percentile of a block data field between heights
+func (k *kv) PercentileBlockDataFieldByHeight(symbol string, field string, percentile float64, omitZero bool, startHeight int64, endHeight int64) (float64, error) {
+
+	values, err := k.blockFieldValues(symbol, field, omitZero, startHeight, endHeight)
+	if err != nil {
+		return 0, err
+	}
+
+	return store.Percentile(values, percentile), nil
+}
+
+// blockFieldValues returns the values of a field (ie data.fee_vsize_p10) for all blocks between heights that have it
+func (k *kv) blockFieldValues(symbol string, field string, omitZero bool, startHeight int64, endHeight int64) ([]float64, error) {
+
+	values := make([]float64, 0)
+	_, err := k.read(symbol, func(bk buckets) error {
+		for _, blockId := range blockIdsByStatusesAndHeight(bk, nil, startHeight, endHeight) {
+			b, err := getBlock(bk, blockId)
+			if err != nil {
+				return err
+			}
+			if b == nil {
+				continue
+			}
+			raw, ok := store.BlockField(b, field)
+			if !ok {
+				continue
+			}
+			// Skip zeros
+			if omitZero && store.IsZeroField(raw) {
+				continue
+			}
+			value, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				return fmt.Errorf("Could not parse %s value %s for block %s: %v", field, raw, b.BlockId, err)
+			}
+			values = append(values, value)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	return values, nil
+
+}
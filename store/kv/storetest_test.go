@@ -0,0 +1,14 @@
+package kv
+
+import (
+	"testing"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store/storetest"
+)
+
+func TestBlockChainStore(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) (blocc.BlockChainStore, func()) {
+		return newTestStore(t)
+	})
+}
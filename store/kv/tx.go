@@ -0,0 +1,467 @@
+package kv
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// InsertTransaction inserts a transaction to the store
+func (k *kv) InsertTransaction(symbol string, tx *blocc.Tx) error {
+
+	// Copy the tx so the caller can modify it
+	tx = proto.Clone(tx).(*blocc.Tx)
+
+	return k.queue(symbol, func(bk buckets) error {
+		return putTx(bk, tx)
+	})
+
+}
+
+// UpsertTransaction updates transaction data (essentially merging data object)
+func (k *kv) UpsertTransaction(symbol string, tx *blocc.Tx) error {
+
+	// Copy the tx so the caller can modify it
+	tx = proto.Clone(tx).(*blocc.Tx)
+
+	return k.queue(symbol, func(bk buckets) error {
+		existing, err := getTx(bk, tx.TxId)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return putTx(bk, tx)
+		}
+		store.MergeTx(existing, tx)
+		return putTx(bk, existing)
+	})
+
+}
+
+// DeleteTransactionsByBlockIdAndTime will remove transactions by BlockId
+func (k *kv) DeleteTransactionsByBlockIdAndTime(symbol string, blockId string, start *time.Time, end *time.Time) error {
+
+	return k.write(symbol, func(bk buckets) error {
+		for _, txId := range prefixIds(bk.get(bucketTxBlock), prefixKey([]byte(blockId))) {
+			tx, err := getTx(bk, txId)
+			if err != nil {
+				return err
+			}
+			if tx == nil || !store.InTimeRange(tx.Time, start, end) {
+				continue
+			}
+			if err := deleteTx(bk, tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+}
+
+// GetTxByTxId will return a transaction by txId
+func (k *kv) GetTxByTxId(symbol string, txId string, include blocc.TxInclude) (*blocc.Tx, error) {
+
+	var tx *blocc.Tx
+	_, err := k.read(symbol, func(bk buckets) error {
+		var err error
+		tx, err = getTx(bk, txId)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Could not get tx: %v", err)
+	}
+
+	if tx == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	return store.IncludeTx(tx, include), nil
+
+}
+
+// GetTxsByTxIds returns multiple transaction by multiple txIds, missing transactions are skipped
+func (k *kv) GetTxsByTxIds(symbol string, txIds []string, include blocc.TxInclude) ([]*blocc.Tx, error) {
+
+	txs := make([]*blocc.Tx, 0)
+	_, err := k.read(symbol, func(bk buckets) error {
+		for _, txId := range txIds {
+			tx, err := getTx(bk, txId)
+			if err != nil {
+				return err
+			}
+			if tx != nil {
+				txs = append(txs, store.IncludeTx(tx, include))
+			}
+		}
+		return nil
+	})
+
+	return txs, err
+
+}
+
+// GetTxsByBlockId returns multiple transaction by block Id ordered by height in the block
+func (k *kv) GetTxsByBlockId(symbol string, blockId string, include blocc.TxInclude) ([]*blocc.Tx, error) {
+
+	ret := make([]*blocc.Tx, 0)
+	_, err := k.read(symbol, func(bk buckets) error {
+		for _, txId := range prefixIds(bk.get(bucketTxBlock), prefixKey([]byte(blockId))) {
+			tx, err := getTx(bk, txId)
+			if err != nil {
+				return err
+			}
+			if tx != nil {
+				ret = append(ret, store.IncludeTx(tx, include))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ret) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	store.SortTxsByHeight(ret)
+
+	return ret, nil
+
+}
+
+// GetTxCountByBlockId will return the number of transactions by block Id in the store
+func (k *kv) GetTxCountByBlockId(symbol string, blockId string, includeIncomplete bool) (int64, error) {
+
+	var count int64
+	_, err := k.read(symbol, func(bk buckets) error {
+		for _, txId := range prefixIds(bk.get(bucketTxBlock), prefixKey([]byte(blockId))) {
+			if includeIncomplete {
+				count++
+				continue
+			}
+			tx, err := getTx(bk, txId)
+			if err != nil {
+				return err
+			}
+			if tx != nil && !tx.Incomplete {
+				count++
+			}
+		}
+		return nil
+	})
+
+	return count, err
+}
+
+// FindTxs will find multiple transactions by optionally multiple fields
+func (k *kv) FindTxs(symbol string, txIds []string, blockId string, dataFields map[string]string, incomplete blocc.TxFilterIncomplete, start *time.Time, end *time.Time, include blocc.TxInclude, offset int, count int) ([]*blocc.Tx, error) {
+
+	match := func(tx *blocc.Tx) bool {
+		if blockId != "" && tx.BlockId != blockId {
+			return false
+		}
+		if !store.MatchDataFields(tx.Data, dataFields) {
+			return false
+		}
+		if incomplete == blocc.TxFilterIncompleteTrue && !tx.Incomplete {
+			return false
+		} else if incomplete == blocc.TxFilterIncompleteFalse && tx.Incomplete {
+			return false
+		}
+		return store.InTimeRange(tx.Time, start, end)
+	}
+
+	var ret []*blocc.Tx
+	var matched int
+
+	_, err := k.read(symbol, func(bk buckets) error {
+
+		var err error
+
+		// Narrow down the candidates using the most specific criteria
+		if len(txIds) > 0 {
+			ret, matched, err = pageTxIds(bk, uniqueStrings(txIds), match, offset, count)
+			return err
+		} else if blockId != "" {
+			ret, matched, err = pageTxIds(bk, prefixIds(bk.get(bucketTxBlock), prefixKey([]byte(blockId))), match, offset, count)
+			return err
+		}
+
+		ret, matched, err = pageTxTime(bk, start, end, match, offset, count)
+		return err
+
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if matched == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	for _, tx := range ret {
+		store.IncludeTx(tx, include)
+	}
+
+	return ret, nil
+
+}
+
+// FindTxsByAddressesAndTime will find transactions by optionally addresses, time and pagination
+func (k *kv) FindTxsByAddressesAndTime(symbol string, addresses []string, start *time.Time, end *time.Time, filter blocc.TxFilterAddress, include blocc.TxInclude, offset int, count int) ([]*blocc.Tx, error) {
+
+	match := func(tx *blocc.Tx) bool {
+		// The index covers inputs and outputs, check the specific side if required
+		if filter&blocc.TxFilterAddressInputOutput != 0 && !store.TxHasAddress(tx, addresses, filter) {
+			return false
+		}
+		return store.InTimeRange(tx.Time, start, end)
+	}
+
+	var ret []*blocc.Tx
+	var matched int
+
+	_, err := k.read(symbol, func(bk buckets) error {
+
+		var err error
+
+		if filter&blocc.TxFilterAddressInputOutput == 0 {
+			ret, matched, err = pageTxTime(bk, start, end, match, offset, count)
+			return err
+		}
+
+		txIds := make([]string, 0)
+		for _, address := range addresses {
+			txIds = append(txIds, prefixIds(bk.get(bucketTxAddress), prefixKey([]byte(address)))...)
+		}
+		ret, matched, err = pageTxIds(bk, uniqueStrings(txIds), match, offset, count)
+		return err
+
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if matched == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	for _, tx := range ret {
+		store.IncludeTx(tx, include)
+	}
+
+	return ret, nil
+
+}
+
+// UpdateTxBlockIdByBlockId will update the blockId of a transaction to a new block id
+func (k *kv) UpdateTxBlockIdByBlockId(symbol string, blockId string, newBlockId string) error {
+
+	return k.write(symbol, func(bk buckets) error {
+		for _, txId := range prefixIds(bk.get(bucketTxBlock), prefixKey([]byte(blockId))) {
+			tx, err := getTx(bk, txId)
+			if err != nil {
+				return err
+			}
+			if tx == nil {
+				continue
+			}
+			tx.BlockId = newBlockId
+			if err := putTx(bk, tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+}
+
+// GetMemPoolStats returns the size and count of the mempool
+func (k *kv) GetMemPoolStats(symbol string) (int64, int64, error) {
+
+	var size, count int64
+	_, err := k.read(symbol, func(bk buckets) error {
+		for _, blockId := range blocc.MemPoolBlockIds {
+			for _, txId := range prefixIds(bk.get(bucketTxBlock), prefixKey([]byte(blockId))) {
+				tx, err := getTx(bk, txId)
+				if err != nil {
+					return err
+				}
+				if tx != nil {
+					size += tx.TxSize
+					count++
+				}
+			}
+		}
+		return nil
+	})
+
+	return size, count, err
+
+}
+
+// GetAddressStats returns the transaction count, value received and value spent for an address
+func (k *kv) GetAddressStats(symbol string, address string) (int64, int64, int64, error) {
+
+	var count, outputValue, inputValue int64
+	_, err := k.read(symbol, func(bk buckets) error {
+		for _, txId := range prefixIds(bk.get(bucketTxAddress), prefixKey([]byte(address))) {
+			tx, err := getTx(bk, txId)
+			if err != nil {
+				return err
+			}
+			if tx == nil {
+				continue
+			}
+			out, in := store.TxAddressValues(tx, address)
+			outputValue += out
+			inputValue += in
+			count++
+		}
+		return nil
+	})
+
+	return count, outputValue, inputValue, err
+
+}
+
+// FlushTransactions writes all pending changes
+func (k *kv) FlushTransactions(symbol string) error {
+
+	if err := k.flush(); err != nil {
+		return err
+	}
+
+	// Return the background flush error (if there was one)
+	return k.flushError()
+
+}
+
+// pageTxIds loads transactions by id, filters them and returns the requested page by descending time along with the number matched
+func pageTxIds(bk buckets, txIds []string, match func(tx *blocc.Tx) bool, offset int, count int) ([]*blocc.Tx, int, error) {
+
+	txs := make([]*blocc.Tx, 0)
+	for _, txId := range txIds {
+		tx, err := getTx(bk, txId)
+		if err != nil {
+			return nil, 0, err
+		}
+		if tx != nil && match(tx) {
+			txs = append(txs, tx)
+		}
+	}
+
+	store.SortTxsByTimeDesc(txs)
+
+	from, to := store.Paginate(len(txs), offset, count)
+	return txs[from:to], len(txs), nil
+
+}
+
+// pageTxTime walks the time index by descending time and returns the requested page along with the number matched
+func pageTxTime(bk buckets, start *time.Time, end *time.Time, match func(tx *blocc.Tx) bool, offset int, count int) ([]*blocc.Tx, int, error) {
+
+	txs := make([]*blocc.Tx, 0)
+	p := newPager(offset, count)
+
+	err := scanTimeDesc(bk.get(bucketTxTime), start, end, func(txId string) (bool, error) {
+		tx, err := getTx(bk, txId)
+		if err != nil {
+			return false, err
+		}
+		if tx != nil && match(tx) && p.add() {
+			txs = append(txs, tx)
+		}
+		return !p.full(), nil
+	})
+
+	return txs, p.matched, err
+
+}
+
+// getTx returns a transaction or nil if it does not exist
+func getTx(bk buckets, txId string) (*blocc.Tx, error) {
+	v := bk.get(bucketTx).Get([]byte(txId))
+	if v == nil {
+		return nil, nil
+	}
+	tx := new(blocc.Tx)
+	if err := tx.Unmarshal(v); err != nil {
+		return nil, fmt.Errorf("Could not parse Tx %s: %v", txId, err)
+	}
+	return tx, nil
+}
+
+// putTx writes a transaction and maintains the indexes
+func putTx(bk buckets, tx *blocc.Tx) error {
+
+	old, err := getTx(bk, tx.TxId)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		if err := unindexTx(bk, old); err != nil {
+			return err
+		}
+	}
+
+	v, err := tx.Marshal()
+	if err != nil {
+		return fmt.Errorf("Could not encode Tx %s: %v", tx.TxId, err)
+	}
+	if err := bk.get(bucketTx).Put([]byte(tx.TxId), v); err != nil {
+		return err
+	}
+
+	return indexTx(bk, tx)
+
+}
+
+// deleteTx removes a transaction and it's index entries
+func deleteTx(bk buckets, tx *blocc.Tx) error {
+	if err := unindexTx(bk, tx); err != nil {
+		return err
+	}
+	return bk.get(bucketTx).Delete([]byte(tx.TxId))
+}
+
+// txIndexKeys returns the index keys for a transaction by bucket
+func txIndexKeys(tx *blocc.Tx) map[string][][]byte {
+	id := []byte(tx.TxId)
+	keys := map[string][][]byte{
+		string(bucketTxBlock): {indexKey([]byte(tx.BlockId), id)},
+		string(bucketTxTime):  {indexKey(intKey(tx.Time), id)},
+	}
+	for _, address := range store.TxAddresses(tx, blocc.TxFilterAddressInputOutput) {
+		keys[string(bucketTxAddress)] = append(keys[string(bucketTxAddress)], indexKey([]byte(address), id))
+	}
+	return keys
+}
+
+func indexTx(bk buckets, tx *blocc.Tx) error {
+	for name, keys := range txIndexKeys(tx) {
+		for _, key := range keys {
+			if err := bk[name].Put(key, []byte{}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func unindexTx(bk buckets, tx *blocc.Tx) error {
+	for name, keys := range txIndexKeys(tx) {
+		for _, key := range keys {
+			if err := bk[name].Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
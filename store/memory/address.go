@@ -0,0 +1,141 @@
+package memory
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// UpsertAddressTxs replaces address transactions and updates the address summaries
+func (m *memory) UpsertAddressTxs(symbol string, atxs []*blocc.AddressTx) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	ss := m.symbol(symbol, true)
+
+	for _, atx := range atxs {
+		ss.updateAddressTx(ss.addressTxs[atx.Address][atx.TxId], proto.Clone(atx).(*blocc.AddressTx))
+	}
+
+	return nil
+
+}
+
+// UpdateAddressTxBlockIdByBlockId changes the BlockId of address transactions
+func (m *memory) UpdateAddressTxBlockIdByBlockId(symbol string, blockId string, newBlockId string) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil
+	}
+
+	for _, atx := range ss.findAddressTxsByBlockId(blockId) {
+		updated := proto.Clone(atx).(*blocc.AddressTx)
+		updated.BlockId = newBlockId
+		ss.updateAddressTx(atx, updated)
+	}
+
+	return nil
+
+}
+
+// RollbackAddressTxsByBlockId removes the address transactions in a block from the address summaries
+func (m *memory) RollbackAddressTxsByBlockId(symbol string, blockId string) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil
+	}
+
+	for _, atx := range ss.findAddressTxsByBlockId(blockId) {
+		ss.updateAddressTx(atx, nil)
+	}
+
+	return nil
+
+}
+
+// GetAddress returns the summary of an address
+func (m *memory) GetAddress(symbol string, address string) (*blocc.Address, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	a, ok := ss.addresses[address]
+	if !ok {
+		return nil, blocc.ErrNotFound
+	}
+
+	return proto.Clone(a).(*blocc.Address), nil
+
+}
+
+// findAddressTxsByBlockId returns the address transactions in a block
+func (ss *symbolStore) findAddressTxsByBlockId(blockId string) []*blocc.AddressTx {
+	ret := make([]*blocc.AddressTx, 0)
+	for _, atxs := range ss.addressTxs {
+		for _, atx := range atxs {
+			if atx.BlockId == blockId {
+				ret = append(ret, atx)
+			}
+		}
+	}
+	return ret
+}
+
+// updateAddressTx replaces the address transaction old with new where either may be nil and updates the summary
+func (ss *symbolStore) updateAddressTx(old *blocc.AddressTx, new *blocc.AddressTx) {
+
+	address := old.GetAddress()
+	if new != nil {
+		address = new.Address
+	}
+
+	a, ok := ss.addresses[address]
+	if ok {
+		a = proto.Clone(a).(*blocc.Address)
+	} else {
+		a = &blocc.Address{Symbol: new.GetSymbol(), Address: address}
+	}
+	reseen := store.UpdateAddress(a, old, new)
+
+	atxs, ok := ss.addressTxs[address]
+	if !ok {
+		atxs = make(map[string]*blocc.AddressTx)
+		ss.addressTxs[address] = atxs
+	}
+	if new != nil {
+		atxs[new.TxId] = new
+	} else {
+		delete(atxs, old.TxId)
+	}
+
+	if reseen {
+		all := make([]*blocc.AddressTx, 0, len(atxs))
+		for _, atx := range atxs {
+			all = append(all, atx)
+		}
+		store.SetAddressSeen(a, all)
+	}
+
+	if store.IsEmptyAddress(a) {
+		delete(ss.addresses, address)
+		delete(ss.addressTxs, address)
+	} else {
+		ss.addresses[address] = a
+	}
+
+}
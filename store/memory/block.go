@@ -0,0 +1,349 @@
+package memory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// InsertBlock replaces a block
+func (m *memory) InsertBlock(symbol string, b *blocc.Block) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.symbol(symbol, true).blocks[b.BlockId] = proto.Clone(b).(*blocc.Block)
+
+	return nil
+
+}
+
+// UpdateBlock updates status and data based on blockId, the block will be created if it does not exist
+func (m *memory) UpdateBlock(symbol string, blockId string, status string, nextBlockId string, data map[string]string, metric map[string]float64) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	ss := m.symbol(symbol, true)
+
+	b, ok := ss.blocks[blockId]
+	if !ok {
+		b = &blocc.Block{BlockId: blockId}
+		ss.blocks[blockId] = b
+	}
+
+	store.UpdateBlockFields(b, status, nextBlockId, data, metric)
+
+	return nil
+
+}
+
+// UpdateBlockStatusByStatusesAndHeight updates status between heights
+func (m *memory) UpdateBlockStatusByStatusesAndHeight(symbol string, statuses []string, startHeight int64, endHeight int64, status string) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil
+	}
+
+	for _, b := range ss.blocks {
+		if store.InStatuses(b.Status, statuses) && store.InHeightRange(b.Height, startHeight, endHeight) {
+			b.Status = status
+		}
+	}
+
+	return nil
+
+}
+
+// DeleteBlockByBlockId removes a block by BlockId
+func (m *memory) DeleteBlockByBlockId(symbol string, blockId string) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return blocc.ErrNotFound
+	}
+
+	if _, ok := ss.blocks[blockId]; !ok {
+		return blocc.ErrNotFound
+	}
+	delete(ss.blocks, blockId)
+
+	return nil
+}
+
+// DeleteAboveBlockHeight removes blocks, transactions, outputs and address transactions above a block height
+func (m *memory) DeleteAboveBlockHeight(symbol string, above int64) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil
+	}
+
+	for blockId, b := range ss.blocks {
+		if b.Height > above {
+			delete(ss.blocks, blockId)
+		}
+	}
+
+	for txId, tx := range ss.txs {
+		if tx.BlockHeight > above {
+			ss.deleteTx(txId)
+		}
+	}
+
+	for key, o := range ss.outputs {
+		if o.BlockHeight > above {
+			ss.deleteOutput(key)
+		} else if o.SpentBlockHeight > above {
+			o = proto.Clone(o).(*blocc.Output)
+			store.UnspendOutput(o)
+			ss.putOutput(o)
+		}
+	}
+
+	for _, atxs := range ss.addressTxs {
+		for _, atx := range atxs {
+			if atx.BlockHeight > above {
+				ss.updateAddressTx(atx, nil)
+			}
+		}
+	}
+
+	return nil
+}
+
+// FlushBlocks is a no-op, writes are visible immediately
+func (m *memory) FlushBlocks(symbol string) error {
+	return nil
+}
+
+// GetBlockHeaderTopByStatuses will return the top block header as it stands
+func (m *memory) GetBlockHeaderTopByStatuses(symbol string, statuses []string) (*blocc.BlockHeader, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	var top *blocc.Block
+	var total int64
+	for _, b := range ss.blocks {
+		if !store.InStatuses(b.Status, statuses) {
+			continue
+		}
+		total++
+		if top == nil || b.Height > top.Height || (b.Height == top.Height && b.BlockId > top.BlockId) {
+			top = b
+		}
+	}
+
+	if top == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	bh := &blocc.BlockHeader{
+		BlockId:     top.BlockId,
+		Height:      top.Height,
+		PrevBlockId: top.PrevBlockId,
+		Time:        top.Time,
+	}
+
+	// Return the height but also an error indicating the height and the number of blocks do not match up (ie missing data)
+	if bh.Height > total+1 {
+		return bh, fmt.Errorf("Validation Error: Missing Blocks Detected height:%d blocks:%d", bh.Height, total)
+	} else if bh.Height+1 < total {
+		return bh, fmt.Errorf("Validation Error: Missing Blocks Detected height:%d blocks:%d", bh.Height, total)
+	}
+
+	return bh, nil
+}
+
+// GetBlockByBlockId gets a block by blockId
+func (m *memory) GetBlockByBlockId(symbol string, blockId string, include blocc.BlockInclude) (*blocc.Block, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	b, ok := ss.blocks[blockId]
+	if !ok {
+		return nil, blocc.ErrNotFound
+	}
+
+	return copyBlock(b, include), nil
+
+}
+
+// GetBlockTopByStatuses gets the top block
+func (m *memory) GetBlockTopByStatuses(symbol string, statuses []string, include blocc.BlockInclude) (*blocc.Block, error) {
+
+	// Determine the tip
+	bh, err := m.GetBlockHeaderTopByStatuses(symbol, statuses)
+	// If it's not a validation error, there's still data
+	if err != nil && !blocc.IsValidationError(err) {
+		return nil, err
+	}
+
+	blk, newErr := m.GetBlockByBlockId(symbol, bh.BlockId, include)
+	if newErr != nil {
+		return nil, newErr
+	}
+
+	// Return the block and original error if any
+	return blk, err
+
+}
+
+// FindBlocksByHeight fetches blocks by height
+func (m *memory) FindBlocksByHeight(symbol string, height int64, include blocc.BlockInclude) ([]*blocc.Block, error) {
+	return m.findBlocks(symbol, func(b *blocc.Block) bool {
+		return b.Height == height
+	}, include)
+}
+
+// FindBlocksByPrevBlockId returns blocks by previous blockId
+func (m *memory) FindBlocksByPrevBlockId(symbol string, prevBlockId string, include blocc.BlockInclude) ([]*blocc.Block, error) {
+	return m.findBlocks(symbol, func(b *blocc.Block) bool {
+		return b.PrevBlockId == prevBlockId
+	}, include)
+}
+
+// FindBlocksByTxId returns blocks with TxId
+func (m *memory) FindBlocksByTxId(symbol string, txId string, include blocc.BlockInclude) ([]*blocc.Block, error) {
+	return m.findBlocks(symbol, func(b *blocc.Block) bool {
+		for _, id := range b.TxIds {
+			if id == txId {
+				return true
+			}
+		}
+		return false
+	}, include)
+}
+
+// FindBlocksByBlockIdsAndTime returns blocks optionally by blockId, time and pagination by descending time
+func (m *memory) FindBlocksByBlockIdsAndTime(symbol string, blockIds []string, start *time.Time, end *time.Time, include blocc.BlockInclude, offset int, count int) ([]*blocc.Block, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	blks := make([]*blocc.Block, 0)
+	if len(blockIds) > 0 {
+		seen := make(map[string]struct{})
+		for _, blockId := range blockIds {
+			if _, ok := seen[blockId]; ok {
+				continue
+			}
+			seen[blockId] = struct{}{}
+			if b, ok := ss.blocks[blockId]; ok && store.InTimeRange(b.Time, start, end) {
+				blks = append(blks, b)
+			}
+		}
+	} else {
+		for _, b := range ss.blocks {
+			if store.InTimeRange(b.Time, start, end) {
+				blks = append(blks, b)
+			}
+		}
+	}
+
+	if len(blks) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	store.SortBlocksByTimeDesc(blks)
+
+	from, to := store.Paginate(len(blks), offset, count)
+	ret := make([]*blocc.Block, 0, to-from)
+	for _, b := range blks[from:to] {
+		ret = append(ret, copyBlock(b, include))
+	}
+
+	return ret, nil
+}
+
+// FindBlocksByStatusAndHeight returns blocks by status and height ascending height
+func (m *memory) FindBlocksByStatusAndHeight(symbol string, statuses []string, startHeight int64, endHeight int64, include blocc.BlockInclude, offset int, count int) ([]*blocc.Block, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	blks := make([]*blocc.Block, 0)
+	for _, b := range ss.blocks {
+		if store.InStatuses(b.Status, statuses) && store.InHeightRange(b.Height, startHeight, endHeight) {
+			blks = append(blks, b)
+		}
+	}
+
+	if len(blks) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	store.SortBlocksByHeight(blks)
+
+	from, to := store.Paginate(len(blks), offset, count)
+	ret := make([]*blocc.Block, 0, to-from)
+	for _, b := range blks[from:to] {
+		ret = append(ret, copyBlock(b, include))
+	}
+
+	return ret, nil
+}
+
+// findBlocks returns all blocks matching a filter by descending time
+func (m *memory) findBlocks(symbol string, filter func(b *blocc.Block) bool, include blocc.BlockInclude) ([]*blocc.Block, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	ret := make([]*blocc.Block, 0)
+	for _, b := range ss.blocks {
+		if filter(b) {
+			ret = append(ret, copyBlock(b, include))
+		}
+	}
+
+	if len(ret) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	store.SortBlocksByTimeDesc(ret)
+
+	return ret, nil
+
+}
@@ -0,0 +1,175 @@
+package memory
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+type memory struct {
+	logger *zap.SugaredLogger
+
+	symbols map[string]*symbolStore
+
+	sync.RWMutex
+}
+
+// symbolStore holds the blocks and transactions for a single symbol
+type symbolStore struct {
+	blocks map[string]*blocc.Block
+	txs    map[string]*blocc.Tx
+
+	// Secondary indexes for transactions
+	txsByBlockId map[string]map[string]struct{}
+	txsByAddress map[string]map[string]struct{}
+
+	// Outputs by outPointKey and the secondary index of unspent outputs by address
+	outputs                 map[string]*blocc.Output
+	unspentOutputsByAddress map[string]map[string]struct{}
+
+	// Address transactions by address and txId and the address summaries
+	addressTxs map[string]map[string]*blocc.AddressTx
+	addresses  map[string]*blocc.Address
+}
+
+// New creates an in-memory BlockChainStore. Nothing is persisted, it is meant for tests and small deployments
+func New() (*memory, error) {
+
+	m := &memory{
+		logger:  zap.S().With("package", "blockstore.memory"),
+		symbols: make(map[string]*symbolStore),
+	}
+
+	m.logger.Warn("Using in-memory block chain store, data will not be persisted")
+
+	return m, nil
+
+}
+
+// Init initializes the storage for a symbol
+func (m *memory) Init(symbol string) error {
+	m.Lock()
+	m.symbol(symbol, true)
+	m.Unlock()
+	return nil
+}
+
+// symbol returns the store for a symbol, optionally creating it. The caller must hold the lock.
+func (m *memory) symbol(symbol string, create bool) *symbolStore {
+	ss, ok := m.symbols[symbol]
+	if !ok && create {
+		ss = &symbolStore{
+			blocks:       make(map[string]*blocc.Block),
+			txs:          make(map[string]*blocc.Tx),
+			txsByBlockId: make(map[string]map[string]struct{}),
+			txsByAddress: make(map[string]map[string]struct{}),
+
+			outputs:                 make(map[string]*blocc.Output),
+			unspentOutputsByAddress: make(map[string]map[string]struct{}),
+
+			addressTxs: make(map[string]map[string]*blocc.AddressTx),
+			addresses:  make(map[string]*blocc.Address),
+		}
+		m.symbols[symbol] = ss
+	}
+	return ss
+}
+
+// putTx stores a transaction maintaining the secondary indexes
+func (ss *symbolStore) putTx(tx *blocc.Tx) {
+	if old, ok := ss.txs[tx.TxId]; ok {
+		ss.unindexTx(old)
+	}
+	ss.txs[tx.TxId] = tx
+	ss.indexTx(tx)
+}
+
+// deleteTx removes a transaction and it's index entries
+func (ss *symbolStore) deleteTx(txId string) {
+	if old, ok := ss.txs[txId]; ok {
+		ss.unindexTx(old)
+		delete(ss.txs, txId)
+	}
+}
+
+func (ss *symbolStore) indexTx(tx *blocc.Tx) {
+	addIndex(ss.txsByBlockId, tx.BlockId, tx.TxId)
+	for _, address := range store.TxAddresses(tx, blocc.TxFilterAddressInputOutput) {
+		addIndex(ss.txsByAddress, address, tx.TxId)
+	}
+}
+
+func (ss *symbolStore) unindexTx(tx *blocc.Tx) {
+	removeIndex(ss.txsByBlockId, tx.BlockId, tx.TxId)
+	for _, address := range store.TxAddresses(tx, blocc.TxFilterAddressInputOutput) {
+		removeIndex(ss.txsByAddress, address, tx.TxId)
+	}
+}
+
+// putOutput stores an output maintaining the unspent address index
+func (ss *symbolStore) putOutput(o *blocc.Output) {
+	key := outPointKey(o.TxId, o.Height)
+	if old, ok := ss.outputs[key]; ok {
+		ss.unindexOutput(key, old)
+	}
+	ss.outputs[key] = o
+	if store.IsUnspentOutput(o) {
+		for _, address := range o.Out.Addresses {
+			addIndex(ss.unspentOutputsByAddress, address, key)
+		}
+	}
+}
+
+// deleteOutput removes an output and it's index entries
+func (ss *symbolStore) deleteOutput(key string) {
+	if old, ok := ss.outputs[key]; ok {
+		ss.unindexOutput(key, old)
+		delete(ss.outputs, key)
+	}
+}
+
+func (ss *symbolStore) unindexOutput(key string, o *blocc.Output) {
+	if o.Out != nil {
+		for _, address := range o.Out.Addresses {
+			removeIndex(ss.unspentOutputsByAddress, address, key)
+		}
+	}
+}
+
+// outPointKey is the key of an output
+func outPointKey(txId string, height int64) string {
+	return txId + ":" + strconv.FormatInt(height, 10)
+}
+
+func addIndex(index map[string]map[string]struct{}, key string, id string) {
+	ids, ok := index[key]
+	if !ok {
+		ids = make(map[string]struct{})
+		index[key] = ids
+	}
+	ids[id] = struct{}{}
+}
+
+func removeIndex(index map[string]map[string]struct{}, key string, id string) {
+	if ids, ok := index[key]; ok {
+		delete(ids, id)
+		if len(ids) == 0 {
+			delete(index, key)
+		}
+	}
+}
+
+// copyBlock returns a copy of the block with only the included fields
+func copyBlock(b *blocc.Block, include blocc.BlockInclude) *blocc.Block {
+	return store.IncludeBlock(proto.Clone(b).(*blocc.Block), include)
+}
+
+// copyTx returns a copy of the transaction with only the included fields
+func copyTx(tx *blocc.Tx, include blocc.TxInclude) *blocc.Tx {
+	return store.IncludeTx(proto.Clone(tx).(*blocc.Tx), include)
+}
@@ -0,0 +1,160 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+func TestUpsertTransactionMerges(t *testing.T) {
+
+	m, err := New()
+	assert.Nil(t, err)
+
+	symbol := "sym"
+	assert.Nil(t, m.InsertTransaction(symbol, &blocc.Tx{
+		TxId:    "tx1",
+		BlockId: blocc.BlockIdMempool,
+		Time:    100,
+		TxSize:  250,
+		Out:     []*blocc.TxOut{{Addresses: []string{"addr1"}, Value: 1000}},
+		Data:    map[string]string{"fee": "10"},
+	}))
+
+	// Partial update should keep existing fields and merge data
+	assert.Nil(t, m.UpsertTransaction(symbol, &blocc.Tx{
+		TxId:        "tx1",
+		BlockId:     "block1",
+		BlockHeight: 5,
+		Data:        map[string]string{"received_time": "90"},
+	}))
+
+	tx, err := m.GetTxByTxId(symbol, "tx1", blocc.TxIncludeAll)
+	assert.Nil(t, err)
+	assert.Equal(t, "block1", tx.BlockId)
+	assert.Equal(t, int64(100), tx.Time)
+	assert.Equal(t, int64(250), tx.TxSize)
+	assert.Len(t, tx.Out, 1)
+	assert.Equal(t, map[string]string{"fee": "10", "received_time": "90"}, tx.Data)
+
+	// Indexes follow the update
+	size, count, err := m.GetMemPoolStats(symbol)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), size)
+	assert.Equal(t, int64(0), count)
+	txs, err := m.GetTxsByBlockId(symbol, "block1", blocc.TxIncludeHeader)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 1)
+
+	// Returned values are copies
+	tx.Data["fee"] = "20"
+	tx, err = m.GetTxByTxId(symbol, "tx1", blocc.TxIncludeAll)
+	assert.Nil(t, err)
+	assert.Equal(t, "10", tx.Data["fee"])
+
+}
+
+func TestFindTxsByAddressesAndTime(t *testing.T) {
+
+	m, err := New()
+	assert.Nil(t, err)
+
+	symbol := "sym"
+	for _, tx := range []*blocc.Tx{
+		{TxId: "tx1", Time: 100, Out: []*blocc.TxOut{{Addresses: []string{"addr1"}, Value: 1000}}},
+		{TxId: "tx2", Time: 200, In: []*blocc.TxIn{{TxId: "tx1", Out: &blocc.TxOut{Addresses: []string{"addr1"}, Value: 1000}}}, Out: []*blocc.TxOut{{Addresses: []string{"addr2"}, Value: 900}}},
+		{TxId: "tx3", Time: 300, Out: []*blocc.TxOut{{Addresses: []string{"addr3"}, Value: 5}}},
+	} {
+		assert.Nil(t, m.InsertTransaction(symbol, tx))
+	}
+
+	txs, err := m.FindTxsByAddressesAndTime(symbol, []string{"addr1"}, nil, nil, blocc.TxFilterAddressInputOutput, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	if assert.Len(t, txs, 2) {
+		assert.Equal(t, "tx2", txs[0].TxId)
+		assert.Equal(t, "tx1", txs[1].TxId)
+	}
+
+	txs, err = m.FindTxsByAddressesAndTime(symbol, []string{"addr1"}, nil, nil, blocc.TxFilterAddressOutput, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	if assert.Len(t, txs, 1) {
+		assert.Equal(t, "tx1", txs[0].TxId)
+	}
+
+	_, err = m.FindTxsByAddressesAndTime(symbol, []string{"nope"}, nil, nil, blocc.TxFilterAddressInputOutput, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+	count, received, spent, err := m.GetAddressStats(symbol, "addr1")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), count)
+	assert.Equal(t, int64(1000), received)
+	assert.Equal(t, int64(1000), spent)
+
+}
+
+func TestBlockDataFieldByHeight(t *testing.T) {
+
+	m, err := New()
+	assert.Nil(t, err)
+
+	symbol := "sym"
+	for i, fee := range []string{"0", "10", "20", "30", "40"} {
+		assert.Nil(t, m.InsertBlock(symbol, &blocc.Block{
+			BlockId: string(rune('a' + i)),
+			Height:  int64(i),
+			Data:    map[string]string{"fee_vsize_p10": fee},
+		}))
+	}
+
+	avg, err := m.AverageBlockDataFieldByHeight(symbol, "data.fee_vsize_p10", true, blocc.HeightUnknown, blocc.HeightUnknown)
+	assert.Nil(t, err)
+	assert.Equal(t, 25.0, avg)
+
+	avg, err = m.AverageBlockDataFieldByHeight(symbol, "data.fee_vsize_p10", false, 0, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 10.0, avg)
+
+	p, err := m.PercentileBlockDataFieldByHeight(symbol, "data.fee_vsize_p10", 50, true, blocc.HeightUnknown, blocc.HeightUnknown)
+	assert.Nil(t, err)
+	assert.Equal(t, 25.0, p)
+
+	_, err = m.AverageBlockDataFieldByHeight(symbol, "data.missing", true, blocc.HeightUnknown, blocc.HeightUnknown)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func TestGetBlockHeaderTopByStatuses(t *testing.T) {
+
+	m, err := New()
+	assert.Nil(t, err)
+
+	symbol := "sym"
+	_, err = m.GetBlockHeaderTopByStatuses(symbol, nil)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+	assert.Nil(t, m.InsertBlock(symbol, &blocc.Block{BlockId: "a", Height: 0, Status: blocc.StatusValid}))
+	assert.Nil(t, m.InsertBlock(symbol, &blocc.Block{BlockId: "b", Height: 1, PrevBlockId: "a", Status: blocc.StatusValid}))
+	assert.Nil(t, m.InsertBlock(symbol, &blocc.Block{BlockId: "d", Height: 5, PrevBlockId: "c", Status: blocc.StatusNew}))
+
+	bh, err := m.GetBlockHeaderTopByStatuses(symbol, []string{blocc.StatusValid})
+	assert.Nil(t, err)
+	assert.Equal(t, "b", bh.BlockId)
+
+	// Missing blocks between height 1 and 5
+	bh, err = m.GetBlockHeaderTopByStatuses(symbol, nil)
+	assert.True(t, blocc.IsValidationError(err))
+	assert.Equal(t, "d", bh.BlockId)
+
+	assert.Nil(t, m.UpdateBlockStatusByStatusesAndHeight(symbol, []string{blocc.StatusNew}, 2, blocc.HeightUnknown, blocc.StatusValid))
+	blks, err := m.FindBlocksByStatusAndHeight(symbol, []string{blocc.StatusValid}, blocc.HeightUnknown, blocc.HeightUnknown, blocc.BlockIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.Len(t, blks, 3)
+
+	assert.Nil(t, m.DeleteAboveBlockHeight(symbol, 1))
+	_, err = m.GetBlockByBlockId(symbol, "d", blocc.BlockIncludeHeader)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
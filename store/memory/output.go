@@ -0,0 +1,137 @@
+package memory
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// UpsertOutputs merges outputs with the existing outputs
+func (m *memory) UpsertOutputs(symbol string, outputs []*blocc.Output) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	ss := m.symbol(symbol, true)
+
+	for _, o := range outputs {
+		merged := new(blocc.Output)
+		if existing, ok := ss.outputs[outPointKey(o.TxId, o.Height)]; ok {
+			merged = proto.Clone(existing).(*blocc.Output)
+		}
+		store.MergeOutput(merged, proto.Clone(o).(*blocc.Output))
+		ss.putOutput(merged)
+	}
+
+	return nil
+
+}
+
+// UpdateOutputBlockIdByBlockId changes the BlockId and SpentBlockId of outputs
+func (m *memory) UpdateOutputBlockIdByBlockId(symbol string, blockId string, newBlockId string) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil
+	}
+
+	for _, o := range ss.outputs {
+		if o.BlockId == blockId {
+			o.BlockId = newBlockId
+		}
+		if o.SpentTxId != "" && o.SpentBlockId == blockId {
+			o.SpentBlockId = newBlockId
+		}
+	}
+
+	return nil
+
+}
+
+// RollbackOutputsByBlockId removes outputs created in a block and unspends the outputs spent in it
+func (m *memory) RollbackOutputsByBlockId(symbol string, blockId string) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil
+	}
+
+	for key, o := range ss.outputs {
+		if o.BlockId == blockId {
+			ss.deleteOutput(key)
+		} else if o.SpentTxId != "" && o.SpentBlockId == blockId {
+			o = proto.Clone(o).(*blocc.Output)
+			store.UnspendOutput(o)
+			ss.putOutput(o)
+		}
+	}
+
+	return nil
+
+}
+
+// GetOutputByOutPoint returns an output by txId and height
+func (m *memory) GetOutputByOutPoint(symbol string, txId string, height int64) (*blocc.Output, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	o, ok := ss.outputs[outPointKey(txId, height)]
+	if !ok {
+		return nil, blocc.ErrNotFound
+	}
+
+	return proto.Clone(o).(*blocc.Output), nil
+
+}
+
+// FindUnspentOutputsByAddresses returns the unspent outputs for addresses
+func (m *memory) FindUnspentOutputsByAddresses(symbol string, addresses []string, offset int, count int) ([]*blocc.Output, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	seen := make(map[string]struct{})
+	outputs := make([]*blocc.Output, 0)
+	for _, address := range addresses {
+		for key := range ss.unspentOutputsByAddress[address] {
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			outputs = append(outputs, ss.outputs[key])
+		}
+	}
+
+	if len(outputs) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	store.SortOutputsByTimeDesc(outputs)
+	start, end := store.Paginate(len(outputs), offset, count)
+
+	ret := make([]*blocc.Output, 0, end-start)
+	for _, o := range outputs[start:end] {
+		ret = append(ret, proto.Clone(o).(*blocc.Output))
+	}
+
+	return ret, nil
+
+}
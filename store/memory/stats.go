@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"fmt"
+	"strconv"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// AverageBlockDataFieldByHeight returns the average of a block data field between heights
+func (m *memory) AverageBlockDataFieldByHeight(symbol string, field string, omitZero bool, startHeight int64, endHeight int64) (float64, error) {
+
+	values, err := m.blockFieldValues(symbol, field, omitZero, startHeight, endHeight)
+	if err != nil {
+		return 0, err
+	}
+
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values)), nil
+}
+
+// PercentileBlockDataFieldByHeight returns the percentile of a block data field between heights
+func (m *memory) PercentileBlockDataFieldByHeight(symbol string, field string, percentile float64, omitZero bool, startHeight int64, endHeight int64) (float64, error) {
+
+	values, err := m.blockFieldValues(symbol, field, omitZero, startHeight, endHeight)
+	if err != nil {
+		return 0, err
+	}
+
+	return store.Percentile(values, percentile), nil
+}
+
+// blockFieldValues returns the values of a field (ie data.fee_vsize_p10) for all blocks between heights that have it
+func (m *memory) blockFieldValues(symbol string, field string, omitZero bool, startHeight int64, endHeight int64) ([]float64, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	values := make([]float64, 0)
+	for _, b := range ss.blocks {
+		if !store.InHeightRange(b.Height, startHeight, endHeight) {
+			continue
+		}
+		raw, ok := store.BlockField(b, field)
+		if !ok {
+			continue
+		}
+		// Skip zeros
+		if omitZero && store.IsZeroField(raw) {
+			continue
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Could not parse %s value %s for block %s: %v", field, raw, b.BlockId, err)
+		}
+		values = append(values, value)
+	}
+
+	if len(values) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	return values, nil
+
+}
@@ -0,0 +1,20 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store/storetest"
+)
+
+func TestBlockChainStore(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) (blocc.BlockChainStore, func()) {
+		m, err := New()
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+		return m, func() {}
+	})
+}
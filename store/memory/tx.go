@@ -0,0 +1,347 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// InsertTransaction inserts a transaction to the store
+func (m *memory) InsertTransaction(symbol string, tx *blocc.Tx) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.symbol(symbol, true).putTx(proto.Clone(tx).(*blocc.Tx))
+
+	return nil
+
+}
+
+// UpsertTransaction updates transaction data (essentially merging data object)
+func (m *memory) UpsertTransaction(symbol string, tx *blocc.Tx) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	ss := m.symbol(symbol, true)
+
+	src := proto.Clone(tx).(*blocc.Tx)
+	if existing, ok := ss.txs[tx.TxId]; ok {
+		merged := proto.Clone(existing).(*blocc.Tx)
+		store.MergeTx(merged, src)
+		src = merged
+	}
+	ss.putTx(src)
+
+	return nil
+
+}
+
+// DeleteTransactionsByBlockIdAndTime will remove transactions by BlockId
+func (m *memory) DeleteTransactionsByBlockIdAndTime(symbol string, blockId string, start *time.Time, end *time.Time) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil
+	}
+
+	for txId := range ss.txsByBlockId[blockId] {
+		if store.InTimeRange(ss.txs[txId].Time, start, end) {
+			ss.deleteTx(txId)
+		}
+	}
+
+	return nil
+}
+
+// GetTxByTxId will return a transaction by txId
+func (m *memory) GetTxByTxId(symbol string, txId string, include blocc.TxInclude) (*blocc.Tx, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	tx, ok := ss.txs[txId]
+	if !ok {
+		return nil, blocc.ErrNotFound
+	}
+
+	return copyTx(tx, include), nil
+
+}
+
+// GetTxsByTxIds returns multiple transaction by multiple txIds, missing transactions are skipped
+func (m *memory) GetTxsByTxIds(symbol string, txIds []string, include blocc.TxInclude) ([]*blocc.Tx, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	txs := make([]*blocc.Tx, 0)
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return txs, nil
+	}
+
+	for _, txId := range txIds {
+		if tx, ok := ss.txs[txId]; ok {
+			txs = append(txs, copyTx(tx, include))
+		}
+	}
+
+	return txs, nil
+
+}
+
+// GetTxsByBlockId returns multiple transaction by block Id ordered by height in the block
+func (m *memory) GetTxsByBlockId(symbol string, blockId string, include blocc.TxInclude) ([]*blocc.Tx, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	ret := make([]*blocc.Tx, 0, len(ss.txsByBlockId[blockId]))
+	for txId := range ss.txsByBlockId[blockId] {
+		ret = append(ret, copyTx(ss.txs[txId], include))
+	}
+
+	if len(ret) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	store.SortTxsByHeight(ret)
+
+	return ret, nil
+
+}
+
+// GetTxCountByBlockId will return the number of transactions by block Id in the store
+func (m *memory) GetTxCountByBlockId(symbol string, blockId string, includeIncomplete bool) (int64, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return 0, nil
+	}
+
+	var count int64
+	for txId := range ss.txsByBlockId[blockId] {
+		if includeIncomplete || !ss.txs[txId].Incomplete {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// FindTxs will find multiple transactions by optionally multiple fields
+func (m *memory) FindTxs(symbol string, txIds []string, blockId string, dataFields map[string]string, incomplete blocc.TxFilterIncomplete, start *time.Time, end *time.Time, include blocc.TxInclude, offset int, count int) ([]*blocc.Tx, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	// Narrow down the candidates using the most specific criteria
+	var candidates []*blocc.Tx
+	if len(txIds) > 0 {
+		seen := make(map[string]struct{})
+		for _, txId := range txIds {
+			if _, ok := seen[txId]; ok {
+				continue
+			}
+			seen[txId] = struct{}{}
+			if tx, ok := ss.txs[txId]; ok {
+				candidates = append(candidates, tx)
+			}
+		}
+	} else if blockId != "" {
+		for txId := range ss.txsByBlockId[blockId] {
+			candidates = append(candidates, ss.txs[txId])
+		}
+	} else {
+		for _, tx := range ss.txs {
+			candidates = append(candidates, tx)
+		}
+	}
+
+	txs := make([]*blocc.Tx, 0)
+	for _, tx := range candidates {
+		if blockId != "" && tx.BlockId != blockId {
+			continue
+		}
+		if !store.MatchDataFields(tx.Data, dataFields) {
+			continue
+		}
+		if incomplete == blocc.TxFilterIncompleteTrue && !tx.Incomplete {
+			continue
+		} else if incomplete == blocc.TxFilterIncompleteFalse && tx.Incomplete {
+			continue
+		}
+		if !store.InTimeRange(tx.Time, start, end) {
+			continue
+		}
+		txs = append(txs, tx)
+	}
+
+	return pageTxs(txs, include, offset, count)
+
+}
+
+// FindTxsByAddressesAndTime will find transactions by optionally addresses, time and pagination
+func (m *memory) FindTxsByAddressesAndTime(symbol string, addresses []string, start *time.Time, end *time.Time, filter blocc.TxFilterAddress, include blocc.TxInclude, offset int, count int) ([]*blocc.Tx, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	var candidates []*blocc.Tx
+	if filter&blocc.TxFilterAddressInputOutput != 0 {
+		seen := make(map[string]struct{})
+		for _, address := range addresses {
+			for txId := range ss.txsByAddress[address] {
+				if _, ok := seen[txId]; ok {
+					continue
+				}
+				seen[txId] = struct{}{}
+				candidates = append(candidates, ss.txs[txId])
+			}
+		}
+	} else {
+		for _, tx := range ss.txs {
+			candidates = append(candidates, tx)
+		}
+	}
+
+	txs := make([]*blocc.Tx, 0)
+	for _, tx := range candidates {
+		// The index covers inputs and outputs, check the specific side if required
+		if filter&blocc.TxFilterAddressInputOutput != 0 && filter&blocc.TxFilterAddressInputOutput != blocc.TxFilterAddressInputOutput {
+			if !store.TxHasAddress(tx, addresses, filter) {
+				continue
+			}
+		}
+		if !store.InTimeRange(tx.Time, start, end) {
+			continue
+		}
+		txs = append(txs, tx)
+	}
+
+	return pageTxs(txs, include, offset, count)
+
+}
+
+// UpdateTxBlockIdByBlockId will update the blockId of a transaction to a new block id
+func (m *memory) UpdateTxBlockIdByBlockId(symbol string, blockId string, newBlockId string) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return nil
+	}
+
+	for txId := range ss.txsByBlockId[blockId] {
+		tx := proto.Clone(ss.txs[txId]).(*blocc.Tx)
+		tx.BlockId = newBlockId
+		ss.putTx(tx)
+	}
+
+	return nil
+
+}
+
+// GetMemPoolStats returns the size and count of the mempool
+func (m *memory) GetMemPoolStats(symbol string) (int64, int64, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return 0, 0, nil
+	}
+
+	var size, count int64
+	for _, blockId := range blocc.MemPoolBlockIds {
+		for txId := range ss.txsByBlockId[blockId] {
+			size += ss.txs[txId].TxSize
+			count++
+		}
+	}
+
+	return size, count, nil
+
+}
+
+// GetAddressStats returns the transaction count, value received and value spent for an address
+func (m *memory) GetAddressStats(symbol string, address string) (int64, int64, int64, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	ss := m.symbol(symbol, false)
+	if ss == nil {
+		return 0, 0, 0, nil
+	}
+
+	var count, outputValue, inputValue int64
+	for txId := range ss.txsByAddress[address] {
+		out, in := store.TxAddressValues(ss.txs[txId], address)
+		outputValue += out
+		inputValue += in
+		count++
+	}
+
+	return count, outputValue, inputValue, nil
+
+}
+
+// FlushTransactions is a no-op, writes are visible immediately
+func (m *memory) FlushTransactions(symbol string) error {
+	return nil
+}
+
+// pageTxs sorts transactions by time descending and returns copies of the requested page
+func pageTxs(txs []*blocc.Tx, include blocc.TxInclude, offset int, count int) ([]*blocc.Tx, error) {
+
+	if len(txs) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	store.SortTxsByTimeDesc(txs)
+
+	from, to := store.Paginate(len(txs), offset, count)
+	ret := make([]*blocc.Tx, 0, to-from)
+	for _, tx := range txs[from:to] {
+		ret = append(ret, copyTx(tx, include))
+	}
+
+	return ret, nil
+
+}
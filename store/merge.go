@@ -0,0 +1,118 @@
+package store
+
+import (
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// MergeTx merges src into dst the same way a partial document update would. Empty fields
+// are omitted and leave the existing value, lists are replaced and maps are merged.
+func MergeTx(dst *blocc.Tx, src *blocc.Tx) {
+	if src.Symbol != "" {
+		dst.Symbol = src.Symbol
+	}
+	if src.BlockId != "" {
+		dst.BlockId = src.BlockId
+	}
+	if src.BlockHeight != 0 {
+		dst.BlockHeight = src.BlockHeight
+	}
+	if src.BlockTime != 0 {
+		dst.BlockTime = src.BlockTime
+	}
+	if src.Height != 0 {
+		dst.Height = src.Height
+	}
+	if src.Time != 0 {
+		dst.Time = src.Time
+	}
+	if src.TxSize != 0 {
+		dst.TxSize = src.TxSize
+	}
+	// Incomplete is never omitted
+	dst.Incomplete = src.Incomplete
+	if len(src.In) > 0 {
+		dst.In = src.In
+	}
+	if len(src.Out) > 0 {
+		dst.Out = src.Out
+	}
+	if len(src.Raw) > 0 {
+		dst.Raw = src.Raw
+	}
+	if len(src.Data) > 0 {
+		if dst.Data == nil {
+			dst.Data = make(map[string]string)
+		}
+		for k, v := range src.Data {
+			dst.Data[k] = v
+		}
+	}
+	if len(src.Metric) > 0 {
+		if dst.Metric == nil {
+			dst.Metric = make(map[string]float64)
+		}
+		for k, v := range src.Metric {
+			dst.Metric[k] = v
+		}
+	}
+}
+
+// UpdateBlockFields applies the fields of BlockChainStore.UpdateBlock to a block, empty values are left untouched
+func UpdateBlockFields(b *blocc.Block, status string, nextBlockId string, data map[string]string, metric map[string]float64) {
+	if status != "" {
+		b.Status = status
+	}
+	if nextBlockId != "" {
+		b.NextBlockId = nextBlockId
+	}
+	// Data and metrics are merged like a partial document update
+	if data != nil {
+		if b.Data == nil {
+			b.Data = make(map[string]string)
+		}
+		for k, v := range data {
+			b.Data[k] = v
+		}
+	}
+	if metric != nil {
+		if b.Metric == nil {
+			b.Metric = make(map[string]float64)
+		}
+		for k, v := range metric {
+			b.Metric[k] = v
+		}
+	}
+}
+
+// MergeOutput merges src into dst following BlockChainStore.UpsertOutputs. The output fields are only set when
+// src.BlockId is provided and the spending fields when src.SpentTxId is provided. A spend in a block is never
+// replaced by a spend in the mempool.
+func MergeOutput(dst *blocc.Output, src *blocc.Output) {
+	if src.Symbol != "" {
+		dst.Symbol = src.Symbol
+	}
+	dst.TxId = src.TxId
+	dst.Height = src.Height
+	if src.BlockId != "" {
+		dst.BlockId = src.BlockId
+		dst.BlockHeight = src.BlockHeight
+		dst.Time = src.Time
+		if src.Out != nil {
+			dst.Out = src.Out
+		}
+	}
+	if src.SpentTxId != "" && (dst.SpentTxId == "" || blocc.IsMemPool(dst.SpentBlockId) || !blocc.IsMemPool(src.SpentBlockId)) {
+		dst.SpentTxId = src.SpentTxId
+		dst.SpentHeight = src.SpentHeight
+		dst.SpentBlockId = src.SpentBlockId
+		dst.SpentBlockHeight = src.SpentBlockHeight
+	}
+}
+
+// UnspendOutput clears the spending fields of an output
+func UnspendOutput(o *blocc.Output) {
+	o.SpentTxId = ""
+	o.SpentHeight = 0
+	o.SpentBlockId = ""
+	o.SpentBlockHeight = 0
+}
@@ -0,0 +1,214 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/lib/pq"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+const (
+	addressTxColumns = "symbol, address, tx_id, block_id, block_height, time, received, sent"
+	addressColumns   = "symbol, address, tx_count, received, sent, balance, mempool_tx_count, mempool_received, mempool_sent, mempool_balance, " +
+		"first_seen_block_id, first_seen_block_height, last_seen_block_id, last_seen_block_height"
+)
+
+// UpsertAddressTxs replaces address transactions and updates the address summaries
+func (p *postgres) UpsertAddressTxs(symbol string, atxs []*blocc.AddressTx) error {
+
+	if len(atxs) == 0 {
+		return nil
+	}
+
+	return p.write(func(sqlTx *sql.Tx) error {
+		for _, atx := range atxs {
+			// Lock the existing address transaction so concurrent updates are applied in turn
+			old, err := queryAddressTxs(sqlTx, "SELECT "+addressTxColumns+" FROM address_tx WHERE symbol = $1 AND address = $2 AND tx_id = $3 FOR UPDATE",
+				symbol, atx.Address, atx.TxId)
+			if err != nil {
+				return fmt.Errorf("Could not get address tx: %v", err)
+			}
+			var existing *blocc.AddressTx
+			if len(old) > 0 {
+				existing = old[0]
+			}
+			if err = updateAddressTx(sqlTx, symbol, existing, atx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+}
+
+// UpdateAddressTxBlockIdByBlockId changes the BlockId of address transactions
+func (p *postgres) UpdateAddressTxBlockIdByBlockId(symbol string, blockId string, newBlockId string) error {
+
+	return p.write(func(sqlTx *sql.Tx) error {
+		return updateAddressTxsWhere(sqlTx, symbol, "block_id = $2", blockId, func(atx *blocc.AddressTx) *blocc.AddressTx {
+			updated := proto.Clone(atx).(*blocc.AddressTx)
+			updated.BlockId = newBlockId
+			return updated
+		})
+	})
+
+}
+
+// RollbackAddressTxsByBlockId removes the address transactions in a block from the address summaries
+func (p *postgres) RollbackAddressTxsByBlockId(symbol string, blockId string) error {
+
+	return p.write(func(sqlTx *sql.Tx) error {
+		return updateAddressTxsWhere(sqlTx, symbol, "block_id = $2", blockId, func(atx *blocc.AddressTx) *blocc.AddressTx {
+			return nil
+		})
+	})
+
+}
+
+// GetAddress returns the summary of an address
+func (p *postgres) GetAddress(symbol string, address string) (*blocc.Address, error) {
+
+	a, err := getAddress(p.db, symbol, address, false)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get address: %v", err)
+	}
+
+	if a == nil {
+		return nil, blocc.ErrNotFound
+	}
+
+	return a, nil
+
+}
+
+// updateAddressTxsWhere replaces each address transaction matching where (with $2 as arg) with the result of fn
+func updateAddressTxsWhere(sqlTx *sql.Tx, symbol string, where string, arg interface{}, fn func(atx *blocc.AddressTx) *blocc.AddressTx) error {
+
+	atxs, err := queryAddressTxs(sqlTx, "SELECT "+addressTxColumns+" FROM address_tx WHERE symbol = $1 AND "+where+" FOR UPDATE", symbol, arg)
+	if err != nil {
+		return fmt.Errorf("Could not find address txs: %v", err)
+	}
+
+	for _, atx := range atxs {
+		if err = updateAddressTx(sqlTx, symbol, atx, fn(atx)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
+// updateAddressTx replaces the address transaction old with new where either may be nil and updates the summary
+func updateAddressTx(sqlTx *sql.Tx, symbol string, old *blocc.AddressTx, new *blocc.AddressTx) error {
+
+	address := old.GetAddress()
+	if new != nil {
+		address = new.Address
+	}
+
+	a, err := getAddress(sqlTx, symbol, address, true)
+	if err != nil {
+		return fmt.Errorf("Could not get address: %v", err)
+	}
+	if a == nil {
+		a = &blocc.Address{Symbol: symbol, Address: address}
+	}
+	reseen := store.UpdateAddress(a, old, new)
+
+	if old != nil {
+		if _, err = sqlTx.Exec("DELETE FROM address_tx WHERE symbol = $1 AND address = $2 AND tx_id = $3", symbol, old.Address, old.TxId); err != nil {
+			return fmt.Errorf("Could not delete address tx: %v", err)
+		}
+	}
+	if new != nil {
+		_, err = sqlTx.Exec("INSERT INTO address_tx ("+addressTxColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+			symbol, new.Address, new.TxId, new.BlockId, new.BlockHeight, new.Time, new.Received, new.Sent)
+		if err != nil {
+			return fmt.Errorf("Could not insert address tx: %v", err)
+		}
+	}
+
+	if reseen {
+		// Only the first and last confirmed address transactions matter
+		seen := make([]*blocc.AddressTx, 0, 2)
+		for _, order := range []string{"block_height, block_id", "block_height DESC, block_id"} {
+			atxs, err := queryAddressTxs(sqlTx, "SELECT "+addressTxColumns+" FROM address_tx WHERE symbol = $1 AND address = $2 AND NOT block_id = ANY($3) ORDER BY "+order+" LIMIT 1",
+				symbol, address, pq.Array(blocc.MemPoolBlockIds))
+			if err != nil {
+				return fmt.Errorf("Could not find address seen: %v", err)
+			}
+			seen = append(seen, atxs...)
+		}
+		store.SetAddressSeen(a, seen)
+	}
+
+	if store.IsEmptyAddress(a) {
+		_, err = sqlTx.Exec("DELETE FROM address WHERE symbol = $1 AND address = $2", symbol, address)
+		return err
+	}
+
+	_, err = sqlTx.Exec(`INSERT INTO address (`+addressColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (symbol, address) DO UPDATE SET
+			tx_count = EXCLUDED.tx_count, received = EXCLUDED.received, sent = EXCLUDED.sent, balance = EXCLUDED.balance,
+			mempool_tx_count = EXCLUDED.mempool_tx_count, mempool_received = EXCLUDED.mempool_received,
+			mempool_sent = EXCLUDED.mempool_sent, mempool_balance = EXCLUDED.mempool_balance,
+			first_seen_block_id = EXCLUDED.first_seen_block_id, first_seen_block_height = EXCLUDED.first_seen_block_height,
+			last_seen_block_id = EXCLUDED.last_seen_block_id, last_seen_block_height = EXCLUDED.last_seen_block_height`,
+		symbol, address, a.TxCount, a.Received, a.Sent, a.Balance, a.MempoolTxCount, a.MempoolReceived, a.MempoolSent, a.MempoolBalance,
+		a.FirstSeenBlockId, a.FirstSeenBlockHeight, a.LastSeenBlockId, a.LastSeenBlockHeight)
+	if err != nil {
+		return fmt.Errorf("Could not upsert address: %v", err)
+	}
+
+	return nil
+
+}
+
+// getAddress returns an address summary or nil if it does not exist, optionally locking it
+func getAddress(q querier, symbol string, address string, lock bool) (*blocc.Address, error) {
+
+	query := "SELECT " + addressColumns + " FROM address WHERE symbol = $1 AND address = $2"
+	if lock {
+		query += " FOR UPDATE"
+	}
+
+	a := new(blocc.Address)
+	err := q.QueryRow(query, symbol, address).Scan(&a.Symbol, &a.Address, &a.TxCount, &a.Received, &a.Sent, &a.Balance,
+		&a.MempoolTxCount, &a.MempoolReceived, &a.MempoolSent, &a.MempoolBalance,
+		&a.FirstSeenBlockId, &a.FirstSeenBlockHeight, &a.LastSeenBlockId, &a.LastSeenBlockHeight)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+
+}
+
+// queryAddressTxs runs a query selecting addressTxColumns
+func queryAddressTxs(q querier, query string, args ...interface{}) ([]*blocc.AddressTx, error) {
+
+	rows, err := q.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	atxs := make([]*blocc.AddressTx, 0)
+	for rows.Next() {
+		atx := new(blocc.AddressTx)
+		if err := rows.Scan(&atx.Symbol, &atx.Address, &atx.TxId, &atx.BlockId, &atx.BlockHeight, &atx.Time, &atx.Received, &atx.Sent); err != nil {
+			return nil, err
+		}
+		atxs = append(atxs, atx)
+	}
+
+	return atxs, rows.Err()
+
+}
@@ -0,0 +1,396 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/lib/pq"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// InsertBlock replaces a block
+func (p *postgres) InsertBlock(symbol string, b *blocc.Block) error {
+
+	// Copy the block so the caller can modify it
+	b = proto.Clone(b).(*blocc.Block)
+
+	return p.queueBlock(symbol, b.BlockId, func(pb *pendingBlock) {
+		pb.block = b
+		pb.replace = true
+	})
+
+}
+
+// UpdateBlock updates status and data based on blockId, the block will be created if it does not exist
+func (p *postgres) UpdateBlock(symbol string, blockId string, status string, nextBlockId string, data map[string]string, metric map[string]float64) error {
+
+	return p.queueBlock(symbol, blockId, func(pb *pendingBlock) {
+		store.UpdateBlockFields(pb.block, status, nextBlockId, data, metric)
+	})
+
+}
+
+// UpdateBlockStatusByStatusesAndHeight updates status between heights
+func (p *postgres) UpdateBlockStatusByStatusesAndHeight(symbol string, statuses []string, startHeight int64, endHeight int64, status string) error {
+
+	return p.write(func(sqlTx *sql.Tx) error {
+		c := newConditions(symbol)
+		c.addStatuses(statuses)
+		c.addHeightRange("height", startHeight, endHeight)
+		// If it's already the same status, don't bother
+		c.add("status <> ?", status)
+		query := "UPDATE block SET status = " + c.arg(status) + c.where()
+		_, err := sqlTx.Exec(query, c.args...)
+		return err
+	})
+
+}
+
+// DeleteBlockByBlockId removes a block by BlockId
+func (p *postgres) DeleteBlockByBlockId(symbol string, blockId string) error {
+
+	var deleted int64
+	err := p.write(func(sqlTx *sql.Tx) error {
+		result, err := sqlTx.Exec("DELETE FROM block WHERE symbol = $1 AND block_id = $2", symbol, blockId)
+		if err != nil {
+			return err
+		}
+		deleted, err = result.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return blocc.ErrNotFound
+	}
+
+	return nil
+}
+
+// DeleteAboveBlockHeight removes blocks, transactions, outputs and address transactions above a block height
+func (p *postgres) DeleteAboveBlockHeight(symbol string, above int64) error {
+
+	return p.write(func(sqlTx *sql.Tx) error {
+		if _, err := sqlTx.Exec("DELETE FROM block WHERE symbol = $1 AND height > $2", symbol, above); err != nil {
+			return fmt.Errorf("Could not delete blocks: %v", err)
+		}
+		// Inputs and outputs cascade
+		if _, err := sqlTx.Exec("DELETE FROM tx WHERE symbol = $1 AND block_height > $2", symbol, above); err != nil {
+			return fmt.Errorf("Could not delete tx: %v", err)
+		}
+		if _, err := sqlTx.Exec("DELETE FROM output WHERE symbol = $1 AND block_height > $2", symbol, above); err != nil {
+			return fmt.Errorf("Could not delete outputs: %v", err)
+		}
+		_, err := sqlTx.Exec(`UPDATE output SET spent_tx_id = '', spent_height = 0, spent_block_id = '', spent_block_height = 0
+			WHERE symbol = $1 AND spent_tx_id <> '' AND spent_block_height > $2`, symbol, above)
+		if err != nil {
+			return fmt.Errorf("Could not unspend outputs: %v", err)
+		}
+		err = updateAddressTxsWhere(sqlTx, symbol, "block_height > $2", above, func(atx *blocc.AddressTx) *blocc.AddressTx {
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Could not delete address txs: %v", err)
+		}
+		return nil
+	})
+
+}
+
+// FlushBlocks writes all pending changes
+func (p *postgres) FlushBlocks(symbol string) error {
+
+	if err := p.flush(); err != nil {
+		return err
+	}
+
+	// Return the background flush error (if there was one)
+	return p.flushError()
+
+}
+
+// GetBlockHeaderTopByStatuses will return the top block header as it stands
+func (p *postgres) GetBlockHeaderTopByStatuses(symbol string, statuses []string) (*blocc.BlockHeader, error) {
+
+	c := newConditions(symbol)
+	c.addStatuses(statuses)
+
+	bh := new(blocc.BlockHeader)
+	var total int64
+
+	// The window is counted before the limit is applied
+	err := p.db.QueryRow("SELECT symbol, block_id, height, prev_block_id, time, COUNT(*) OVER () FROM block"+c.where()+
+		" ORDER BY height DESC, block_id DESC LIMIT 1", c.args...).Scan(&bh.Symbol, &bh.BlockId, &bh.Height, &bh.PrevBlockId, &bh.Time, &total)
+	if err == sql.ErrNoRows {
+		return nil, blocc.ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("Could not get top block: %v", err)
+	}
+
+	// Return the height but also an error indicating the height and the number of blocks do not match up (ie missing data)
+	if bh.Height > total+1 {
+		return bh, fmt.Errorf("Validation Error: Missing Blocks Detected height:%d blocks:%d", bh.Height, total)
+	} else if bh.Height+1 < total {
+		return bh, fmt.Errorf("Validation Error: Missing Blocks Detected height:%d blocks:%d", bh.Height, total)
+	}
+
+	return bh, nil
+}
+
+// GetBlockByBlockId gets a block by blockId
+func (p *postgres) GetBlockByBlockId(symbol string, blockId string, include blocc.BlockInclude) (*blocc.Block, error) {
+
+	c := newConditions(symbol)
+	c.add("block_id = ?", blockId)
+
+	blks, err := queryBlocks(p.db, "SELECT "+blockColumns(include)+" FROM block"+c.where(), c.args)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get block: %v", err)
+	}
+
+	if len(blks) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	return blks[0], nil
+
+}
+
+// GetBlockTopByStatuses gets the top block
+func (p *postgres) GetBlockTopByStatuses(symbol string, statuses []string, include blocc.BlockInclude) (*blocc.Block, error) {
+
+	// Determine the tip
+	bh, err := p.GetBlockHeaderTopByStatuses(symbol, statuses)
+	// If it's not a validation error, there's still data
+	if err != nil && !blocc.IsValidationError(err) {
+		return nil, err
+	}
+
+	blk, newErr := p.GetBlockByBlockId(symbol, bh.BlockId, include)
+	if newErr != nil {
+		return nil, newErr
+	}
+
+	// Return the block and original error if any
+	return blk, err
+
+}
+
+// FindBlocksByHeight fetches blocks by height
+func (p *postgres) FindBlocksByHeight(symbol string, height int64, include blocc.BlockInclude) ([]*blocc.Block, error) {
+	c := newConditions(symbol)
+	c.add("height = ?", height)
+	return p.findBlocks(c, include)
+}
+
+// FindBlocksByPrevBlockId returns blocks by previous blockId
+func (p *postgres) FindBlocksByPrevBlockId(symbol string, prevBlockId string, include blocc.BlockInclude) ([]*blocc.Block, error) {
+	c := newConditions(symbol)
+	c.add("prev_block_id = ?", prevBlockId)
+	return p.findBlocks(c, include)
+}
+
+// FindBlocksByTxId returns blocks with TxId
+func (p *postgres) FindBlocksByTxId(symbol string, txId string, include blocc.BlockInclude) ([]*blocc.Block, error) {
+	c := newConditions(symbol)
+	c.add("tx_ids @> ARRAY[?::text]", txId)
+	return p.findBlocks(c, include)
+}
+
+// FindBlocksByBlockIdsAndTime returns blocks optionally by blockId, time and pagination by descending time
+func (p *postgres) FindBlocksByBlockIdsAndTime(symbol string, blockIds []string, start *time.Time, end *time.Time, include blocc.BlockInclude, offset int, count int) ([]*blocc.Block, error) {
+
+	c := newConditions(symbol)
+	if len(blockIds) > 0 {
+		c.add("block_id = ANY(?)", pq.Array(blockIds))
+	}
+	c.addTimeRange("time", start, end)
+
+	return p.pageBlocks(c, "time DESC, block_id", include, offset, count)
+
+}
+
+// FindBlocksByStatusAndHeight returns blocks by status and height ascending height
+func (p *postgres) FindBlocksByStatusAndHeight(symbol string, statuses []string, startHeight int64, endHeight int64, include blocc.BlockInclude, offset int, count int) ([]*blocc.Block, error) {
+
+	c := newConditions(symbol)
+	c.addStatuses(statuses)
+	c.addHeightRange("height", startHeight, endHeight)
+
+	return p.pageBlocks(c, "height, block_id", include, offset, count)
+
+}
+
+// findBlocks returns all blocks matching the conditions by descending time
+func (p *postgres) findBlocks(c *conditions, include blocc.BlockInclude) ([]*blocc.Block, error) {
+
+	blks, err := queryBlocks(p.db, "SELECT "+blockColumns(include)+" FROM block"+c.where()+" ORDER BY time DESC, block_id", c.args)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get block: %v", err)
+	}
+
+	if len(blks) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	return blks, nil
+
+}
+
+// pageBlocks returns a page of blocks, it's only not found if nothing matches at all
+func (p *postgres) pageBlocks(c *conditions, order string, include blocc.BlockInclude, offset int, count int) ([]*blocc.Block, error) {
+
+	where := c.where()
+	args := append([]interface{}{}, c.args...)
+
+	query := "SELECT " + blockColumns(include) + " FROM block" + where + " ORDER BY " + order + c.limit(offset, count)
+	blks, err := queryBlocks(p.db, query, c.args)
+	if err != nil {
+		return nil, fmt.Errorf("Could not find blocks: %v", err)
+	}
+
+	if len(blks) == 0 {
+		// An empty page past the end is not an error
+		var exists bool
+		if err := p.db.QueryRow("SELECT EXISTS (SELECT 1 FROM block"+where+")", args...).Scan(&exists); err != nil {
+			return nil, fmt.Errorf("Could not find blocks: %v", err)
+		}
+		if !exists {
+			return nil, blocc.ErrNotFound
+		}
+	}
+
+	return blks, nil
+
+}
+
+// blockColumns returns the columns to select, excluded fields are selected as NULL
+func blockColumns(include blocc.BlockInclude) string {
+	txIds, raw, data := "tx_ids", "raw", "data"
+	if include&blocc.BlockIncludeTxIds == 0 {
+		txIds = "NULL"
+	}
+	if include&blocc.BlockIncludeRaw == 0 {
+		raw = "NULL"
+	}
+	if include&blocc.BlockIncludeData == 0 {
+		data = "NULL"
+	}
+	return "symbol, block_id, height, prev_block_id, next_block_id, time, tx_count, size, status, incomplete, " + txIds + ", " + raw + ", " + data + ", metric"
+}
+
+// queryBlocks runs a query that selects blockColumns
+func queryBlocks(q querier, query string, args []interface{}) ([]*blocc.Block, error) {
+
+	rows, err := q.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	blks := make([]*blocc.Block, 0)
+	for rows.Next() {
+		b := new(blocc.Block)
+		var raw []byte
+		var data, metric sql.NullString
+		err := rows.Scan(&b.Symbol, &b.BlockId, &b.Height, &b.PrevBlockId, &b.NextBlockId, &b.Time, &b.TxCount, &b.BlockSize,
+			&b.Status, &b.Incomplete, pq.Array(&b.TxIds), &raw, &data, &metric)
+		if err != nil {
+			return nil, err
+		}
+		b.Raw = raw
+		if b.Data, err = scanData(data); err != nil {
+			return nil, fmt.Errorf("Could not parse Block %s data: %v", b.BlockId, err)
+		}
+		if b.Metric, err = scanMetric(metric); err != nil {
+			return nil, fmt.Errorf("Could not parse Block %s metric: %v", b.BlockId, err)
+		}
+		blks = append(blks, b)
+	}
+
+	return blks, rows.Err()
+
+}
+
+// writeBlocks merges partial blocks with the stored block and replaces them all using COPY
+func writeBlocks(sqlTx *sql.Tx, pending []*pendingBlock) error {
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	// Load the blocks that are partial updates
+	partial := make(map[string][]string)
+	for _, pb := range pending {
+		if !pb.replace {
+			partial[pb.symbol] = append(partial[pb.symbol], pb.block.BlockId)
+		}
+	}
+	existing := make(map[string]*blocc.Block)
+	for symbol, blockIds := range partial {
+		blks, err := queryBlocks(sqlTx, "SELECT "+blockColumns(blocc.BlockIncludeAll)+" FROM block WHERE symbol = $1 AND block_id = ANY($2) FOR UPDATE",
+			[]interface{}{symbol, pq.Array(blockIds)})
+		if err != nil {
+			return err
+		}
+		for _, b := range blks {
+			existing[symbol+"/"+b.BlockId] = b
+		}
+	}
+
+	// Remove the old versions
+	ids := make(map[string][]string)
+	for _, pb := range pending {
+		ids[pb.symbol] = append(ids[pb.symbol], pb.block.BlockId)
+	}
+	for symbol, blockIds := range ids {
+		if _, err := sqlTx.Exec("DELETE FROM block WHERE symbol = $1 AND block_id = ANY($2)", symbol, pq.Array(blockIds)); err != nil {
+			return err
+		}
+	}
+
+	stmt, err := sqlTx.Prepare(pq.CopyIn("block", "symbol", "block_id", "height", "prev_block_id", "next_block_id", "time", "tx_count", "size",
+		"status", "incomplete", "tx_ids", "raw", "data", "metric"))
+	if err != nil {
+		return err
+	}
+
+	for _, pb := range pending {
+		b := pb.block
+		if b2, ok := existing[pb.symbol+"/"+b.BlockId]; ok && !pb.replace {
+			store.UpdateBlockFields(b2, b.Status, b.NextBlockId, b.Data, b.Metric)
+			b = b2
+		}
+		data, err := jsonValue(b.Data)
+		if err != nil {
+			stmt.Close()
+			return err
+		}
+		metric, err := jsonValue(b.Metric)
+		if err != nil {
+			stmt.Close()
+			return err
+		}
+		_, err = stmt.Exec(pb.symbol, b.BlockId, b.Height, b.PrevBlockId, b.NextBlockId, b.Time, b.TxCount, b.BlockSize,
+			b.Status, b.Incomplete, arrayValue(b.TxIds), bytesValue(b.Raw), data, metric)
+		if err != nil {
+			stmt.Close()
+			return err
+		}
+	}
+
+	// Executing without arguments completes the copy
+	if _, err = stmt.Exec(); err != nil {
+		stmt.Close()
+		return err
+	}
+
+	return stmt.Close()
+
+}
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"git.coinninja.net/backend/blocc/embed"
+)
+
+// Key for the advisory lock held while migrating so multiple instances don't race
+const migrationLockId = 7478836
+
+// migrate applies the embedded postgres/NNNN_name.sql migrations that have not been applied yet
+func (p *postgres) migrate() error {
+
+	conn, err := p.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// The lock is held by the session so use a single connection
+	if _, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockId); err != nil {
+		return fmt.Errorf("Could not get migration lock: %v", err)
+	}
+	defer conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", migrationLockId)
+
+	_, err = conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		name       TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`)
+	if err != nil {
+		return fmt.Errorf("Could not create schema_migrations: %v", err)
+	}
+
+	names, err := embed.AssetDir("postgres")
+	if err != nil {
+		return fmt.Errorf("Could not find migrations: %v", err)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+
+		if !strings.HasSuffix(name, ".sql") {
+			continue
+		}
+		version, err := strconv.Atoi(strings.SplitN(name, "_", 2)[0])
+		if err != nil {
+			return fmt.Errorf("Invalid migration name %s: %v", name, err)
+		}
+
+		var applied bool
+		if err = conn.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $1)", version).Scan(&applied); err != nil {
+			return err
+		}
+		if applied {
+			continue
+		}
+
+		migration, err := embed.Asset("postgres/" + name)
+		if err != nil {
+			return fmt.Errorf("Could not load migration %s: %v", name, err)
+		}
+
+		sqlTx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if err = applyMigration(sqlTx, version, name, string(migration)); err != nil {
+			sqlTx.Rollback()
+			return fmt.Errorf("Could not apply migration %s: %v", name, err)
+		}
+		if err = sqlTx.Commit(); err != nil {
+			return fmt.Errorf("Could not apply migration %s: %v", name, err)
+		}
+
+		p.logger.Infow("Applied migration", "version", version, "name", name)
+
+	}
+
+	return nil
+
+}
+
+// applyMigration runs a migration and records it
+func applyMigration(sqlTx *sql.Tx, version int, name string, migration string) error {
+	if _, err := sqlTx.Exec(migration); err != nil {
+		return err
+	}
+	_, err := sqlTx.Exec("INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", version, name)
+	return err
+}
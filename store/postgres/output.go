@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+const outputColumns = "symbol, tx_id, height, block_id, block_height, time, has_out, type, addresses, value, raw, data, metric, spent_tx_id, spent_height, spent_block_id, spent_block_height"
+
+// UpsertOutputs merges outputs with the existing outputs
+func (p *postgres) UpsertOutputs(symbol string, outputs []*blocc.Output) error {
+
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	return p.write(func(sqlTx *sql.Tx) error {
+
+		stmt, err := sqlTx.Prepare(`INSERT INTO output (` + outputColumns + `)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+			ON CONFLICT (symbol, tx_id, height) DO UPDATE SET
+				block_id = EXCLUDED.block_id, block_height = EXCLUDED.block_height, time = EXCLUDED.time,
+				has_out = EXCLUDED.has_out, type = EXCLUDED.type, addresses = EXCLUDED.addresses, value = EXCLUDED.value,
+				raw = EXCLUDED.raw, data = EXCLUDED.data, metric = EXCLUDED.metric,
+				spent_tx_id = EXCLUDED.spent_tx_id, spent_height = EXCLUDED.spent_height,
+				spent_block_id = EXCLUDED.spent_block_id, spent_block_height = EXCLUDED.spent_block_height`)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, o := range outputs {
+
+			// Lock the existing output so concurrent merges don't lose a spend
+			existing, err := queryOutputs(sqlTx, "SELECT "+outputColumns+" FROM output WHERE symbol = $1 AND tx_id = $2 AND height = $3 FOR UPDATE",
+				[]interface{}{symbol, o.TxId, o.Height})
+			if err != nil {
+				return fmt.Errorf("Could not get output: %v", err)
+			}
+
+			merged := &blocc.Output{Symbol: symbol}
+			if len(existing) > 0 {
+				merged = existing[0]
+			}
+			store.MergeOutput(merged, o)
+
+			args, err := outputArgs(symbol, merged)
+			if err != nil {
+				return err
+			}
+			if _, err = stmt.Exec(args...); err != nil {
+				return fmt.Errorf("Could not upsert output: %v", err)
+			}
+
+		}
+
+		return nil
+
+	})
+
+}
+
+// UpdateOutputBlockIdByBlockId changes the BlockId and SpentBlockId of outputs
+func (p *postgres) UpdateOutputBlockIdByBlockId(symbol string, blockId string, newBlockId string) error {
+
+	return p.write(func(sqlTx *sql.Tx) error {
+		if _, err := sqlTx.Exec("UPDATE output SET block_id = $3 WHERE symbol = $1 AND block_id = $2", symbol, blockId, newBlockId); err != nil {
+			return err
+		}
+		_, err := sqlTx.Exec("UPDATE output SET spent_block_id = $3 WHERE symbol = $1 AND spent_tx_id <> '' AND spent_block_id = $2", symbol, blockId, newBlockId)
+		return err
+	})
+
+}
+
+// RollbackOutputsByBlockId removes outputs created in a block and unspends the outputs spent in it
+func (p *postgres) RollbackOutputsByBlockId(symbol string, blockId string) error {
+
+	return p.write(func(sqlTx *sql.Tx) error {
+		if _, err := sqlTx.Exec("DELETE FROM output WHERE symbol = $1 AND block_id = $2", symbol, blockId); err != nil {
+			return err
+		}
+		_, err := sqlTx.Exec(`UPDATE output SET spent_tx_id = '', spent_height = 0, spent_block_id = '', spent_block_height = 0
+			WHERE symbol = $1 AND spent_tx_id <> '' AND spent_block_id = $2`, symbol, blockId)
+		return err
+	})
+
+}
+
+// GetOutputByOutPoint returns an output by txId and height
+func (p *postgres) GetOutputByOutPoint(symbol string, txId string, height int64) (*blocc.Output, error) {
+
+	outputs, err := queryOutputs(p.db, "SELECT "+outputColumns+" FROM output WHERE symbol = $1 AND tx_id = $2 AND height = $3",
+		[]interface{}{symbol, txId, height})
+	if err != nil {
+		return nil, fmt.Errorf("Could not get output: %v", err)
+	}
+
+	if len(outputs) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	return outputs[0], nil
+
+}
+
+// FindUnspentOutputsByAddresses returns the unspent outputs for addresses
+func (p *postgres) FindUnspentOutputsByAddresses(symbol string, addresses []string, offset int, count int) ([]*blocc.Output, error) {
+
+	c := newConditions(symbol)
+	c.add("addresses && ?", pq.Array(addresses))
+	c.add("has_out AND block_id <> ''")
+	// Outputs spent in the mempool are still unspent
+	c.add("(spent_tx_id = '' OR spent_block_id = ANY(?))", pq.Array(blocc.MemPoolBlockIds))
+
+	where := c.where()
+	args := append([]interface{}{}, c.args...)
+
+	outputs, err := queryOutputs(p.db, "SELECT "+outputColumns+" FROM output"+where+" ORDER BY time DESC, tx_id, height"+c.limit(offset, count), c.args)
+	if err != nil {
+		return nil, fmt.Errorf("Could not find outputs: %v", err)
+	}
+
+	if len(outputs) == 0 {
+		// An empty page past the end is not an error
+		var exists bool
+		if err := p.db.QueryRow("SELECT EXISTS (SELECT 1 FROM output"+where+")", args...).Scan(&exists); err != nil {
+			return nil, fmt.Errorf("Could not find outputs: %v", err)
+		}
+		if !exists {
+			return nil, blocc.ErrNotFound
+		}
+	}
+
+	return outputs, nil
+
+}
+
+// outputArgs returns the parameters for outputColumns
+func outputArgs(symbol string, o *blocc.Output) ([]interface{}, error) {
+
+	out := o.Out
+	if out == nil {
+		out = new(blocc.TxOut)
+	}
+	data, err := jsonValue(out.Data)
+	if err != nil {
+		return nil, err
+	}
+	metric, err := jsonValue(out.Metric)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{symbol, o.TxId, o.Height, o.BlockId, o.BlockHeight, o.Time, o.Out != nil, out.Type, arrayValue(out.Addresses), out.Value,
+		bytesValue(out.Raw), data, metric, o.SpentTxId, o.SpentHeight, o.SpentBlockId, o.SpentBlockHeight}, nil
+
+}
+
+// queryOutputs runs a query selecting outputColumns
+func queryOutputs(q querier, query string, args []interface{}) ([]*blocc.Output, error) {
+
+	rows, err := q.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	outputs := make([]*blocc.Output, 0)
+	for rows.Next() {
+		o := new(blocc.Output)
+		out := new(blocc.TxOut)
+		var hasOut bool
+		var raw []byte
+		var data, metric sql.NullString
+		err := rows.Scan(&o.Symbol, &o.TxId, &o.Height, &o.BlockId, &o.BlockHeight, &o.Time, &hasOut, &out.Type, pq.Array(&out.Addresses), &out.Value,
+			&raw, &data, &metric, &o.SpentTxId, &o.SpentHeight, &o.SpentBlockId, &o.SpentBlockHeight)
+		if err != nil {
+			return nil, err
+		}
+		if hasOut {
+			out.Raw = raw
+			if out.Data, err = scanData(data); err != nil {
+				return nil, err
+			}
+			if out.Metric, err = scanMetric(metric); err != nil {
+				return nil, err
+			}
+			o.Out = out
+		}
+		outputs = append(outputs, o)
+	}
+
+	return outputs, rows.Err()
+
+}
@@ -0,0 +1,404 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/lib/pq"
+	config "github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/conf"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// Used for the operations that require a context
+var ctx = context.Background()
+
+type postgres struct {
+	logger *zap.SugaredLogger
+
+	db        *sql.DB
+	batchSize int
+
+	// Pending writes are coalesced by symbol and id and written when flushed
+	pendingBlocks   []*pendingBlock
+	pendingBlockIdx map[string]*pendingBlock
+	pendingTxs      []*pendingTx
+	pendingTxIdx    map[string]*pendingTx
+	lastFlushError  error
+
+	sync.Mutex
+}
+
+// pendingBlock is a block to write, if replace is false it's merged with the existing block
+type pendingBlock struct {
+	symbol  string
+	block   *blocc.Block
+	replace bool
+}
+
+// pendingTx is a transaction to write, if replace is false it's merged with the existing transaction
+type pendingTx struct {
+	symbol  string
+	tx      *blocc.Tx
+	replace bool
+}
+
+// New connects to Postgres and applies any schema migrations
+func New() (*postgres, error) {
+
+	p := &postgres{
+		logger:          zap.S().With("package", "blockstore.postgres"),
+		batchSize:       config.GetInt("postgres.batch_size"),
+		pendingBlockIdx: make(map[string]*pendingBlock),
+		pendingTxIdx:    make(map[string]*pendingTx),
+	}
+
+	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		config.GetString("postgres.host"),
+		config.GetString("postgres.port"),
+		config.GetString("postgres.user"),
+		config.GetString("postgres.password"),
+		config.GetString("postgres.dbname"),
+		config.GetString("postgres.sslmode"),
+	)
+
+	var err error
+	p.db, err = sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("Could not open postgres: %v", err)
+	}
+	p.db.SetMaxOpenConns(config.GetInt("postgres.max_connections"))
+
+	for retries := config.GetInt("postgres.retries"); retries > 0 && !conf.Stop.Bool(); retries-- {
+		err = p.db.Ping()
+		if err != nil {
+			p.logger.Warnw("Connection to postgres failed. Sleeping and retry.",
+				"host", config.GetString("postgres.host"),
+				"port", config.GetString("postgres.port"),
+				"error", err,
+			)
+			time.Sleep(config.GetDuration("postgres.sleep_between_retries"))
+			continue
+		}
+		break
+	}
+
+	// Aborted before connected
+	if conf.Stop.Bool() {
+		return nil, fmt.Errorf("Connection to postgres aborted")
+	}
+
+	// Unable to connect to postgres
+	if err != nil {
+		return nil, fmt.Errorf("Unable to connect to postgres: %v", err)
+	}
+
+	// Bring the schema up to date
+	if err = p.migrate(); err != nil {
+		return nil, fmt.Errorf("Could not migrate postgres schema: %v", err)
+	}
+
+	// Periodically flush pending writes
+	if interval := config.GetDuration("postgres.flush_interval"); interval > 0 {
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for range ticker.C {
+				if err := p.flush(); err != nil {
+					p.logger.Errorw("Flush Error", "error", err)
+				}
+				if conf.Stop.Bool() {
+					return
+				}
+			}
+		}()
+	}
+
+	return p, nil
+}
+
+// Init does nothing, all symbols share the same tables
+func (p *postgres) Init(symbol string) error {
+	return nil
+}
+
+// queueBlock adds or merges a pending block write
+func (p *postgres) queueBlock(symbol string, blockId string, fn func(pb *pendingBlock)) error {
+
+	p.Lock()
+	key := symbol + "/" + blockId
+	pb, ok := p.pendingBlockIdx[key]
+	if !ok {
+		pb = &pendingBlock{symbol: symbol, block: &blocc.Block{BlockId: blockId}}
+		p.pendingBlockIdx[key] = pb
+		p.pendingBlocks = append(p.pendingBlocks, pb)
+	}
+	fn(pb)
+	full := p.full()
+	p.Unlock()
+
+	if full {
+		return p.flush()
+	}
+
+	return nil
+
+}
+
+// queueTx adds or merges a pending transaction write
+func (p *postgres) queueTx(symbol string, tx *blocc.Tx, replace bool) error {
+
+	// Copy the tx so the caller can modify it
+	tx = proto.Clone(tx).(*blocc.Tx)
+
+	p.Lock()
+	key := symbol + "/" + tx.TxId
+	if pt, ok := p.pendingTxIdx[key]; ok {
+		if replace {
+			pt.tx = tx
+			pt.replace = true
+		} else {
+			store.MergeTx(pt.tx, tx)
+		}
+	} else {
+		pt = &pendingTx{symbol: symbol, tx: tx, replace: replace}
+		p.pendingTxIdx[key] = pt
+		p.pendingTxs = append(p.pendingTxs, pt)
+	}
+	full := p.full()
+	p.Unlock()
+
+	if full {
+		return p.flush()
+	}
+
+	return nil
+
+}
+
+// full checks if the batch size has been reached, the caller must hold the lock
+func (p *postgres) full() bool {
+	return p.batchSize > 0 && len(p.pendingBlocks)+len(p.pendingTxs) >= p.batchSize
+}
+
+// flush writes all pending blocks and transactions in a single transaction
+func (p *postgres) flush() error {
+
+	p.Lock()
+	defer p.Unlock()
+
+	return p.flushLocked()
+
+}
+
+// flushLocked writes all pending writes, the caller must hold the lock
+func (p *postgres) flushLocked() error {
+
+	if len(p.pendingBlocks) == 0 && len(p.pendingTxs) == 0 {
+		return nil
+	}
+
+	blocks := p.pendingBlocks
+	txs := p.pendingTxs
+	p.pendingBlocks = nil
+	p.pendingBlockIdx = make(map[string]*pendingBlock)
+	p.pendingTxs = nil
+	p.pendingTxIdx = make(map[string]*pendingTx)
+
+	err := p.inTx(func(sqlTx *sql.Tx) error {
+		if err := writeBlocks(sqlTx, blocks); err != nil {
+			return fmt.Errorf("Could not write blocks: %v", err)
+		}
+		if err := writeTxs(sqlTx, txs); err != nil {
+			return fmt.Errorf("Could not write txs: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		p.lastFlushError = fmt.Errorf("Could not flush %d blocks %d txs: %v", len(blocks), len(txs), err)
+	}
+
+	return err
+
+}
+
+// write flushes pending writes and then runs fn. It's used for immediate operations
+func (p *postgres) write(fn func(sqlTx *sql.Tx) error) error {
+
+	p.Lock()
+	defer p.Unlock()
+
+	if err := p.flushLocked(); err != nil {
+		return err
+	}
+
+	return p.inTx(fn)
+
+}
+
+// flushError returns and clears the last flush error
+func (p *postgres) flushError() error {
+	p.Lock()
+	defer p.Unlock()
+	err := p.lastFlushError
+	p.lastFlushError = nil
+	return err
+}
+
+// inTx runs fn in a database transaction
+func (p *postgres) inTx(fn func(sqlTx *sql.Tx) error) error {
+
+	sqlTx, err := p.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := fn(sqlTx); err != nil {
+		sqlTx.Rollback()
+		return err
+	}
+
+	return sqlTx.Commit()
+
+}
+
+// conditions builds a WHERE clause with numbered parameters
+type conditions struct {
+	clauses []string
+	args    []interface{}
+}
+
+func newConditions(symbol string) *conditions {
+	c := new(conditions)
+	c.add("symbol = ?", symbol)
+	return c
+}
+
+// add appends a clause, each ? is replaced with the next parameter number so the jsonb ? operator can't be used
+func (c *conditions) add(clause string, args ...interface{}) {
+	for _, arg := range args {
+		c.args = append(c.args, arg)
+		clause = strings.Replace(clause, "?", fmt.Sprintf("$%d", len(c.args)), 1)
+	}
+	c.clauses = append(c.clauses, clause)
+}
+
+// arg adds a parameter without a clause and returns the placeholder
+func (c *conditions) arg(arg interface{}) string {
+	c.args = append(c.args, arg)
+	return fmt.Sprintf("$%d", len(c.args))
+}
+
+func (c *conditions) where() string {
+	return " WHERE " + strings.Join(c.clauses, " AND ")
+}
+
+// addHeightRange adds a height filter where HeightUnknown is open ended
+func (c *conditions) addHeightRange(column string, startHeight int64, endHeight int64) {
+	if startHeight != blocc.HeightUnknown {
+		c.add(column+" >= ?", startHeight)
+	}
+	if endHeight != blocc.HeightUnknown {
+		c.add(column+" <= ?", endHeight)
+	}
+}
+
+// addStatuses adds a status filter, no statuses matches everything
+func (c *conditions) addStatuses(statuses []string) {
+	if len(statuses) > 0 {
+		c.add("status = ANY(?)", pq.Array(statuses))
+	}
+}
+
+// addTimeRange adds an optional time filter
+func (c *conditions) addTimeRange(column string, start *time.Time, end *time.Time) {
+	if start != nil {
+		c.add(column+" >= ?", start.Unix())
+	}
+	if end != nil {
+		c.add(column+" <= ?", end.Unix())
+	}
+}
+
+// limit returns the LIMIT/OFFSET clause
+func (c *conditions) limit(offset int, count int) string {
+	var ret string
+	if count >= 0 && count != store.CountMax {
+		ret += " LIMIT " + c.arg(count)
+	}
+	if offset > 0 {
+		ret += " OFFSET " + c.arg(offset)
+	}
+	return ret
+}
+
+// jsonValue encodes a map for a jsonb column, empty maps are NULL
+func jsonValue(m interface{}) (interface{}, error) {
+	switch v := m.(type) {
+	case map[string]string:
+		if len(v) == 0 {
+			return nil, nil
+		}
+	case map[string]float64:
+		if len(v) == 0 {
+			return nil, nil
+		}
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	// Strings are used so COPY does not encode it as bytea
+	return string(b), nil
+}
+
+// bytesValue returns nil for empty byte slices so they are stored as NULL
+func bytesValue(b []byte) interface{} {
+	if len(b) == 0 {
+		return nil
+	}
+	return b
+}
+
+// arrayValue returns an array parameter, empty arrays are NULL
+func arrayValue(a []string) interface{} {
+	if len(a) == 0 {
+		return nil
+	}
+	return pq.Array(a)
+}
+
+// querier is implemented by *sql.DB and *sql.Tx
+type querier interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// scanData decodes a jsonb data column
+func scanData(raw sql.NullString) (map[string]string, error) {
+	if !raw.Valid {
+		return nil, nil
+	}
+	var ret map[string]string
+	err := json.Unmarshal([]byte(raw.String), &ret)
+	return ret, err
+}
+
+// scanMetric decodes a jsonb metric column
+func scanMetric(raw sql.NullString) (map[string]float64, error) {
+	if !raw.Valid {
+		return nil, nil
+	}
+	var ret map[string]float64
+	err := json.Unmarshal([]byte(raw.String), &ret)
+	return ret, err
+}
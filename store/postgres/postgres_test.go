@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	"os"
+	"testing"
+
+	config "github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// newTestStore connects to the database at POSTGRES_TEST_HOST (ie docker-services/postgres) and empties it
+func newTestStore(t *testing.T) *postgres {
+
+	host := os.Getenv("POSTGRES_TEST_HOST")
+	if host == "" {
+		t.Skip("POSTGRES_TEST_HOST not set")
+	}
+
+	config.Set("postgres.host", host)
+	config.Set("postgres.retries", 1)
+	config.Set("postgres.batch_size", 0)
+	config.Set("postgres.flush_interval", 0)
+
+	p, err := New()
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	_, err = p.db.Exec("TRUNCATE block, tx, tx_in, tx_out")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	return p
+
+}
+
+func TestConditions(t *testing.T) {
+
+	c := newConditions("sym")
+	c.addStatuses(nil)
+	c.addHeightRange("height", blocc.HeightUnknown, 10)
+	c.add("block_id = ?", "a")
+
+	assert.Equal(t, " WHERE symbol = $1 AND height <= $2 AND block_id = $3", c.where())
+	assert.Equal(t, " LIMIT $4 OFFSET $5", c.limit(5, 20))
+	assert.Equal(t, "", c.limit(0, store.CountMax))
+	assert.Len(t, c.args, 5)
+
+}
+
+func TestFlushBatches(t *testing.T) {
+
+	p := newTestStore(t)
+	defer p.db.Close()
+
+	symbol := "sym"
+	assert.Nil(t, p.InsertBlock(symbol, &blocc.Block{BlockId: "a", Height: 0, Status: blocc.StatusValid, TxIds: []string{"tx1"}}))
+	assert.Nil(t, p.InsertTransaction(symbol, &blocc.Tx{TxId: "tx1", BlockId: "a", Time: 100}))
+
+	// Nothing visible until flushed
+	_, err := p.GetBlockByBlockId(symbol, "a", blocc.BlockIncludeAll)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+	assert.Nil(t, p.FlushBlocks(symbol))
+	b, err := p.GetBlockByBlockId(symbol, "a", blocc.BlockIncludeAll)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"tx1"}, b.TxIds)
+
+	blks, err := p.FindBlocksByTxId(symbol, "tx1", blocc.BlockIncludeHeader)
+	assert.Nil(t, err)
+	assert.Len(t, blks, 1)
+
+	count, err := p.GetTxCountByBlockId(symbol, "a", false)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), count)
+
+}
+
+func TestUpsertTransactionInputsOutputs(t *testing.T) {
+
+	p := newTestStore(t)
+	defer p.db.Close()
+
+	symbol := "sym"
+	assert.Nil(t, p.InsertTransaction(symbol, &blocc.Tx{
+		TxId:    "tx1",
+		BlockId: blocc.BlockIdMempool,
+		Time:    100,
+		TxSize:  250,
+		In:      []*blocc.TxIn{{TxId: "prev", Height: 1, Out: &blocc.TxOut{Addresses: []string{"addr2"}, Value: 1500}}},
+		Out:     []*blocc.TxOut{{Addresses: []string{"addr1"}, Value: 1000}, {Addresses: []string{"addr2"}, Value: 400}},
+		Data:    map[string]string{"fee": "100"},
+	}))
+	assert.Nil(t, p.FlushTransactions(symbol))
+
+	// Merged with the stored transaction in the flush
+	assert.Nil(t, p.UpsertTransaction(symbol, &blocc.Tx{
+		TxId:        "tx1",
+		BlockId:     "block1",
+		BlockHeight: 5,
+		Data:        map[string]string{"received_time": "90"},
+	}))
+	assert.Nil(t, p.FlushTransactions(symbol))
+
+	tx, err := p.GetTxByTxId(symbol, "tx1", blocc.TxIncludeAll)
+	assert.Nil(t, err)
+	assert.Equal(t, "block1", tx.BlockId)
+	assert.Equal(t, int64(250), tx.TxSize)
+	assert.Equal(t, map[string]string{"fee": "100", "received_time": "90"}, tx.Data)
+	if assert.Len(t, tx.In, 1) && assert.NotNil(t, tx.In[0].Out) {
+		assert.Equal(t, "prev", tx.In[0].TxId)
+		assert.Equal(t, int64(1500), tx.In[0].Out.Value)
+	}
+	if assert.Len(t, tx.Out, 2) {
+		assert.Equal(t, []string{"addr1"}, tx.Out[0].Addresses)
+	}
+
+	txs, err := p.FindTxs(symbol, nil, "", map[string]string{"fee": "100"}, blocc.TxFilterIncompleteAll, nil, nil, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 1)
+
+	txs, err = p.FindTxsByAddressesAndTime(symbol, []string{"addr2"}, nil, nil, blocc.TxFilterAddressInput, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 1)
+
+	count, outputValue, inputValue, err := p.GetAddressStats(symbol, "addr2")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), count)
+	assert.Equal(t, int64(400), outputValue)
+	assert.Equal(t, int64(1500), inputValue)
+
+	// Inputs and outputs are removed with the transaction
+	assert.Nil(t, p.DeleteAboveBlockHeight(symbol, 4))
+	_, err = p.GetTxByTxId(symbol, "tx1", blocc.TxIncludeHeader)
+	assert.Equal(t, blocc.ErrNotFound, err)
+	_, err = p.FindTxsByAddressesAndTime(symbol, []string{"addr1"}, nil, nil, blocc.TxFilterAddressOutput, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func TestBlockStatusAndStats(t *testing.T) {
+
+	p := newTestStore(t)
+	defer p.db.Close()
+
+	symbol := "sym"
+	for i, blockId := range []string{"a", "b", "c", "d"} {
+		assert.Nil(t, p.InsertBlock(symbol, &blocc.Block{BlockId: blockId, Height: int64(i), Time: int64(i * 600), Status: blocc.StatusNew}))
+	}
+
+	// Applied after the pending writes
+	assert.Nil(t, p.UpdateBlockStatusByStatusesAndHeight(symbol, []string{blocc.StatusNew}, blocc.HeightUnknown, 1, blocc.StatusValid))
+
+	bh, err := p.GetBlockHeaderTopByStatuses(symbol, []string{blocc.StatusValid})
+	assert.Nil(t, err)
+	assert.Equal(t, "b", bh.BlockId)
+
+	// Empty page past the end is not an error
+	blks, err := p.FindBlocksByStatusAndHeight(symbol, []string{blocc.StatusNew}, blocc.HeightUnknown, blocc.HeightUnknown, blocc.BlockIncludeHeader, 5, 10)
+	assert.Nil(t, err)
+	assert.Len(t, blks, 0)
+
+	for i, blockId := range []string{"a", "b", "c"} {
+		assert.Nil(t, p.UpdateBlock(symbol, blockId, "", "", map[string]string{"fee_vsize_p10": []string{"0", "5", "10"}[i]}, nil))
+	}
+	assert.Nil(t, p.FlushBlocks(symbol))
+
+	avg, err := p.AverageBlockDataFieldByHeight(symbol, "data.fee_vsize_p10", true, blocc.HeightUnknown, blocc.HeightUnknown)
+	assert.Nil(t, err)
+	assert.Equal(t, 7.5, avg)
+
+	pct, err := p.PercentileBlockDataFieldByHeight(symbol, "data.fee_vsize_p10", 50, false, blocc.HeightUnknown, blocc.HeightUnknown)
+	assert.Nil(t, err)
+	assert.Equal(t, 5.0, pct)
+
+	_, err = p.AverageBlockDataFieldByHeight(symbol, "data.missing", true, blocc.HeightUnknown, blocc.HeightUnknown)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
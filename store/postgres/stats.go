@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"database/sql"
+	"strings"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// AverageBlockDataFieldByHeight returns the average of a block data field between heights
+func (p *postgres) AverageBlockDataFieldByHeight(symbol string, field string, omitZero bool, startHeight int64, endHeight int64) (float64, error) {
+	return p.blockFieldAggregate(symbol, field, "AVG(value)", omitZero, startHeight, endHeight)
+}
+
+// PercentileBlockDataFieldByHeight returns the percentile of a block data field between heights
+func (p *postgres) PercentileBlockDataFieldByHeight(symbol string, field string, percentile float64, omitZero bool, startHeight int64, endHeight int64) (float64, error) {
+	return p.blockFieldAggregate(symbol, field, "percentile_cont(?::float8 / 100) WITHIN GROUP (ORDER BY value)", omitZero, startHeight, endHeight, percentile)
+}
+
+// blockFieldAggregate applies an aggregate to the values of a field (ie data.fee_vsize_p10) for all blocks between heights that have it
+func (p *postgres) blockFieldAggregate(symbol string, field string, aggregate string, omitZero bool, startHeight int64, endHeight int64, args ...interface{}) (float64, error) {
+
+	var column string
+	if strings.HasPrefix(field, "data.") {
+		column = "data"
+	} else if strings.HasPrefix(field, "metric.") {
+		column = "metric"
+	} else {
+		return 0, blocc.ErrNotFound
+	}
+
+	c := newConditions(symbol)
+	c.addHeightRange("height", startHeight, endHeight)
+	name := c.arg(strings.TrimPrefix(field, column+"."))
+	c.add(column + "->>" + name + " IS NOT NULL")
+	// Skip zeros
+	if omitZero {
+		c.add(column + "->>" + name + " NOT IN ('0', '0.0')")
+	}
+
+	// The aggregate parameters are numbered after the conditions
+	for _, arg := range args {
+		aggregate = strings.Replace(aggregate, "?", c.arg(arg), 1)
+	}
+
+	var value sql.NullFloat64
+	query := "SELECT " + aggregate + " FROM (SELECT (" + column + "->>" + name + ")::float8 AS value FROM block" + c.where() + ") t"
+	if err := p.db.QueryRow(query, c.args...).Scan(&value); err != nil {
+		return 0, err
+	}
+
+	// No blocks had the field
+	if !value.Valid {
+		return 0, blocc.ErrNotFound
+	}
+
+	return value.Float64, nil
+
+}
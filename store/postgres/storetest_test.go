@@ -0,0 +1,15 @@
+package postgres
+
+import (
+	"testing"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store/storetest"
+)
+
+func TestBlockChainStore(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) (blocc.BlockChainStore, func()) {
+		p := newTestStore(t)
+		return p, func() { p.db.Close() }
+	})
+}
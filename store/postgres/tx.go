@@ -0,0 +1,553 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// InsertTransaction inserts a transaction to the store
+func (p *postgres) InsertTransaction(symbol string, tx *blocc.Tx) error {
+	return p.queueTx(symbol, tx, true)
+}
+
+// UpsertTransaction updates transaction data (essentially merging data object)
+func (p *postgres) UpsertTransaction(symbol string, tx *blocc.Tx) error {
+	return p.queueTx(symbol, tx, false)
+}
+
+// DeleteTransactionsByBlockIdAndTime will remove transactions by BlockId
+func (p *postgres) DeleteTransactionsByBlockIdAndTime(symbol string, blockId string, start *time.Time, end *time.Time) error {
+
+	return p.write(func(sqlTx *sql.Tx) error {
+		c := newConditions(symbol)
+		c.add("block_id = ?", blockId)
+		c.addTimeRange("time", start, end)
+		// Inputs and outputs cascade
+		_, err := sqlTx.Exec("DELETE FROM tx"+c.where(), c.args...)
+		return err
+	})
+
+}
+
+// GetTxByTxId will return a transaction by txId
+func (p *postgres) GetTxByTxId(symbol string, txId string, include blocc.TxInclude) (*blocc.Tx, error) {
+
+	c := newConditions(symbol)
+	c.add("tx_id = ?", txId)
+
+	txs, err := queryTxs(p.db, symbol, "SELECT "+txColumns(include)+" FROM tx"+c.where(), c.args, include)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get tx: %v", err)
+	}
+
+	if len(txs) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	return txs[0], nil
+
+}
+
+// GetTxsByTxIds returns multiple transaction by multiple txIds, missing transactions are skipped
+func (p *postgres) GetTxsByTxIds(symbol string, txIds []string, include blocc.TxInclude) ([]*blocc.Tx, error) {
+
+	c := newConditions(symbol)
+	c.add("tx_id = ANY(?)", pq.Array(txIds))
+
+	txs, err := queryTxs(p.db, symbol, "SELECT "+txColumns(include)+" FROM tx"+c.where(), c.args, include)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return them in the order requested
+	byTxId := make(map[string]*blocc.Tx, len(txs))
+	for _, tx := range txs {
+		byTxId[tx.TxId] = tx
+	}
+	ret := make([]*blocc.Tx, 0, len(txs))
+	for _, txId := range txIds {
+		if tx, ok := byTxId[txId]; ok {
+			ret = append(ret, tx)
+		}
+	}
+
+	return ret, nil
+
+}
+
+// GetTxsByBlockId returns multiple transaction by block Id ordered by height in the block
+func (p *postgres) GetTxsByBlockId(symbol string, blockId string, include blocc.TxInclude) ([]*blocc.Tx, error) {
+
+	c := newConditions(symbol)
+	c.add("block_id = ?", blockId)
+
+	txs, err := queryTxs(p.db, symbol, "SELECT "+txColumns(include)+" FROM tx"+c.where()+" ORDER BY height, tx_id", c.args, include)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(txs) == 0 {
+		return nil, blocc.ErrNotFound
+	}
+
+	return txs, nil
+
+}
+
+// GetTxCountByBlockId will return the number of transactions by block Id in the store
+func (p *postgres) GetTxCountByBlockId(symbol string, blockId string, includeIncomplete bool) (int64, error) {
+
+	c := newConditions(symbol)
+	c.add("block_id = ?", blockId)
+	if !includeIncomplete {
+		c.add("NOT incomplete")
+	}
+
+	var count int64
+	err := p.db.QueryRow("SELECT COUNT(*) FROM tx"+c.where(), c.args...).Scan(&count)
+
+	return count, err
+}
+
+// FindTxs will find multiple transactions by optionally multiple fields
+func (p *postgres) FindTxs(symbol string, txIds []string, blockId string, dataFields map[string]string, incomplete blocc.TxFilterIncomplete, start *time.Time, end *time.Time, include blocc.TxInclude, offset int, count int) ([]*blocc.Tx, error) {
+
+	c := newConditions(symbol)
+	if len(txIds) > 0 {
+		c.add("tx_id = ANY(?)", pq.Array(txIds))
+	}
+	if blockId != "" {
+		c.add("block_id = ?", blockId)
+	}
+	if len(dataFields) != 0 {
+		// Containment can use the data index
+		fields, err := json.Marshal(dataFields)
+		if err != nil {
+			return nil, err
+		}
+		c.add("data @> ?::jsonb", string(fields))
+	}
+	switch incomplete {
+	case blocc.TxFilterIncompleteTrue:
+		c.add("incomplete")
+	case blocc.TxFilterIncompleteFalse:
+		c.add("NOT incomplete")
+	}
+	c.addTimeRange("time", start, end)
+
+	return p.pageTxs(symbol, c, include, offset, count)
+
+}
+
+// FindTxsByAddressesAndTime will find transactions by optionally addresses, time and pagination
+func (p *postgres) FindTxsByAddressesAndTime(symbol string, addresses []string, start *time.Time, end *time.Time, filter blocc.TxFilterAddress, include blocc.TxInclude, offset int, count int) ([]*blocc.Tx, error) {
+
+	c := newConditions(symbol)
+	if filter&blocc.TxFilterAddressInputOutput != 0 {
+		c.add("tx_id IN (" + addressTxIds(filter, "$1", c.arg(pq.Array(addresses))) + ")")
+	}
+	c.addTimeRange("time", start, end)
+
+	return p.pageTxs(symbol, c, include, offset, count)
+
+}
+
+// UpdateTxBlockIdByBlockId will update the blockId of a transaction to a new block id
+func (p *postgres) UpdateTxBlockIdByBlockId(symbol string, blockId string, newBlockId string) error {
+
+	return p.write(func(sqlTx *sql.Tx) error {
+		_, err := sqlTx.Exec("UPDATE tx SET block_id = $3 WHERE symbol = $1 AND block_id = $2", symbol, blockId, newBlockId)
+		return err
+	})
+
+}
+
+// GetMemPoolStats returns the size and count of the mempool
+func (p *postgres) GetMemPoolStats(symbol string) (int64, int64, error) {
+
+	var size, count int64
+	err := p.db.QueryRow("SELECT COALESCE(SUM(size), 0), COUNT(*) FROM tx WHERE symbol = $1 AND block_id = ANY($2)",
+		symbol, pq.Array(blocc.MemPoolBlockIds)).Scan(&size, &count)
+
+	return size, count, err
+
+}
+
+// GetAddressStats returns the transaction count, value received and value spent for an address
+func (p *postgres) GetAddressStats(symbol string, address string) (int64, int64, int64, error) {
+
+	var count, outputValue, inputValue int64
+	err := p.db.QueryRow(`SELECT
+		(SELECT COUNT(*) FROM (`+addressTxIds(blocc.TxFilterAddressInputOutput, "$1", "$2")+`) t),
+		(SELECT COALESCE(SUM(value), 0) FROM tx_out WHERE symbol = $1 AND addresses && $2),
+		(SELECT COALESCE(SUM(out_value), 0) FROM tx_in WHERE symbol = $1 AND out_addresses && $2)`,
+		symbol, pq.Array([]string{address})).Scan(&count, &outputValue, &inputValue)
+
+	return count, outputValue, inputValue, err
+
+}
+
+// FlushTransactions writes all pending changes
+func (p *postgres) FlushTransactions(symbol string) error {
+
+	if err := p.flush(); err != nil {
+		return err
+	}
+
+	// Return the background flush error (if there was one)
+	return p.flushError()
+
+}
+
+// addressTxIds returns a subquery selecting the txIds with any of the addresses for a symbol given their placeholders
+func addressTxIds(filter blocc.TxFilterAddress, symbol string, addresses string) string {
+	var query string
+	if filter&blocc.TxFilterAddressOutput != 0 {
+		query = "SELECT tx_id FROM tx_out WHERE symbol = " + symbol + " AND addresses && " + addresses
+	}
+	if filter&blocc.TxFilterAddressInput != 0 {
+		if query != "" {
+			query += " UNION "
+		}
+		query += "SELECT tx_id FROM tx_in WHERE symbol = " + symbol + " AND out_addresses && " + addresses
+	}
+	return query
+}
+
+// pageTxs returns a page of transactions by descending time, it's only not found if nothing matches at all
+func (p *postgres) pageTxs(symbol string, c *conditions, include blocc.TxInclude, offset int, count int) ([]*blocc.Tx, error) {
+
+	where := c.where()
+	args := append([]interface{}{}, c.args...)
+
+	query := "SELECT " + txColumns(include) + " FROM tx" + where + " ORDER BY time DESC, tx_id" + c.limit(offset, count)
+	txs, err := queryTxs(p.db, symbol, query, c.args, include)
+	if err != nil {
+		return nil, fmt.Errorf("Could not find txs: %v", err)
+	}
+
+	if len(txs) == 0 {
+		// An empty page past the end is not an error
+		var exists bool
+		if err := p.db.QueryRow("SELECT EXISTS (SELECT 1 FROM tx"+where+")", args...).Scan(&exists); err != nil {
+			return nil, fmt.Errorf("Could not find txs: %v", err)
+		}
+		if !exists {
+			return nil, blocc.ErrNotFound
+		}
+	}
+
+	return txs, nil
+
+}
+
+// txColumns returns the columns to select, excluded fields are selected as NULL
+func txColumns(include blocc.TxInclude) string {
+	raw, data := "raw", "data"
+	if include&blocc.TxIncludeRaw == 0 {
+		raw = "NULL"
+	}
+	if include&blocc.TxIncludeData == 0 {
+		data = "NULL"
+	}
+	return "symbol, tx_id, block_id, block_height, block_time, height, time, size, incomplete, " + raw + ", " + data + ", metric"
+}
+
+// queryTxs runs a query that selects txColumns and loads the inputs and outputs if included
+func queryTxs(q querier, symbol string, query string, args []interface{}, include blocc.TxInclude) ([]*blocc.Tx, error) {
+
+	rows, err := q.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	txs := make([]*blocc.Tx, 0)
+	byTxId := make(map[string]*blocc.Tx)
+	for rows.Next() {
+		tx := new(blocc.Tx)
+		var raw []byte
+		var data, metric sql.NullString
+		err := rows.Scan(&tx.Symbol, &tx.TxId, &tx.BlockId, &tx.BlockHeight, &tx.BlockTime, &tx.Height, &tx.Time, &tx.TxSize,
+			&tx.Incomplete, &raw, &data, &metric)
+		if err != nil {
+			return nil, err
+		}
+		tx.Raw = raw
+		if tx.Data, err = scanData(data); err != nil {
+			return nil, fmt.Errorf("Could not parse Tx %s data: %v", tx.TxId, err)
+		}
+		if tx.Metric, err = scanMetric(metric); err != nil {
+			return nil, fmt.Errorf("Could not parse Tx %s metric: %v", tx.TxId, err)
+		}
+		txs = append(txs, tx)
+		byTxId[tx.TxId] = tx
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if len(txs) == 0 {
+		return txs, nil
+	}
+
+	txIds := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		txIds = append(txIds, tx.TxId)
+	}
+
+	if include&blocc.TxIncludeIn != 0 {
+		if err := queryTxIns(q, symbol, txIds, byTxId); err != nil {
+			return nil, fmt.Errorf("Could not get tx inputs: %v", err)
+		}
+	}
+
+	if include&blocc.TxIncludeOut != 0 {
+		if err := queryTxOuts(q, symbol, txIds, byTxId); err != nil {
+			return nil, fmt.Errorf("Could not get tx outputs: %v", err)
+		}
+	}
+
+	return txs, nil
+
+}
+
+// queryTxIns loads the inputs of transactions in order
+func queryTxIns(q querier, symbol string, txIds []string, byTxId map[string]*blocc.Tx) error {
+
+	rows, err := q.Query(`SELECT tx_id, prev_tx_id, prev_height, raw, data, metric, has_out, out_type, out_addresses, out_value, out_raw, out_data, out_metric
+		FROM tx_in WHERE symbol = $1 AND tx_id = ANY($2) ORDER BY tx_id, n`, symbol, pq.Array(txIds))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var txId string
+		var hasOut bool
+		var raw, outRaw []byte
+		var data, metric, outData, outMetric sql.NullString
+		in := new(blocc.TxIn)
+		out := new(blocc.TxOut)
+		err := rows.Scan(&txId, &in.TxId, &in.Height, &raw, &data, &metric, &hasOut, &out.Type, pq.Array(&out.Addresses), &out.Value, &outRaw, &outData, &outMetric)
+		if err != nil {
+			return err
+		}
+		in.Raw = raw
+		if in.Data, err = scanData(data); err != nil {
+			return err
+		}
+		if in.Metric, err = scanMetric(metric); err != nil {
+			return err
+		}
+		if hasOut {
+			out.Raw = outRaw
+			if out.Data, err = scanData(outData); err != nil {
+				return err
+			}
+			if out.Metric, err = scanMetric(outMetric); err != nil {
+				return err
+			}
+			in.Out = out
+		}
+		if tx, ok := byTxId[txId]; ok {
+			tx.In = append(tx.In, in)
+		}
+	}
+
+	return rows.Err()
+
+}
+
+// queryTxOuts loads the outputs of transactions in order
+func queryTxOuts(q querier, symbol string, txIds []string, byTxId map[string]*blocc.Tx) error {
+
+	rows, err := q.Query(`SELECT tx_id, type, addresses, value, raw, data, metric
+		FROM tx_out WHERE symbol = $1 AND tx_id = ANY($2) ORDER BY tx_id, n`, symbol, pq.Array(txIds))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var txId string
+		var raw []byte
+		var data, metric sql.NullString
+		out := new(blocc.TxOut)
+		err := rows.Scan(&txId, &out.Type, pq.Array(&out.Addresses), &out.Value, &raw, &data, &metric)
+		if err != nil {
+			return err
+		}
+		out.Raw = raw
+		if out.Data, err = scanData(data); err != nil {
+			return err
+		}
+		if out.Metric, err = scanMetric(metric); err != nil {
+			return err
+		}
+		if tx, ok := byTxId[txId]; ok {
+			tx.Out = append(tx.Out, out)
+		}
+	}
+
+	return rows.Err()
+
+}
+
+// writeTxs merges partial transactions with the stored transaction and replaces them all using COPY
+func writeTxs(sqlTx *sql.Tx, pending []*pendingTx) error {
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	// Load the transactions that are partial updates
+	partial := make(map[string][]string)
+	for _, pt := range pending {
+		if !pt.replace {
+			partial[pt.symbol] = append(partial[pt.symbol], pt.tx.TxId)
+		}
+	}
+	existing := make(map[string]*blocc.Tx)
+	for symbol, txIds := range partial {
+		txs, err := queryTxs(sqlTx, symbol, "SELECT "+txColumns(blocc.TxIncludeAll)+" FROM tx WHERE symbol = $1 AND tx_id = ANY($2) FOR UPDATE",
+			[]interface{}{symbol, pq.Array(txIds)}, blocc.TxIncludeAll)
+		if err != nil {
+			return err
+		}
+		for _, tx := range txs {
+			existing[symbol+"/"+tx.TxId] = tx
+		}
+	}
+
+	txs := make([]*pendingTx, 0, len(pending))
+	ids := make(map[string][]string)
+	for _, pt := range pending {
+		if e, ok := existing[pt.symbol+"/"+pt.tx.TxId]; ok && !pt.replace {
+			store.MergeTx(e, pt.tx)
+			pt = &pendingTx{symbol: pt.symbol, tx: e, replace: true}
+		}
+		txs = append(txs, pt)
+		ids[pt.symbol] = append(ids[pt.symbol], pt.tx.TxId)
+	}
+
+	// Remove the old versions, inputs and outputs cascade
+	for symbol, txIds := range ids {
+		if _, err := sqlTx.Exec("DELETE FROM tx WHERE symbol = $1 AND tx_id = ANY($2)", symbol, pq.Array(txIds)); err != nil {
+			return err
+		}
+	}
+
+	// Only one COPY can run at a time so each table is copied in turn
+	err := copyIn(sqlTx, pq.CopyIn("tx", "symbol", "tx_id", "block_id", "block_height", "block_time", "height", "time", "size", "incomplete", "raw", "data", "metric"),
+		txs, func(symbol string, tx *blocc.Tx, exec func(args ...interface{}) error) error {
+			data, err := jsonValue(tx.Data)
+			if err != nil {
+				return err
+			}
+			metric, err := jsonValue(tx.Metric)
+			if err != nil {
+				return err
+			}
+			return exec(symbol, tx.TxId, tx.BlockId, tx.BlockHeight, tx.BlockTime, tx.Height, tx.Time, tx.TxSize, tx.Incomplete, bytesValue(tx.Raw), data, metric)
+		})
+	if err != nil {
+		return err
+	}
+
+	err = copyIn(sqlTx, pq.CopyIn("tx_in", "symbol", "tx_id", "n", "prev_tx_id", "prev_height", "raw", "data", "metric",
+		"has_out", "out_type", "out_addresses", "out_value", "out_raw", "out_data", "out_metric"),
+		txs, func(symbol string, tx *blocc.Tx, exec func(args ...interface{}) error) error {
+			for n, in := range tx.In {
+				if in == nil {
+					continue
+				}
+				data, err := jsonValue(in.Data)
+				if err != nil {
+					return err
+				}
+				metric, err := jsonValue(in.Metric)
+				if err != nil {
+					return err
+				}
+				out := in.Out
+				if out == nil {
+					out = new(blocc.TxOut)
+				}
+				outData, err := jsonValue(out.Data)
+				if err != nil {
+					return err
+				}
+				outMetric, err := jsonValue(out.Metric)
+				if err != nil {
+					return err
+				}
+				err = exec(symbol, tx.TxId, n, in.TxId, in.Height, bytesValue(in.Raw), data, metric,
+					in.Out != nil, out.Type, arrayValue(out.Addresses), out.Value, bytesValue(out.Raw), outData, outMetric)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	return copyIn(sqlTx, pq.CopyIn("tx_out", "symbol", "tx_id", "n", "type", "addresses", "value", "raw", "data", "metric"),
+		txs, func(symbol string, tx *blocc.Tx, exec func(args ...interface{}) error) error {
+			for n, out := range tx.Out {
+				if out == nil {
+					continue
+				}
+				data, err := jsonValue(out.Data)
+				if err != nil {
+					return err
+				}
+				metric, err := jsonValue(out.Metric)
+				if err != nil {
+					return err
+				}
+				if err = exec(symbol, tx.TxId, n, out.Type, arrayValue(out.Addresses), out.Value, bytesValue(out.Raw), data, metric); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+}
+
+// copyIn runs a COPY statement calling rows for each transaction to add it's rows
+func copyIn(sqlTx *sql.Tx, copyStmt string, txs []*pendingTx, rows func(symbol string, tx *blocc.Tx, exec func(args ...interface{}) error) error) error {
+
+	stmt, err := sqlTx.Prepare(copyStmt)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	exec := func(args ...interface{}) error {
+		_, err := stmt.Exec(args...)
+		return err
+	}
+
+	for _, pt := range txs {
+		if err := rows(pt.symbol, pt.tx, exec); err != nil {
+			return err
+		}
+	}
+
+	// Executing without arguments completes the copy
+	_, err = stmt.Exec()
+
+	return err
+
+}
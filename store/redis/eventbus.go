@@ -0,0 +1,67 @@
+package redis
+
+import (
+	"encoding/json"
+
+	"github.com/go-redis/redis"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+const eventKey = "events"
+
+type eventChannel struct {
+	sub    *redis.PubSub
+	client *client
+}
+
+// PublishEvent will publish an event to any listeners
+func (c *client) PublishEvent(symbol string, event *blocc.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return c.client.Publish(c.symPrefix(symbol)+eventKey, string(data)).Err()
+}
+
+// SubscribeEvents will listen for events
+func (c *client) SubscribeEvents(symbol string) (blocc.EventChannel, error) {
+	sub := c.client.Subscribe(c.symPrefix(symbol) + eventKey)
+	_, err := sub.Receive()
+	if err != nil {
+		return nil, err
+	}
+	return &eventChannel{
+		sub:    sub,
+		client: c,
+	}, nil
+}
+
+// Channel get a channel of events
+func (c *eventChannel) Channel() <-chan *blocc.Event {
+	eventChan := make(chan *blocc.Event)
+	go func() {
+		subChan := c.sub.Channel()
+		for {
+			// Get a message
+			m := <-subChan
+			// Channel closed
+			if m == nil {
+				close(eventChan)
+				return
+			}
+			event := new(blocc.Event)
+			err := json.Unmarshal([]byte(m.Payload), event)
+			if err != nil {
+				c.client.logger.Errorw("Could not unmarshal event", "error", err, "payload", m.Payload)
+				continue
+			}
+			eventChan <- event
+		}
+	}()
+	return eventChan
+}
+
+func (c *eventChannel) Close() {
+	c.sub.Close()
+}
@@ -0,0 +1,34 @@
+package redis
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/mocks"
+)
+
+func TestPublishEvent(t *testing.T) {
+
+	r := new(mocks.UniversalClient)
+	c := &client{
+		logger: zap.S().With("package", "cache.redis"),
+		prefix: "test",
+		client: r,
+	}
+
+	event := blocc.NewTxEvent(blocc.EventTxAdded, "btc", &blocc.Tx{TxId: "tx"})
+	data, err := json.Marshal(event)
+	assert.Nil(t, err)
+
+	r.On("Publish", "test:btc:events", string(data)).Once().Return(redis.NewIntResult(1, nil))
+
+	assert.Nil(t, c.PublishEvent("btc", event))
+
+	r.AssertExpectations(t)
+
+}
@@ -0,0 +1,126 @@
+package redis
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+const (
+	txRelayKey      = "relay_tx"
+	txRelayReplyKey = "relay_tx_reply"
+)
+
+// txRelayRequest is a transaction sent to the TxRelay handlers, the result is pushed on the reply list of it's id
+type txRelayRequest struct {
+	Id      string        `json:"id"`
+	Raw     []byte        `json:"raw"`
+	Timeout time.Duration `json:"timeout"`
+}
+
+type txRelayHandler struct {
+	sub *redis.PubSub
+}
+
+// RelayTx will publish a transaction to the TxRelay handlers and wait for the first result
+func (c *client) RelayTx(symbol string, raw []byte, timeout time.Duration) error {
+
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return err
+	}
+
+	req := &txRelayRequest{
+		Id:      hex.EncodeToString(id),
+		Raw:     raw,
+		Timeout: timeout,
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	// Nobody is listening
+	receivers, err := c.client.Publish(c.symPrefix(symbol)+txRelayKey, string(data)).Result()
+	if err != nil {
+		return err
+	} else if receivers == 0 {
+		return blocc.ErrNoTxRelay
+	}
+
+	// The reply is the error or blank if it was relayed
+	values, err := c.client.BLPop(timeout, c.symPrefix(symbol)+txRelayReplyKey+Delimeter+req.Id).Result()
+	if err == redis.Nil {
+		return fmt.Errorf("Timed out waiting for transaction relay")
+	} else if err != nil {
+		return err
+	} else if len(values) != 2 {
+		return fmt.Errorf("Invalid transaction relay reply")
+	}
+	if values[1] != "" {
+		return errors.New(values[1])
+	}
+
+	return nil
+
+}
+
+// HandleRelayTxs will call relay for each transaction published with RelayTx and reply with the result
+func (c *client) HandleRelayTxs(symbol string, relay func(raw []byte) error) (blocc.TxRelayHandler, error) {
+
+	sub := c.client.Subscribe(c.symPrefix(symbol) + txRelayKey)
+	_, err := sub.Receive()
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for m := range sub.Channel() {
+			req := new(txRelayRequest)
+			err := json.Unmarshal([]byte(m.Payload), req)
+			if err != nil {
+				c.logger.Errorw("Could not unmarshal relay request", "error", err, "payload", m.Payload)
+				continue
+			}
+			err = c.replyRelayTx(symbol, req, relay(req.Raw))
+			if err != nil {
+				c.logger.Errorw("Could not reply to relay request", "error", err, "id", req.Id)
+			}
+		}
+	}()
+
+	return &txRelayHandler{
+		sub: sub,
+	}, nil
+
+}
+
+// replyRelayTx pushes the result of a request, it expires if the sender is no longer waiting
+func (c *client) replyRelayTx(symbol string, req *txRelayRequest, relayErr error) error {
+
+	var reply string
+	if relayErr != nil {
+		reply = relayErr.Error()
+	}
+
+	key := c.symPrefix(symbol) + txRelayReplyKey + Delimeter + req.Id
+	_, err := c.client.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.RPush(key, reply)
+		pipe.Expire(key, req.Timeout)
+		return nil
+	})
+	return err
+
+}
+
+func (h *txRelayHandler) Close() {
+	h.sub.Close()
+}
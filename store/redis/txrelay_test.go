@@ -0,0 +1,40 @@
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/mocks"
+)
+
+func TestRelayTx(t *testing.T) {
+
+	r := new(mocks.UniversalClient)
+	c := &client{
+		logger: zap.S().With("package", "cache.redis"),
+		prefix: "test",
+		client: r,
+	}
+
+	// Nothing is handling transactions
+	r.On("Publish", "test:btc:relay_tx", mock.AnythingOfType("string")).Once().Return(redis.NewIntResult(0, nil))
+	assert.Equal(t, blocc.ErrNoTxRelay, c.RelayTx("btc", []byte{1}, time.Second))
+
+	// Relayed
+	r.On("Publish", "test:btc:relay_tx", mock.AnythingOfType("string")).Twice().Return(redis.NewIntResult(1, nil))
+	r.On("BLPop", time.Second, mock.AnythingOfType("string")).Once().Return(redis.NewStringSliceResult([]string{"key", ""}, nil))
+	assert.Nil(t, c.RelayTx("btc", []byte{1}, time.Second))
+
+	// The handler could not relay it
+	r.On("BLPop", time.Second, mock.AnythingOfType("string")).Once().Return(redis.NewStringSliceResult([]string{"key", "Not connected to peer"}, nil))
+	assert.EqualError(t, c.RelayTx("btc", []byte{1}, time.Second), "Not connected to peer")
+
+	r.AssertExpectations(t)
+
+}
@@ -0,0 +1,172 @@
+package redis
+
+import (
+	"sort"
+	"time"
+
+	"github.com/go-redis/redis"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+const (
+	webhookKey           = "webhooks"
+	webhookQueueKey      = "webhook_queue"
+	webhookDeliveryKey   = "webhook_deliveries"
+	webhookDeadLetterKey = "webhook_dead_letters"
+
+	// Take the due deliveries from the queue (KEYS[1]) and their data (KEYS[2]) scheduling them again at the lease time
+	WebhookTakeScript = `local ret = {}
+for _,id in ipairs(redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[3])) do
+	local d = redis.call('HGET', KEYS[2], id)
+	if d then
+		redis.call('ZADD', KEYS[1], ARGV[2], id)
+		table.insert(ret, d)
+	else
+		redis.call('ZREM', KEYS[1], id)
+	end
+end
+return ret`
+)
+
+// InsertWebhook will add or replace a webhook
+func (c *client) InsertWebhook(symbol string, wh *blocc.Webhook) error {
+	return c.client.HSet(c.symPrefix(symbol)+webhookKey, wh.Id, wh).Err()
+}
+
+// GetWebhook will return a webhook by id
+func (c *client) GetWebhook(symbol string, id string) (*blocc.Webhook, error) {
+	wh := new(blocc.Webhook)
+	err := c.client.HGet(c.symPrefix(symbol)+webhookKey, id).Scan(wh)
+	if err == redis.Nil {
+		return nil, blocc.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return wh, nil
+}
+
+// FindWebhooks will return all of the webhooks ordered by time
+func (c *client) FindWebhooks(symbol string) ([]*blocc.Webhook, error) {
+	values, err := c.client.HGetAll(c.symPrefix(symbol) + webhookKey).Result()
+	if err == redis.Nil {
+		return []*blocc.Webhook{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	whs := make([]*blocc.Webhook, 0, len(values))
+	for _, value := range values {
+		wh := new(blocc.Webhook)
+		err = wh.UnmarshalBinary([]byte(value))
+		if err != nil {
+			return nil, err
+		}
+		whs = append(whs, wh)
+	}
+	sort.Slice(whs, func(i, j int) bool {
+		if whs[i].Time == whs[j].Time {
+			return whs[i].Id < whs[j].Id
+		}
+		return whs[i].Time < whs[j].Time
+	})
+	return whs, nil
+}
+
+// DeleteWebhook will remove a webhook and it's dead letters, queued deliveries are left for the worker to drop
+func (c *client) DeleteWebhook(symbol string, id string) error {
+	_, err := c.client.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.HDel(c.symPrefix(symbol)+webhookKey, id)
+		pipe.Del(c.symPrefix(symbol) + webhookDeadLetterKey + Delimeter + id)
+		return nil
+	})
+	if err == redis.Nil {
+		return nil
+	}
+	return err
+}
+
+// ScheduleWebhookDelivery will queue a delivery to be taken at a time
+func (c *client) ScheduleWebhookDelivery(symbol string, d *blocc.WebhookDelivery, at time.Time) error {
+	_, err := c.client.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.HSet(c.symPrefix(symbol)+webhookDeliveryKey, d.Id, d)
+		pipe.ZAdd(c.symPrefix(symbol)+webhookQueueKey, redis.Z{Score: float64(unixMilli(at)), Member: d.Id})
+		return nil
+	})
+	return err
+}
+
+// TakeWebhookDeliveries will return up to count deliveries due by now and schedule them again after lease
+func (c *client) TakeWebhookDeliveries(symbol string, now time.Time, lease time.Duration, count int) ([]*blocc.WebhookDelivery, error) {
+	values, err := c.client.Eval(WebhookTakeScript, []string{c.symPrefix(symbol) + webhookQueueKey, c.symPrefix(symbol) + webhookDeliveryKey}, unixMilli(now), unixMilli(now.Add(lease)), count).Result()
+	if err == redis.Nil {
+		return []*blocc.WebhookDelivery{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return unmarshalWebhookDeliveries(values)
+}
+
+// DeleteWebhookDelivery will remove a delivery from the queue
+func (c *client) DeleteWebhookDelivery(symbol string, id string) error {
+	_, err := c.client.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.ZRem(c.symPrefix(symbol)+webhookQueueKey, id)
+		pipe.HDel(c.symPrefix(symbol)+webhookDeliveryKey, id)
+		return nil
+	})
+	if err == redis.Nil {
+		return nil
+	}
+	return err
+}
+
+// DeadLetterWebhookDelivery will remove a delivery from the queue and push it on the dead letter list of it's webhook
+func (c *client) DeadLetterWebhookDelivery(symbol string, d *blocc.WebhookDelivery, max int) error {
+	_, err := c.client.TxPipelined(func(pipe redis.Pipeliner) error {
+		key := c.symPrefix(symbol) + webhookDeadLetterKey + Delimeter + d.WebhookId
+		pipe.LPush(key, d)
+		pipe.LTrim(key, 0, int64(max-1))
+		pipe.ZRem(c.symPrefix(symbol)+webhookQueueKey, d.Id)
+		pipe.HDel(c.symPrefix(symbol)+webhookDeliveryKey, d.Id)
+		return nil
+	})
+	return err
+}
+
+// GetWebhookDeadLetters will return the dead letters of a webhook, most recent first
+func (c *client) GetWebhookDeadLetters(symbol string, webhookId string) ([]*blocc.WebhookDelivery, error) {
+	values, err := c.client.LRange(c.symPrefix(symbol)+webhookDeadLetterKey+Delimeter+webhookId, 0, -1).Result()
+	if err == redis.Nil {
+		return []*blocc.WebhookDelivery{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	ret := make([]interface{}, len(values))
+	for i, value := range values {
+		ret[i] = value
+	}
+	return unmarshalWebhookDeliveries(ret)
+}
+
+// unmarshalWebhookDeliveries parses the deliveries returned from a script or list
+func unmarshalWebhookDeliveries(values interface{}) ([]*blocc.WebhookDelivery, error) {
+	list, _ := values.([]interface{})
+	ds := make([]*blocc.WebhookDelivery, 0, len(list))
+	for _, value := range list {
+		s, ok := value.(string)
+		if !ok {
+			continue
+		}
+		d := new(blocc.WebhookDelivery)
+		err := d.UnmarshalBinary([]byte(s))
+		if err != nil {
+			return nil, err
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+// unixMilli is the queue score of a time
+func unixMilli(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
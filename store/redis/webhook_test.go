@@ -0,0 +1,39 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/mocks"
+)
+
+func TestGetWebhook(t *testing.T) {
+
+	r := new(mocks.UniversalClient)
+	c := &client{
+		logger: zap.S().With("package", "cache.redis"),
+		prefix: "test",
+		client: r,
+	}
+
+	wh := &blocc.Webhook{Id: "webhook", URL: "https://example.com/hook", Blocks: true}
+	data, err := wh.MarshalBinary()
+	assert.Nil(t, err)
+
+	r.On("HGet", "test:btc:webhooks", "webhook").Once().Return(redis.NewStringResult(string(data), nil))
+	r.On("HGet", "test:btc:webhooks", "missing").Once().Return(redis.NewStringResult("", redis.Nil))
+
+	got, err := c.GetWebhook("btc", "webhook")
+	assert.Nil(t, err)
+	assert.Equal(t, wh, got)
+
+	_, err = c.GetWebhook("btc", "missing")
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+	r.AssertExpectations(t)
+
+}
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"sort"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// SortBlocksByTimeDesc sorts blocks by time descending using the blockId as a tie breaker
+func SortBlocksByTimeDesc(blks []*blocc.Block) {
+	sort.Slice(blks, func(i, j int) bool {
+		if blks[i].Time != blks[j].Time {
+			return blks[i].Time > blks[j].Time
+		}
+		return blks[i].BlockId < blks[j].BlockId
+	})
+}
+
+// SortBlocksByHeight sorts blocks by height ascending using the blockId as a tie breaker
+func SortBlocksByHeight(blks []*blocc.Block) {
+	sort.Slice(blks, func(i, j int) bool {
+		if blks[i].Height != blks[j].Height {
+			return blks[i].Height < blks[j].Height
+		}
+		return blks[i].BlockId < blks[j].BlockId
+	})
+}
+
+// SortTxsByTimeDesc sorts transactions by time descending using the txId as a tie breaker
+func SortTxsByTimeDesc(txs []*blocc.Tx) {
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].Time != txs[j].Time {
+			return txs[i].Time > txs[j].Time
+		}
+		return txs[i].TxId < txs[j].TxId
+	})
+}
+
+// SortTxsByHeight sorts transactions by height within the block ascending
+func SortTxsByHeight(txs []*blocc.Tx) {
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].Height != txs[j].Height {
+			return txs[i].Height < txs[j].Height
+		}
+		return txs[i].TxId < txs[j].TxId
+	})
+}
+
+// SortOutputsByTimeDesc sorts outputs by time descending using the txId and height as tie breakers
+func SortOutputsByTimeDesc(outputs []*blocc.Output) {
+	sort.Slice(outputs, func(i, j int) bool {
+		if outputs[i].Time != outputs[j].Time {
+			return outputs[i].Time > outputs[j].Time
+		}
+		if outputs[i].TxId != outputs[j].TxId {
+			return outputs[i].TxId < outputs[j].TxId
+		}
+		return outputs[i].Height < outputs[j].Height
+	})
+}
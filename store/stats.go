@@ -0,0 +1,50 @@
+package store
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+// BlockField returns the string value of a data or metric field (ie data.fee_vsize_p10) on a block
+func BlockField(b *blocc.Block, field string) (string, bool) {
+	if strings.HasPrefix(field, "data.") {
+		value, ok := b.Data[strings.TrimPrefix(field, "data.")]
+		return value, ok
+	} else if strings.HasPrefix(field, "metric.") {
+		value, ok := b.Metric[strings.TrimPrefix(field, "metric.")]
+		return strconv.FormatFloat(value, 'f', -1, 64), ok
+	}
+	return "", false
+}
+
+// IsZeroField checks for the zero values that are skipped when omitting zeros
+func IsZeroField(value string) bool {
+	return value == "0" || value == "0.0"
+}
+
+// Percentile returns the percentile (0-100) of values using linear interpolation between closest ranks
+func Percentile(values []float64, percentile float64) float64 {
+
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	rank := percentile / 100 * float64(len(sorted)-1)
+	if rank <= 0 {
+		return sorted[0]
+	} else if rank >= float64(len(sorted)-1) {
+		return sorted[len(sorted)-1]
+	}
+	lower := math.Floor(rank)
+	frac := rank - lower
+
+	return sorted[int(lower)] + frac*(sorted[int(lower)+1]-sorted[int(lower)])
+}
@@ -0,0 +1,290 @@
+package storetest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/gogo/protobuf/proto"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/btc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// Symbol is the symbol the fixtures are stored under
+const Symbol = "btc"
+
+// golden is the format of testdata/regtest.json, the serialized regtest blocks and mempool transactions
+type golden struct {
+	Blocks []struct {
+		Height int64  `json:"height"`
+		Status string `json:"status"`
+		Hex    string `json:"hex"`
+	} `json:"blocks"`
+	MemPool []struct {
+		Time int64  `json:"time"`
+		Hex  string `json:"hex"`
+	} `json:"mempool"`
+}
+
+// Fixtures are the regtest blocks and transactions as the btc extractor would store them
+//
+// The chain is the regtest genesis block followed by blocks 1-4 spending between three addresses
+// (A and C are P2PKH, B is P2WPKH). Block 3 has an orphaned sibling and there are two mempool
+// transactions, the second of which spends an unknown output and is incomplete.
+type Fixtures struct {
+	Blocks []*blocc.Block // Valid blocks by height followed by the orphan
+	Txs    []*blocc.Tx    // Transactions in block order followed by the mempool
+
+	Outputs    []*blocc.Output    // The outputs created and spent by Txs as the extractor would upsert them
+	AddressTxs []*blocc.AddressTx // The effect of Txs on their addresses as the extractor would upsert them
+
+	AddressA string
+	AddressB string
+	AddressC string
+}
+
+// goldenPath returns the path of the golden file regardless of the package running the tests
+func goldenPath() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "testdata", "regtest.json")
+}
+
+// LoadFixtures parses the golden regtest blocks
+func LoadFixtures() (*Fixtures, error) {
+
+	raw, err := ioutil.ReadFile(goldenPath())
+	if err != nil {
+		return nil, fmt.Errorf("Could not read fixtures: %v", err)
+	}
+
+	var g golden
+	if err = json.Unmarshal(raw, &g); err != nil {
+		return nil, fmt.Errorf("Could not parse fixtures: %v", err)
+	}
+
+	return parseGolden(&g)
+
+}
+
+// parseGolden converts the serialized blocks and transactions
+func parseGolden(g *golden) (*Fixtures, error) {
+
+	chain, err := btc.GetChain(Symbol)
+	if err != nil {
+		return nil, err
+	}
+	params := &chaincfg.RegressionNetParams
+	f := new(Fixtures)
+	outputs := make(map[string]*blocc.Tx)
+
+	for _, gb := range g.Blocks {
+		raw, err := hex.DecodeString(gb.Hex)
+		if err != nil {
+			return nil, fmt.Errorf("Could not decode block %d: %v", gb.Height, err)
+		}
+		wBlk := new(wire.MsgBlock)
+		if err = wBlk.Deserialize(bytes.NewReader(raw)); err != nil {
+			return nil, fmt.Errorf("Could not parse block %d: %v", gb.Height, err)
+		}
+		blk, txs := btc.ConvertBlock(wBlk, gb.Height, chain, params, outputs)
+		blk.Status = gb.Status
+		f.Blocks = append(f.Blocks, blk)
+		f.Txs = append(f.Txs, txs...)
+	}
+
+	// Link the valid chain
+	for x := 1; x < len(f.Blocks); x++ {
+		if f.Blocks[x].PrevBlockId == f.Blocks[x-1].BlockId && f.Blocks[x].Status == blocc.StatusValid {
+			f.Blocks[x-1].NextBlockId = f.Blocks[x].BlockId
+		}
+	}
+
+	for _, gt := range g.MemPool {
+		raw, err := hex.DecodeString(gt.Hex)
+		if err != nil {
+			return nil, fmt.Errorf("Could not decode mempool tx: %v", err)
+		}
+		wTx := new(wire.MsgTx)
+		if err = wTx.Deserialize(bytes.NewReader(raw)); err != nil {
+			return nil, fmt.Errorf("Could not parse mempool tx: %v", err)
+		}
+		tx := btc.ConvertTx(wTx, 0, nil, chain, params, outputs)
+		tx.Time = gt.Time
+		tx.Data["received_time"] = fmt.Sprint(gt.Time)
+		f.Txs = append(f.Txs, tx)
+	}
+
+	for _, tx := range f.Txs {
+		f.Outputs = append(f.Outputs, btc.TrackOutputs(tx, tx.Data["coinbase"] == "true")...)
+		f.AddressTxs = append(f.AddressTxs, store.AddressTxs(tx)...)
+	}
+
+	// The addresses are those paid by the coinbase of blocks 1, 2 and 4
+	if len(f.Blocks) < 5 {
+		return nil, fmt.Errorf("Expected at least 5 blocks got %d", len(f.Blocks))
+	}
+	f.AddressA = f.Tx(f.Blocks[1].TxIds[0]).Out[0].Addresses[0]
+	f.AddressB = f.Tx(f.Blocks[2].TxIds[0]).Out[0].Addresses[0]
+	f.AddressC = f.Tx(f.Blocks[4].TxIds[0]).Out[0].Addresses[0]
+
+	return f, nil
+
+}
+
+// Block returns a copy of the fixture block at index x
+func (f *Fixtures) Block(x int) *blocc.Block {
+	return cloneBlock(f.Blocks[x])
+}
+
+// Tx returns a copy of the fixture transaction by txId or nil
+func (f *Fixtures) Tx(txId string) *blocc.Tx {
+	for _, tx := range f.Txs {
+		if tx.TxId == txId {
+			return cloneTx(tx)
+		}
+	}
+	return nil
+}
+
+// TxsByBlockId returns copies of the fixture transactions in a block
+func (f *Fixtures) TxsByBlockId(blockId string) []*blocc.Tx {
+	ret := make([]*blocc.Tx, 0)
+	for _, tx := range f.Txs {
+		if tx.BlockId == blockId {
+			ret = append(ret, cloneTx(tx))
+		}
+	}
+	return ret
+}
+
+// MemPool returns copies of the fixture mempool transactions in the order received
+func (f *Fixtures) MemPool() []*blocc.Tx {
+	return f.TxsByBlockId(blocc.BlockIdMempool)
+}
+
+// UnspentOutputs returns the expected unspent outputs of addresses after all of Outputs are upserted
+func (f *Fixtures) UnspentOutputs(addresses ...string) []*blocc.Output {
+
+	merged := make(map[string]*blocc.Output)
+	keys := make([]string, 0)
+	for _, o := range f.Outputs {
+		key := fmt.Sprintf("%s:%d", o.TxId, o.Height)
+		if _, ok := merged[key]; !ok {
+			merged[key] = new(blocc.Output)
+			keys = append(keys, key)
+		}
+		store.MergeOutput(merged[key], proto.Clone(o).(*blocc.Output))
+	}
+
+	ret := make([]*blocc.Output, 0)
+	for _, key := range keys {
+		o := merged[key]
+		if store.IsUnspentOutput(o) && store.OutputHasAddress(o, addresses) {
+			ret = append(ret, o)
+		}
+	}
+	store.SortOutputsByTimeDesc(ret)
+
+	return ret
+
+}
+
+// Address returns the expected summary of an address after all of AddressTxs except those in skipBlockIds
+// are upserted or nil if it has no transactions
+func (f *Fixtures) Address(address string, skipBlockIds ...string) *blocc.Address {
+
+	skip := make(map[string]bool)
+	for _, blockId := range skipBlockIds {
+		skip[blockId] = true
+	}
+
+	a := &blocc.Address{Symbol: Symbol, Address: address}
+	var first, last *blocc.AddressTx
+	for _, atx := range f.AddressTxs {
+		if atx.Address != address || skip[atx.BlockId] {
+			continue
+		}
+		if blocc.IsMemPool(atx.BlockId) {
+			a.MempoolTxCount++
+			a.MempoolReceived += atx.Received
+			a.MempoolSent += atx.Sent
+			continue
+		}
+		a.TxCount++
+		a.Received += atx.Received
+		a.Sent += atx.Sent
+		if first == nil || atx.BlockHeight < first.BlockHeight || (atx.BlockHeight == first.BlockHeight && atx.BlockId < first.BlockId) {
+			first = atx
+		}
+		if last == nil || atx.BlockHeight > last.BlockHeight || (atx.BlockHeight == last.BlockHeight && atx.BlockId < last.BlockId) {
+			last = atx
+		}
+	}
+	if a.TxCount == 0 && a.MempoolTxCount == 0 {
+		return nil
+	}
+	a.Balance = a.Received - a.Sent
+	a.MempoolBalance = a.MempoolReceived - a.MempoolSent
+	if first != nil {
+		a.FirstSeenBlockId = first.BlockId
+		a.FirstSeenBlockHeight = first.BlockHeight
+		a.LastSeenBlockId = last.BlockId
+		a.LastSeenBlockHeight = last.BlockHeight
+	}
+
+	return a
+
+}
+
+// Orphan returns the orphaned block
+func (f *Fixtures) Orphan() *blocc.Block {
+	for _, blk := range f.Blocks {
+		if blk.Status == blocc.StatusOrphaned {
+			return cloneBlock(blk)
+		}
+	}
+	return nil
+}
+
+// Load stores all of the fixtures and flushes them
+func (f *Fixtures) Load(bcs blocc.BlockChainStore) error {
+
+	if err := bcs.Init(Symbol); err != nil {
+		return fmt.Errorf("Could not Init: %v", err)
+	}
+	for _, blk := range f.Blocks {
+		if err := bcs.InsertBlock(Symbol, cloneBlock(blk)); err != nil {
+			return fmt.Errorf("Could not InsertBlock: %v", err)
+		}
+	}
+	for _, tx := range f.Txs {
+		if err := bcs.InsertTransaction(Symbol, cloneTx(tx)); err != nil {
+			return fmt.Errorf("Could not InsertTransaction: %v", err)
+		}
+	}
+	outputs := make([]*blocc.Output, len(f.Outputs))
+	for x, o := range f.Outputs {
+		outputs[x] = proto.Clone(o).(*blocc.Output)
+	}
+	if err := bcs.UpsertOutputs(Symbol, outputs); err != nil {
+		return fmt.Errorf("Could not UpsertOutputs: %v", err)
+	}
+	atxs := make([]*blocc.AddressTx, len(f.AddressTxs))
+	for x, atx := range f.AddressTxs {
+		atxs[x] = proto.Clone(atx).(*blocc.AddressTx)
+	}
+	if err := bcs.UpsertAddressTxs(Symbol, atxs); err != nil {
+		return fmt.Errorf("Could not UpsertAddressTxs: %v", err)
+	}
+
+	return flush(bcs)
+
+}
@@ -0,0 +1,278 @@
+package storetest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+
+	"git.coinninja.net/backend/blocc/blocc"
+)
+
+var update = flag.Bool("update", false, "Regenerate testdata/regtest.json")
+
+// TestGolden checks the golden file matches the regtest chain built below, run with -update to regenerate it
+func TestGolden(t *testing.T) {
+
+	g, err := buildGolden()
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	generated, err := json.MarshalIndent(g, "", "  ")
+	assert.Nil(t, err)
+
+	if *update {
+		assert.Nil(t, ioutil.WriteFile(goldenPath(), append(generated, '\n'), 0644))
+	}
+
+	raw, err := ioutil.ReadFile(goldenPath())
+	assert.Nil(t, err)
+	assert.JSONEq(t, string(generated), string(raw))
+
+	f, err := LoadFixtures()
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	assert.Len(t, f.Blocks, 6)
+	assert.Len(t, f.Txs, 11)
+	assert.Equal(t, "5000010000", f.Blocks[2].Data["coinbase_value"])
+	assert.Equal(t, "70000", f.Blocks[3].Data["fee"])
+	assert.True(t, f.Txs[len(f.Txs)-1].Incomplete)
+
+}
+
+// regtest signs and mines blocks on the regression test network
+type regtest struct {
+	params *chaincfg.Params
+	keys   map[string]*btcec.PrivateKey
+	golden *golden
+}
+
+// key returns a deterministic key
+func (r *regtest) key(name string) *btcec.PrivateKey {
+	if key, ok := r.keys[name]; ok {
+		return key
+	}
+	key, _ := btcec.PrivKeyFromBytes(btcec.S256(), chainhash.HashB([]byte("storetest "+name)))
+	r.keys[name] = key
+	return key
+}
+
+// p2pkh returns the pay to pubkey hash script for a key
+func (r *regtest) p2pkh(name string) []byte {
+	addr, err := btcutil.NewAddressPubKeyHash(btcutil.Hash160(r.key(name).PubKey().SerializeCompressed()), r.params)
+	if err != nil {
+		panic(err)
+	}
+	script, _ := txscript.PayToAddrScript(addr)
+	return script
+}
+
+// p2wpkh returns the pay to witness pubkey hash script for a key
+func (r *regtest) p2wpkh(name string) []byte {
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(r.key(name).PubKey().SerializeCompressed()), r.params)
+	if err != nil {
+		panic(err)
+	}
+	script, _ := txscript.PayToAddrScript(addr)
+	return script
+}
+
+// coinbase pays value to script
+func (r *regtest) coinbase(height int64, extraNonce int64, script []byte, value int64) *wire.MsgTx {
+	sigScript, _ := txscript.NewScriptBuilder().AddInt64(height).AddInt64(extraNonce).Script()
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{}, wire.MaxPrevOutIndex),
+		SignatureScript:  sigScript,
+		Sequence:         wire.MaxTxInSequenceNum,
+	})
+	tx.AddTxOut(wire.NewTxOut(value, script))
+	return tx
+}
+
+// spend signs a transaction spending output n of prev with the key name
+func (r *regtest) spend(prev *chainhash.Hash, n uint32, prevScript []byte, prevValue int64, name string, outs ...*wire.TxOut) (*wire.MsgTx, error) {
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prev, n), nil, nil))
+	for _, out := range outs {
+		tx.AddTxOut(out)
+	}
+
+	var err error
+	if txscript.IsPayToWitnessPubKeyHash(prevScript) {
+		tx.TxIn[0].Witness, err = txscript.WitnessSignature(tx, txscript.NewTxSigHashes(tx), 0, prevValue, prevScript, txscript.SigHashAll, r.key(name), true)
+	} else {
+		tx.TxIn[0].SignatureScript, err = txscript.SignatureScript(tx, 0, prevScript, txscript.SigHashAll, r.key(name), true)
+	}
+
+	return tx, err
+
+}
+
+// mine builds a block with a valid proof of work and adds it to the golden file
+func (r *regtest) mine(prev *wire.MsgBlock, height int64, timestamp int64, status string, coinbase *wire.MsgTx, txs ...*wire.MsgTx) *wire.MsgBlock {
+
+	txs = append([]*wire.MsgTx{coinbase}, txs...)
+	utxs := make([]*btcutil.Tx, len(txs))
+	witness := false
+	for x, tx := range txs {
+		utxs[x] = btcutil.NewTx(tx)
+		witness = witness || tx.HasWitness()
+	}
+
+	// Commit to the witness data in the coinbase
+	if witness {
+		var nonce [blockchain.CoinbaseWitnessDataLen]byte
+		coinbase.TxIn[0].Witness = wire.TxWitness{nonce[:]}
+		witnessTree := blockchain.BuildMerkleTreeStore(utxs, true)
+		preimage := append(witnessTree[len(witnessTree)-1][:], nonce[:]...)
+		script := append(append([]byte{}, blockchain.WitnessMagicBytes...), chainhash.DoubleHashB(preimage)...)
+		coinbase.AddTxOut(wire.NewTxOut(0, script))
+		utxs[0] = btcutil.NewTx(coinbase)
+	}
+
+	merkles := blockchain.BuildMerkleTreeStore(utxs, false)
+	blk := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:    0x20000000,
+			PrevBlock:  prev.BlockHash(),
+			MerkleRoot: *merkles[len(merkles)-1],
+			Timestamp:  time.Unix(timestamp, 0),
+			Bits:       r.params.PowLimitBits,
+		},
+		Transactions: txs,
+	}
+
+	target := blockchain.CompactToBig(blk.Header.Bits)
+	for hash := blk.BlockHash(); blockchain.HashToBig(&hash).Cmp(target) > 0; hash = blk.BlockHash() {
+		blk.Header.Nonce++
+	}
+
+	r.addBlock(blk, height, status)
+
+	return blk
+
+}
+
+func (r *regtest) addBlock(blk *wire.MsgBlock, height int64, status string) {
+	var b bytes.Buffer
+	blk.Serialize(&b)
+	r.golden.Blocks = append(r.golden.Blocks, struct {
+		Height int64  `json:"height"`
+		Status string `json:"status"`
+		Hex    string `json:"hex"`
+	}{height, status, hex.EncodeToString(b.Bytes())})
+}
+
+func (r *regtest) addMemPool(tx *wire.MsgTx, timestamp int64) {
+	var b bytes.Buffer
+	tx.Serialize(&b)
+	r.golden.MemPool = append(r.golden.MemPool, struct {
+		Time int64  `json:"time"`
+		Hex  string `json:"hex"`
+	}{timestamp, hex.EncodeToString(b.Bytes())})
+}
+
+// buildGolden builds the chain described on Fixtures
+func buildGolden() (*golden, error) {
+
+	r := &regtest{
+		params: &chaincfg.RegressionNetParams,
+		keys:   make(map[string]*btcec.PrivateKey),
+		golden: new(golden),
+	}
+
+	const coin = btcutil.SatoshiPerBitcoin
+	const subsidy = 50 * coin
+
+	genesis := r.params.GenesisBlock
+	t0 := genesis.Header.Timestamp.Unix()
+	r.addBlock(genesis, 0, blocc.StatusValid)
+
+	cb1 := r.coinbase(1, 0, r.p2pkh("a"), subsidy)
+	blk1 := r.mine(genesis, 1, t0+600, blocc.StatusValid, cb1)
+
+	tx2a, err := r.spend(ptr(cb1.TxHash()), 0, r.p2pkh("a"), subsidy, "a",
+		wire.NewTxOut(30*coin, r.p2wpkh("b")),
+		wire.NewTxOut(20*coin-10000, r.p2pkh("c")),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not sign tx2a: %v", err)
+	}
+	cb2 := r.coinbase(2, 0, r.p2wpkh("b"), subsidy+10000)
+	blk2 := r.mine(blk1, 2, t0+1200, blocc.StatusValid, cb2, tx2a)
+
+	tx3a, err := r.spend(ptr(tx2a.TxHash()), 0, r.p2wpkh("b"), 30*coin, "b",
+		wire.NewTxOut(10*coin, r.p2pkh("a")),
+		wire.NewTxOut(20*coin-20000, r.p2wpkh("b")),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not sign tx3a: %v", err)
+	}
+	tx3b, err := r.spend(ptr(cb2.TxHash()), 0, r.p2wpkh("b"), subsidy+10000, "b",
+		wire.NewTxOut(subsidy+10000-50000, r.p2pkh("c")),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not sign tx3b: %v", err)
+	}
+	cb3 := r.coinbase(3, 0, r.p2pkh("a"), subsidy+70000)
+	blk3 := r.mine(blk2, 3, t0+1800, blocc.StatusValid, cb3, tx3a, tx3b)
+
+	cb4 := r.coinbase(4, 0, r.p2pkh("c"), subsidy)
+	blk4 := r.mine(blk3, 4, t0+2400, blocc.StatusValid, cb4)
+
+	// A competing block 3 that lost
+	r.mine(blk2, 3, t0+1801, blocc.StatusOrphaned, r.coinbase(3, 1, r.p2pkh("c"), subsidy))
+
+	m1, err := r.spend(ptr(tx3a.TxHash()), 0, r.p2pkh("a"), 10*coin, "a",
+		wire.NewTxOut(10*coin-100000, r.p2pkh("c")),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not sign m1: %v", err)
+	}
+	r.addMemPool(m1, blk4.Header.Timestamp.Unix()+100)
+
+	// Spends an output that is not in the chain
+	m2, err := r.spend(ptr(chainhash.HashH([]byte("storetest missing"))), 0, r.p2pkh("a"), coin, "a",
+		wire.NewTxOut(coin-1000, r.p2pkh("a")),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not sign m2: %v", err)
+	}
+	r.addMemPool(m2, blk4.Header.Timestamp.Unix()+200)
+
+	// Sanity check the proof of work
+	for _, gb := range r.golden.Blocks[1:] {
+		raw, _ := hex.DecodeString(gb.Hex)
+		blk, err := btcutil.NewBlockFromBytes(raw)
+		if err != nil {
+			return nil, err
+		}
+		if err = blockchain.CheckProofOfWork(blk, r.params.PowLimit); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.golden, nil
+
+}
+
+func ptr(h chainhash.Hash) *chainhash.Hash {
+	return &h
+}
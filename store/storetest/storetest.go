@@ -0,0 +1,1086 @@
+// Package storetest is a conformance test suite for blocc.BlockChainStore implementations. Each backend
+// runs it from it's own tests with a Factory that returns an empty store:
+//
+//	func TestBlockChainStore(t *testing.T) {
+//		storetest.Run(t, func(t *testing.T) (blocc.BlockChainStore, func()) {
+//			...
+//		})
+//	}
+//
+// Every test loads the golden regtest fixtures into a new store and checks the results against them.
+package storetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"git.coinninja.net/backend/blocc/blocc"
+	"git.coinninja.net/backend/blocc/blocc/btc"
+	"git.coinninja.net/backend/blocc/store"
+)
+
+// Factory returns a new empty store and a function that cleans it up
+type Factory func(t *testing.T) (blocc.BlockChainStore, func())
+
+var tests = []struct {
+	name string
+	fn   func(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures)
+}{
+	{"GetBlockByBlockId", testGetBlockByBlockId},
+	{"GetBlockHeaderTopByStatuses", testGetBlockHeaderTopByStatuses},
+	{"GetBlockTopByStatuses", testGetBlockTopByStatuses},
+	{"FindBlocksByHeight", testFindBlocksByHeight},
+	{"FindBlocksByPrevBlockId", testFindBlocksByPrevBlockId},
+	{"FindBlocksByTxId", testFindBlocksByTxId},
+	{"FindBlocksByBlockIdsAndTime", testFindBlocksByBlockIdsAndTime},
+	{"FindBlocksByStatusAndHeight", testFindBlocksByStatusAndHeight},
+	{"UpdateBlock", testUpdateBlock},
+	{"UpdateBlockStatusByStatusesAndHeight", testUpdateBlockStatusByStatusesAndHeight},
+	{"DeleteBlockByBlockId", testDeleteBlockByBlockId},
+	{"DeleteAboveBlockHeight", testDeleteAboveBlockHeight},
+	{"GetTxByTxId", testGetTxByTxId},
+	{"GetTxsByTxIds", testGetTxsByTxIds},
+	{"GetTxsByBlockId", testGetTxsByBlockId},
+	{"GetTxCountByBlockId", testGetTxCountByBlockId},
+	{"FindTxs", testFindTxs},
+	{"FindTxsByAddressesAndTime", testFindTxsByAddressesAndTime},
+	{"UpsertTransaction", testUpsertTransaction},
+	{"UpdateTxBlockIdByBlockId", testUpdateTxBlockIdByBlockId},
+	{"DeleteTransactionsByBlockIdAndTime", testDeleteTransactionsByBlockIdAndTime},
+	{"GetMemPoolStats", testGetMemPoolStats},
+	{"GetAddressStats", testGetAddressStats},
+	{"UpsertOutputs", testUpsertOutputs},
+	{"UpdateOutputBlockIdByBlockId", testUpdateOutputBlockIdByBlockId},
+	{"RollbackOutputsByBlockId", testRollbackOutputsByBlockId},
+	{"GetOutputByOutPoint", testGetOutputByOutPoint},
+	{"FindUnspentOutputsByAddresses", testFindUnspentOutputsByAddresses},
+	{"GetAddress", testGetAddress},
+	{"UpsertAddressTxs", testUpsertAddressTxs},
+	{"UpdateAddressTxBlockIdByBlockId", testUpdateAddressTxBlockIdByBlockId},
+	{"RollbackAddressTxsByBlockId", testRollbackAddressTxsByBlockId},
+	{"ReplaceMemPoolTx", testReplaceMemPoolTx},
+	{"AverageBlockDataFieldByHeight", testAverageBlockDataFieldByHeight},
+	{"PercentileBlockDataFieldByHeight", testPercentileBlockDataFieldByHeight},
+}
+
+// Run runs every conformance test against a new store from newStore
+func Run(t *testing.T, newStore Factory) {
+
+	f, err := LoadFixtures()
+	if err != nil {
+		t.Fatalf("Could not load fixtures: %v", err)
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			bcs, done := newStore(t)
+			defer done()
+			if err := f.Load(bcs); err != nil {
+				t.Fatalf("Could not load fixtures: %v", err)
+			}
+			test.fn(t, bcs, f)
+		})
+	}
+
+}
+
+func testGetBlockByBlockId(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	blk, err := bcs.GetBlockByBlockId(Symbol, f.Blocks[2].BlockId, blocc.BlockIncludeAll)
+	assert.Nil(t, err)
+	assertBlocks(t, []*blocc.Block{f.Block(2)}, []*blocc.Block{blk})
+
+	blk, err = bcs.GetBlockByBlockId(Symbol, f.Blocks[2].BlockId, blocc.BlockIncludeHeader)
+	assert.Nil(t, err)
+	assertBlocks(t, []*blocc.Block{store.IncludeBlock(f.Block(2), blocc.BlockIncludeHeader)}, []*blocc.Block{blk})
+
+	_, err = bcs.GetBlockByBlockId(Symbol, "missing", blocc.BlockIncludeAll)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testGetBlockHeaderTopByStatuses(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	bh, err := bcs.GetBlockHeaderTopByStatuses(Symbol, []string{blocc.StatusValid})
+	assert.Nil(t, err)
+	assert.Equal(t, f.Blocks[4].BlockHeader(), bh)
+
+	// The orphan is counted so the number of blocks does not match the height
+	bh, err = bcs.GetBlockHeaderTopByStatuses(Symbol, nil)
+	assert.True(t, blocc.IsValidationError(err))
+	assert.Equal(t, f.Blocks[4].BlockHeader(), bh)
+
+	// Height 3 with only 1 block
+	bh, err = bcs.GetBlockHeaderTopByStatuses(Symbol, []string{blocc.StatusOrphaned})
+	assert.True(t, blocc.IsValidationError(err))
+	assert.Equal(t, f.Orphan().BlockHeader(), bh)
+
+	_, err = bcs.GetBlockHeaderTopByStatuses(Symbol, []string{blocc.StatusInvalid})
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testGetBlockTopByStatuses(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	blk, err := bcs.GetBlockTopByStatuses(Symbol, []string{blocc.StatusValid}, blocc.BlockIncludeAll)
+	assert.Nil(t, err)
+	assertBlocks(t, []*blocc.Block{f.Block(4)}, []*blocc.Block{blk})
+
+	// The block is still returned with a validation error
+	blk, err = bcs.GetBlockTopByStatuses(Symbol, nil, blocc.BlockIncludeHeader)
+	assert.True(t, blocc.IsValidationError(err))
+	assertBlocks(t, []*blocc.Block{store.IncludeBlock(f.Block(4), blocc.BlockIncludeHeader)}, []*blocc.Block{blk})
+
+	_, err = bcs.GetBlockTopByStatuses(Symbol, []string{blocc.StatusInvalid}, blocc.BlockIncludeHeader)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testFindBlocksByHeight(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	// Descending time
+	blks, err := bcs.FindBlocksByHeight(Symbol, 3, blocc.BlockIncludeAll)
+	assert.Nil(t, err)
+	assertBlocks(t, []*blocc.Block{f.Orphan(), f.Block(3)}, blks)
+
+	_, err = bcs.FindBlocksByHeight(Symbol, 99, blocc.BlockIncludeAll)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testFindBlocksByPrevBlockId(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	blks, err := bcs.FindBlocksByPrevBlockId(Symbol, f.Blocks[2].BlockId, blocc.BlockIncludeHeader)
+	assert.Nil(t, err)
+	assertBlockIds(t, []string{f.Orphan().BlockId, f.Blocks[3].BlockId}, blks)
+
+	_, err = bcs.FindBlocksByPrevBlockId(Symbol, "missing", blocc.BlockIncludeHeader)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testFindBlocksByTxId(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	blks, err := bcs.FindBlocksByTxId(Symbol, f.Blocks[2].TxIds[1], blocc.BlockIncludeTxIds)
+	assert.Nil(t, err)
+	assertBlocks(t, []*blocc.Block{store.IncludeBlock(f.Block(2), blocc.BlockIncludeTxIds)}, blks)
+
+	_, err = bcs.FindBlocksByTxId(Symbol, "missing", blocc.BlockIncludeHeader)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testFindBlocksByBlockIdsAndTime(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	orphan := f.Orphan().BlockId
+
+	// Descending time
+	blks, err := bcs.FindBlocksByBlockIdsAndTime(Symbol, nil, nil, nil, blocc.BlockIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assertBlockIds(t, []string{f.Blocks[4].BlockId, orphan, f.Blocks[3].BlockId, f.Blocks[2].BlockId, f.Blocks[1].BlockId, f.Blocks[0].BlockId}, blks)
+
+	// Inclusive time range
+	blks, err = bcs.FindBlocksByBlockIdsAndTime(Symbol, nil, unix(f.Blocks[2].Time), unix(f.Blocks[3].Time), blocc.BlockIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assertBlockIds(t, []string{f.Blocks[3].BlockId, f.Blocks[2].BlockId}, blks)
+
+	// Pagination
+	blks, err = bcs.FindBlocksByBlockIdsAndTime(Symbol, nil, nil, nil, blocc.BlockIncludeHeader, 1, 2)
+	assert.Nil(t, err)
+	assertBlockIds(t, []string{orphan, f.Blocks[3].BlockId}, blks)
+
+	// A page past the end is empty but not an error
+	blks, err = bcs.FindBlocksByBlockIdsAndTime(Symbol, nil, nil, nil, blocc.BlockIncludeHeader, 10, 10)
+	assert.Nil(t, err)
+	assert.Len(t, blks, 0)
+
+	// Missing blocks are skipped
+	blks, err = bcs.FindBlocksByBlockIdsAndTime(Symbol, []string{f.Blocks[0].BlockId, "missing", f.Blocks[1].BlockId}, nil, nil, blocc.BlockIncludeAll, 0, store.CountMax)
+	assert.Nil(t, err)
+	assertBlocks(t, []*blocc.Block{f.Block(1), f.Block(0)}, blks)
+
+	_, err = bcs.FindBlocksByBlockIdsAndTime(Symbol, nil, unix(f.Blocks[4].Time+1), nil, blocc.BlockIncludeHeader, 0, store.CountMax)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testFindBlocksByStatusAndHeight(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	// Ascending height
+	blks, err := bcs.FindBlocksByStatusAndHeight(Symbol, []string{blocc.StatusValid}, 1, 3, blocc.BlockIncludeAll, 0, store.CountMax)
+	assert.Nil(t, err)
+	assertBlocks(t, []*blocc.Block{f.Block(1), f.Block(2), f.Block(3)}, blks)
+
+	// Open ended
+	blks, err = bcs.FindBlocksByStatusAndHeight(Symbol, nil, blocc.HeightUnknown, blocc.HeightUnknown, blocc.BlockIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	if assert.Len(t, blks, 6) {
+		for x := 1; x < len(blks); x++ {
+			assert.True(t, blks[x-1].Height <= blks[x].Height)
+		}
+	}
+
+	blks, err = bcs.FindBlocksByStatusAndHeight(Symbol, []string{blocc.StatusValid, blocc.StatusOrphaned}, 3, blocc.HeightUnknown, blocc.BlockIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.Len(t, blks, 3)
+
+	// Pagination
+	blks, err = bcs.FindBlocksByStatusAndHeight(Symbol, nil, blocc.HeightUnknown, blocc.HeightUnknown, blocc.BlockIncludeHeader, 5, 1)
+	assert.Nil(t, err)
+	assertBlockIds(t, []string{f.Blocks[4].BlockId}, blks)
+
+	blks, err = bcs.FindBlocksByStatusAndHeight(Symbol, nil, blocc.HeightUnknown, blocc.HeightUnknown, blocc.BlockIncludeHeader, 6, 1)
+	assert.Nil(t, err)
+	assert.Len(t, blks, 0)
+
+	_, err = bcs.FindBlocksByStatusAndHeight(Symbol, []string{blocc.StatusInvalid}, blocc.HeightUnknown, blocc.HeightUnknown, blocc.BlockIncludeHeader, 0, store.CountMax)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testUpdateBlock(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	// Only the provided fields are changed and data is merged
+	assert.Nil(t, bcs.UpdateBlock(Symbol, f.Blocks[4].BlockId, "", "next", map[string]string{"extra": "1"}, map[string]float64{"metric": 1.5}))
+	// The block is created if it does not exist
+	assert.Nil(t, bcs.UpdateBlock(Symbol, "created", blocc.StatusNew, "", nil, nil))
+	flush(bcs)
+
+	expected := f.Block(4)
+	expected.NextBlockId = "next"
+	expected.Data["extra"] = "1"
+	expected.Metric = map[string]float64{"metric": 1.5}
+	blk, err := bcs.GetBlockByBlockId(Symbol, f.Blocks[4].BlockId, blocc.BlockIncludeAll)
+	assert.Nil(t, err)
+	assertBlocks(t, []*blocc.Block{expected}, []*blocc.Block{blk})
+
+	blk, err = bcs.GetBlockByBlockId(Symbol, "created", blocc.BlockIncludeHeader)
+	assert.Nil(t, err)
+	if assert.NotNil(t, blk) {
+		assert.Equal(t, blocc.StatusNew, blk.Status)
+	}
+
+}
+
+func testUpdateBlockStatusByStatusesAndHeight(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	assert.Nil(t, bcs.UpdateBlockStatusByStatusesAndHeight(Symbol, []string{blocc.StatusValid}, 3, blocc.HeightUnknown, blocc.StatusInvalid))
+	flush(bcs)
+
+	blks, err := bcs.FindBlocksByStatusAndHeight(Symbol, []string{blocc.StatusInvalid}, blocc.HeightUnknown, blocc.HeightUnknown, blocc.BlockIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assertBlockIds(t, []string{f.Blocks[3].BlockId, f.Blocks[4].BlockId}, blks)
+
+	// Other statuses are untouched
+	blk, err := bcs.GetBlockByBlockId(Symbol, f.Orphan().BlockId, blocc.BlockIncludeHeader)
+	assert.Nil(t, err)
+	assert.Equal(t, blocc.StatusOrphaned, blk.Status)
+
+	bh, err := bcs.GetBlockHeaderTopByStatuses(Symbol, []string{blocc.StatusValid})
+	assert.Nil(t, err)
+	assert.Equal(t, f.Blocks[2].BlockHeader(), bh)
+
+}
+
+func testDeleteBlockByBlockId(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	orphan := f.Orphan().BlockId
+	assert.Nil(t, bcs.DeleteBlockByBlockId(Symbol, orphan))
+	flush(bcs)
+
+	_, err := bcs.GetBlockByBlockId(Symbol, orphan, blocc.BlockIncludeHeader)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+	assert.Equal(t, blocc.ErrNotFound, bcs.DeleteBlockByBlockId(Symbol, orphan))
+
+	// Now the chain adds up
+	bh, err := bcs.GetBlockHeaderTopByStatuses(Symbol, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, f.Blocks[4].BlockHeader(), bh)
+
+}
+
+func testDeleteAboveBlockHeight(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	assert.Nil(t, bcs.DeleteAboveBlockHeight(Symbol, 2))
+	flush(bcs)
+
+	blks, err := bcs.FindBlocksByStatusAndHeight(Symbol, nil, blocc.HeightUnknown, blocc.HeightUnknown, blocc.BlockIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assertBlockIds(t, []string{f.Blocks[0].BlockId, f.Blocks[1].BlockId, f.Blocks[2].BlockId}, blks)
+
+	// Transactions in the removed blocks are removed, the mempool is not
+	_, err = bcs.GetTxByTxId(Symbol, f.Blocks[3].TxIds[1], blocc.TxIncludeHeader)
+	assert.Equal(t, blocc.ErrNotFound, err)
+	_, err = bcs.GetTxByTxId(Symbol, f.Blocks[2].TxIds[1], blocc.TxIncludeHeader)
+	assert.Nil(t, err)
+	_, count, err := bcs.GetMemPoolStats(Symbol)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), count)
+
+	// Outputs created above are removed and those spent above are unspent again
+	outputs, err := bcs.FindUnspentOutputsByAddresses(Symbol, []string{f.AddressB}, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{outPoint(f.Blocks[2].TxIds[0], 0), outPoint(f.Blocks[2].TxIds[1], 0)}, outPoints(outputs))
+	for _, o := range outputs {
+		assert.Empty(t, o.SpentTxId)
+	}
+	outputs, err = bcs.FindUnspentOutputsByAddresses(Symbol, []string{f.AddressA}, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{outPoint(f.MemPool()[1].TxId, 0)}, outPoints(outputs))
+
+	// Address transactions above are removed from the summaries
+	for _, address := range []string{f.AddressA, f.AddressB, f.AddressC} {
+		a, err := bcs.GetAddress(Symbol, address)
+		assert.Nil(t, err)
+		assertJSONEq(t, f.Address(address, f.Blocks[3].BlockId, f.Blocks[4].BlockId, f.Orphan().BlockId), a, address)
+	}
+
+}
+
+func testGetTxByTxId(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	for _, include := range []blocc.TxInclude{blocc.TxIncludeAll, blocc.TxIncludeHeader, blocc.TxIncludeIn | blocc.TxIncludeOut} {
+		for _, txId := range []string{f.Blocks[2].TxIds[1], f.Blocks[3].TxIds[1], f.MemPool()[1].TxId} {
+			tx, err := bcs.GetTxByTxId(Symbol, txId, include)
+			assert.Nil(t, err)
+			assertTxs(t, []*blocc.Tx{store.IncludeTx(f.Tx(txId), include)}, []*blocc.Tx{tx})
+		}
+	}
+
+	_, err := bcs.GetTxByTxId(Symbol, "missing", blocc.TxIncludeAll)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testGetTxsByTxIds(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	// Missing transactions are skipped
+	txs, err := bcs.GetTxsByTxIds(Symbol, []string{f.Blocks[3].TxIds[1], "missing", f.Blocks[2].TxIds[1]}, blocc.TxIncludeAll)
+	assert.Nil(t, err)
+	if assert.Len(t, txs, 2) {
+		for _, tx := range txs {
+			assertTxs(t, []*blocc.Tx{f.Tx(tx.TxId)}, []*blocc.Tx{tx})
+		}
+		assert.ElementsMatch(t, []string{f.Blocks[3].TxIds[1], f.Blocks[2].TxIds[1]}, txIds(txs))
+	}
+
+}
+
+func testGetTxsByBlockId(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	// Ascending height in the block
+	txs, err := bcs.GetTxsByBlockId(Symbol, f.Blocks[3].BlockId, blocc.TxIncludeAll)
+	assert.Nil(t, err)
+	assertTxs(t, f.TxsByBlockId(f.Blocks[3].BlockId), txs)
+
+	_, err = bcs.GetTxsByBlockId(Symbol, "missing", blocc.TxIncludeAll)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testGetTxCountByBlockId(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	for _, test := range []struct {
+		blockId           string
+		includeIncomplete bool
+		count             int64
+	}{
+		{f.Blocks[3].BlockId, false, 3},
+		{blocc.BlockIdMempool, true, 2},
+		{blocc.BlockIdMempool, false, 1},
+		{"missing", true, 0},
+	} {
+		count, err := bcs.GetTxCountByBlockId(Symbol, test.blockId, test.includeIncomplete)
+		assert.Nil(t, err)
+		assert.Equal(t, test.count, count, "blockId:%s includeIncomplete:%v", test.blockId, test.includeIncomplete)
+	}
+
+}
+
+func testFindTxs(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	mempool := f.MemPool()
+
+	// Descending time
+	txs, err := bcs.FindTxs(Symbol, nil, blocc.BlockIdMempool, nil, blocc.TxFilterIncompleteAll, nil, nil, blocc.TxIncludeAll, 0, store.CountMax)
+	assert.Nil(t, err)
+	assertTxs(t, []*blocc.Tx{mempool[1], mempool[0]}, txs)
+
+	txs, err = bcs.FindTxs(Symbol, nil, "", map[string]string{"coinbase": "true"}, blocc.TxFilterIncompleteAll, nil, nil, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 6)
+
+	txs, err = bcs.FindTxs(Symbol, nil, "", nil, blocc.TxFilterIncompleteTrue, nil, nil, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{mempool[1].TxId}, txIds(txs))
+
+	txs, err = bcs.FindTxs(Symbol, nil, "", nil, blocc.TxFilterIncompleteFalse, nil, nil, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.Len(t, txs, len(f.Txs)-1)
+
+	// All of the criteria must match
+	txs, err = bcs.FindTxs(Symbol, []string{f.Blocks[2].TxIds[1], f.Blocks[3].TxIds[1]}, f.Blocks[3].BlockId, nil, blocc.TxFilterIncompleteAll, nil, nil, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{f.Blocks[3].TxIds[1]}, txIds(txs))
+
+	txs, err = bcs.FindTxs(Symbol, nil, "", nil, blocc.TxFilterIncompleteAll, unix(mempool[0].Time+1), nil, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{mempool[1].TxId}, txIds(txs))
+
+	// Pagination
+	txs, err = bcs.FindTxs(Symbol, nil, blocc.BlockIdMempool, nil, blocc.TxFilterIncompleteAll, nil, nil, blocc.TxIncludeHeader, 1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{mempool[0].TxId}, txIds(txs))
+
+	txs, err = bcs.FindTxs(Symbol, nil, blocc.BlockIdMempool, nil, blocc.TxFilterIncompleteAll, nil, nil, blocc.TxIncludeHeader, 5, 1)
+	assert.Nil(t, err)
+	assert.Len(t, txs, 0)
+
+	_, err = bcs.FindTxs(Symbol, nil, "missing", nil, blocc.TxFilterIncompleteAll, nil, nil, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testFindTxsByAddressesAndTime(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	mempool := f.MemPool()
+
+	txs, err := bcs.FindTxsByAddressesAndTime(Symbol, []string{f.AddressC}, nil, nil, blocc.TxFilterAddressOutput, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{f.Blocks[2].TxIds[1], f.Blocks[3].TxIds[2], f.Blocks[4].TxIds[0], f.Orphan().TxIds[0], mempool[0].TxId}, txIds(txs))
+
+	_, err = bcs.FindTxsByAddressesAndTime(Symbol, []string{f.AddressC}, nil, nil, blocc.TxFilterAddressInput, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+	// Descending time
+	txs, err = bcs.FindTxsByAddressesAndTime(Symbol, []string{f.AddressA}, nil, nil, blocc.TxFilterAddressInput, blocc.TxIncludeAll, 0, store.CountMax)
+	assert.Nil(t, err)
+	assertTxs(t, []*blocc.Tx{mempool[0], f.Tx(f.Blocks[2].TxIds[1])}, txs)
+
+	txs, err = bcs.FindTxsByAddressesAndTime(Symbol, []string{f.AddressB}, nil, nil, blocc.TxFilterAddressInputOutput, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{f.Blocks[2].TxIds[0], f.Blocks[2].TxIds[1], f.Blocks[3].TxIds[1], f.Blocks[3].TxIds[2]}, txIds(txs))
+
+	// Multiple addresses
+	txs, err = bcs.FindTxsByAddressesAndTime(Symbol, []string{f.AddressA, f.AddressB}, unix(f.Blocks[3].Time), unix(f.Blocks[3].Time), blocc.TxFilterAddressOutput, blocc.TxIncludeHeader, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{f.Blocks[3].TxIds[0], f.Blocks[3].TxIds[1]}, txIds(txs))
+
+	// Pagination
+	txs, err = bcs.FindTxsByAddressesAndTime(Symbol, []string{f.AddressA}, nil, nil, blocc.TxFilterAddressOutput, blocc.TxIncludeHeader, 0, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{mempool[1].TxId}, txIds(txs))
+
+	txs, err = bcs.FindTxsByAddressesAndTime(Symbol, []string{f.AddressA}, nil, nil, blocc.TxFilterAddressOutput, blocc.TxIncludeHeader, 3, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{f.Blocks[1].TxIds[0]}, txIds(txs))
+
+}
+
+func testUpsertTransaction(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	mempool := f.MemPool()
+
+	// Only the provided fields are changed and data is merged
+	assert.Nil(t, bcs.UpsertTransaction(Symbol, &blocc.Tx{
+		TxId:        mempool[0].TxId,
+		BlockId:     f.Blocks[4].BlockId,
+		BlockHeight: 4,
+		BlockTime:   f.Blocks[4].Time,
+		Data:        map[string]string{"extra": "1"},
+	}))
+	// Incomplete is always replaced
+	assert.Nil(t, bcs.UpsertTransaction(Symbol, &blocc.Tx{TxId: mempool[1].TxId, Incomplete: false}))
+	// The transaction is created if it does not exist
+	assert.Nil(t, bcs.UpsertTransaction(Symbol, &blocc.Tx{TxId: "created", BlockId: blocc.BlockIdMempool, Time: mempool[1].Time}))
+	flush(bcs)
+
+	expected := mempool[0]
+	expected.BlockId = f.Blocks[4].BlockId
+	expected.BlockHeight = 4
+	expected.BlockTime = f.Blocks[4].Time
+	expected.Data["extra"] = "1"
+	tx, err := bcs.GetTxByTxId(Symbol, mempool[0].TxId, blocc.TxIncludeAll)
+	assert.Nil(t, err)
+	assertTxs(t, []*blocc.Tx{expected}, []*blocc.Tx{tx})
+
+	tx, err = bcs.GetTxByTxId(Symbol, mempool[1].TxId, blocc.TxIncludeHeader)
+	assert.Nil(t, err)
+	assert.False(t, tx.Incomplete)
+
+	txs, err := bcs.GetTxsByBlockId(Symbol, blocc.BlockIdMempool, blocc.TxIncludeHeader)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{mempool[1].TxId, "created"}, txIds(txs))
+
+}
+
+func testUpdateTxBlockIdByBlockId(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	assert.Nil(t, bcs.UpdateTxBlockIdByBlockId(Symbol, blocc.BlockIdMempool, "moved"))
+	flush(bcs)
+
+	txs, err := bcs.GetTxsByBlockId(Symbol, "moved", blocc.TxIncludeHeader)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, txIds(f.MemPool()), txIds(txs))
+
+	_, err = bcs.GetTxsByBlockId(Symbol, blocc.BlockIdMempool, blocc.TxIncludeHeader)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testDeleteTransactionsByBlockIdAndTime(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	mempool := f.MemPool()
+
+	// Inclusive time range
+	assert.Nil(t, bcs.DeleteTransactionsByBlockIdAndTime(Symbol, blocc.BlockIdMempool, unix(mempool[1].Time), nil))
+	flush(bcs)
+	txs, err := bcs.GetTxsByBlockId(Symbol, blocc.BlockIdMempool, blocc.TxIncludeHeader)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{mempool[0].TxId}, txIds(txs))
+
+	assert.Nil(t, bcs.DeleteTransactionsByBlockIdAndTime(Symbol, blocc.BlockIdMempool, nil, nil))
+	flush(bcs)
+	_, err = bcs.GetTxsByBlockId(Symbol, blocc.BlockIdMempool, blocc.TxIncludeHeader)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+	// Other blocks are untouched
+	count, err := bcs.GetTxCountByBlockId(Symbol, f.Blocks[3].BlockId, true)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), count)
+
+}
+
+func testGetMemPoolStats(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	mempool := f.MemPool()
+
+	size, count, err := bcs.GetMemPoolStats(Symbol)
+	assert.Nil(t, err)
+	assert.Equal(t, mempool[0].TxSize+mempool[1].TxSize, size)
+	assert.Equal(t, int64(2), count)
+
+}
+
+func testGetAddressStats(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	count, outputValue, inputValue, err := bcs.GetAddressStats(Symbol, f.AddressB)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), count)
+	assert.Equal(t, int64(9999990000), outputValue)
+	assert.Equal(t, int64(8000010000), inputValue)
+
+	count, outputValue, inputValue, err = bcs.GetAddressStats(Symbol, "missing")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), count)
+	assert.Equal(t, int64(0), outputValue)
+	assert.Equal(t, int64(0), inputValue)
+
+}
+
+func testUpsertOutputs(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	m1 := f.MemPool()[0]
+	tx3a := f.Tx(f.Blocks[3].TxIds[1])
+
+	// A spend in the mempool never replaces a spend in a block
+	assert.Nil(t, bcs.UpsertOutputs(Symbol, []*blocc.Output{{
+		TxId:             f.Blocks[2].TxIds[1],
+		Height:           0,
+		SpentTxId:        m1.TxId,
+		SpentBlockId:     blocc.BlockIdMempool,
+		SpentBlockHeight: blocc.HeightUnknown,
+	}}))
+	flush(bcs)
+	outputs, err := bcs.FindUnspentOutputsByAddresses(Symbol, []string{f.AddressB}, 0, store.CountMax)
+	assert.Nil(t, err)
+	assertOutputs(t, f.UnspentOutputs(f.AddressB), outputs)
+
+	// A spend in a block replaces a spend in the mempool
+	assert.Nil(t, bcs.UpsertOutputs(Symbol, []*blocc.Output{{
+		TxId:             tx3a.TxId,
+		Height:           0,
+		SpentTxId:        m1.TxId,
+		SpentBlockId:     f.Blocks[4].BlockId,
+		SpentBlockHeight: f.Blocks[4].Height,
+	}}))
+	flush(bcs)
+	outputs, err = bcs.FindUnspentOutputsByAddresses(Symbol, []string{f.AddressA}, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{outPoint(f.MemPool()[1].TxId, 0), outPoint(f.Blocks[3].TxIds[0], 0)}, outPoints(outputs))
+
+	// The spend can arrive before the output is created
+	spent := &blocc.Output{
+		TxId:             "fffe",
+		Height:           1,
+		SpentTxId:        m1.TxId,
+		SpentBlockId:     blocc.BlockIdMempool,
+		SpentBlockHeight: blocc.HeightUnknown,
+	}
+	assert.Nil(t, bcs.UpsertOutputs(Symbol, []*blocc.Output{spent}))
+	flush(bcs)
+	_, err = bcs.FindUnspentOutputsByAddresses(Symbol, []string{"address-new"}, 0, store.CountMax)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+	created := &blocc.Output{
+		Symbol:      Symbol,
+		TxId:        "fffe",
+		Height:      1,
+		BlockId:     f.Blocks[4].BlockId,
+		BlockHeight: f.Blocks[4].Height,
+		Time:        f.Blocks[4].Time,
+		Out:         &blocc.TxOut{Type: "pubkeyhash", Addresses: []string{"address-new"}, Value: 1234},
+	}
+	assert.Nil(t, bcs.UpsertOutputs(Symbol, []*blocc.Output{created}))
+	flush(bcs)
+	outputs, err = bcs.FindUnspentOutputsByAddresses(Symbol, []string{"address-new"}, 0, store.CountMax)
+	assert.Nil(t, err)
+	expected := proto.Clone(created).(*blocc.Output)
+	expected.SpentTxId = spent.SpentTxId
+	expected.SpentBlockId = spent.SpentBlockId
+	expected.SpentBlockHeight = spent.SpentBlockHeight
+	assertOutputs(t, []*blocc.Output{expected}, outputs)
+
+}
+
+func testUpdateOutputBlockIdByBlockId(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	assert.Nil(t, bcs.UpdateOutputBlockIdByBlockId(Symbol, blocc.BlockIdMempool, blocc.BlockIdMempoolUpdate))
+	flush(bcs)
+
+	outputs, err := bcs.FindUnspentOutputsByAddresses(Symbol, []string{f.AddressA, f.AddressC}, 0, store.CountMax)
+	assert.Nil(t, err)
+	expected := f.UnspentOutputs(f.AddressA, f.AddressC)
+	for _, o := range expected {
+		if o.BlockId == blocc.BlockIdMempool {
+			o.BlockId = blocc.BlockIdMempoolUpdate
+		}
+		if o.SpentBlockId == blocc.BlockIdMempool {
+			o.SpentBlockId = blocc.BlockIdMempoolUpdate
+		}
+	}
+	assertOutputs(t, expected, outputs)
+
+	// Scrubbing the mempool-update leaves only the outputs in blocks unspent
+	assert.Nil(t, bcs.RollbackOutputsByBlockId(Symbol, blocc.BlockIdMempoolUpdate))
+	flush(bcs)
+
+	outputs, err = bcs.FindUnspentOutputsByAddresses(Symbol, []string{f.AddressA, f.AddressC}, 0, store.CountMax)
+	assert.Nil(t, err)
+	for _, o := range outputs {
+		assert.False(t, blocc.IsMemPool(o.BlockId))
+		assert.Empty(t, o.SpentTxId)
+	}
+	assert.Contains(t, outPoints(outputs), outPoint(f.Blocks[3].TxIds[1], 0))
+
+}
+
+func testRollbackOutputsByBlockId(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	// The orphan only paid address C
+	assert.Nil(t, bcs.RollbackOutputsByBlockId(Symbol, f.Orphan().BlockId))
+	flush(bcs)
+	outputs, err := bcs.FindUnspentOutputsByAddresses(Symbol, []string{f.AddressC}, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.NotContains(t, outPoints(outputs), outPoint(f.Orphan().TxIds[0], 0))
+	assert.Len(t, outputs, len(f.UnspentOutputs(f.AddressC))-1)
+
+	// Rolling back block 3 removes it's outputs and unspends the outputs of block 2
+	assert.Nil(t, bcs.RollbackOutputsByBlockId(Symbol, f.Blocks[3].BlockId))
+	flush(bcs)
+	outputs, err = bcs.FindUnspentOutputsByAddresses(Symbol, []string{f.AddressB}, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{outPoint(f.Blocks[2].TxIds[0], 0), outPoint(f.Blocks[2].TxIds[1], 0)}, outPoints(outputs))
+
+	// The mempool spend of an output in block 3 no longer has an output
+	outputs, err = bcs.FindUnspentOutputsByAddresses(Symbol, []string{f.AddressA}, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{outPoint(f.MemPool()[1].TxId, 0)}, outPoints(outputs))
+
+}
+
+func testGetOutputByOutPoint(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	// An output of block 2 spent in block 3
+	tx2 := f.Tx(f.Blocks[2].TxIds[1])
+	o, err := bcs.GetOutputByOutPoint(Symbol, tx2.TxId, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, f.Blocks[2].BlockId, o.BlockId)
+	assert.Equal(t, tx2.Out[0].Value, o.Out.Value)
+	assert.Equal(t, f.Blocks[3].BlockId, o.SpentBlockId)
+	assert.Equal(t, f.Blocks[3].Height, o.SpentBlockHeight)
+	spender := f.Tx(o.SpentTxId)
+	if assert.NotNil(t, spender) && assert.True(t, int(o.SpentHeight) < len(spender.In)) {
+		assert.Equal(t, tx2.TxId, spender.In[o.SpentHeight].TxId)
+		assert.Equal(t, int64(0), spender.In[o.SpentHeight].Height)
+	}
+
+	// An output of block 3 spent in the mempool
+	o, err = bcs.GetOutputByOutPoint(Symbol, f.Blocks[3].TxIds[1], 0)
+	assert.Nil(t, err)
+	assert.Equal(t, f.MemPool()[0].TxId, o.SpentTxId)
+	assert.Equal(t, blocc.BlockIdMempool, o.SpentBlockId)
+
+	// An unspent output
+	o, err = bcs.GetOutputByOutPoint(Symbol, f.MemPool()[1].TxId, 0)
+	assert.Nil(t, err)
+	assert.Empty(t, o.SpentTxId)
+
+	_, err = bcs.GetOutputByOutPoint(Symbol, f.Blocks[2].TxIds[1], 99)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testFindUnspentOutputsByAddresses(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	for _, addresses := range [][]string{{f.AddressA}, {f.AddressB}, {f.AddressC}, {f.AddressA, f.AddressB, f.AddressC}} {
+		outputs, err := bcs.FindUnspentOutputsByAddresses(Symbol, addresses, 0, store.CountMax)
+		assert.Nil(t, err)
+		assertOutputs(t, f.UnspentOutputs(addresses...), outputs)
+	}
+
+	// Address A has the mempool output, the coinbase of block 3 and the output of block 3 spent in the mempool
+	outputs, err := bcs.FindUnspentOutputsByAddresses(Symbol, []string{f.AddressA}, 0, store.CountMax)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{outPoint(f.MemPool()[1].TxId, 0), outPoint(f.Blocks[3].TxIds[0], 0), outPoint(f.Blocks[3].TxIds[1], 0)}, outPoints(outputs))
+	for _, o := range outputs {
+		if o.TxId == f.Blocks[3].TxIds[1] {
+			assert.Equal(t, f.MemPool()[0].TxId, o.SpentTxId)
+			assert.Equal(t, blocc.BlockIdMempool, o.SpentBlockId)
+		}
+	}
+
+	// Pages
+	outputs, err = bcs.FindUnspentOutputsByAddresses(Symbol, []string{f.AddressA}, 1, 1)
+	assert.Nil(t, err)
+	assertOutputs(t, f.UnspentOutputs(f.AddressA)[1:2], outputs)
+
+	// An empty page past the end is not an error
+	outputs, err = bcs.FindUnspentOutputsByAddresses(Symbol, []string{f.AddressA}, 10, 10)
+	assert.Nil(t, err)
+	assert.Len(t, outputs, 0)
+
+	_, err = bcs.FindUnspentOutputsByAddresses(Symbol, []string{"missing"}, 0, store.CountMax)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testGetAddress(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	for _, address := range []string{f.AddressA, f.AddressB, f.AddressC} {
+		a, err := bcs.GetAddress(Symbol, address)
+		assert.Nil(t, err)
+		assertJSONEq(t, f.Address(address), a, address)
+	}
+
+	// Address A has spent in the mempool and received in a mempool transaction
+	a, err := bcs.GetAddress(Symbol, f.AddressA)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), a.MempoolTxCount)
+	assert.Equal(t, a.Received-a.Sent, a.Balance)
+	assert.Equal(t, f.Blocks[1].BlockId, a.FirstSeenBlockId)
+	assert.Equal(t, f.Blocks[3].BlockId, a.LastSeenBlockId)
+
+	_, err = bcs.GetAddress(Symbol, "missing")
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testUpsertAddressTxs(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	// The first mempool transaction is mined in a new block
+	m1 := f.MemPool()[0]
+	mined := cloneTx(m1)
+	mined.BlockId = "fffb"
+	mined.BlockHeight = 5
+	mined.Time = f.Blocks[4].Time + 600
+
+	assert.Nil(t, bcs.UpsertAddressTxs(Symbol, store.AddressTxs(mined)))
+	// Upserting the same address transactions again changes nothing
+	assert.Nil(t, bcs.UpsertAddressTxs(Symbol, store.AddressTxs(mined)))
+	flush(bcs)
+
+	g := *f
+	g.AddressTxs = make([]*blocc.AddressTx, 0, len(f.AddressTxs))
+	for _, atx := range f.AddressTxs {
+		if atx.TxId != m1.TxId {
+			g.AddressTxs = append(g.AddressTxs, atx)
+		}
+	}
+	g.AddressTxs = append(g.AddressTxs, store.AddressTxs(mined)...)
+
+	for _, address := range []string{f.AddressA, f.AddressB, f.AddressC} {
+		a, err := bcs.GetAddress(Symbol, address)
+		assert.Nil(t, err)
+		assertJSONEq(t, g.Address(address), a, address)
+	}
+	a, err := bcs.GetAddress(Symbol, f.AddressA)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), a.MempoolTxCount)
+	assert.Equal(t, "fffb", a.LastSeenBlockId)
+	assert.Equal(t, int64(5), a.LastSeenBlockHeight)
+
+	// Returning it to the mempool restores the original summaries
+	assert.Nil(t, bcs.UpsertAddressTxs(Symbol, store.AddressTxs(m1)))
+	flush(bcs)
+	for _, address := range []string{f.AddressA, f.AddressB, f.AddressC} {
+		a, err := bcs.GetAddress(Symbol, address)
+		assert.Nil(t, err)
+		assertJSONEq(t, f.Address(address), a, address)
+	}
+
+}
+
+func testUpdateAddressTxBlockIdByBlockId(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	// The mempool-update flag is still the mempool
+	assert.Nil(t, bcs.UpdateAddressTxBlockIdByBlockId(Symbol, blocc.BlockIdMempool, blocc.BlockIdMempoolUpdate))
+	flush(bcs)
+	for _, address := range []string{f.AddressA, f.AddressC} {
+		a, err := bcs.GetAddress(Symbol, address)
+		assert.Nil(t, err)
+		assertJSONEq(t, f.Address(address), a, address)
+	}
+
+	// Scrubbing the mempool-update leaves only the confirmed transactions
+	assert.Nil(t, bcs.RollbackAddressTxsByBlockId(Symbol, blocc.BlockIdMempoolUpdate))
+	flush(bcs)
+	for _, address := range []string{f.AddressA, f.AddressC} {
+		a, err := bcs.GetAddress(Symbol, address)
+		assert.Nil(t, err)
+		assertJSONEq(t, f.Address(address, blocc.BlockIdMempool), a, address)
+		assert.Equal(t, int64(0), a.MempoolTxCount)
+	}
+
+}
+
+// testReplaceMemPoolTx evicts a replaced mempool transaction the way the extractor does when a conflicting one arrives
+func testReplaceMemPoolTx(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	mempool := f.MemPool()
+	tx := mempool[1]
+	tx.BlockId = blocc.BlockIdMempoolReplaced
+	tx.Data["replaced_by"] = "replacement"
+
+	assert.Nil(t, bcs.UpsertTransaction(Symbol, tx))
+	assert.Nil(t, bcs.UpsertOutputs(Symbol, btc.TrackOutputs(tx, false)))
+	assert.Nil(t, bcs.UpsertAddressTxs(Symbol, store.AddressTxs(tx)))
+	flush(bcs)
+
+	// It is still in the mempool until it's removed
+	size, count, err := bcs.GetMemPoolStats(Symbol)
+	assert.Nil(t, err)
+	assert.Equal(t, mempool[0].TxSize+mempool[1].TxSize, size)
+	assert.Equal(t, int64(2), count)
+	for _, address := range []string{f.AddressA, f.AddressB, f.AddressC} {
+		a, err := bcs.GetAddress(Symbol, address)
+		assert.Nil(t, err)
+		assertJSONEq(t, f.Address(address), a, address)
+	}
+
+	assert.Nil(t, bcs.DeleteTransactionsByBlockIdAndTime(Symbol, blocc.BlockIdMempoolReplaced, nil, nil))
+	assert.Nil(t, bcs.RollbackOutputsByBlockId(Symbol, blocc.BlockIdMempoolReplaced))
+	assert.Nil(t, bcs.RollbackAddressTxsByBlockId(Symbol, blocc.BlockIdMempoolReplaced))
+	flush(bcs)
+
+	_, err = bcs.GetTxByTxId(Symbol, tx.TxId, blocc.TxIncludeHeader)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+	size, count, err = bcs.GetMemPoolStats(Symbol)
+	assert.Nil(t, err)
+	assert.Equal(t, mempool[0].TxSize, size)
+	assert.Equal(t, int64(1), count)
+
+	// The fixtures without the replaced transaction
+	without := *f
+	without.Outputs = make([]*blocc.Output, 0)
+	for _, o := range f.Outputs {
+		if o.TxId != tx.TxId && o.SpentTxId != tx.TxId {
+			without.Outputs = append(without.Outputs, o)
+		}
+	}
+	without.AddressTxs = make([]*blocc.AddressTx, 0)
+	for _, atx := range f.AddressTxs {
+		if atx.TxId != tx.TxId {
+			without.AddressTxs = append(without.AddressTxs, atx)
+		}
+	}
+
+	outputs, err := bcs.FindUnspentOutputsByAddresses(Symbol, []string{f.AddressA, f.AddressB, f.AddressC}, 0, store.CountMax)
+	assert.Nil(t, err)
+	assertOutputs(t, without.UnspentOutputs(f.AddressA, f.AddressB, f.AddressC), outputs)
+
+	for _, address := range []string{f.AddressA, f.AddressB, f.AddressC} {
+		a, err := bcs.GetAddress(Symbol, address)
+		if expected := without.Address(address); expected == nil {
+			assert.Equal(t, blocc.ErrNotFound, err, address)
+		} else {
+			assert.Nil(t, err)
+			assertJSONEq(t, expected, a, address)
+		}
+	}
+
+}
+
+func testRollbackAddressTxsByBlockId(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	// Rolling back the first and last seen blocks finds the next ones
+	assert.Nil(t, bcs.RollbackAddressTxsByBlockId(Symbol, f.Blocks[1].BlockId))
+	assert.Nil(t, bcs.RollbackAddressTxsByBlockId(Symbol, f.Blocks[4].BlockId))
+	flush(bcs)
+	for _, address := range []string{f.AddressA, f.AddressB, f.AddressC} {
+		a, err := bcs.GetAddress(Symbol, address)
+		assert.Nil(t, err)
+		assertJSONEq(t, f.Address(address, f.Blocks[1].BlockId, f.Blocks[4].BlockId), a, address)
+	}
+	a, err := bcs.GetAddress(Symbol, f.AddressA)
+	assert.Nil(t, err)
+	assert.Equal(t, f.Blocks[2].BlockId, a.FirstSeenBlockId)
+	a, err = bcs.GetAddress(Symbol, f.AddressC)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), a.LastSeenBlockHeight)
+
+	// An address without transactions is not found
+	genesis := f.AddressTxs[0].Address
+	assert.Nil(t, bcs.RollbackAddressTxsByBlockId(Symbol, f.Blocks[0].BlockId))
+	flush(bcs)
+	_, err = bcs.GetAddress(Symbol, genesis)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testAverageBlockDataFieldByHeight(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	// Blocks 2 and 3 have fees of 10000 and 70000
+	avg, err := bcs.AverageBlockDataFieldByHeight(Symbol, "data.fee", true, blocc.HeightUnknown, blocc.HeightUnknown)
+	assert.Nil(t, err)
+	assert.InDelta(t, 40000.0, avg, 0.01)
+
+	avg, err = bcs.AverageBlockDataFieldByHeight(Symbol, "data.fee", false, blocc.HeightUnknown, blocc.HeightUnknown)
+	assert.Nil(t, err)
+	assert.InDelta(t, 80000.0/6, avg, 0.01)
+
+	avg, err = bcs.AverageBlockDataFieldByHeight(Symbol, "data.fee", true, 3, 4)
+	assert.Nil(t, err)
+	assert.InDelta(t, 70000.0, avg, 0.01)
+
+	_, err = bcs.AverageBlockDataFieldByHeight(Symbol, "data.missing", true, blocc.HeightUnknown, blocc.HeightUnknown)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+func testPercentileBlockDataFieldByHeight(t *testing.T, bcs blocc.BlockChainStore, f *Fixtures) {
+
+	value, err := bcs.PercentileBlockDataFieldByHeight(Symbol, "data.fee", 50, true, blocc.HeightUnknown, blocc.HeightUnknown)
+	assert.Nil(t, err)
+	assert.InDelta(t, 40000.0, value, 0.01)
+
+	value, err = bcs.PercentileBlockDataFieldByHeight(Symbol, "data.fee", 100, false, blocc.HeightUnknown, 2)
+	assert.Nil(t, err)
+	assert.InDelta(t, 10000.0, value, 0.01)
+
+	_, err = bcs.PercentileBlockDataFieldByHeight(Symbol, "data.missing", 50, true, blocc.HeightUnknown, blocc.HeightUnknown)
+	assert.Equal(t, blocc.ErrNotFound, err)
+
+}
+
+// flush writes any pending changes
+func flush(bcs blocc.BlockChainStore) error {
+	if err := bcs.FlushBlocks(Symbol); err != nil {
+		return err
+	}
+	return bcs.FlushTransactions(Symbol)
+}
+
+// assertBlocks checks the blocks match in order
+func assertBlocks(t *testing.T, expected []*blocc.Block, actual []*blocc.Block) {
+	t.Helper()
+	if !assert.Len(t, actual, len(expected)) {
+		return
+	}
+	for x := range expected {
+		assertJSONEq(t, expected[x], actual[x], "block %d", x)
+	}
+}
+
+// assertJSONEq compares through JSON so that nil and empty maps, slices and bytes are equal
+func assertJSONEq(t *testing.T, expected interface{}, actual interface{}, msgAndArgs ...interface{}) {
+	t.Helper()
+	e, err := json.Marshal(expected)
+	assert.Nil(t, err)
+	a, err := json.Marshal(actual)
+	assert.Nil(t, err)
+	assert.JSONEq(t, string(e), string(a), msgAndArgs...)
+}
+
+// assertBlockIds checks the blocks have the blockIds in order
+func assertBlockIds(t *testing.T, expected []string, actual []*blocc.Block) {
+	t.Helper()
+	blockIds := make([]string, len(actual))
+	for x, blk := range actual {
+		blockIds[x] = blk.BlockId
+	}
+	assert.Equal(t, expected, blockIds)
+}
+
+// assertTxs checks the transactions match in order
+func assertTxs(t *testing.T, expected []*blocc.Tx, actual []*blocc.Tx) {
+	t.Helper()
+	if !assert.Len(t, actual, len(expected)) {
+		return
+	}
+	for x := range expected {
+		assertJSONEq(t, expected[x], actual[x], "tx %d", x)
+	}
+}
+
+// assertOutputs checks the outputs match in order
+func assertOutputs(t *testing.T, expected []*blocc.Output, actual []*blocc.Output) {
+	t.Helper()
+	if !assert.Len(t, actual, len(expected)) {
+		return
+	}
+	for x := range expected {
+		assertJSONEq(t, expected[x], actual[x], "output %d", x)
+	}
+}
+
+func outPoint(txId string, height int64) string {
+	return fmt.Sprintf("%s:%d", txId, height)
+}
+
+func outPoints(outputs []*blocc.Output) []string {
+	ret := make([]string, len(outputs))
+	for x, o := range outputs {
+		ret[x] = outPoint(o.TxId, o.Height)
+	}
+	return ret
+}
+
+func txIds(txs []*blocc.Tx) []string {
+	ret := make([]string, len(txs))
+	for x, tx := range txs {
+		ret[x] = tx.TxId
+	}
+	return ret
+}
+
+func unix(t int64) *time.Time {
+	ret := time.Unix(t, 0)
+	return &ret
+}
+
+func cloneBlock(blk *blocc.Block) *blocc.Block {
+	return proto.Clone(blk).(*blocc.Block)
+}
+
+func cloneTx(tx *blocc.Tx) *blocc.Tx {
+	return proto.Clone(tx).(*blocc.Tx)
+}